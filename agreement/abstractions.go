@@ -305,6 +305,13 @@ type EventsProcessingMonitor interface {
 	UpdateEventsQueue(queueName string, queueLength int)
 }
 
+// An EvidenceRecorder keeps the evidence of the equivocations observed by the
+// agreement service.
+type EvidenceRecorder interface {
+	// RecordEquivocation records the evidence of a single equivocation.
+	RecordEquivocation(EquivocationEvidence) error
+}
+
 // LedgerDroppedRoundError is a wrapper error for when the ledger cannot return a Lookup query because
 // the entry is old and was dropped from the ledger. The purpose of this wrapper is to help the
 // agreement differentiate between a malicious vote and a vote that it cannot verify
@@ -20,10 +20,15 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/algorand/go-algorand/util/execpool"
 )
 
+// voteVerificationMaxBatchSize is the number of votes after which a batch is
+// verified without waiting for the batch window to expire.
+const voteVerificationMaxBatchSize = 64
+
 type asyncVerifyVoteRequest struct {
 	ctx     context.Context
 	l       LedgerReader
@@ -57,12 +62,26 @@ type AsyncVoteVerifier struct {
 	execpoolOut     chan interface{}
 	ctx             context.Context
 	ctxCancel       context.CancelFunc
+
+	// batchWindow is the collection window for vote batches; if zero,
+	// votes are verified one at a time.
+	batchWindow  time.Duration
+	batchMu      sync.Mutex
+	pendingBatch []asyncVerifyVoteRequest
+	batchTimer   *time.Timer
 }
 
 // MakeAsyncVoteVerifier creates an AsyncVoteVerifier with workers as the number of CPUs
 func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVerifier {
+	return MakeBatchingAsyncVoteVerifier(verificationPool, 0)
+}
+
+// MakeBatchingAsyncVoteVerifier creates an AsyncVoteVerifier which collects votes
+// for batchWindow before verifying them together. A zero batchWindow disables batching.
+func MakeBatchingAsyncVoteVerifier(verificationPool execpool.BacklogPool, batchWindow time.Duration) *AsyncVoteVerifier {
 	verifier := &AsyncVoteVerifier{
-		done: make(chan struct{}),
+		done:        make(chan struct{}),
+		batchWindow: batchWindow,
 	}
 	if verificationPool == nil {
 		// The MakeBacklog would internall allocate an execution pool if none was provided.
@@ -86,11 +105,18 @@ func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVeri
 func (avv *AsyncVoteVerifier) worker() {
 	defer close(avv.workerWaitCh)
 	for res := range avv.execpoolOut {
-		asyncResponse := res.(*asyncVerifyVoteResponse)
-		if asyncResponse != nil {
-			asyncResponse.req.out <- *asyncResponse
+		switch asyncResponse := res.(type) {
+		case *asyncVerifyVoteResponse:
+			if asyncResponse != nil {
+				asyncResponse.req.out <- *asyncResponse
+			}
+			avv.wg.Done()
+		case []*asyncVerifyVoteResponse:
+			for _, r := range asyncResponse {
+				r.req.out <- *r
+				avv.wg.Done()
+			}
 		}
-		avv.wg.Done()
 	}
 }
 
@@ -113,6 +139,46 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 	}
 }
 
+// executeVoteBatchVerification verifies a batch of votes collected by verifyVote.
+// It returns a response for every request in the batch.
+func (avv *AsyncVoteVerifier) executeVoteBatchVerification(task interface{}) interface{} {
+	reqs := task.([]asyncVerifyVoteRequest)
+	responses := make([]*asyncVerifyVoteResponse, len(reqs))
+
+	// the requests which passed the non-cryptographic checks, along with their parameters
+	pending := make([]int, 0, len(reqs))
+	uvs := make([]unauthenticatedVote, 0, len(reqs))
+	vps := make([]voteVerificationParams, 0, len(reqs))
+	for i := range reqs {
+		req := &reqs[i]
+		select {
+		case <-req.ctx.Done():
+			responses[i] = &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: req}
+			continue
+		default:
+		}
+
+		vp, err := req.uv.verificationParams(req.l)
+		if err != nil {
+			var e *LedgerDroppedRoundError
+			cancelled := errors.As(err, &e)
+			responses[i] = &asyncVerifyVoteResponse{index: req.index, message: req.message, err: err, cancelled: cancelled, req: req}
+			continue
+		}
+		pending = append(pending, i)
+		uvs = append(uvs, *req.uv)
+		vps = append(vps, vp)
+	}
+
+	votes, errs := verifyVoteBatch(uvs, vps)
+	for j, i := range pending {
+		req := &reqs[i]
+		req.message.Vote = votes[j]
+		responses[i] = &asyncVerifyVoteResponse{v: votes[j], index: req.index, message: req.message, err: errs[j], req: req}
+	}
+	return responses
+}
+
 func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interface{} {
 	req := task.(asyncVerifyVoteRequest)
 
@@ -140,6 +206,10 @@ func (avv *AsyncVoteVerifier) verifyVote(verctx context.Context, l LedgerReader,
 		// if we're done while waiting for room in the requests channel, don't queue the request
 		req := asyncVerifyVoteRequest{ctx: verctx, l: l, uv: &uv, index: index, message: message, out: out}
 		avv.wg.Add(1)
+		if avv.batchWindow > 0 {
+			avv.addToBatch(req)
+			return
+		}
 		if avv.backlogExecPool.EnqueueBacklog(avv.ctx, avv.executeVoteVerification, req, avv.execpoolOut) != nil {
 			// we want to call "wg.Done()" here to "fix" the accounting of the number of pending tasks.
 			// if we got a non-nil, it means that our context has expired, which means that we won't see this task
@@ -149,6 +219,57 @@ func (avv *AsyncVoteVerifier) verifyVote(verctx context.Context, l LedgerReader,
 	}
 }
 
+// addToBatch adds a request to the pending batch, starting the batch window
+// if this is the first request of the batch. A full batch is verified immediately.
+func (avv *AsyncVoteVerifier) addToBatch(req asyncVerifyVoteRequest) {
+	var ready []asyncVerifyVoteRequest
+	avv.batchMu.Lock()
+	avv.pendingBatch = append(avv.pendingBatch, req)
+	if len(avv.pendingBatch) >= voteVerificationMaxBatchSize {
+		ready = avv.takeBatchLocked()
+	} else if len(avv.pendingBatch) == 1 {
+		avv.batchTimer = time.AfterFunc(avv.batchWindow, avv.flushBatch)
+	}
+	avv.batchMu.Unlock()
+
+	if ready != nil {
+		avv.enqueueBatch(ready)
+	}
+}
+
+// takeBatchLocked removes and returns the pending batch.
+// avv.batchMu must be held.
+func (avv *AsyncVoteVerifier) takeBatchLocked() []asyncVerifyVoteRequest {
+	ready := avv.pendingBatch
+	avv.pendingBatch = nil
+	if avv.batchTimer != nil {
+		avv.batchTimer.Stop()
+		avv.batchTimer = nil
+	}
+	return ready
+}
+
+// flushBatch sends the pending batch, if any, to the verification pool.
+func (avv *AsyncVoteVerifier) flushBatch() {
+	avv.batchMu.Lock()
+	ready := avv.takeBatchLocked()
+	avv.batchMu.Unlock()
+
+	if len(ready) > 0 {
+		avv.enqueueBatch(ready)
+	}
+}
+
+func (avv *AsyncVoteVerifier) enqueueBatch(reqs []asyncVerifyVoteRequest) {
+	if avv.backlogExecPool.EnqueueBacklog(avv.ctx, avv.executeVoteBatchVerification, reqs, avv.execpoolOut) != nil {
+		// as in verifyVote, the requests will never reach the verification function,
+		// so we need to fix the accounting of the number of pending tasks here.
+		for range reqs {
+			avv.wg.Done()
+		}
+	}
+}
+
 func (avv *AsyncVoteVerifier) verifyEqVote(verctx context.Context, l LedgerReader, uev unauthenticatedEquivocationVote, index int, message message, out chan<- asyncVerifyVoteResponse) {
 	select {
 	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the request
@@ -172,6 +293,9 @@ func (avv *AsyncVoteVerifier) Quit() {
 	// indicate we're done and wait for all workers to finish
 	avv.ctxCancel()
 
+	// release any votes still waiting for the batch window to expire.
+	avv.flushBatch()
+
 	// wait until all the tasks we've given the pool are done.
 	avv.wg.Wait()
 	if avv.backlogExecPool.GetOwner() == avv {
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeSelectedVotesTesting returns soft votes from all the fixture accounts selected in the next round.
+func makeSelectedVotesTesting(t *testing.T) (Ledger, []unauthenticatedVote) {
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+	proposal := proposalValue{BlockDigest: randomBlockHash()}
+
+	var votes []unauthenticatedVote
+	for i, address := range addresses {
+		rv := rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
+		require.NoError(t, err)
+		if _, err := uv.verify(ledger); err == nil {
+			votes = append(votes, uv)
+		}
+	}
+	require.NotEmpty(t, votes)
+	return ledger, votes
+}
+
+func verifyVotesAsyncTesting(avv *AsyncVoteVerifier, ledger Ledger, votes []unauthenticatedVote) []asyncVerifyVoteResponse {
+	out := make(chan asyncVerifyVoteResponse, len(votes))
+	for i, uv := range votes {
+		avv.verifyVote(context.Background(), ledger, uv, i, message{}, out)
+	}
+	responses := make([]asyncVerifyVoteResponse, len(votes))
+	for range votes {
+		res := <-out
+		responses[res.index] = res
+	}
+	return responses
+}
+
+func TestAsyncVoteVerifierBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, votes := makeSelectedVotesTesting(t)
+	avv := MakeBatchingAsyncVoteVerifier(nil, 5*time.Millisecond)
+	defer avv.Quit()
+
+	responses := verifyVotesAsyncTesting(avv, ledger, votes)
+	for i, res := range responses {
+		require.NoError(t, res.err)
+		require.False(t, res.cancelled)
+		expected, err := votes[i].verify(ledger)
+		require.NoError(t, err)
+		require.Equal(t, expected, res.v)
+		require.Equal(t, expected, res.message.Vote)
+	}
+}
+
+func TestAsyncVoteVerifierBatchFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, votes := makeSelectedVotesTesting(t)
+	require.True(t, len(votes) > 2)
+
+	badSig := 0
+	votes[badSig].Sig = crypto.OneTimeSignature{}
+	badCred := len(votes) - 1
+	votes[badCred].Cred.Proof = votes[0].Cred.Proof
+
+	for _, batchWindow := range []time.Duration{5 * time.Millisecond, 0} {
+		avv := MakeBatchingAsyncVoteVerifier(nil, batchWindow)
+
+		responses := verifyVotesAsyncTesting(avv, ledger, votes)
+		for i, res := range responses {
+			if i == badSig || i == badCred {
+				require.Error(t, res.err)
+				continue
+			}
+			require.NoError(t, res.err)
+			require.Equal(t, votes[i].R, res.v.R)
+		}
+		avv.Quit()
+	}
+}
+
+func TestAsyncVoteVerifierBatchCancelled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, votes := makeSelectedVotesTesting(t)
+	avv := MakeBatchingAsyncVoteVerifier(nil, 5*time.Millisecond)
+	defer avv.Quit()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	out := make(chan asyncVerifyVoteResponse, len(votes))
+	for i, uv := range votes {
+		avv.verifyVote(ctx, ledger, uv, i, message{}, out)
+	}
+	for range votes {
+		res := <-out
+		require.True(t, res.cancelled)
+		require.Error(t, res.err)
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
@@ -51,7 +52,7 @@ func TestBundleCreation(t *testing.T) {
 			address := addresses[i]
 			step := step(s)
 			rv := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			uv, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 
 			vote, err := uv.verify(ledger)
@@ -130,7 +131,7 @@ func TestBundleCreationWithVotesFromSameAddress(t *testing.T) {
 			step := step(s)
 
 			rv0 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+			uv0, err := makeVote(rv0, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote0, err := uv0.verify(ledger)
 			if err != nil {
@@ -138,7 +139,7 @@ func TestBundleCreationWithVotesFromSameAddress(t *testing.T) {
 			}
 
 			rv1 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal2}
-			uv1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+			uv1, err := makeVote(rv1, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote1, err := uv1.verify(ledger)
 			if err != nil {
@@ -196,7 +197,7 @@ func TestBundleCreationWithEquivocationVotes(t *testing.T) {
 			step := step(s)
 
 			rv0 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+			uv0, err := makeVote(rv0, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote0, err := uv0.verify(ledger)
 			if err != nil {
@@ -204,7 +205,7 @@ func TestBundleCreationWithEquivocationVotes(t *testing.T) {
 			}
 
 			rv1 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal2}
-			uv1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+			uv1, err := makeVote(rv1, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote1, err := uv1.verify(ledger)
 			if err != nil {
@@ -305,7 +306,7 @@ func TestBundleCertificationWithEquivocationVotes(t *testing.T) {
 			step := step(s)
 
 			rv0 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+			uv0, err := makeVote(rv0, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote0, err := uv0.verify(ledger)
 			if err != nil {
@@ -313,7 +314,7 @@ func TestBundleCertificationWithEquivocationVotes(t *testing.T) {
 			}
 
 			rv1 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal2}
-			uv1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+			uv1, err := makeVote(rv1, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote1, err := uv1.verify(ledger)
 			if err != nil {
@@ -379,7 +380,7 @@ func TestBundleCreationWithEquivocationVotesUnderQuorum(t *testing.T) {
 			step := step(s)
 
 			rv0 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+			uv0, err := makeVote(rv0, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote0, err := uv0.verify(ledger)
 			if err != nil {
@@ -387,7 +388,7 @@ func TestBundleCreationWithEquivocationVotesUnderQuorum(t *testing.T) {
 			}
 
 			rv1 := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal2}
-			uv1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+			uv1, err := makeVote(rv1, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			vote1, err := uv1.verify(ledger)
 			if err != nil {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
@@ -421,6 +422,11 @@ type testAccountData struct {
 	ots       []crypto.OneTimeSigner
 }
 
+// signer returns the participation signer of the i-th test account.
+func (accs testAccountData) signer(i int) account.ParticipationSigner {
+	return account.MakeLocalSigner(accs.ots[i], accs.vrfs[i])
+}
+
 func makeProposalsTesting(accs testAccountData, round basics.Round, period period, factory BlockFactory, ledger Ledger) (ps []proposal, vs []vote) {
 	ve, err := factory.AssembleBlock(round, time.Now().Add(time.Minute))
 	if err != nil {
@@ -432,7 +438,7 @@ func makeProposalsTesting(accs testAccountData, round basics.Round, period perio
 	var votes []vote
 	proposals := make([]proposal, 0)
 	for i := range accs.addresses {
-		payload, proposal, err := proposalForBlock(accs.addresses[i], accs.vrfs[i], ve, period, ledger)
+		payload, proposal, err := proposalForBlock(accs.addresses[i], accs.signer(i), ve, period, ledger)
 		if err != nil {
 			logging.Base().Errorf("proposalForBlock could not create proposal under address %v (corrupt VRF key?): %v", accs.addresses[i], err)
 			return
@@ -440,7 +446,7 @@ func makeProposalsTesting(accs testAccountData, round basics.Round, period perio
 
 		// attempt to make the vote
 		rv := rawVote{Sender: accs.addresses[i], Round: round, Period: period, Step: propose, Proposal: proposal}
-		uv, err := makeVote(rv, accs.ots[i], accs.vrfs[i], ledger)
+		uv, err := makeVote(rv, account.MakeLocalSigner(accs.ots[i], accs.vrfs[i]), ledger)
 		if err != nil {
 			logging.Base().Errorf("AccountManager.makeVotes: Could not create vote: %v", err)
 			return
@@ -464,7 +470,7 @@ func makeVotesTesting(accs testAccountData, round basics.Round, period period, s
 	votes := make([]vote, 0)
 	for i := range accs.addresses {
 		rv := rawVote{Sender: accs.addresses[i], Round: round, Period: period, Step: step, Proposal: proposal}
-		uv, err := makeVote(rv, accs.ots[i], accs.vrfs[i], ledger)
+		uv, err := makeVote(rv, account.MakeLocalSigner(accs.ots[i], accs.vrfs[i]), ledger)
 		if err != nil {
 			logging.Base().Errorf("AccountManager.makeVotes: Could not create vote: %v", err)
 			return
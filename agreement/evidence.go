@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// EquivocationEvidence proves that the participation key of an account signed two votes for different proposal-values
+// in the same round, period and step.
+//
+// The evidence holds both signed votes, along with the voting key the account had registered at the time, as seen by
+// the ledger of the node which observed the equivocation. Verify checks the evidence without access to a ledger; a
+// verifier who doesn't trust the observing node should also check that VoteID and KeyDilution match the account's
+// registration on chain.
+type EquivocationEvidence struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sender basics.Address `codec:"snd"`
+	Round  basics.Round   `codec:"rnd"`
+	Period uint64         `codec:"per"`
+	Step   uint64         `codec:"step"`
+
+	// VoteID and KeyDilution are the voting key registered by the sender, which both votes were signed with.
+	VoteID      crypto.OneTimeSignatureVerifier `codec:"vid"`
+	KeyDilution uint64                          `codec:"kd"`
+
+	Votes [2]unauthenticatedVote `codec:"votes"`
+}
+
+// makeEquivocationEvidence creates the evidence for the given pair of conflicting votes, given the voting key and key
+// dilution the sender had registered.
+func makeEquivocationEvidence(first, second vote, voteID crypto.OneTimeSignatureVerifier, keyDilution uint64) EquivocationEvidence {
+	return EquivocationEvidence{
+		Sender:      first.R.Sender,
+		Round:       first.R.Round,
+		Period:      uint64(first.R.Period),
+		Step:        uint64(first.R.Step),
+		VoteID:      voteID,
+		KeyDilution: keyDilution,
+		Votes:       [2]unauthenticatedVote{first.u(), second.u()},
+	}
+}
+
+// evidenceQueueSize is the number of equivocations which may be pending on the evidence writer before further ones
+// are dropped.
+const evidenceQueueSize = 64
+
+// equivocation is a pair of conflicting votes, as observed by the vote tracker.
+type equivocation struct {
+	first, second vote
+}
+
+// enqueueEquivocation hands the given pair of conflicting votes to the evidence writer. It is invoked by the main
+// state machine loop, so it never blocks: the equivocation is dropped if the writer falls behind.
+func (s *Service) enqueueEquivocation(first, second vote) {
+	select {
+	case s.equivocations <- equivocation{first: first, second: second}:
+	default:
+		s.log.Warnf("agreement: dropping the equivocation evidence of %v (r=%d, p=%d, s=%d): evidence writer is busy", first.R.Sender, first.R.Round, first.R.Period, first.R.Step)
+	}
+}
+
+// evidenceLoop records the equivocations handed over by enqueueEquivocation, until the equivocations channel is
+// closed.
+func (s *Service) evidenceLoop() {
+	defer close(s.evidenceDone)
+	for e := range s.equivocations {
+		s.recordEquivocation(e.first, e.second)
+	}
+}
+
+// recordEquivocation reports the given pair of conflicting votes to the evidence recorder and to telemetry. It is
+// invoked by the evidence writer, since looking up the voting key and storing the evidence may hit the disk.
+func (s *Service) recordEquivocation(first, second vote) {
+	r, p, st := first.R.Round, first.R.Period, first.R.Step
+	m, err := membership(s.Ledger, first.R.Sender, r, p, st)
+	if err != nil {
+		s.log.Warnf("agreement: unable to look up the voting key of equivocator %v (r=%d, p=%d, s=%d): %v", first.R.Sender, r, p, st, err)
+		return
+	}
+	keyDilution := m.Record.VoteKeyDilution
+	if keyDilution == 0 {
+		cparams, err := s.Ledger.ConsensusParams(ParamsRound(r))
+		if err != nil {
+			s.log.Warnf("agreement: unable to look up the consensus parameters of round %d: %v", r, err)
+			return
+		}
+		keyDilution = cparams.DefaultKeyDilution
+	}
+	ev := makeEquivocationEvidence(first, second, m.Record.VoteID, keyDilution)
+
+	if s.EvidenceRecorder != nil {
+		err = s.EvidenceRecorder.RecordEquivocation(ev)
+		if err != nil {
+			s.log.Warnf("agreement: unable to record the equivocation evidence of %v (r=%d, p=%d, s=%d): %v", ev.Sender, r, p, st, err)
+		}
+	}
+
+	proposals := ev.Proposals()
+	s.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.EquivocationEvidenceEvent, telemetryspec.EquivocationEvidenceEventDetails{
+		VoterAddress:  ev.Sender.String(),
+		Round:         uint64(ev.Round),
+		Period:        ev.Period,
+		Step:          ev.Step,
+		ProposalHash1: proposals[0].BlockDigest.String(),
+		ProposalHash2: proposals[1].BlockDigest.String(),
+		Evidence:      base64.StdEncoding.EncodeToString(protocol.Encode(&ev)),
+	})
+}
+
+// Proposals returns the proposal-values the two votes were cast for.
+func (ev EquivocationEvidence) Proposals() [2]ProposalID {
+	return [2]ProposalID{*makeProposalID(ev.Votes[0].R.Proposal), *makeProposalID(ev.Votes[1].R.Proposal)}
+}
+
+// Verify checks that the evidence indeed proves an equivocation: both votes are from the sender, for the same round,
+// period and step, for different proposal-values, and are correctly signed by the sender's voting key.
+func (ev EquivocationEvidence) Verify() error {
+	if ev.Votes[0].R.Proposal == ev.Votes[1].R.Proposal {
+		return fmt.Errorf("votes are for the same proposal-value %v", ev.Votes[0].R.Proposal)
+	}
+	if ev.KeyDilution == 0 {
+		return fmt.Errorf("key dilution is missing")
+	}
+	id := basics.OneTimeIDForRound(ev.Round, ev.KeyDilution)
+	for i, uv := range ev.Votes {
+		if uv.R.Sender != ev.Sender {
+			return fmt.Errorf("vote %d sender %v doesn't match %v", i, uv.R.Sender, ev.Sender)
+		}
+		if uv.R.Round != ev.Round || uint64(uv.R.Period) != ev.Period || uint64(uv.R.Step) != ev.Step {
+			return fmt.Errorf("vote %d is for (%d, %d, %d) rather than (%d, %d, %d)", i, uv.R.Round, uv.R.Period, uv.R.Step, ev.Round, ev.Period, ev.Step)
+		}
+		if !ev.VoteID.Verify(id, uv.R, uv.Sig) {
+			return fmt.Errorf("vote %d signature doesn't verify against voting key %v", i, ev.VoteID)
+		}
+	}
+	return nil
+}
+
+// EvidenceStore persists equivocation evidence. It implements the EvidenceRecorder interface.
+type EvidenceStore struct {
+	store db.Accessor
+}
+
+// MakeEvidenceStore creates an EvidenceStore persisting the evidence into the given database.
+func MakeEvidenceStore(store db.Accessor) (*EvidenceStore, error) {
+	err := store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS equivocations (
+			sender BLOB NOT NULL,
+			round INTEGER NOT NULL,
+			period INTEGER NOT NULL,
+			step INTEGER NOT NULL,
+			evidence BLOB NOT NULL, --*  msgpack encoding of EquivocationEvidence
+			PRIMARY KEY (sender, round, period, step)
+		)`)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("MakeEvidenceStore: unable to create the equivocations table: %w", err)
+	}
+	return &EvidenceStore{store: store}, nil
+}
+
+// Close closes the underlying database. The store must not be used once closed.
+func (es *EvidenceStore) Close() {
+	es.store.Close()
+}
+
+// RecordEquivocation implements the EvidenceRecorder interface. Only the first evidence of each sender, round, period
+// and step is kept.
+func (es *EvidenceStore) RecordEquivocation(ev EquivocationEvidence) error {
+	encoded := protocol.Encode(&ev)
+	return es.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("INSERT OR IGNORE INTO equivocations (sender, round, period, step, evidence) VALUES (?, ?, ?, ?, ?)",
+			ev.Sender[:], ev.Round, ev.Period, ev.Step, encoded)
+		return err
+	})
+}
+
+// Equivocations returns up to max of the stored evidence, for rounds starting at minRound, ordered by round.
+// A max of zero returns all the matching evidence.
+func (es *EvidenceStore) Equivocations(minRound basics.Round, max uint64) (evidence []EquivocationEvidence, err error) {
+	err = es.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		evidence = nil
+		query := "SELECT evidence FROM equivocations WHERE round>=? ORDER BY round, period, step, sender"
+		args := []interface{}{minRound}
+		if max > 0 {
+			query += " LIMIT ?"
+			args = append(args, max)
+		}
+		rows, err := tx.Query(query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var encoded []byte
+			err = rows.Scan(&encoded)
+			if err != nil {
+				return err
+			}
+			var ev EquivocationEvidence
+			err = protocol.Decode(encoded, &ev)
+			if err != nil {
+				return err
+			}
+			evidence = append(evidence, ev)
+		}
+		return rows.Err()
+	})
+	return
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestEquivocationEvidence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	// find an account selected for one of the steps, and have it vote for two different blocks.
+	var first, second vote
+	found := false
+	for i, address := range addresses {
+		var err error
+		first, err = makeVoteTesting(address, vrfSecrets[i], otSecrets[i], ledger, round, 0, step(i), randomBlockHash())
+		if err != nil {
+			continue
+		}
+		second, err = makeVoteTesting(address, vrfSecrets[i], otSecrets[i], ledger, round, 0, step(i), randomBlockHash())
+		require.NoError(t, err)
+		found = true
+		break
+	}
+	require.True(t, found, "no account was selected")
+
+	accessor, err := db.MakeAccessor(t.Name()+"_evidence.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+	store, err := MakeEvidenceStore(accessor)
+	require.NoError(t, err)
+
+	s := &Service{
+		parameters: parameters{Ledger: ledger, EvidenceRecorder: store},
+		log:        serviceLogger{Logger: logging.Base()},
+	}
+	// the main loop hands the equivocations over to the evidence writer without blocking; once the queue is full,
+	// further ones are dropped.
+	s.equivocations = make(chan equivocation, 2)
+	s.evidenceDone = make(chan struct{})
+	s.enqueueEquivocation(first, second)
+	// recording the same equivocation again keeps the first evidence only
+	s.enqueueEquivocation(second, first)
+	s.enqueueEquivocation(first, second)
+	require.Len(t, s.equivocations, 2)
+	close(s.equivocations)
+	s.evidenceLoop()
+	<-s.evidenceDone
+
+	evidence, err := store.Equivocations(0, 0)
+	require.NoError(t, err)
+	require.Len(t, evidence, 1)
+	ev := evidence[0]
+	require.Equal(t, first.R.Sender, ev.Sender)
+	require.Equal(t, round, ev.Round)
+	require.Equal(t, uint64(first.R.Step), ev.Step)
+	proposals := ev.Proposals()
+	require.Equal(t, first.R.Proposal.BlockDigest, proposals[0].BlockDigest)
+	require.Equal(t, second.R.Proposal.BlockDigest, proposals[1].BlockDigest)
+	require.NoError(t, ev.Verify())
+
+	// the evidence survives a round trip through its encoding, as handed to the offline verification
+	var decoded EquivocationEvidence
+	require.NoError(t, protocol.Decode(protocol.Encode(&ev), &decoded))
+	require.NoError(t, decoded.Verify())
+
+	sameVotes := ev
+	sameVotes.Votes[1] = sameVotes.Votes[0]
+	require.Error(t, sameVotes.Verify())
+
+	forged := ev
+	forged.Votes[1].R.Proposal.BlockDigest = randomBlockHash()
+	require.Error(t, forged.Verify())
+
+	otherSender := ev
+	otherSender.Sender = basics.Address(randomBlockHash())
+	require.Error(t, otherSender.Verify())
+
+	otherStep := ev
+	otherStep.Votes[1].R.Step++
+	require.Error(t, otherStep.Verify())
+
+	evidence, err = store.Equivocations(round+1, 0)
+	require.NoError(t, err)
+	require.Empty(t, evidence)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/timers"
 )
@@ -61,6 +62,7 @@ type Fuzzer struct {
 	blockValidator   agreement.BlockValidator
 	agreementParams  []agreement.Parameters
 	disableTraces    bool
+	consensusVersion protocol.ConsensusVersion
 }
 
 type FuzzerConfig struct {
@@ -70,6 +72,13 @@ type FuzzerConfig struct {
 	Filters       []NetworkFilterFactory
 	LogLevel      logging.Level
 	DisableTraces bool
+
+	// Stakes optionally sets the stake of each of the nodes' accounts, in microalgos.
+	Stakes []uint64
+	// TickGranularity optionally sets the virtual time each tick represents.
+	TickGranularity time.Duration
+	// ConsensusVersion optionally sets the consensus protocol the ledgers report.
+	ConsensusVersion protocol.ConsensusVersion
 }
 
 // MakeFuzzer creates a fuzzer object with nodesCount nodes.
@@ -91,6 +100,11 @@ func MakeFuzzer(config FuzzerConfig) *Fuzzer {
 		accelerateClock:  true,
 		blockValidator:   testBlockValidator{},
 		disableTraces:    config.DisableTraces,
+		consensusVersion: config.ConsensusVersion,
+	}
+
+	if config.TickGranularity > 0 {
+		n.tickGranularity = config.TickGranularity
 	}
 
 	n.router = MakeRouter(n)
@@ -105,7 +119,7 @@ func MakeFuzzer(config FuzzerConfig) *Fuzzer {
 	n.log.SetOutput(f)
 	n.log.SetLevel(config.LogLevel)
 
-	n.initAccountsAndBalances((&[32]byte{})[:], config.OnlineNodes)
+	n.initAccountsAndBalances((&[32]byte{})[:], config.OnlineNodes, config.Stakes)
 	for i := range n.agreements {
 		if !n.initAgreementNode(i, config.Filters...) {
 			return nil
@@ -120,6 +134,7 @@ func (n *Fuzzer) initAgreementNode(nodeID int, filters ...NetworkFilterFactory)
 	n.disconnected[nodeID] = make([]bool, n.nodesCount)
 	n.facades[nodeID] = MakeNetworkFacade(n, nodeID)
 	n.ledgers[nodeID] = makeTestLedger(n.balances, n.LedgerSync)
+	n.ledgers[nodeID].consensusVersion = n.consensusVersion
 	n.clocks[nodeID] = n.facades[nodeID]
 
 	n.crashAccessors[nodeID], err = db.MakeAccessor(n.networkName+"_"+strconv.Itoa(nodeID)+"_crash.db", false, true)
@@ -175,7 +190,7 @@ func (n *Fuzzer) initFiltersChain(nodeID int, filters ...NetworkFilterFactory) {
 	currentFilter.SetDownstreamFilter(n.router)
 }
 
-func (n *Fuzzer) initAccountsAndBalances(rootSeed []byte, onlineNodes []bool) error {
+func (n *Fuzzer) initAccountsAndBalances(rootSeed []byte, onlineNodes []bool, stakes []uint64) error {
 	off := int(rand.Uint32() >> 2) // prevent name collision from running tests more than once
 
 	// system state setup: keygen, stake initialization
@@ -188,6 +203,9 @@ func (n *Fuzzer) initAccountsAndBalances(rootSeed []byte, onlineNodes []bool) er
 
 	for i := 0; i < n.nodesCount; i++ {
 		stake := basics.MicroAlgos{Raw: 1000000}
+		if len(stakes) > i {
+			stake.Raw = stakes[i]
+		}
 		firstValid := basics.Round(0)
 		lastValid := basics.Round(1000)
 
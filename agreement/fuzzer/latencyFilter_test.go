@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package fuzzer
+
+import (
+	"container/heap"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// LatencyDistribution describes the distribution of the one-way latency of the messages delivered to a node, in
+// milliseconds.
+type LatencyDistribution struct {
+	// Type is one of "constant", "uniform", "normal" or "lognormal".
+	Type string
+	// Mean is the constant latency, the mean of the normal distribution or the median of the lognormal distribution.
+	Mean float64
+	// StdDev is the standard deviation of the normal distribution, or of the logarithm of the lognormal distribution.
+	StdDev float64
+	// Min and Max are the bounds of the uniform distribution. For the other distributions, the samples are clamped
+	// to them, where Max is ignored when zero.
+	Min float64
+	Max float64
+}
+
+// sample draws a single latency from the distribution.
+func (d LatencyDistribution) sample(rng *rand.Rand) time.Duration {
+	var ms float64
+	switch d.Type {
+	case "uniform":
+		ms = d.Min + rng.Float64()*(d.Max-d.Min)
+	case "normal":
+		ms = d.Mean + rng.NormFloat64()*d.StdDev
+	case "lognormal":
+		ms = d.Mean * math.Exp(rng.NormFloat64()*d.StdDev)
+	default: // i.e. constant
+		ms = d.Mean
+	}
+	if ms < d.Min {
+		ms = d.Min
+	}
+	if d.Max > 0 && ms > d.Max {
+		ms = d.Max
+	}
+	if ms < 0 {
+		ms = 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// LatencyFilterFactory creates LatencyFilters which delay each of the messages delivered to a node by a latency
+// drawn from a distribution, and keeps count of the delivered messages across all the nodes.
+type LatencyFilterFactory struct {
+	NetworkFilterFactory
+
+	distribution LatencyDistribution
+	seed         int64
+
+	mu                deadlock.Mutex
+	deliveredMessages TrafficMetric
+}
+
+// MakeLatencyFilterFactory creates a LatencyFilterFactory with the given latency distribution. The per-node random
+// sources are derived from the given seed, so that runs are reproducible.
+func MakeLatencyFilterFactory(distribution LatencyDistribution, seed int64) *LatencyFilterFactory {
+	return &LatencyFilterFactory{
+		distribution: distribution,
+		seed:         seed,
+	}
+}
+
+// DeliveredMessages returns the count and size of the messages delivered to all the nodes so far.
+func (n *LatencyFilterFactory) DeliveredMessages() TrafficMetric {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.deliveredMessages
+}
+
+func (n *LatencyFilterFactory) CreateFilter(nodeID int, fuzzer *Fuzzer) NetworkFilter {
+	f := &LatencyFilter{
+		factory:         n,
+		rng:             rand.New(rand.NewSource(n.seed + int64(nodeID))),
+		tickGranularity: fuzzer.tickGranularity,
+	}
+	heap.Init(&f.upStreamMessageQueue)
+	return f
+}
+
+func (n *LatencyFilterFactory) Unmarshal(b []byte) NetworkFilterFactory {
+	type latencyFilterJSON struct {
+		Name         string
+		Distribution LatencyDistribution
+		Seed         int64
+	}
+	var jsonConfig latencyFilterJSON
+	if json.Unmarshal(b, &jsonConfig) != nil {
+		return nil
+	}
+	if jsonConfig.Name != "LatencyFilter" {
+		return nil
+	}
+	return MakeLatencyFilterFactory(jsonConfig.Distribution, jsonConfig.Seed)
+}
+
+// LatencyFilter delays the messages delivered to a single node.
+type LatencyFilter struct {
+	NetworkFilter
+
+	upstream   UpstreamFilter
+	downstream DownstreamFilter
+
+	factory         *LatencyFilterFactory
+	rng             *rand.Rand
+	tickGranularity time.Duration
+
+	upMutex              deadlock.Mutex
+	upStreamMessageQueue PriorityQueue
+	currentTick          int
+}
+
+func (n *LatencyFilter) SendMessage(sourceNode, targetNode int, tag protocol.Tag, data []byte) {
+	n.downstream.SendMessage(sourceNode, targetNode, tag, data)
+}
+
+func (n *LatencyFilter) GetDownstreamFilter() DownstreamFilter {
+	return n.downstream
+}
+
+// ReceiveMessage holds the message until its sampled latency, rounded up to whole ticks, elapses.
+func (n *LatencyFilter) ReceiveMessage(sourceNode int, tag protocol.Tag, data []byte) {
+	n.factory.mu.Lock()
+	n.factory.deliveredMessages.IncreaseMetric(1, len(data)+len(tag))
+	n.factory.mu.Unlock()
+
+	n.upMutex.Lock()
+	latency := n.factory.distribution.sample(n.rng)
+	tickDelay := int((latency + n.tickGranularity - 1) / n.tickGranularity)
+	if tickDelay == 0 {
+		n.upMutex.Unlock()
+		n.upstream.ReceiveMessage(sourceNode, tag, data)
+		return
+	}
+	heap.Push(&n.upStreamMessageQueue, &QueueItem{
+		priority: n.currentTick + tickDelay,
+		message: AlgoMessage{
+			sourceNode: sourceNode,
+			tag:        tag,
+			data:       data,
+		},
+	})
+	n.upMutex.Unlock()
+}
+
+func (n *LatencyFilter) SetDownstreamFilter(f DownstreamFilter) {
+	n.downstream = f
+}
+
+func (n *LatencyFilter) SetUpstreamFilter(f UpstreamFilter) {
+	n.upstream = f
+}
+
+func (n *LatencyFilter) Tick(newClockTime int) bool {
+	n.upMutex.Lock()
+	n.currentTick = newClockTime
+	var due []AlgoMessage
+	for n.upStreamMessageQueue.Len() > 0 && n.upStreamMessageQueue[0].priority <= newClockTime {
+		due = append(due, heap.Pop(&n.upStreamMessageQueue).(*QueueItem).message)
+	}
+	n.upMutex.Unlock()
+
+	for _, msg := range due {
+		n.upstream.ReceiveMessage(msg.sourceNode, msg.tag, msg.data)
+	}
+	return n.upstream.Tick(newClockTime) || len(due) > 0
+}
+
+func init() {
+	registeredFilterFactories = append(registeredFilterFactories, &LatencyFilterFactory{})
+}
@@ -135,6 +135,9 @@ type testLedger struct {
 	ensuringDigest        bool
 	ensuringDigestTry     chan struct{}
 	catchingUp            bool
+
+	// consensusVersion is the protocol reported for all rounds; the current protocol is used when empty.
+	consensusVersion protocol.ConsensusVersion
 }
 
 func makeTestLedger(state map[basics.Address]basics.AccountData, sync testLedgerSyncFunc) *testLedger {
@@ -341,6 +344,9 @@ func (l *testLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, er
 }
 
 func (l *testLedger) ConsensusVersion(r basics.Round) (protocol.ConsensusVersion, error) {
+	if l.consensusVersion != "" {
+		return l.consensusVersion, nil
+	}
 	return protocol.ConsensusCurrentVersion, nil
 }
 
@@ -0,0 +1,396 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package fuzzer
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// The agreement timing simulator runs the real agreement services of a set of nodes over the fuzzer network facade,
+// in virtual time, and reports how long rounds take under a given latency distribution, stake distribution and set
+// of consensus parameters. It's meant for evaluating parameter changes, such as faster block times, without running
+// real networks. Since the network facade, ledger and key manager of the fuzzer are test code, the simulator is run
+// through go test rather than as a binary of its own:
+//
+//	go test ./agreement/fuzzer -run TestAgreementSimulation -simulation testdata/simulation_fast_blocks.json -v
+//
+// where the given file holds a SimulationConfig, e.g.
+//
+//	{
+//	    "Name": "fast-blocks",
+//	    "NodesCount": 20,
+//	    "StakeDistribution": "zipf",
+//	    "Latency": {"Type": "lognormal", "Mean": 80, "StdDev": 0.5, "Max": 1000},
+//	    "Rounds": 50,
+//	    "Parameters": {"FilterTimeoutPeriod0Ms": 1500, "BigLambdaMs": 6000, "SmallLambdaMs": 1000}
+//	}
+var simulationConfigFile = flag.String("simulation", "", "a JSON file with the agreement timing simulation to run")
+
+// SimulationParameters are the consensus parameters a simulation overrides. Zero values keep the parameters of the
+// current consensus protocol.
+type SimulationParameters struct {
+	FilterTimeoutPeriod0Ms int64
+	FilterTimeoutMs        int64
+	BigLambdaMs            int64
+	SmallLambdaMs          int64
+
+	NumProposers           uint64
+	SoftCommitteeSize      uint64
+	SoftCommitteeThreshold uint64
+	CertCommitteeSize      uint64
+	CertCommitteeThreshold uint64
+	NextCommitteeSize      uint64
+	NextCommitteeThreshold uint64
+}
+
+// apply overrides the given consensus parameters.
+func (p SimulationParameters) apply(proto *config.ConsensusParams) {
+	if p.FilterTimeoutPeriod0Ms > 0 {
+		proto.AgreementFilterTimeoutPeriod0 = time.Duration(p.FilterTimeoutPeriod0Ms) * time.Millisecond
+	}
+	if p.FilterTimeoutMs > 0 {
+		proto.AgreementFilterTimeout = time.Duration(p.FilterTimeoutMs) * time.Millisecond
+	}
+	overrides := []struct {
+		value  uint64
+		target *uint64
+	}{
+		{p.NumProposers, &proto.NumProposers},
+		{p.SoftCommitteeSize, &proto.SoftCommitteeSize},
+		{p.SoftCommitteeThreshold, &proto.SoftCommitteeThreshold},
+		{p.CertCommitteeSize, &proto.CertCommitteeSize},
+		{p.CertCommitteeThreshold, &proto.CertCommitteeThreshold},
+		{p.NextCommitteeSize, &proto.NextCommitteeSize},
+		{p.NextCommitteeThreshold, &proto.NextCommitteeThreshold},
+	}
+	for _, o := range overrides {
+		if o.value > 0 {
+			*o.target = o.value
+		}
+	}
+}
+
+// SimulationConfig describes a single simulation.
+type SimulationConfig struct {
+	Name       string
+	NodesCount int
+
+	// Stakes sets the stake of each node, in microalgos. When empty, the stakes follow StakeDistribution, which is
+	// one of "equal" (the default), "linear" (node i holds i+1 shares) or "zipf" (node i holds 1/(i+1) shares).
+	Stakes            []uint64
+	StakeDistribution string
+
+	Latency LatencyDistribution
+
+	// Rounds is the number of rounds to run. The simulation gives up once TimeLimitSec of virtual time elapsed,
+	// which defaults to a minute per round.
+	Rounds       int
+	TimeLimitSec int64
+	// TickMs is the virtual time granularity; latencies are rounded up to whole ticks. Defaults to 20ms.
+	TickMs int64
+
+	Parameters SimulationParameters
+	Seed       int64
+}
+
+// stakes returns the stake of each of the nodes.
+func (cfg SimulationConfig) stakes() ([]uint64, error) {
+	if len(cfg.Stakes) > 0 {
+		if len(cfg.Stakes) != cfg.NodesCount {
+			return nil, fmt.Errorf("%d stakes were given for %d nodes", len(cfg.Stakes), cfg.NodesCount)
+		}
+		return cfg.Stakes, nil
+	}
+	shares := make([]float64, cfg.NodesCount)
+	for i := range shares {
+		switch cfg.StakeDistribution {
+		case "", "equal":
+			shares[i] = 1
+		case "linear":
+			shares[i] = float64(i + 1)
+		case "zipf":
+			shares[i] = 1 / float64(i+1)
+		default:
+			return nil, fmt.Errorf("unknown stake distribution '%s'", cfg.StakeDistribution)
+		}
+	}
+	var total float64
+	for _, s := range shares {
+		total += s
+	}
+	// keep the total stake the same as the fuzzer's default of 1 algo per node.
+	stakes := make([]uint64, cfg.NodesCount)
+	for i, s := range shares {
+		stakes[i] = uint64(s / total * float64(cfg.NodesCount) * 1000000)
+	}
+	return stakes, nil
+}
+
+// SimulationReport holds the outcome of a simulation.
+type SimulationReport struct {
+	Name string
+	// Rounds is the number of rounds all the nodes completed.
+	Rounds      int
+	Stalled     bool
+	VirtualTime time.Duration
+
+	// The round time percentiles are taken over the time it took each of the nodes to complete each of the rounds.
+	RoundTimeP50 time.Duration
+	RoundTimeP90 time.Duration
+	RoundTimeP99 time.Duration
+	RoundTimeMax time.Duration
+
+	// Forks is the number of rounds for which nodes committed different blocks.
+	Forks int
+	// RecoveredRounds is the number of rounds which weren't certified in their first period.
+	RecoveredRounds int
+
+	Messages         int
+	MessageBytes     int
+	MessagesPerRound float64
+}
+
+func (r *SimulationReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "simulation %s: %d rounds in %v of virtual time", r.Name, r.Rounds, r.VirtualTime)
+	if r.Stalled {
+		sb.WriteString(" (stalled)")
+	}
+	fmt.Fprintf(&sb, "\n  round time: p50 %v, p90 %v, p99 %v, max %v", r.RoundTimeP50, r.RoundTimeP90, r.RoundTimeP99, r.RoundTimeMax)
+	fmt.Fprintf(&sb, "\n  forks: %d, recovered rounds: %d", r.Forks, r.RecoveredRounds)
+	fmt.Fprintf(&sb, "\n  messages: %d (%d bytes), %.1f per round", r.Messages, r.MessageBytes, r.MessagesPerRound)
+	return sb.String()
+}
+
+// percentile returns the nearest-rank percentile of the given sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// RunSimulation runs the given simulation to completion and reports its outcome.
+func RunSimulation(cfg SimulationConfig) (*SimulationReport, error) {
+	if cfg.NodesCount <= 0 || cfg.NodesCount > len(readOnlyParticipationVotes) {
+		return nil, fmt.Errorf("the nodes count must be between 1 and %d", len(readOnlyParticipationVotes))
+	}
+	if cfg.Rounds <= 0 {
+		return nil, fmt.Errorf("the number of rounds must be positive")
+	}
+	stakes, err := cfg.stakes()
+	if err != nil {
+		return nil, err
+	}
+	tick := 20 * time.Millisecond
+	if cfg.TickMs > 0 {
+		tick = time.Duration(cfg.TickMs) * time.Millisecond
+	}
+	timeLimit := time.Duration(cfg.Rounds) * time.Minute
+	if cfg.TimeLimitSec > 0 {
+		timeLimit = time.Duration(cfg.TimeLimitSec) * time.Second
+	}
+
+	// register the simulated parameters as a consensus protocol of their own, which the nodes' ledgers report.
+	version := protocol.ConsensusVersion("fuzzer-simulation-" + cfg.Name)
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	cfg.Parameters.apply(&proto)
+	config.Consensus[version] = proto
+	defer delete(config.Consensus, version)
+
+	// the step timeouts are derived from the big and small lambda of config.Protocol; these are overridden only
+	// while no agreement service is running.
+	defer func(protocolTiming config.Global) {
+		config.Protocol = protocolTiming
+	}(config.Protocol)
+	if cfg.Parameters.BigLambdaMs > 0 {
+		config.Protocol.BigLambda = time.Duration(cfg.Parameters.BigLambdaMs) * time.Millisecond
+	}
+	if cfg.Parameters.SmallLambdaMs > 0 {
+		config.Protocol.SmallLambda = time.Duration(cfg.Parameters.SmallLambdaMs) * time.Millisecond
+	}
+
+	latency := MakeLatencyFilterFactory(cfg.Latency, cfg.Seed)
+	fuzzer := MakeFuzzer(FuzzerConfig{
+		FuzzerName:       "simulation-" + cfg.Name,
+		NodesCount:       cfg.NodesCount,
+		Filters:          []NetworkFilterFactory{latency},
+		LogLevel:         logging.Error,
+		DisableTraces:    true,
+		Stakes:           stakes,
+		TickGranularity:  tick,
+		ConsensusVersion: version,
+	})
+	if fuzzer == nil {
+		return nil, fmt.Errorf("unable to create the simulated network")
+	}
+	// virtual time must advance one tick at a time, or the timeouts would fire late.
+	fuzzer.accelerateClock = false
+	fuzzer.Start()
+	defer fuzzer.Shutdown()
+
+	// completions[i][r-1] is the tick at which node i completed round r.
+	completions := make([][]int, cfg.NodesCount)
+	startTick := fuzzer.WallClock()
+	maxTicks := int(timeLimit / tick)
+	report := &SimulationReport{Name: cfg.Name, Stalled: true}
+	for t := 0; t < maxTicks; t++ {
+		fuzzer.exhaustNetworkOperations()
+		wallClock := int(atomic.AddInt32(&fuzzer.wallClock, 1))
+		fuzzer.router.Tick(wallClock)
+		fuzzer.CheckBlockingEnsureDigest()
+
+		done := true
+		for i, l := range fuzzer.ledgers {
+			nextRound := int(l.NextRound())
+			for len(completions[i])+1 < nextRound {
+				completions[i] = append(completions[i], wallClock)
+			}
+			if nextRound <= cfg.Rounds {
+				done = false
+			}
+		}
+		if done {
+			report.Stalled = false
+			break
+		}
+	}
+	report.VirtualTime = time.Duration(fuzzer.WallClock()-startTick) * tick
+
+	var roundTimes []time.Duration
+	report.Rounds = cfg.Rounds
+	for _, nodeCompletions := range completions {
+		if len(nodeCompletions) < report.Rounds {
+			report.Rounds = len(nodeCompletions)
+		}
+		prevTick := startTick
+		for _, completionTick := range nodeCompletions {
+			roundTimes = append(roundTimes, time.Duration(completionTick-prevTick)*tick)
+			prevTick = completionTick
+		}
+	}
+	sort.Slice(roundTimes, func(i, j int) bool { return roundTimes[i] < roundTimes[j] })
+	report.RoundTimeP50 = percentile(roundTimes, 50)
+	report.RoundTimeP90 = percentile(roundTimes, 90)
+	report.RoundTimeP99 = percentile(roundTimes, 99)
+	report.RoundTimeMax = percentile(roundTimes, 100)
+
+	for r := basics.Round(1); r <= basics.Round(report.Rounds); r++ {
+		digests := make(map[crypto.Digest]bool)
+		recovered := false
+		for _, l := range fuzzer.ledgers {
+			l.mu.Lock()
+			digests[l.entries[r].Digest()] = true
+			recovered = recovered || l.certs[r].Period > 0
+			l.mu.Unlock()
+		}
+		if len(digests) > 1 {
+			report.Forks++
+		}
+		if recovered {
+			report.RecoveredRounds++
+		}
+	}
+
+	messages := latency.DeliveredMessages()
+	report.Messages, report.MessageBytes = messages.Count, messages.Bytes
+	if report.Rounds > 0 {
+		report.MessagesPerRound = float64(messages.Count) / float64(report.Rounds)
+	}
+	return report, nil
+}
+
+func TestAgreementSimulation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	if *simulationConfigFile != "" {
+		configBytes, err := ioutil.ReadFile(*simulationConfigFile)
+		require.NoError(t, err)
+		var cfg SimulationConfig
+		require.NoError(t, json.Unmarshal(configBytes, &cfg))
+		report, err := RunSimulation(cfg)
+		require.NoError(t, err)
+		t.Log(report)
+		return
+	}
+
+	baseline := SimulationConfig{
+		Name:              "baseline",
+		NodesCount:        5,
+		StakeDistribution: "linear",
+		Latency:           LatencyDistribution{Type: "normal", Mean: 100, StdDev: 30, Min: 20},
+		Rounds:            5,
+		Seed:              1,
+	}
+	fast := baseline
+	fast.Name = "fast"
+	fast.Parameters = SimulationParameters{FilterTimeoutPeriod0Ms: 1500, BigLambdaMs: 6000, SmallLambdaMs: 1000}
+
+	baselineReport, err := RunSimulation(baseline)
+	require.NoError(t, err)
+	t.Log(baselineReport)
+	fastReport, err := RunSimulation(fast)
+	require.NoError(t, err)
+	t.Log(fastReport)
+
+	for _, report := range []*SimulationReport{baselineReport, fastReport} {
+		require.False(t, report.Stalled)
+		require.Equal(t, 5, report.Rounds)
+		require.Zero(t, report.Forks)
+		require.NotZero(t, report.Messages)
+	}
+	// a round can't complete before the filter timeout of its first period expires.
+	require.GreaterOrEqual(t, int64(baselineReport.RoundTimeP50), int64(config.Consensus[protocol.ConsensusCurrentVersion].AgreementFilterTimeoutPeriod0))
+	require.GreaterOrEqual(t, int64(fastReport.RoundTimeP50), int64(1500*time.Millisecond))
+	require.Less(t, int64(fastReport.RoundTimeP50), int64(baselineReport.RoundTimeP50))
+}
+
+func TestLatencyDistributionSample(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rng := rand.New(rand.NewSource(1))
+	require.Equal(t, 50*time.Millisecond, LatencyDistribution{Mean: 50}.sample(rng))
+	for i := 0; i < 1000; i++ {
+		uniform := LatencyDistribution{Type: "uniform", Min: 10, Max: 20}.sample(rng)
+		require.True(t, uniform >= 10*time.Millisecond && uniform <= 20*time.Millisecond)
+		normal := LatencyDistribution{Type: "normal", Mean: 100, StdDev: 50, Min: 20, Max: 150}.sample(rng)
+		require.True(t, normal >= 20*time.Millisecond && normal <= 150*time.Millisecond)
+		require.True(t, LatencyDistribution{Type: "lognormal", Mean: 100, StdDev: 1}.sample(rng) > 0)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// ErrServiceNotRunning is returned when the state of an agreement service that isn't running is inspected.
+var ErrServiceNotRunning = errors.New("agreement service is not running")
+
+// State is a snapshot of the state of the agreement service, as seen by its player and router state machines.
+type State struct {
+	// Round, Period and Step are the current round, period and step of the player.
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+	// LastConcluding is the largest step reached in the previous period.
+	LastConcluding uint64
+
+	// TimeInPeriod is the time elapsed since the beginning of the current period.
+	TimeInPeriod time.Duration
+	// Deadline is the time, relative to the beginning of the current period, of the next timeout expected by the player.
+	Deadline time.Duration
+	// Napping is set when the player is waiting on a random timeout before sending a next-vote.
+	Napping bool
+	// FastRecoveryDeadline is the time, relative to the beginning of the current period, of the next fast partition
+	// recovery timeout.
+	FastRecoveryDeadline time.Duration
+
+	// Pinned is the proposal-value for which a certificate may have formed in an earlier period, if any.
+	Pinned *ProposalID
+	// Proposals are the proposals tracked in the current round, along with whether their payload was received.
+	Proposals []ProposalState
+	// Periods holds the state of the periods tracked in the current round, in increasing order.
+	Periods []PeriodState
+}
+
+// ProposalID identifies a proposal-value.
+type ProposalID struct {
+	OriginalPeriod   uint64
+	OriginalProposer basics.Address
+	BlockDigest      crypto.Digest
+	// Bottom is set for the empty proposal-value, which next-votes may vote for.
+	Bottom bool
+}
+
+// ProposalState describes a proposal tracked by the agreement.
+type ProposalState struct {
+	ProposalID
+	// PayloadReceived is set once the proposal payload matching the proposal-value was received.
+	PayloadReceived bool
+}
+
+// PeriodState describes the state of a single period.
+type PeriodState struct {
+	Period uint64
+	// Frozen is the proposal-value with the lowest credential seen in the period; once the player
+	// freezes it, it no longer changes.
+	Frozen *ProposalID
+	// FrozenFinal is set once the frozen value can no longer change.
+	FrozenFinal bool
+	// Staged is the proposal-value a soft bundle was observed for in the period, if any.
+	Staged *ProposalID
+	// Steps holds the votes counted in each of the steps of the period, in increasing order.
+	Steps []StepState
+}
+
+// StepState describes the votes counted in a single step.
+type StepState struct {
+	Step uint64
+	// Threshold is the total vote weight a single proposal-value needs in order to form a bundle.
+	Threshold uint64
+	// CommitteeSize is the expected total vote weight of the step.
+	CommitteeSize uint64
+	// EquivocatorsWeight is the vote weight of the equivocating voters, which counts toward any proposal-value.
+	EquivocatorsWeight uint64
+	// Proposals are the proposal-values voted for in the step, ordered by decreasing weight.
+	Proposals []ProposalWeight
+}
+
+// ProposalWeight is the vote weight counted for a proposal-value within a step.
+type ProposalWeight struct {
+	ProposalID
+	// Weight is the weight of the votes cast directly for the proposal-value.
+	Weight uint64
+	// Voters is the number of distinct voters that voted for the proposal-value.
+	Voters int
+}
+
+// Inspect returns a snapshot of the current state of the agreement service. The snapshot is taken by the main
+// loop of the service between two events, so it reflects a consistent state of the state machines.
+func (s *Service) Inspect(ctx context.Context) (State, error) {
+	s.runningMu.Lock()
+	running := s.running
+	s.runningMu.Unlock()
+	if running == nil {
+		return State{}, ErrServiceNotRunning
+	}
+	response := make(chan State, 1)
+	select {
+	case s.stateRequests <- response:
+	case <-running:
+		return State{}, ErrServiceNotRunning
+	case <-ctx.Done():
+		return State{}, ctx.Err()
+	}
+	select {
+	case state := <-response:
+		return state, nil
+	case <-ctx.Done():
+		return State{}, ctx.Err()
+	}
+}
+
+// awaitInput waits for the next input event, serving state inspection requests while waiting.
+func (s *Service) awaitInput(input <-chan externalEvent, status player, router *rootRouter) (externalEvent, bool) {
+	for {
+		select {
+		case e, ok := <-input:
+			return e, ok
+		case response := <-s.stateRequests:
+			response <- s.inspect(status, router)
+		}
+	}
+}
+
+// inspect builds a State out of the given player and router.
+func (s *Service) inspect(status player, router *rootRouter) State {
+	state := State{
+		Round:                status.Round,
+		Period:               uint64(status.Period),
+		Step:                 uint64(status.Step),
+		LastConcluding:       uint64(status.LastConcluding),
+		Deadline:             status.Deadline,
+		Napping:              status.Napping,
+		FastRecoveryDeadline: status.FastRecoveryDeadline,
+	}
+	if clock, ok := s.Clock.(timers.WallClock); ok {
+		state.TimeInPeriod = clock.Since()
+	}
+
+	rr := router.Children[status.Round]
+	if rr == nil {
+		return state
+	}
+	var proto config.ConsensusParams
+	if cv, err := s.Ledger.ConsensusVersion(ParamsRound(status.Round)); err == nil {
+		proto = config.Consensus[cv]
+	}
+
+	if rr.ProposalStore.Pinned != bottom {
+		state.Pinned = makeProposalID(rr.ProposalStore.Pinned)
+	}
+	for pv, assembler := range rr.ProposalStore.Assemblers {
+		state.Proposals = append(state.Proposals, ProposalState{
+			ProposalID:      *makeProposalID(pv),
+			PayloadReceived: assembler.Filled,
+		})
+	}
+	sort.Slice(state.Proposals, func(i, j int) bool {
+		return state.Proposals[i].OriginalPeriod < state.Proposals[j].OriginalPeriod ||
+			(state.Proposals[i].OriginalPeriod == state.Proposals[j].OriginalPeriod &&
+				state.Proposals[i].OriginalProposer.String() < state.Proposals[j].OriginalProposer.String())
+	})
+
+	for p, pr := range rr.Children {
+		ps := inspectPeriod(proto, p, pr)
+		// the router creates the state machines of a period upon the first query regarding it, so skip periods
+		// for which nothing was seen.
+		if ps.Frozen == nil && ps.Staged == nil && len(ps.Steps) == 0 {
+			continue
+		}
+		state.Periods = append(state.Periods, ps)
+	}
+	sort.Slice(state.Periods, func(i, j int) bool {
+		return state.Periods[i].Period < state.Periods[j].Period
+	})
+	return state
+}
+
+// inspectPeriod builds a PeriodState out of the given period router.
+func inspectPeriod(proto config.ConsensusParams, p period, pr *periodRouter) PeriodState {
+	ps := PeriodState{Period: uint64(p)}
+	tracker := pr.ProposalTracker
+	if tracker.Freezer.Filled {
+		ps.Frozen = makeProposalID(tracker.Freezer.Lowest.R.Proposal)
+	}
+	ps.FrozenFinal = tracker.Freezer.Frozen
+	if tracker.Staging != bottom {
+		ps.Staged = makeProposalID(tracker.Staging)
+	}
+
+	for st, sr := range pr.Children {
+		if len(sr.VoteTracker.Counts) == 0 && sr.VoteTracker.EquivocatorsCount == 0 {
+			continue
+		}
+		ss := StepState{
+			Step:               uint64(st),
+			CommitteeSize:      st.committeeSize(proto),
+			EquivocatorsWeight: sr.VoteTracker.EquivocatorsCount,
+		}
+		if st != propose {
+			ss.Threshold = st.threshold(proto)
+		}
+		for pv, counter := range sr.VoteTracker.Counts {
+			ss.Proposals = append(ss.Proposals, ProposalWeight{
+				ProposalID: *makeProposalID(pv),
+				Weight:     counter.Count,
+				Voters:     len(counter.Votes),
+			})
+		}
+		sort.Slice(ss.Proposals, func(i, j int) bool {
+			return ss.Proposals[i].Weight > ss.Proposals[j].Weight
+		})
+		ps.Steps = append(ps.Steps, ss)
+	}
+	sort.Slice(ps.Steps, func(i, j int) bool {
+		return ps.Steps[i].Step < ps.Steps[j].Step
+	})
+	return ps
+}
+
+// makeProposalID converts a proposalValue into a ProposalID.
+func makeProposalID(pv proposalValue) *ProposalID {
+	return &ProposalID{
+		OriginalPeriod:   uint64(pv.OriginalPeriod),
+		OriginalProposer: pv.OriginalProposer,
+		BlockDigest:      pv.BlockDigest,
+		Bottom:           pv == bottom,
+	}
+}
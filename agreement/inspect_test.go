@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAgreementInspect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	baseNetwork, baseLedger, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	version, _ := baseLedger.ConsensusVersion(startRound)
+	defer cleanupFn()
+
+	_, err := services[0].Inspect(context.Background())
+	require.Error(t, err)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+
+	// the proposals were gossiped, but no soft vote was sent yet.
+	state, err := services[0].Inspect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, startRound, state.Round)
+	require.Equal(t, uint64(0), state.Period)
+	require.Equal(t, uint64(soft), state.Step)
+	require.Equal(t, FilterTimeout(0, version), state.Deadline)
+	require.NotEmpty(t, state.Proposals)
+	require.Nil(t, state.Pinned)
+	require.Len(t, state.Periods, 1)
+	require.NotNil(t, state.Periods[0].Frozen)
+	require.False(t, state.Periods[0].FrozenFinal)
+	require.Nil(t, state.Periods[0].Staged)
+
+	// withhold the cert votes, so that the round would be stuck right after the soft bundle.
+	pocket := make(chan multicastParams, 100)
+	closeFn := baseNetwork.pocketAllCertVotes(pocket)
+	triggerGlobalTimeout(FilterTimeout(0, version), clocks, activityMonitor)
+	zeroes = expectNoNewPeriod(clocks, zeroes)
+
+	state, err = services[0].Inspect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, startRound, state.Round)
+	require.Equal(t, uint64(cert), state.Step)
+	require.Len(t, state.Periods, 1)
+	period := state.Periods[0]
+	require.True(t, period.FrozenFinal)
+	require.NotNil(t, period.Staged)
+	require.Equal(t, *period.Frozen, *period.Staged)
+
+	var softState *StepState
+	for i := range period.Steps {
+		if period.Steps[i].Step == uint64(soft) {
+			softState = &period.Steps[i]
+		}
+	}
+	require.NotNil(t, softState)
+	require.NotZero(t, softState.Threshold)
+	require.NotEmpty(t, softState.Proposals)
+	require.Equal(t, *period.Staged, softState.Proposals[0].ProposalID)
+	require.GreaterOrEqual(t, softState.Proposals[0].Weight, softState.Threshold)
+	require.Equal(t, numNodes, softState.Proposals[0].Voters)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	closeFn()
+
+	_, err = services[0].Inspect(context.Background())
+	require.Error(t, err)
+}
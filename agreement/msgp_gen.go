@@ -21,6 +21,14 @@ import (
 //      |-----> (*) Msgsize
 //      |-----> (*) MsgIsZero
 //
+// EquivocationEvidence
+//           |-----> (*) MarshalMsg
+//           |-----> (*) CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> (*) Msgsize
+//           |-----> (*) MsgIsZero
+//
 // bundle
 //    |-----> (*) MarshalMsg
 //    |-----> (*) CanMarshalMsg
@@ -516,6 +524,280 @@ func (z *Certificate) MsgIsZero() bool {
 	return ((*z).Round.MsgIsZero()) && ((*z).Period == 0) && ((*z).Step == 0) && ((*z).Proposal.MsgIsZero()) && (len((*z).Votes) == 0) && (len((*z).EquivocationVotes) == 0)
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *EquivocationEvidence) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(7)
+	var zb0002Mask uint8 /* 8 bits */
+	if (*z).KeyDilution == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).Period == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if (*z).Round.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	if (*z).Sender.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x10
+	}
+	if (*z).Step == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x20
+	}
+	if (*z).VoteID.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x40
+	}
+	if ((*z).Votes[0].MsgIsZero()) && ((*z).Votes[1].MsgIsZero()) {
+		zb0002Len--
+		zb0002Mask |= 0x80
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "kd"
+			o = append(o, 0xa2, 0x6b, 0x64)
+			o = msgp.AppendUint64(o, (*z).KeyDilution)
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "per"
+			o = append(o, 0xa3, 0x70, 0x65, 0x72)
+			o = msgp.AppendUint64(o, (*z).Period)
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "rnd"
+			o = append(o, 0xa3, 0x72, 0x6e, 0x64)
+			o = (*z).Round.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x10) == 0 { // if not empty
+			// string "snd"
+			o = append(o, 0xa3, 0x73, 0x6e, 0x64)
+			o = (*z).Sender.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x20) == 0 { // if not empty
+			// string "step"
+			o = append(o, 0xa4, 0x73, 0x74, 0x65, 0x70)
+			o = msgp.AppendUint64(o, (*z).Step)
+		}
+		if (zb0002Mask & 0x40) == 0 { // if not empty
+			// string "vid"
+			o = append(o, 0xa3, 0x76, 0x69, 0x64)
+			o = (*z).VoteID.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x80) == 0 { // if not empty
+			// string "votes"
+			o = append(o, 0xa5, 0x76, 0x6f, 0x74, 0x65, 0x73)
+			o = msgp.AppendArrayHeader(o, 2)
+			for zb0001 := range (*z).Votes {
+				o = (*z).Votes[zb0001].MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *EquivocationEvidence) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*EquivocationEvidence)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *EquivocationEvidence) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Sender.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Sender")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Round.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Round")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Period, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Period")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Step, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Step")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).VoteID.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "VoteID")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).KeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "KeyDilution")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			zb0004, _, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Votes")
+				return
+			}
+			if zb0004 > 2 {
+				err = msgp.ArrayError{Wanted: 2, Got: zb0004}
+				return
+			}
+			for zb0001 := 0; zb0001 < zb0004; zb0001++ {
+				bts, err = (*z).Votes[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Votes", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = EquivocationEvidence{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "snd":
+				bts, err = (*z).Sender.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Sender")
+					return
+				}
+			case "rnd":
+				bts, err = (*z).Round.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Round")
+					return
+				}
+			case "per":
+				(*z).Period, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Period")
+					return
+				}
+			case "step":
+				(*z).Step, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Step")
+					return
+				}
+			case "vid":
+				bts, err = (*z).VoteID.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "VoteID")
+					return
+				}
+			case "kd":
+				(*z).KeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "KeyDilution")
+					return
+				}
+			case "votes":
+				var zb0005 int
+				zb0005, _, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Votes")
+					return
+				}
+				if zb0005 > 2 {
+					err = msgp.ArrayError{Wanted: 2, Got: zb0005}
+					return
+				}
+				for zb0001 := 0; zb0001 < zb0005; zb0001++ {
+					bts, err = (*z).Votes[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Votes", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *EquivocationEvidence) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*EquivocationEvidence)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *EquivocationEvidence) Msgsize() (s int) {
+	s = 1 + 4 + (*z).Sender.Msgsize() + 4 + (*z).Round.Msgsize() + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 4 + (*z).VoteID.Msgsize() + 3 + msgp.Uint64Size + 6 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Votes {
+		s += (*z).Votes[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *EquivocationEvidence) MsgIsZero() bool {
+	return ((*z).Sender.MsgIsZero()) && ((*z).Round.MsgIsZero()) && ((*z).Period == 0) && ((*z).Step == 0) && ((*z).VoteID.MsgIsZero()) && ((*z).KeyDilution == 0) && (((*z).Votes[0].MsgIsZero()) && ((*z).Votes[1].MsgIsZero()))
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *bundle) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
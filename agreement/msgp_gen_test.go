@@ -1,3 +1,4 @@
+//go:build !skip_msgp_testing
 // +build !skip_msgp_testing
 
 package agreement
@@ -72,6 +73,66 @@ func BenchmarkUnmarshalCertificate(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalEquivocationEvidence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := EquivocationEvidence{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingEquivocationEvidence(t *testing.T) {
+	protocol.RunEncodingTest(t, &EquivocationEvidence{})
+}
+
+func BenchmarkMarshalMsgEquivocationEvidence(b *testing.B) {
+	v := EquivocationEvidence{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgEquivocationEvidence(b *testing.B) {
+	v := EquivocationEvidence{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalEquivocationEvidence(b *testing.B) {
+	v := EquivocationEvidence{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalbundle(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := bundle{}
@@ -144,7 +144,7 @@ func (p *player) handleFastTimeout(r routerHandle, e timeoutEvent) []action {
 
 func (p *player) issueSoftVote(r routerHandle) (actions []action) {
 	defer func() {
-		p.Deadline = deadlineTimeout
+		p.Deadline = deadlineTimeout()
 	}()
 
 	e := r.dispatch(*p, proposalFrozenEvent{}, proposalMachinePeriod, p.Round, p.Period, 0)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
@@ -127,7 +128,7 @@ func (i seedInput) ToBeHashed() (protocol.HashID, []byte) {
 	return protocol.ProposerSeed, protocol.Encode(&i)
 }
 
-func deriveNewSeed(address basics.Address, vrf *crypto.VRFSecrets, rnd round, period period, ledger LedgerReader) (newSeed committee.Seed, seedProof crypto.VRFProof, reterr error) {
+func deriveNewSeed(address basics.Address, vrf account.ParticipationSigner, rnd round, period period, ledger LedgerReader) (newSeed committee.Seed, seedProof crypto.VRFProof, reterr error) {
 	var ok bool
 	var vrfOut crypto.VrfOutput
 
@@ -144,9 +145,9 @@ func deriveNewSeed(address basics.Address, vrf *crypto.VRFSecrets, rnd round, pe
 	}
 
 	if period == 0 {
-		seedProof, ok = vrf.SK.Prove(prevSeed)
-		if !ok {
-			reterr = fmt.Errorf("could not make seed proof")
+		seedProof, err = vrf.ProveVRF(prevSeed)
+		if err != nil {
+			reterr = fmt.Errorf("could not make seed proof: %v", err)
 			return
 		}
 		vrfOut, ok = seedProof.Hash()
@@ -230,7 +231,7 @@ func verifyNewSeed(p unauthenticatedProposal, ledger LedgerReader) error {
 	return nil
 }
 
-func proposalForBlock(address basics.Address, vrf *crypto.VRFSecrets, ve ValidatedBlock, period period, ledger LedgerReader) (proposal, proposalValue, error) {
+func proposalForBlock(address basics.Address, vrf account.ParticipationSigner, ve ValidatedBlock, period period, ledger LedgerReader) (proposal, proposalValue, error) {
 	rnd := ve.Block().Round()
 	newSeed, seedProof, err := deriveNewSeed(address, vrf, rnd, period, ledger)
 	if err != nil {
@@ -69,7 +69,7 @@ func TestBlockAssemblerPipeline(t *testing.T) {
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", round, err)
 
 	accountIndex := 0
-	proposal, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, period, ledger)
+	proposal, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, period, ledger)
 	accountIndex++
 
 	uap := unauthenticatedProposal{}
@@ -138,7 +138,7 @@ func TestBlockAssemblerBind(t *testing.T) {
 
 	accountIndex := 0
 
-	proposal, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposal, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 	accountIndex++
 
 	uap := unauthenticatedProposal{}
@@ -204,7 +204,7 @@ func TestBlockAssemblerAuthenticator(t *testing.T) {
 	testBlockFactory, err := factory.AssembleBlock(player.Round, time.Now().Add(time.Minute))
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", player.Round, err)
 	accountIndex := 0
-	proposalPayload, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposalPayload, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	currentAccount := accounts.addresses[accountIndex]
 
@@ -270,7 +270,7 @@ func TestBlockAssemblerTrim(t *testing.T) {
 	testBlockFactory, err := factory.AssembleBlock(player.Round, time.Now().Add(time.Minute))
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", player.Round, err)
 	accountIndex := 0
-	proposalPayload, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposalPayload, _, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	currentAccount := accounts.addresses[accountIndex]
 
@@ -343,7 +343,7 @@ func TestProposalStoreT(t *testing.T) {
 	testBlockFactory, err := factory.AssembleBlock(player.Round, time.Now().Add(time.Minute))
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", player.Round, err)
 	accountIndex := 0
-	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	currentAccount := accounts.addresses[accountIndex]
 
@@ -417,7 +417,7 @@ func TestProposalStoreUnderlying(t *testing.T) {
 	testBlockFactory, err := factory.AssembleBlock(player.Round, time.Now().Add(time.Minute))
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", player.Round, err)
 	accountIndex := 0
-	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	currentAccount := accounts.addresses[accountIndex]
 
@@ -481,9 +481,9 @@ func TestProposalStoreHandle(t *testing.T) {
 	testBlockFactory, err := factory.AssembleBlock(player.Round, time.Now().Add(time.Minute))
 	require.NoError(t, err, "Could not generate a proposal for round %d: %v", player.Round, err)
 	accountIndex := 0
-	_, proposalV0, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	_, proposalV0, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 	accountIndex++
-	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	proposalPayload, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	currentAccount := accounts.addresses[accountIndex]
 
@@ -671,7 +671,7 @@ func TestProposalStoreGetPinnedValue(t *testing.T) {
 		r:   &router,
 		src: proposalMachinePeriod,
 	}
-	payloadV, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.vrfs[accountIndex], testBlockFactory, player.Period, ledger)
+	payloadV, proposalV, _ := proposalForBlock(accounts.addresses[accountIndex], accounts.signer(accountIndex), testBlockFactory, player.Period, ledger)
 
 	testProposalStore := proposalStore{
 		Relevant:   map[period]proposalValue{},
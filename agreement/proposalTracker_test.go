@@ -21,6 +21,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,7 +37,7 @@ func sortedVoteGen(t *testing.T) (votes []vote) {
 			EncodingDigest:   randomBlockHash(),
 		}
 		rv := rawVote{Round: ledger.NextRound(), Sender: addr, Proposal: pv}
-		uv, err := makeVote(rv, ots[i], vrfs[i], ledger)
+		uv, err := makeVote(rv, account.MakeLocalSigner(ots[i], vrfs[i]), ledger)
 		require.NoError(t, err)
 		v, err := uv.verify(ledger)
 		if err == nil {
@@ -310,7 +311,7 @@ func setupProposalTrackerTests(t *testing.T) (votes []vote) {
 			Proposal: prop,
 		}
 
-		uv, err := makeVote(rv, ots[i], vrfs[i], ledger)
+		uv, err := makeVote(rv, account.MakeLocalSigner(ots[i], vrfs[i]), ledger)
 		require.NoError(t, err)
 
 		v, err := uv.verify(ledger)
@@ -21,6 +21,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/algorand/go-deadlock"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -57,6 +59,17 @@ type Service struct {
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
+
+	// stateRequests carries the state inspection requests to the main loop. runningMu guards running, the done
+	// channel of the current execution, which tells Inspect whether the main loop is there to serve the requests.
+	stateRequests chan chan State
+	runningMu     deadlock.Mutex
+	running       chan struct{}
+
+	// equivocations carries the equivocations observed by the main loop to the evidence writer, which closes
+	// evidenceDone once it exits.
+	equivocations chan equivocation
+	evidenceDone  chan struct{}
 }
 
 // Parameters holds the parameters necessary to run the agreement protocol.
@@ -73,6 +86,9 @@ type Parameters struct {
 	logging.Logger
 	config.Local
 	execpool.BacklogPool
+
+	// EvidenceRecorder is optional; when set, it is handed the evidence of every observed equivocation.
+	EvidenceRecorder
 }
 
 // parameters is a convenience typedef for Parameters.
@@ -99,8 +115,10 @@ func MakeService(p Parameters) *Service {
 	// accessed by main state machine loop.
 	s.tracer = makeTracer(s.log, defaultCadaverName, p.CadaverSizeTarget,
 		s.Local.EnableAgreementReporting, s.Local.EnableAgreementTimeMetrics)
+	s.tracer.equivocations = s.enqueueEquivocation
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
+	s.stateRequests = make(chan chan State)
 
 	return s
 }
@@ -118,8 +136,11 @@ func (s *Service) Start() {
 
 	s.quit = make(chan struct{})
 	s.done = make(chan struct{})
+	s.runningMu.Lock()
+	s.running = s.done
+	s.runningMu.Unlock()
 
-	s.voteVerifier = MakeAsyncVoteVerifier(s.BacklogPool)
+	s.voteVerifier = MakeBatchingAsyncVoteVerifier(s.BacklogPool, s.Local.VoteVerificationBatchWindow)
 	s.demux = makeDemux(demuxParams{
 		net:               s.Network,
 		ledger:            s.Ledger,
@@ -140,6 +161,9 @@ func (s *Service) Start() {
 	})
 
 	s.persistenceLoop.Start()
+	s.equivocations = make(chan equivocation, evidenceQueueSize)
+	s.evidenceDone = make(chan struct{})
+	go s.evidenceLoop()
 	input := make(chan externalEvent)
 	output := make(chan []action)
 	ready := make(chan externalDemuxSignals)
@@ -155,6 +179,9 @@ func (s *Service) Shutdown() {
 	s.quitFn()
 	<-s.done
 	s.persistenceLoop.Quit()
+	// the main loop has exited, so no more equivocations are handed to the evidence writer.
+	close(s.equivocations)
+	<-s.evidenceDone
 }
 
 // demuxLoop repeatedly executes pending actions and then requests the next event from the Service.demux.
@@ -223,7 +250,7 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 	for {
 		output <- a
 		ready <- externalDemuxSignals{Deadline: status.Deadline, FastRecoveryDeadline: status.FastRecoveryDeadline, CurrentRound: status.Round}
-		e, ok := <-input
+		e, ok := s.awaitInput(input, status, &router)
 		if !ok {
 			break
 		}
@@ -70,6 +70,9 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+
+	// equivocations, if set, is invoked with the two conflicting votes of every newly observed equivocator.
+	equivocations func(first, second vote)
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -96,6 +99,14 @@ func makeTracer(log serviceLogger, cadaverFilename string, cadaverSizeTarget uin
 	return t
 }
 
+// observeEquivocation reports a newly observed pair of conflicting votes by the same sender.
+func (t *tracer) observeEquivocation(first, second vote) {
+	if t == nil || t.equivocations == nil {
+		return
+	}
+	t.equivocations(first, second)
+}
+
 // call this method to setup timing generators before entering target round, pipelining properly.
 func (t *tracer) resetTimingWithPipeline(target round) {
 	if t.tRPlus1 != nil && t.tRPlus1.i.Round == uint64(target) {
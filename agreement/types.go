@@ -25,9 +25,17 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-var deadlineTimeout = config.Protocol.BigLambda + config.Protocol.SmallLambda
 var partitionStep = next + 3
-var recoveryExtraTimeout = config.Protocol.SmallLambda
+
+// deadlineTimeout and recoveryExtraTimeout are derived from config.Protocol whenever they're needed, so that
+// simulations could evaluate alternative timings by overriding it before starting the agreement services.
+func deadlineTimeout() time.Duration {
+	return config.Protocol.BigLambda + config.Protocol.SmallLambda
+}
+
+func recoveryExtraTimeout() time.Duration {
+	return config.Protocol.SmallLambda
+}
 
 // FilterTimeout is the duration of the first agreement step.
 func FilterTimeout(p period, v protocol.ConsensusVersion) time.Duration {
@@ -40,7 +48,7 @@ func FilterTimeout(p period, v protocol.ConsensusVersion) time.Duration {
 
 // DeadlineTimeout is the duration of the second agreement step.
 func DeadlineTimeout() time.Duration {
-	return deadlineTimeout
+	return deadlineTimeout()
 }
 
 type (
@@ -68,9 +76,9 @@ const (
 )
 
 func (s step) nextVoteRanges() (lower, upper time.Duration) {
-	extra := recoveryExtraTimeout // eg  2500 ms
-	lower = deadlineTimeout       // eg 17500 ms (15000 + 2500)
-	upper = lower + extra         // eg 20000 ms
+	extra := recoveryExtraTimeout() // eg  2500 ms
+	lower = deadlineTimeout()       // eg 17500 ms (15000 + 2500)
+	upper = lower + extra           // eg 20000 ms
 
 	for i := next; i < s; i++ {
 		extra *= 2
@@ -19,13 +19,19 @@ package agreement
 import (
 	"fmt"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
+var voteBatchVerificationTotal = metrics.MakeCounter(metrics.AgreementVoteBatchesVerified)
+var voteBatchVerificationFallback = metrics.MakeCounter(metrics.AgreementVoteBatchFallbacks)
+
 type (
 	// rawVote is the inner struct which is authenticated with keys
 	rawVote struct {
@@ -85,52 +91,76 @@ type (
 	}
 )
 
+// voteVerificationParams holds the ledger state needed to check the
+// signature and credential of an unauthenticatedVote.
+type voteVerificationParams struct {
+	m     committee.Membership
+	proto config.ConsensusParams
+	ephID crypto.OneTimeSignatureIdentifier
+}
+
 // verify verifies that a vote that was received from the network is valid.
 func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
+	vp, err := uv.verificationParams(l)
+	if err != nil {
+		return vote{}, err
+	}
+	return uv.verifyWithParams(vp)
+}
+
+// verificationParams performs the checks on a vote which do not involve any
+// cryptography and looks up the parameters needed to check its signature and credential.
+func (uv unauthenticatedVote) verificationParams(l LedgerReader) (voteVerificationParams, error) {
 	rv := uv.R
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
+		return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
 	}
 
 	switch rv.Step {
 	case propose:
 		if rv.Period == rv.Proposal.OriginalPeriod && rv.Sender != rv.Proposal.OriginalProposer {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
+			return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
 		}
 		// The following check could apply to all steps, but it's sufficient to only check in the propose step.
 		if rv.Proposal.OriginalPeriod > rv.Period {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
+			return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
 		}
 		fallthrough
 	case soft:
 		fallthrough
 	case cert:
 		if rv.Proposal == bottom {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
+			return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
 		}
 	}
 
 	proto, err := l.ConsensusParams(ParamsRound(rv.Round))
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
+		return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
 	}
 
 	if rv.Round < m.Record.VoteFirstValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
+		return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
 	}
 
 	if m.Record.VoteLastValid != 0 && rv.Round > m.Record.VoteLastValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
+		return voteVerificationParams{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
 	}
 
 	ephID := basics.OneTimeIDForRound(rv.Round, m.Record.KeyDilution(proto))
-	voteID := m.Record.VoteID
-	if !voteID.Verify(ephID, rv, uv.Sig) {
+	return voteVerificationParams{m: m, proto: proto, ephID: ephID}, nil
+}
+
+// verifyWithParams checks the signature and credential of a vote.
+func (uv unauthenticatedVote) verifyWithParams(vp voteVerificationParams) (vote, error) {
+	rv := uv.R
+	voteID := vp.m.Record.VoteID
+	if !voteID.Verify(vp.ephID, rv, uv.Sig) {
 		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", rv.Sender, voteID, uv)
 	}
 
-	cred, err := uv.Cred.Verify(proto, m)
+	cred, err := uv.Cred.Verify(vp.proto, vp.m)
 	if err != nil {
 		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
 	}
@@ -138,10 +168,62 @@ func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
 	return vote{R: rv, Cred: cred, Sig: uv.Sig}, nil
 }
 
+// verifyVoteBatch checks the signatures and credentials of several votes at
+// once, given the parameters returned by verificationParams for each of them.
+//
+// The one-time signatures of all the votes are verified as a single batch. If
+// the batch fails, every vote is verified once on its own, so that only the
+// invalid votes are rejected. Each VRF proof is verified once.
+func verifyVoteBatch(uvs []unauthenticatedVote, vps []voteVerificationParams) ([]vote, []error) {
+	votes := make([]vote, len(uvs))
+	errs := make([]error, len(uvs))
+	if len(uvs) == 0 {
+		return votes, errs
+	}
+
+	voteBatchVerificationTotal.Inc(nil)
+	sigBatch := crypto.MakeBatchVerifier(3 * len(uvs))
+	for i, uv := range uvs {
+		vps[i].m.Record.VoteID.EnqueueBatch(sigBatch, vps[i].ephID, uv.R, uv.Sig)
+	}
+	if sigBatch.Verify() != nil {
+		voteBatchVerificationFallback.Inc(nil)
+		for i, uv := range uvs {
+			votes[i], errs[i] = uv.verifyWithParams(vps[i])
+		}
+		return votes, errs
+	}
+
+	vrfBatch := crypto.MakeVrfBatchVerifier(len(uvs))
+	for i, uv := range uvs {
+		vrfBatch.EnqueueProof(vps[i].m.Record.SelectionID, uv.Cred.Proof, vps[i].m.Selector)
+	}
+	vrfOuts, vrfValid, err := vrfBatch.Verify()
+	if err != nil {
+		for i := range uvs {
+			errs[i] = fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
+		}
+		return votes, errs
+	}
+	for i, uv := range uvs {
+		if !vrfValid[i] {
+			errs[i] = fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", crypto.ErrBatchVerificationFailed)
+			continue
+		}
+		cred, err := uv.Cred.VerifyWithOutput(vps[i].proto, vps[i].m, vrfOuts[i])
+		if err != nil {
+			errs[i] = fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
+			continue
+		}
+		votes[i] = vote{R: uv.R, Cred: cred, Sig: uv.Sig}
+	}
+	return votes, errs
+}
+
 // makeVote creates a new unauthenticated vote from its constituent components.
 //
 // makeVote returns an error it it fails.
-func makeVote(rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecrets, l Ledger) (unauthenticatedVote, error) {
+func makeVote(rv rawVote, signer account.ParticipationSigner, l Ledger) (unauthenticatedVote, error) {
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
 		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not get membership parameters: %v", err)
@@ -172,13 +254,20 @@ func makeVote(rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecr
 		}
 	}
 
-	ephID := basics.OneTimeIDForRound(rv.Round, voting.KeyDilution(proto))
-	sig := voting.Sign(ephID, rv)
+	ephID := basics.OneTimeIDForRound(rv.Round, signer.KeyDilution(proto))
+	sig, err := signer.SignOneTime(ephID, rv)
+	if err != nil {
+		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not sign vote: %v", err)
+	}
 	if (sig == crypto.OneTimeSignature{}) {
 		return unauthenticatedVote{}, fmt.Errorf("makeVote: got back empty signature for vote")
 	}
 
-	cred := committee.MakeCredential(&selection.SK, m.Selector)
+	proof, err := signer.ProveVRF(m.Selector)
+	if err != nil {
+		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not construct credential: %v", err)
+	}
+	cred := committee.UnauthenticatedCredential{Proof: proof}
 	return unauthenticatedVote{R: rv, Cred: cred, Sig: sig}, nil
 }
 
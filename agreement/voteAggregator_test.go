@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -59,7 +60,7 @@ func TestVoteAggregatorVotes(t *testing.T) {
 			address := addresses[i]
 			step := step(s)
 			rv := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			uv, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			assert.NoError(t, err)
 
 			vote, err := uv.verify(ledger)
@@ -115,7 +116,7 @@ func TestVoteAggregatorBundles(t *testing.T) {
 			address := addresses[i]
 			step := step(s)
 			rv := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-			uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			uv, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			assert.NoError(t, err)
 
 			vote, err := uv.verify(ledger)
@@ -885,7 +886,7 @@ func TestVoteAggregatorOldVote(t *testing.T) {
 		address := addresses[i]
 		step := step(1)
 		rv := rawVote{Sender: address, Round: round, Period: period, Step: step, Proposal: proposal}
-		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		uv, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		assert.NoError(t, err)
 		uvs = append(uvs, uv)
 	}
@@ -173,6 +173,7 @@ func (tracker *voteTracker) handle(r routerHandle, p player, e0 event) event {
 			logging.Base().EventWithDetails(telemetryspec.ApplicationState, telemetryspec.EquivocatedVoteEvent, equivocationDetails)
 
 			logging.Base().Warnf("voteTracker: observed an equivocator: %v (vote was %v)", sender, e.Vote)
+			r.t.observeEquivocation(oldVote, e.Vote)
 
 			// sender was not already marked as an equivocator so track
 			// their weight
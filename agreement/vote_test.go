@@ -17,6 +17,8 @@
 package agreement
 
 import (
+	"fmt"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -24,11 +26,13 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
 )
 
 // error is set if this address is not selected
@@ -36,7 +40,7 @@ func makeVoteTesting(addr basics.Address, vrfSecs *crypto.VRFSecrets, otSecs cry
 	var proposal proposalValue
 	proposal.BlockDigest = digest
 	rv := rawVote{Sender: addr, Round: round, Period: period, Step: step, Proposal: proposal}
-	v, fatalerr := makeVote(rv, otSecs, vrfSecs, ledger)
+	v, fatalerr := makeVote(rv, account.MakeLocalSigner(otSecs, vrfSecs), ledger)
 	if fatalerr != nil {
 		panic(fatalerr)
 	}
@@ -57,7 +61,7 @@ func TestVoteValidation(t *testing.T) {
 		proposal.BlockDigest = randomBlockHash()
 		proposal.OriginalProposer = address
 		rv := rawVote{Sender: address, Round: round, Period: period, Step: step(i), Proposal: proposal}
-		unauthenticatedVote, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		require.NoError(t, err)
 
 		m, err := membership(ledger, address, round, period, step(i))
@@ -132,7 +136,7 @@ func TestVoteReproposalValidation(t *testing.T) {
 		proposal.OriginalProposer = address
 		proposal.OriginalPeriod = per
 		rv := rawVote{Sender: address, Round: round, Period: per, Step: step(0), Proposal: proposal}
-		unauthenticatedVote, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		require.NoError(t, err)
 
 		m, err := membership(ledger, address, round, per, step(0))
@@ -150,7 +154,7 @@ func TestVoteReproposalValidation(t *testing.T) {
 			rv = rawVote{Sender: address, Round: round, Period: per, Step: step(0), Proposal: proposal}
 			rv.Proposal.OriginalPeriod = period(0)
 			rv.Proposal.OriginalProposer = basics.Address(randomBlockHash())
-			reproposalVote, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			reproposalVote, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			_, err = reproposalVote.verify(ledger)
 			require.NoError(t, err)
@@ -159,7 +163,7 @@ func TestVoteReproposalValidation(t *testing.T) {
 			rv = rawVote{Sender: address, Round: round, Period: per, Step: step(0), Proposal: proposal}
 			rv.Proposal.OriginalPeriod = period(1)
 			rv.Proposal.OriginalProposer = basics.Address(randomBlockHash())
-			badReproposalVote, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			badReproposalVote, err := makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			_, err = badReproposalVote.verify(ledger)
 			require.Error(t, err)
@@ -168,7 +172,7 @@ func TestVoteReproposalValidation(t *testing.T) {
 			rv = rawVote{Sender: address, Round: round, Period: per, Step: step(0), Proposal: proposal}
 			rv.Proposal.OriginalPeriod = period(2)
 			rv.Proposal.OriginalProposer = address
-			badReproposalVote, err = makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			badReproposalVote, err = makeVote(rv, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 			require.NoError(t, err)
 			_, err = badReproposalVote.verify(ledger)
 			require.Error(t, err)
@@ -191,7 +195,7 @@ func TestVoteMakeVote(t *testing.T) {
 
 	address := addresses[addressIndex]
 	rv := rawVote{Sender: address, Round: round, Period: period, Step: step(addressIndex), Proposal: proposal}
-	unauthenticatedVote, err := makeVote(rv, otSecrets[addressIndex], vrfSecrets[addressIndex], ledger)
+	unauthenticatedVote, err := makeVote(rv, account.MakeLocalSigner(otSecrets[addressIndex], vrfSecrets[addressIndex]), ledger)
 	require.NoError(t, err)
 	require.NotNil(t, unauthenticatedVote)
 
@@ -200,7 +204,7 @@ func TestVoteMakeVote(t *testing.T) {
 
 	// TODO, fail membership and one time signature
 	rv = rawVote{Sender: basics.Address{}, Round: round, Period: period, Step: step(addressIndex), Proposal: proposal}
-	unauthenticatedVote, err = makeVote(rv, otSecrets[addressIndex], vrfSecrets[addressIndex], ledger)
+	unauthenticatedVote, err = makeVote(rv, account.MakeLocalSigner(otSecrets[addressIndex], vrfSecrets[addressIndex]), ledger)
 	//require.Error(t, err)
 
 	//  creating a vote in cert and bottom mode results in panic.
@@ -211,9 +215,68 @@ func TestVoteMakeVote(t *testing.T) {
 
 }
 
+func TestVoteRemoteSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesis, addresses, vrfSecrets, otSecrets := generateEnvironment(10)
+	ledger := makeTestLedger(genesis)
+
+	keys := make([]account.PersistedParticipation, len(addresses))
+	for i := range addresses {
+		partDB, err := db.MakeAccessor(fmt.Sprintf("%s_part%d", t.Name(), i), false, true)
+		require.NoError(t, err)
+		defer partDB.Close()
+		keys[i] = account.PersistedParticipation{
+			Participation: account.Participation{
+				Parent:      addresses[i],
+				VRF:         vrfSecrets[i],
+				Voting:      otSecrets[i].OneTimeSignatureSecrets,
+				FirstValid:  0,
+				LastValid:   1000,
+				KeyDilution: otSecrets[i].KeyDilution(config.Consensus[protocol.ConsensusCurrentVersion]),
+			},
+			Store: partDB,
+		}
+		require.NoError(t, keys[i].Persist())
+	}
+	stateDB, err := db.MakeAccessor(t.Name()+"_state", false, true)
+	require.NoError(t, err)
+	defer stateDB.Close()
+	server, err := account.MakeRemoteSignerServer(keys, stateDB, "token", logging.TestingLog(t))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	remote, err := account.FetchRemoteParticipations(httpServer.URL, "token")
+	require.NoError(t, err)
+	require.Len(t, remote, len(addresses))
+
+	round := ledger.NextRound()
+	selected := 0
+	for _, part := range remote {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		proposal.OriginalProposer = part.Parent
+		rv := rawVote{Sender: part.Parent, Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeVote(rv, part.Signer(), ledger)
+		require.NoError(t, err)
+
+		// a vote for another proposal in the same round, period and step is refused.
+		conflicting := rv
+		conflicting.Proposal.BlockDigest = randomBlockHash()
+		_, err = makeVote(conflicting, part.Signer(), ledger)
+		require.Error(t, err)
+
+		if _, err := uv.verify(ledger); err == nil {
+			selected++
+		}
+	}
+	require.NotZero(t, selected)
+}
+
 func makeVotePanicWrapper(t *testing.T, message string, rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecrets, l Ledger) (uav unauthenticatedVote, err error) {
 	logging.Base().SetOutput(nullWriter{})
-	require.Panics(t, func() { uav, err = makeVote(rv, voting, selection, l) })
+	require.Panics(t, func() { uav, err = makeVote(rv, account.MakeLocalSigner(voting, selection), l) })
 	logging.Base().SetOutput(os.Stderr)
 	return
 }
@@ -232,7 +295,7 @@ func TestVoteValidationStepCertAndProposalBottom(t *testing.T) {
 
 		//  creating a vote in cert and bottom mode results in panic.
 		rawVote := rawVote{Sender: address, Round: round, Period: period, Step: step(i), Proposal: proposal}
-		unauthenticatedVote, err := makeVote(rawVote, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote, err := makeVote(rawVote, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 
 		_, err = unauthenticatedVote.verify(ledger)
 		//loop to find votes selected to participate
@@ -262,17 +325,17 @@ func TestEquivocationVoteValidation(t *testing.T) {
 		var proposal1 proposalValue
 		proposal1.BlockDigest = randomBlockHash()
 		rv0 := rawVote{Sender: address, Round: round, Period: period, Step: step(i), Proposal: proposal1}
-		unauthenticatedVote0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote0, err := makeVote(rv0, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		require.NoError(t, err)
 
 		rv0Copy := rawVote{Sender: address, Round: round, Period: period, Step: step(i), Proposal: proposal1}
-		unauthenticatedVote0Copy, err := makeVote(rv0Copy, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote0Copy, err := makeVote(rv0Copy, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		require.NoError(t, err)
 
 		var proposal2 proposalValue
 		proposal2.BlockDigest = randomBlockHash()
 		rv1 := rawVote{Sender: address, Round: round, Period: period, Step: step(i), Proposal: proposal2}
-		unauthenticatedVote1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+		unauthenticatedVote1, err := makeVote(rv1, account.MakeLocalSigner(otSecrets[i], vrfSecrets[i]), ledger)
 		require.NoError(t, err)
 
 		m, err := membership(ledger, address, round, period, step(i))
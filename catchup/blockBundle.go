@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// readBlockBundleHeader reads the header of the block bundle file at the given path.
+func readBlockBundleHeader(path string) (rpcs.BlockBundleHeader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return rpcs.BlockBundleHeader{}, err
+	}
+	defer f.Close()
+	br, err := rpcs.OpenBlockBundle(f)
+	if err != nil {
+		return rpcs.BlockBundleHeader{}, err
+	}
+	defer br.Close()
+	return br.Header, nil
+}
+
+// importBlockBundles adds to the ledger the blocks of the block bundles found in the given directory, as long as they
+// extend the ledger. The blocks are authenticated and validated just like blocks fetched from peers would be.
+func (s *Service) importBlockBundles(dir string) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		s.log.Warnf("importBlockBundles: unable to list the block bundles in %s: %v", dir, err)
+		return
+	}
+	type bundleFile struct {
+		path   string
+		header rpcs.BlockBundleHeader
+	}
+	var bundles []bundleFile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), rpcs.BlockBundleFileExtension) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		header, err := readBlockBundleHeader(path)
+		if err != nil {
+			s.log.Warnf("importBlockBundles: skipping %s: %v", path, err)
+			continue
+		}
+		bundles = append(bundles, bundleFile{path: path, header: header})
+	}
+	if len(bundles) == 0 {
+		return
+	}
+	sort.Slice(bundles, func(i, j int) bool { return bundles[i].header.FirstRound < bundles[j].header.FirstRound })
+
+	lastBlock, err := s.ledger.Block(s.ledger.LastRound())
+	if err != nil {
+		s.log.Warnf("importBlockBundles: unable to retrieve the last block: %v", err)
+		return
+	}
+	genesisHash := lastBlock.GenesisHash()
+
+	start := time.Now()
+	atomic.CompareAndSwapInt64(&s.syncStartNS, 0, start.UnixNano())
+	defer atomic.StoreInt64(&s.syncStartNS, 0)
+	pr := s.ledger.LastRound()
+	for _, bundle := range bundles {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if bundle.header.GenesisHash != genesisHash {
+			s.log.Warnf("importBlockBundles: skipping %s, which belongs to %s (%v)", bundle.path, bundle.header.GenesisID, bundle.header.GenesisHash)
+			continue
+		}
+		if bundle.header.LastRound <= s.ledger.LastRound() {
+			continue
+		}
+		if bundle.header.FirstRound > s.ledger.LastRound()+1 {
+			s.log.Warnf("importBlockBundles: no bundle holds round %d; the following bundles would be imported once the ledger reaches round %d", s.ledger.LastRound()+1, bundle.header.FirstRound-1)
+			break
+		}
+		err = s.importBlockBundle(bundle.path)
+		if err != nil {
+			s.log.Warnf("importBlockBundles: stopped importing %s at round %d: %v", bundle.path, s.ledger.LastRound()+1, err)
+			break
+		}
+	}
+	if s.ledger.LastRound() == pr {
+		return
+	}
+	s.log.Infof("importBlockBundles: imported rounds %d to %d from %s in %v", pr+1, s.ledger.LastRound(), dir, time.Now().Sub(start))
+}
+
+// importBlockBundle adds to the ledger the blocks of the given bundle which extend it.
+func (s *Service) importBlockBundle(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	br, err := rpcs.OpenBlockBundle(f)
+	if err != nil {
+		return err
+	}
+	defer br.Close()
+
+	for {
+		block, cert, err := br.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if block.Round() <= s.ledger.LastRound() {
+			continue
+		}
+		if s.nextRoundIsNotSupported(block.Round()) {
+			return fmt.Errorf("round %d is not supported", block.Round())
+		}
+		err = s.importBlock(block, cert)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// importBlock authenticates, validates and adds the given block to the ledger. The block needs to be the next one.
+func (s *Service) importBlock(block *bookkeeping.Block, cert *agreement.Certificate) error {
+	r := block.Round()
+	if s.cfg.CatchupVerifyPaysetHash() && !block.ContentsMatchHeader() {
+		return fmt.Errorf("block %d contents don't match its header", r)
+	}
+	if s.cfg.CatchupVerifyCertificate() {
+		err := s.auth.Authenticate(block, cert)
+		if err != nil {
+			return fmt.Errorf("cert did not authenticate block %d: %w", r, err)
+		}
+	}
+
+	// make sure the ledger wrote enough of the account data to disk, so that it wouldn't hold too many blocks in memory.
+	proto, err := s.ledger.ConsensusParams(r.SubSaturate(1))
+	if err != nil {
+		return err
+	}
+	select {
+	case <-s.ledger.Wait(r.SubSaturate(basics.Round(proto.MaxBalLookback))):
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+
+	if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
+		var vb *ledgercore.ValidatedBlock
+		vb, err = s.ledger.Validate(s.ctx, *block, s.blockValidationPool)
+		if err != nil {
+			return fmt.Errorf("failed to validate block %d: %w", r, err)
+		}
+		err = s.ledger.AddValidatedBlock(*vb, *cert)
+	} else {
+		err = s.ledger.AddBlock(*block, *cert)
+	}
+	if _, ok := err.(ledgercore.BlockInLedgerError); ok {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package catchup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func writeTestBlockBundle(t *testing.T, dir string, l *data.Ledger, genesisHash crypto.Digest, first, last basics.Round) {
+	header := rpcs.BlockBundleHeader{GenesisID: "test", GenesisHash: genesisHash, FirstRound: first, LastRound: last}
+	f, err := os.Create(filepath.Join(dir, rpcs.BlockBundleFileName(header.GenesisID, first, last)))
+	require.NoError(t, err)
+	defer f.Close()
+
+	bw, err := rpcs.MakeBlockBundleWriter(f, header)
+	require.NoError(t, err)
+	for r := first; r <= last; r++ {
+		data, err := rpcs.RawBlockBytes(l, r)
+		require.NoError(t, err)
+		require.NoError(t, bw.Add(data))
+	}
+	require.NoError(t, bw.Close())
+}
+
+func TestImportBlockBundles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, 12)
+	genesis, err := remote.Block(0)
+	require.NoError(t, err)
+
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, genesis)
+
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 5, 8)
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 1, 4)
+	// overlapping bundles are fine.
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 3, 6)
+	// round 9 is missing, so the last bundle can't be imported.
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 10, 13)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "garbage"+rpcs.BlockBundleFileExtension), []byte("garbage"), 0644))
+
+	syncer := MakeService(logging.TestingLog(t), defaultConfig, &httpTestPeerSource{}, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	syncer.testStart()
+	syncer.importBlockBundles(dir)
+	require.Equal(t, basics.Round(8), local.LastRound())
+	for r := basics.Round(1); r <= local.LastRound(); r++ {
+		localBlock, err := local.Block(r)
+		require.NoError(t, err)
+		remoteBlock, err := remote.Block(r)
+		require.NoError(t, err)
+		require.Equal(t, remoteBlock.Hash(), localBlock.Hash())
+	}
+
+	// once round 9 shows up, the import picks up where it stopped.
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 9, 9)
+	syncer.importBlockBundles(dir)
+	require.Equal(t, basics.Round(13), local.LastRound())
+}
+
+func TestImportBlockBundlesChecks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, 4)
+	genesis, err := remote.Block(0)
+	require.NoError(t, err)
+
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, genesis)
+
+	// bundles of other networks are skipped.
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	otherGenesis := genesis
+	otherGenesis.BlockHeader.GenesisHash = crypto.Hash([]byte("other"))
+	otherLocal := new(mockedLedger)
+	otherLocal.blocks = append(otherLocal.blocks, otherGenesis)
+	writeTestBlockBundle(t, dir, remote, genesis.GenesisHash(), 1, 5)
+
+	syncer := MakeService(logging.TestingLog(t), defaultConfig, &httpTestPeerSource{}, otherLocal, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	syncer.testStart()
+	syncer.importBlockBundles(dir)
+	require.Equal(t, basics.Round(0), otherLocal.LastRound())
+
+	// blocks which fail to authenticate stop the import.
+	syncer = MakeService(logging.TestingLog(t), defaultConfig, &httpTestPeerSource{}, local, &mockedAuthenticator{errorRound: 3}, nil, nil)
+	syncer.testStart()
+	syncer.importBlockBundles(dir)
+	require.Equal(t, basics.Round(2), local.LastRound())
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package catchup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+const (
+	// catchupStealMinTimeout is the shortest time we would wait for the peer assigned to a round before handing the
+	// rest of its range to another peer.
+	catchupStealMinTimeout = 2 * time.Second
+
+	// catchupStealSlowdownFactor is how much slower than its average block download time an assigned peer may be
+	// before the rest of its range is handed to another peer.
+	catchupStealSlowdownFactor = 4
+)
+
+// peerRange is a contiguous range of rounds assigned to a single peer.
+type peerRange struct {
+	first basics.Round
+	last  basics.Round
+	peer  *peerSelectorPeer
+}
+
+// peerRangeAssigner splits the rounds requested by the pipelined fetch into contiguous ranges, one per peer of the
+// best available peer class, sized in proportion to the throughput measured for each of these peers. The ranges
+// are planned one window at a time, so that the split follows the peers' performance as it changes.
+//
+// When an assigned peer turns out to be much slower than its measured performance, the rounds remaining in its
+// range are stolen by the fastest other peer.
+type peerRangeAssigner struct {
+	mu         deadlock.Mutex
+	selector   *peerSelector
+	stats      *PeerStatsStore
+	windowSize uint64
+
+	ranges []peerRange
+	// planEnd is the first round which isn't covered by the planned ranges.
+	planEnd basics.Round
+}
+
+// makePeerRangeAssigner creates a peerRangeAssigner planning windowSize rounds at a time.
+func makePeerRangeAssigner(selector *peerSelector, stats *PeerStatsStore, windowSize uint64) *peerRangeAssigner {
+	if windowSize == 0 {
+		windowSize = 1
+	}
+	return &peerRangeAssigner{
+		selector:   selector,
+		stats:      stats,
+		windowSize: windowSize,
+	}
+}
+
+// peerForRound returns the peer assigned to fetch the given round, or nil if no peer could be assigned.
+func (a *peerRangeAssigner) peerForRound(r basics.Round) *peerSelectorPeer {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if r >= a.planEnd {
+		a.plan(r)
+	}
+	for _, pr := range a.ranges {
+		if pr.first <= r && r <= pr.last {
+			return pr.peer
+		}
+	}
+	return nil
+}
+
+// plan splits the window of rounds starting at the given round between the peers of the best available class.
+// The caller is expected to hold the lock.
+func (a *peerRangeAssigner) plan(from basics.Round) {
+	peers := a.selector.bestClassPeers()
+	if len(peers) == 0 {
+		return
+	}
+	weights := a.peerWeights(peers)
+	// give the first rounds of the window, which are the first to be written to the ledger, to the fastest peers.
+	sort.SliceStable(peers, func(i, j int) bool {
+		return weights[peerAddress(peers[i].Peer)] > weights[peerAddress(peers[j].Peer)]
+	})
+	peerWeights := make([]float64, len(peers))
+	for i, psp := range peers {
+		peerWeights[i] = weights[peerAddress(psp.Peer)]
+	}
+
+	// keep the ranges of the previous window around, for rounds which are retried after the window moved on.
+	var ranges []peerRange
+	for _, pr := range a.ranges {
+		if pr.last+basics.Round(a.windowSize) >= from {
+			ranges = append(ranges, pr)
+		}
+	}
+	first := from
+	for i, size := range splitProportionally(a.windowSize, peerWeights) {
+		if size == 0 {
+			continue
+		}
+		ranges = append(ranges, peerRange{first: first, last: first + basics.Round(size) - 1, peer: peers[i]})
+		first += basics.Round(size)
+	}
+	a.ranges = ranges
+	a.planEnd = first
+}
+
+// peerWeights returns the throughput weight of each of the given peers, indexed by the peers' addresses. The weight
+// of a peer is its measured throughput scaled by its success rate. Peers we don't know enough about are given the
+// average weight of the known peers, so that they get a fair chance to prove themselves.
+func (a *peerRangeAssigner) peerWeights(peers []*peerSelectorPeer) map[string]float64 {
+	weights := make(map[string]float64, len(peers))
+	var knownSum float64
+	var knownCount int
+	var unknown []string
+	for _, psp := range peers {
+		address := peerAddress(psp.Peer)
+		perf, known := a.stats.performance(address)
+		weight := perf.throughput() * perf.successRate()
+		if !known || weight <= 0 {
+			unknown = append(unknown, address)
+			continue
+		}
+		weights[address] = weight
+		knownSum += weight
+		knownCount++
+	}
+	defaultWeight := 1.0
+	if knownCount > 0 {
+		defaultWeight = knownSum / float64(knownCount)
+	}
+	for _, address := range unknown {
+		weights[address] = defaultWeight
+	}
+	return weights
+}
+
+// stealTimeout returns how long to wait for the given peer to deliver a round assigned to it before stealing the
+// rest of its range, or zero if we don't know enough about the peer to tell it's stuck.
+func (a *peerRangeAssigner) stealTimeout(psp *peerSelectorPeer) time.Duration {
+	perf, known := a.stats.performance(peerAddress(psp.Peer))
+	if !known || perf.successes == 0 {
+		return 0
+	}
+	timeout := perf.averageDownloadDuration() * catchupStealSlowdownFactor
+	if timeout < catchupStealMinTimeout {
+		timeout = catchupStealMinTimeout
+	}
+	return timeout
+}
+
+// steal hands the rounds following r in the range the given peer was assigned to the fastest other peer of the best
+// available class. It returns the peer that took over the rounds, or nil if there was none to hand them to.
+func (a *peerRangeAssigner) steal(r basics.Round, slow *peerSelectorPeer) *peerSelectorPeer {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slowAddress := peerAddress(slow.Peer)
+	for i, pr := range a.ranges {
+		if r < pr.first || r > pr.last || peerAddress(pr.peer.Peer) != slowAddress {
+			continue
+		}
+		if r == pr.last {
+			return nil
+		}
+		var candidates []*peerSelectorPeer
+		for _, psp := range a.selector.bestClassPeers() {
+			if peerAddress(psp.Peer) != slowAddress {
+				candidates = append(candidates, psp)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+		weights := a.peerWeights(candidates)
+		fastest := candidates[0]
+		for _, psp := range candidates[1:] {
+			if weights[peerAddress(psp.Peer)] > weights[peerAddress(fastest.Peer)] {
+				fastest = psp
+			}
+		}
+		stolen := peerRange{first: r + 1, last: pr.last, peer: fastest}
+		a.ranges[i].last = r
+		a.ranges = append(a.ranges, stolen)
+		return fastest
+	}
+	return nil
+}
+
+// splitProportionally splits total into len(weights) parts, proportional to the given weights, using the largest
+// remainder method so that the parts add up to total.
+func splitProportionally(total uint64, weights []float64) []uint64 {
+	parts := make([]uint64, len(weights))
+	var weightSum float64
+	for _, w := range weights {
+		weightSum += w
+	}
+	if len(weights) == 0 || weightSum <= 0 {
+		return parts
+	}
+	remainders := make([]float64, len(weights))
+	var assigned uint64
+	for i, w := range weights {
+		share := float64(total) * w / weightSum
+		parts[i] = uint64(share)
+		remainders[i] = share - float64(parts[i])
+		assigned += parts[i]
+	}
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for i := 0; assigned < total; i = (i + 1) % len(order) {
+		parts[order[i]]++
+		assigned++
+	}
+	return parts
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package catchup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSplitProportionally(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, []uint64{8, 2}, splitProportionally(10, []float64{4, 1}))
+	require.Equal(t, []uint64{4, 3, 3}, splitProportionally(10, []float64{1, 1, 1}))
+	require.Equal(t, []uint64{10, 0}, splitProportionally(10, []float64{1000, 1}))
+	require.Equal(t, []uint64{0, 0}, splitProportionally(10, []float64{0, 0}))
+	require.Equal(t, []uint64{}, splitProportionally(10, []float64{}))
+}
+
+func makeRangeAssignerTestSelector(addresses ...string) *peerSelector {
+	return makePeerSelector(
+		makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer {
+			peers := make([]network.Peer, 0, len(addresses))
+			for _, address := range addresses {
+				peers = append(peers, &mockHTTPPeer{address: address})
+			}
+			return peers
+		}), []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookArchivers}},
+	)
+}
+
+func TestPeerRangeAssignerProportionalRanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stats := makeMemoryPeerStatsStore()
+	for r := basics.Round(1); r <= peerStatsMinSamples; r++ {
+		// the fast peer downloads three times as many bytes per second as the slow one.
+		stats.recordDownload("fast", r, 3000, time.Second)
+		stats.recordDownload("slow", r, 1000, time.Second)
+	}
+	selector := makeRangeAssignerTestSelector("slow", "fast", "new")
+	assigner := makePeerRangeAssigner(selector, stats, 20)
+
+	assigned := make(map[string][]basics.Round)
+	for r := basics.Round(100); r < 120; r++ {
+		psp := assigner.peerForRound(r)
+		require.NotNil(t, psp)
+		address := peerAddress(psp.Peer)
+		assigned[address] = append(assigned[address], r)
+	}
+	// the unknown peer is given the average throughput of the known peers.
+	require.Len(t, assigned["fast"], 10)
+	require.Len(t, assigned["new"], 7)
+	require.Len(t, assigned["slow"], 3)
+	// the fastest peer gets the first rounds, and each peer gets a contiguous range.
+	require.Equal(t, basics.Round(100), assigned["fast"][0])
+	for _, rounds := range assigned {
+		require.Equal(t, rounds[0]+basics.Round(len(rounds)-1), rounds[len(rounds)-1])
+	}
+
+	// rounds past the planned window get a new plan.
+	require.NotNil(t, assigner.peerForRound(120))
+	require.Equal(t, basics.Round(140), assigner.planEnd)
+}
+
+func TestPeerRangeAssignerSteal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stats := makeMemoryPeerStatsStore()
+	for r := basics.Round(1); r <= peerStatsMinSamples; r++ {
+		stats.recordDownload("a", r, 1000, 100*time.Millisecond)
+		stats.recordDownload("b", r, 1000, 5*time.Second)
+		stats.recordDownload("c", r, 1000, time.Second)
+	}
+	selector := makeRangeAssignerTestSelector("a", "b", "c")
+	assigner := makePeerRangeAssigner(selector, stats, 30)
+
+	slow := assigner.peerForRound(1)
+	require.Equal(t, "a", peerAddress(slow.Peer))
+	require.Equal(t, catchupStealMinTimeout, assigner.stealTimeout(slow))
+	// the slowest peer is too slow to be assigned any of the rounds; its steal timeout follows its average.
+	require.Equal(t, "c", peerAddress(assigner.peerForRound(30).Peer))
+	require.Equal(t, 20*time.Second, assigner.stealTimeout(&peerSelectorPeer{&mockHTTPPeer{address: "b"}, network.PeersPhonebookArchivers}))
+
+	// the rounds following the stuck round are taken over by the fastest other peer.
+	thief := assigner.steal(3, slow)
+	require.NotNil(t, thief)
+	require.Equal(t, "c", peerAddress(thief.Peer))
+	require.Equal(t, "a", peerAddress(assigner.peerForRound(3).Peer))
+	require.Equal(t, "c", peerAddress(assigner.peerForRound(4).Peer))
+
+	// a peer can't steal from a range that isn't assigned to it.
+	require.Nil(t, assigner.steal(4, slow))
+
+	// peers we know nothing about can't be told to be stuck.
+	require.Equal(t, time.Duration(0), assigner.stealTimeout(&peerSelectorPeer{&mockHTTPPeer{address: "d"}, network.PeersPhonebookArchivers}))
+}
@@ -111,6 +111,10 @@ type peerSelector struct {
 	peerClasses []peerClass
 	pools       []peerPool
 	counter     uint64
+
+	// stats, when set, provides the performance measured for the peers in the past, which is used for the initial
+	// ranking of peers that are new to the selector.
+	stats *PeerStatsStore
 }
 
 // historicStats stores the past windowSize ranks for the peer passed
@@ -131,7 +135,12 @@ type historicStats struct {
 }
 
 func makeHistoricStatus(windowSize int, class peerClass) *historicStats {
-	// Initialize the window (rankSamples) with zeros but rankSum with the equivalent sum of class initial rank.
+	return makeSeededHistoricStatus(windowSize, class.initialRank)
+}
+
+// makeSeededHistoricStatus creates a historicStats whose window is filled with the given rank.
+func makeSeededHistoricStatus(windowSize int, rank int) *historicStats {
+	// Initialize the window (rankSamples) with the initial rank.
 	// This way, every peer will slowly build up its profile.
 	// Otherwise, if the best peer gets a bad download the first time,
 	// that will determine the rank of the peer.
@@ -139,10 +148,10 @@ func makeHistoricStatus(windowSize int, class peerClass) *historicStats {
 		windowSize:  windowSize,
 		rankSamples: make([]int, windowSize, windowSize),
 		requestGaps: make([]uint64, 0, windowSize),
-		rankSum:     uint64(class.initialRank) * uint64(windowSize),
+		rankSum:     uint64(rank) * uint64(windowSize),
 		gapSum:      0.0}
 	for i := 0; i < windowSize; i++ {
-		hs.rankSamples[i] = class.initialRank
+		hs.rankSamples[i] = rank
 	}
 	return &hs
 }
@@ -375,8 +384,12 @@ func (ps *peerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockD
 	if poolIdx < 0 || peerIdx < 0 {
 		return peerRankInvalidDownload
 	}
+	return classDownloadDurationToRank(ps.pools[poolIdx].peers[peerIdx].class, blockDownloadDuration)
+}
 
-	switch ps.pools[poolIdx].peers[peerIdx].class.initialRank {
+// classDownloadDurationToRank maps the block download time into the ranking range of the given peer class.
+func classDownloadDurationToRank(class peerClass, blockDownloadDuration time.Duration) (rank int) {
+	switch class.initialRank {
 	case peerRankInitialFirstPriority:
 		return downloadDurationToRank(blockDownloadDuration, lowBlockDownloadThreshold, highBlockDownloadThreshold, peerRank0LowBlockTime, peerRank0HighBlockTime)
 	case peerRankInitialSecondPriority:
@@ -452,7 +465,8 @@ func (ps *peerSelector) refreshAvailablePeers() {
 				continue
 			}
 			// it's an entry which we did not have before.
-			sortNeeded = ps.addToPool(peer, initClass.initialRank, initClass, makeHistoricStatus(peerHistoryWindowSize, initClass)) || sortNeeded
+			rank := ps.initialPeerRank(peerAddress, initClass)
+			sortNeeded = ps.addToPool(peer, rank, initClass, makeSeededHistoricStatus(peerHistoryWindowSize, rank)) || sortNeeded
 		}
 	}
 
@@ -481,6 +495,52 @@ func (ps *peerSelector) refreshAvailablePeers() {
 	}
 }
 
+// initialPeerRank returns the rank a peer that is new to the selector starts with. Peers with a recorded performance
+// are placed within their class according to their average block download time, or at the bottom of the class if
+// most of the downloads attempted from them failed. Other peers start with the initial rank of the class.
+func (ps *peerSelector) initialPeerRank(address string, class peerClass) int {
+	if ps.stats == nil {
+		return class.initialRank
+	}
+	perf, known := ps.stats.performance(address)
+	if !known {
+		return class.initialRank
+	}
+	if perf.successRate() < 0.5 {
+		return upperBound(class)
+	}
+	return classDownloadDurationToRank(class, perf.averageDownloadDuration())
+}
+
+// bestClassPeers returns the peers of the highest priority class that has peers which aren't ranked as failing.
+func (ps *peerSelector) bestClassPeers() (peers []*peerSelectorPeer) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.refreshAvailablePeers()
+	bestClass := -1
+	for _, pool := range ps.pools {
+		if pool.rank >= peerRankDownloadFailed {
+			break
+		}
+		for _, entry := range pool.peers {
+			if bestClass < 0 || entry.class.initialRank < bestClass {
+				bestClass = entry.class.initialRank
+			}
+		}
+	}
+	for _, pool := range ps.pools {
+		if pool.rank >= peerRankDownloadFailed {
+			break
+		}
+		for _, entry := range pool.peers {
+			if entry.class.initialRank == bestClass {
+				peers = append(peers, &peerSelectorPeer{entry.peer, entry.class.peerClass})
+			}
+		}
+	}
+	return
+}
+
 // findPeer look into the peer pool and find the given peer.
 // The method returns the pool and peer indices if a peer was found, or (-1, -1) otherwise.
 func (ps *peerSelector) findPeer(psp *peerSelectorPeer) (poolIdx, peerIdx int) {
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -627,3 +628,37 @@ func TestEvictionAndUpgrade(t *testing.T) {
 	psp, err := peerSelector.getNextPeer()
 	require.Equal(t, psp.peerClass, network.PeersPhonebookRelays)
 }
+
+func TestPeerSelectorInitialRankFromStats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stats := makeMemoryPeerStatsStore()
+	for r := basics.Round(1); r <= peerStatsMinSamples; r++ {
+		stats.recordDownload("fast", r, 1000, lowBlockDownloadThreshold)
+		stats.recordDownload("slow", r, 1000, highBlockDownloadThreshold)
+		stats.recordFailure("failing")
+	}
+
+	peerSelector := makePeerSelector(
+		makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer {
+			return []network.Peer{&mockHTTPPeer{address: "fast"}, &mockHTTPPeer{address: "slow"}, &mockHTTPPeer{address: "failing"}, &mockHTTPPeer{address: "new"}}
+		}), []peerClass{{initialRank: peerRankInitialSecondPriority, peerClass: network.PeersPhonebookArchivers}},
+	)
+	peerSelector.stats = stats
+
+	// peers we know nothing about keep the initial rank of their class, while the others are placed within the
+	// class according to the performance they had.
+	_, err := peerSelector.getNextPeer()
+	require.NoError(t, err)
+
+	ranks := make(map[string]int)
+	for _, pool := range peerSelector.pools {
+		for _, entry := range pool.peers {
+			ranks[peerAddress(entry.peer)] = pool.rank
+		}
+	}
+	require.Equal(t, peerRank1LowBlockTime, ranks["fast"])
+	require.Equal(t, peerRank1HighBlockTime, ranks["slow"])
+	require.Equal(t, peerRank1HighBlockTime, ranks["failing"])
+	require.Equal(t, peerRankInitialSecondPriority, ranks["new"])
+}
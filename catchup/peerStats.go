@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package catchup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const (
+	// peerStatsMinSamples is the number of downloads attempts we need to have observed from a peer before its
+	// recorded performance is used for ranking it, splitting rounds between peers or detecting that it is stuck.
+	peerStatsMinSamples = 4
+
+	// peerStatsMaxSamples bounds the number of download attempts the statistics account for. Once reached, all the
+	// counters of the peer are halved, so that recent performance outweighs the performance observed long ago.
+	peerStatsMaxSamples = 1000
+)
+
+// peerPerformance is the block download performance measured for a single peer.
+type peerPerformance struct {
+	successes    uint64
+	failures     uint64
+	bytes        uint64
+	downloadTime time.Duration
+
+	// firstRound and lastRound are the lowest and highest rounds the peer has served us.
+	firstRound basics.Round
+	lastRound  basics.Round
+}
+
+// samples returns the number of download attempts the performance accounts for.
+func (pp peerPerformance) samples() uint64 {
+	return pp.successes + pp.failures
+}
+
+// successRate returns the fraction of the download attempts which were successful.
+func (pp peerPerformance) successRate() float64 {
+	if pp.samples() == 0 {
+		return 0
+	}
+	return float64(pp.successes) / float64(pp.samples())
+}
+
+// throughput returns the average download rate of the peer, in bytes per second.
+func (pp peerPerformance) throughput() float64 {
+	if pp.downloadTime <= 0 {
+		return 0
+	}
+	return float64(pp.bytes) / pp.downloadTime.Seconds()
+}
+
+// averageDownloadDuration returns the average time it took the peer to serve a single block.
+func (pp peerPerformance) averageDownloadDuration() time.Duration {
+	if pp.successes == 0 {
+		return 0
+	}
+	return pp.downloadTime / time.Duration(pp.successes)
+}
+
+// decay halves the counters once the number of samples reaches peerStatsMaxSamples.
+func (pp *peerPerformance) decay() {
+	if pp.samples() < peerStatsMaxSamples {
+		return
+	}
+	pp.successes /= 2
+	pp.failures /= 2
+	pp.bytes /= 2
+	pp.downloadTime /= 2
+}
+
+// PeerStatsStore keeps track of the block download performance of the peers the catchup service fetches blocks
+// from. When backed by a database, the statistics are persisted so that peer selection after a restart starts from
+// the performance measured earlier, rather than from the peer class alone.
+type PeerStatsStore struct {
+	mu    deadlock.Mutex
+	store *db.Accessor
+	peers map[string]*peerPerformance
+	dirty map[string]bool
+}
+
+// makeMemoryPeerStatsStore creates a PeerStatsStore which isn't persisted.
+func makeMemoryPeerStatsStore() *PeerStatsStore {
+	return &PeerStatsStore{
+		peers: make(map[string]*peerPerformance),
+		dirty: make(map[string]bool),
+	}
+}
+
+// MakePeerStatsStore creates a PeerStatsStore persisting the statistics into the given database, and loads the
+// statistics previously stored in it.
+func MakePeerStatsStore(store db.Accessor) (*PeerStatsStore, error) {
+	ps := makeMemoryPeerStatsStore()
+	ps.store = &store
+	err := store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS peerstats (
+			address TEXT PRIMARY KEY,
+			successes INTEGER NOT NULL,
+			failures INTEGER NOT NULL,
+			bytes INTEGER NOT NULL,
+			downloadtime INTEGER NOT NULL, --* total download time of the successful downloads, in nanoseconds
+			firstround INTEGER NOT NULL,
+			lastround INTEGER NOT NULL
+		)`)
+		if err != nil {
+			return err
+		}
+		rows, err := tx.Query("SELECT address, successes, failures, bytes, downloadtime, firstround, lastround FROM peerstats")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var address string
+			var pp peerPerformance
+			err = rows.Scan(&address, &pp.successes, &pp.failures, &pp.bytes, &pp.downloadTime, &pp.firstRound, &pp.lastRound)
+			if err != nil {
+				return err
+			}
+			ps.peers[address] = &pp
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("MakePeerStatsStore: unable to load the peer statistics: %w", err)
+	}
+	return ps, nil
+}
+
+// peer returns the entry of the given address, creating it if needed. The caller is expected to hold the lock.
+func (ps *PeerStatsStore) peer(address string) *peerPerformance {
+	pp, has := ps.peers[address]
+	if !has {
+		pp = &peerPerformance{}
+		ps.peers[address] = pp
+	}
+	ps.dirty[address] = true
+	return pp
+}
+
+// recordDownload records the successful download of the given round from a peer.
+func (ps *PeerStatsStore) recordDownload(address string, round basics.Round, size int, downloadDuration time.Duration) {
+	if address == "" {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	pp := ps.peer(address)
+	pp.decay()
+	pp.successes++
+	pp.bytes += uint64(size)
+	pp.downloadTime += downloadDuration
+	if pp.firstRound == 0 || round < pp.firstRound {
+		pp.firstRound = round
+	}
+	if round > pp.lastRound {
+		pp.lastRound = round
+	}
+}
+
+// recordFailure records a failed, invalid or overly slow download attempt from a peer.
+func (ps *PeerStatsStore) recordFailure(address string) {
+	if address == "" {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	pp := ps.peer(address)
+	pp.decay()
+	pp.failures++
+}
+
+// performance returns the performance recorded for the given address. The returned boolean is false if we
+// haven't observed enough download attempts from the peer to tell how it performs.
+func (ps *PeerStatsStore) performance(address string) (peerPerformance, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	pp, has := ps.peers[address]
+	if !has || pp.samples() < peerStatsMinSamples {
+		return peerPerformance{}, false
+	}
+	return *pp, true
+}
+
+// Flush writes the statistics which changed since the last flush to the database. It's a no-op for a PeerStatsStore
+// which isn't persisted.
+func (ps *PeerStatsStore) Flush() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.store == nil || len(ps.dirty) == 0 {
+		return nil
+	}
+	err := ps.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for address := range ps.dirty {
+			pp := ps.peers[address]
+			_, err := tx.Exec("INSERT OR REPLACE INTO peerstats (address, successes, failures, bytes, downloadtime, firstround, lastround) VALUES (?, ?, ?, ?, ?, ?, ?)",
+				address, pp.successes, pp.failures, pp.bytes, int64(pp.downloadTime), uint64(pp.firstRound), uint64(pp.lastRound))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ps.dirty = make(map[string]bool)
+	return nil
+}
+
+// Close flushes the statistics and closes the underlying database. The store must not be used once closed.
+func (ps *PeerStatsStore) Close() error {
+	err := ps.Flush()
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.store != nil {
+		ps.store.Close()
+		ps.store = nil
+	}
+	return err
+}
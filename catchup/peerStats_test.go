@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package catchup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestPeerStatsStorePersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tempDir, err := ioutil.TempDir("", "catchuppeers")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	dbPath := filepath.Join(tempDir, "catchuppeers.sqlite")
+
+	accessor, err := db.MakeAccessor(dbPath, false, false)
+	require.NoError(t, err)
+	stats, err := MakePeerStatsStore(accessor)
+	require.NoError(t, err)
+
+	for r := basics.Round(10); r < 14; r++ {
+		stats.recordDownload("fast", r, 1000, 100*time.Millisecond)
+	}
+	stats.recordFailure("fast")
+	stats.recordDownload("new", 20, 1000, time.Second)
+	// closing the store flushes the pending statistics and closes the database.
+	require.NoError(t, stats.Close())
+
+	// reopen the database, as the node would after a restart.
+	accessor, err = db.MakeAccessor(dbPath, false, false)
+	require.NoError(t, err)
+	defer accessor.Close()
+	stats, err = MakePeerStatsStore(accessor)
+	require.NoError(t, err)
+
+	perf, known := stats.performance("fast")
+	require.True(t, known)
+	require.Equal(t, uint64(4), perf.successes)
+	require.Equal(t, uint64(1), perf.failures)
+	require.Equal(t, 0.8, perf.successRate())
+	require.Equal(t, float64(10000), perf.throughput())
+	require.Equal(t, 100*time.Millisecond, perf.averageDownloadDuration())
+	require.Equal(t, basics.Round(10), perf.firstRound)
+	require.Equal(t, basics.Round(13), perf.lastRound)
+
+	// a single download isn't enough to tell how the peer performs.
+	_, known = stats.performance("new")
+	require.False(t, known)
+	_, known = stats.performance("unknown")
+	require.False(t, known)
+}
+
+func TestPeerStatsDecay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stats := makeMemoryPeerStatsStore()
+	for i := 0; i < peerStatsMaxSamples; i++ {
+		stats.recordFailure("peer")
+	}
+	stats.recordDownload("peer", 1, 1000, time.Second)
+
+	perf, known := stats.performance("peer")
+	require.True(t, known)
+	require.Equal(t, uint64(1), perf.successes)
+	require.Equal(t, uint64(peerStatsMaxSamples/2), perf.failures)
+
+	// the in-memory store has nothing to flush to.
+	require.NoError(t, stats.Flush())
+}
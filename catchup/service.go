@@ -76,6 +76,7 @@ type Service struct {
 	parallelBlocks      uint64
 	deadlineTimeout     time.Duration
 	blockValidationPool execpool.BacklogPool
+	peerStats           *PeerStatsStore
 
 	// suspendForCatchpointWriting defines whether we've ran into a state where the ledger is currently busy writing the
 	// catchpoint file. If so, we want to suspend the catchup process until the catchpoint file writing is complete,
@@ -116,10 +117,17 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.parallelBlocks = config.CatchupParallelBlocks
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
+	s.peerStats = makeMemoryPeerStatsStore()
 
 	return s
 }
 
+// SetPeerStatsStore sets the store which keeps track of the block download performance of the peers. It replaces the
+// default store, which isn't persisted, and should be called before the service is started.
+func (s *Service) SetPeerStatsStore(peerStats *PeerStatsStore) {
+	s.peerStats = peerStats
+}
+
 // Start the catchup service
 func (s *Service) Start() {
 	s.done = make(chan struct{})
@@ -159,17 +167,27 @@ func (s *Service) SynchronizingTime() time.Duration {
 // errLedgerAlreadyHasBlock is returned by innerFetch in case the local ledger already has the requested block.
 var errLedgerAlreadyHasBlock = errors.New("ledger already has block")
 
+// errPeerTooSlow is returned by innerFetch in case the peer didn't deliver the block within the given timeout.
+var errPeerTooSlow = errors.New("peer did not deliver the block in time")
+
 // function scope to make a bunch of defer statements better
-func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeeping.Block, cert *agreement.Certificate, ddur time.Duration, err error) {
+// A non-zero timeout limits the time we would wait for the peer to deliver the block.
+func (s *Service) innerFetch(r basics.Round, peer network.Peer, timeout time.Duration) (blk *bookkeeping.Block, cert *agreement.Certificate, ddur time.Duration, size int, err error) {
 	ledgerWaitCh := s.ledger.Wait(r)
 	select {
 	case <-ledgerWaitCh:
 		// if our ledger already have this block, no need to attempt to fetch it.
-		return nil, nil, time.Duration(0), errLedgerAlreadyHasBlock
+		return nil, nil, time.Duration(0), 0, errLedgerAlreadyHasBlock
 	default:
 	}
 
-	ctx, cf := context.WithCancel(s.ctx)
+	var ctx context.Context
+	var cf context.CancelFunc
+	if timeout > 0 {
+		ctx, cf = context.WithTimeout(s.ctx, timeout)
+	} else {
+		ctx, cf = context.WithCancel(s.ctx)
+	}
 	fetcher := makeUniversalBlockFetcher(s.log, s.net, s.cfg)
 	defer cf()
 	stopWaitingForLedgerRound := make(chan struct{})
@@ -181,7 +199,7 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 			cf()
 		}
 	}()
-	blk, cert, ddur, err = fetcher.fetchBlock(ctx, r, peer)
+	blk, cert, ddur, size, err = fetcher.fetchBlock(ctx, r, peer)
 	// check to see if we aborted due to ledger.
 	if err != nil {
 		select {
@@ -189,14 +207,19 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 			// yes, we aborted since the ledger received this round.
 			err = errLedgerAlreadyHasBlock
 		default:
+			if timeout > 0 && ctx.Err() == context.DeadlineExceeded && s.ctx.Err() == nil {
+				err = errPeerTooSlow
+			}
 		}
 	}
 	return
 }
 
 // fetchAndWrite fetches a block, checks the cert, and writes it to the ledger. Cert checking and ledger writing both wait for the ledger to advance if necessary.
+// The first attempt is made with the peer the round was assigned to by the peerRangeAssigner, if one is provided; the
+// retries use the peers returned by the peerSelector.
 // Returns false if we couldn't fetch or write (i.e., if we failed even after a given number of retries or if we were told to abort.)
-func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool, lookbackComplete chan bool, peerSelector *peerSelector) bool {
+func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool, lookbackComplete chan bool, peerSelector *peerSelector, ranges *peerRangeAssigner) bool {
 	i := 0
 	hasLookback := false
 	for true {
@@ -229,21 +252,41 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			return false
 		}
 
-		psp, getPeerErr := peerSelector.getNextPeer()
-		if getPeerErr != nil {
-			s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
-			break
+		var psp *peerSelectorPeer
+		var stealTimeout time.Duration
+		if i == 1 && ranges != nil {
+			psp = ranges.peerForRound(r)
+			if psp != nil {
+				stealTimeout = ranges.stealTimeout(psp)
+			}
+		}
+		if psp == nil {
+			var getPeerErr error
+			psp, getPeerErr = peerSelector.getNextPeer()
+			if getPeerErr != nil {
+				s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
+				break
+			}
 		}
 		peer := psp.Peer
 
 		// Try to fetch, timing out after retryInterval
-		block, cert, blockDownloadDuration, err := s.innerFetch(r, peer)
+		block, cert, blockDownloadDuration, blockSize, err := s.innerFetch(r, peer, stealTimeout)
 
 		if err != nil {
 			if err == errLedgerAlreadyHasBlock {
 				// ledger already has the block, no need to request this block from anyone.
 				return true
 			}
+			s.peerStats.recordFailure(peerAddress(peer))
+			if err == errPeerTooSlow {
+				// the peer is stuck on its range; let another peer take over the rest of it, and retry this round.
+				if thief := ranges.steal(r, psp); thief != nil {
+					s.log.Debugf("fetchAndWrite(%v): %s took over the rounds assigned to %s, which did not deliver the block within %v", r, peerAddress(thief.Peer), peerAddress(peer), stealTimeout)
+				}
+				peerSelector.rankPeer(psp, peerSelector.peerDownloadDurationToRank(psp, stealTimeout))
+				continue
+			}
 			s.log.Debugf("fetchAndWrite(%v): Could not fetch: %v (attempt %d)", r, err, i)
 			peerSelector.rankPeer(psp, peerRankDownloadFailed)
 			// we've just failed to retrieve a block; wait until the previous block is fetched before trying again
@@ -271,6 +314,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 		// Check that the block's contents match the block header (necessary with an untrusted block because b.Hash() only hashes the header)
 		if s.cfg.CatchupVerifyPaysetHash() {
 			if !block.ContentsMatchHeader() {
+				s.peerStats.recordFailure(peerAddress(peer))
 				peerSelector.rankPeer(psp, peerRankInvalidDownload)
 				// Check if this mismatch is due to an unsupported protocol version
 				if _, ok := config.Consensus[block.BlockHeader.CurrentProtocol]; !ok {
@@ -300,11 +344,13 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			err = s.auth.Authenticate(block, cert)
 			if err != nil {
 				s.log.Warnf("fetchAndWrite(%v): cert did not authenticate block (attempt %d): %v", r, i, err)
+				s.peerStats.recordFailure(peerAddress(peer))
 				peerSelector.rankPeer(psp, peerRankInvalidDownload)
 				continue // retry the fetch
 			}
 		}
 
+		s.peerStats.recordDownload(peerAddress(peer), r, blockSize, blockDownloadDuration)
 		peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
 		r1, r2 := peerSelector.rankPeer(psp, peerRank)
 		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
@@ -378,9 +424,9 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 
 type task func() basics.Round
 
-func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool, prevFetchCompleteChan chan bool, lookbackChan chan bool, peerSelector *peerSelector) func() basics.Round {
+func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool, prevFetchCompleteChan chan bool, lookbackChan chan bool, peerSelector *peerSelector, ranges *peerRangeAssigner) func() basics.Round {
 	return func() basics.Round {
-		fetchResult := s.fetchAndWrite(r, prevFetchCompleteChan, lookbackChan, peerSelector)
+		fetchResult := s.fetchAndWrite(r, prevFetchCompleteChan, lookbackChan, peerSelector, ranges)
 
 		// the fetch result will be read at most twice (once as the lookback block and once as the prev block, so we write the result twice)
 		thisFetchComplete <- fetchResult
@@ -417,6 +463,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from")
 		return
 	}
+	ranges := makePeerRangeAssigner(peerSelector, s.peerStats, parallelRequests)
 
 	// Invariant: len(taskCh) + (# pending writes to completed) <= N
 	wg.Add(int(parallelRequests))
@@ -464,7 +511,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 
 		currentRoundComplete := make(chan bool, 2)
 		// len(taskCh) + (# pending writes to completed) increases by 1
-		taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[len(recentReqs)-int(seedLookback)], peerSelector)
+		taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[len(recentReqs)-int(seedLookback)], peerSelector, ranges)
 		recentReqs = append(recentReqs[1:], currentRoundComplete)
 	}
 
@@ -496,7 +543,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 
 				currentRoundComplete := make(chan bool, 2)
 				// len(taskCh) + (# pending writes to completed) increases by 1
-				taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[0], peerSelector)
+				taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[0], peerSelector, ranges)
 				recentReqs = append(recentReqs[1:], currentRoundComplete)
 				nextRound++
 			}
@@ -509,6 +556,9 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 // periodicSync periodically asks the network for its latest round and syncs if we've fallen behind (also if our ledger stops advancing)
 func (s *Service) periodicSync() {
 	defer close(s.done)
+	if s.cfg.CatchupBlockBundlesDir != "" {
+		s.importBlockBundles(s.cfg.CatchupBlockBundlesDir)
+	}
 	// if the catchup is disabled in the config file, just skip it.
 	if s.parallelBlocks != 0 && !s.cfg.DisableNetworking {
 		// The following request might be redundant, but it ensures we wait long enough for the DNS records to be loaded,
@@ -595,6 +645,9 @@ func (s *Service) sync() {
 		seedLookback = proto.SeedLookback
 	}
 	s.pipelinedFetch(seedLookback)
+	if err := s.peerStats.Flush(); err != nil {
+		s.log.Warnf("catchup: unable to save the peer statistics: %v", err)
+	}
 
 	initSync := false
 
@@ -650,7 +703,7 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 		peer := psp.Peer
 
 		// Ask the fetcher to get the block somehow
-		block, fetchedCert, _, err := s.innerFetch(cert.Round, peer)
+		block, fetchedCert, _, _, err := s.innerFetch(cert.Round, peer, 0)
 
 		if err != nil {
 			select {
@@ -804,5 +857,7 @@ func (s *Service) createPeerSelector(pipelineFetch bool) *peerSelector {
 			}
 		}
 	}
-	return makePeerSelector(s.net, peerClasses)
+	peerSelector := makePeerSelector(s.net, peerClasses)
+	peerSelector.stats = s.peerStats
+	return peerSelector
 }
@@ -287,7 +287,7 @@ func TestServiceFetchBlocksOneBlock(t *testing.T) {
 	require.Equal(t, lastRoundAtStart+basics.Round(numBlocks), local.LastRound())
 
 	// Get the same block we wrote
-	block, _, _, err := makeUniversalBlockFetcher(logging.Base(),
+	block, _, _, _, err := makeUniversalBlockFetcher(logging.Base(),
 		net,
 		defaultConfig).fetchBlock(context.Background(), lastRoundAtStart+1, net.peers[0])
 
@@ -409,7 +409,7 @@ func TestServiceFetchBlocksMultiBlocks(t *testing.T) {
 
 	for i := basics.Round(1); i <= numberOfBlocks; i++ {
 		// Get the same block we wrote
-		blk, _, _, err2 := fetcher.fetchBlock(context.Background(), i, net.GetPeers()[0])
+		blk, _, _, _, err2 := fetcher.fetchBlock(context.Background(), i, net.GetPeers()[0])
 		require.NoError(t, err2)
 
 		// Check we wrote the correct block
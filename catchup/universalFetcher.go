@@ -51,9 +51,10 @@ func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, confi
 		log:    log}
 }
 
-// fetchBlock returns a block from the peer. The peer can be either an http or ws peer.
+// fetchBlock returns a block from the peer, along with the time it took to download it and its encoded size. The peer
+// can be either an http or ws peer.
 func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Round, peer network.Peer) (blk *bookkeeping.Block,
-	cert *agreement.Certificate, downloadDuration time.Duration, err error) {
+	cert *agreement.Certificate, downloadDuration time.Duration, size int, err error) {
 
 	var fetchedBuf []byte
 	var address string
@@ -65,7 +66,7 @@ func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Ro
 		}
 		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
 		if err != nil {
-			return nil, nil, time.Duration(0), err
+			return nil, nil, time.Duration(0), 0, err
 		}
 		address = fetcherClient.address()
 	} else if httpPeer, validHTTPPeer := peer.(network.HTTPPeer); validHTTPPeer {
@@ -78,19 +79,19 @@ func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Ro
 			config:  &uf.config}
 		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
 		if err != nil {
-			return nil, nil, time.Duration(0), err
+			return nil, nil, time.Duration(0), 0, err
 		}
 		address = fetcherClient.address()
 	} else {
-		return nil, nil, time.Duration(0), fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
+		return nil, nil, time.Duration(0), 0, fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
 	}
 	downloadDuration = time.Now().Sub(blockDownloadStartTime)
 	block, cert, err := processBlockBytes(fetchedBuf, round, address)
 	if err != nil {
-		return nil, nil, time.Duration(0), err
+		return nil, nil, time.Duration(0), 0, err
 	}
 	uf.log.Debugf("fetchBlock: downloaded block %d in %d from %s", uint64(round), downloadDuration, address)
-	return block, cert, downloadDuration, err
+	return block, cert, downloadDuration, len(fetchedBuf), err
 }
 
 func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk *bookkeeping.Block, cert *agreement.Certificate, err error) {
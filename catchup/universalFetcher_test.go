@@ -64,13 +64,13 @@ func TestUGetBlockWs(t *testing.T) {
 	var cert *agreement.Certificate
 	var duration time.Duration
 
-	block, cert, _, err = fetcher.fetchBlock(context.Background(), next, up)
+	block, cert, _, _, err = fetcher.fetchBlock(context.Background(), next, up)
 
 	require.NoError(t, err)
 	require.Equal(t, &b, block)
 	require.GreaterOrEqual(t, int64(duration), int64(0))
 
-	block, cert, duration, err = fetcher.fetchBlock(context.Background(), next+1, up)
+	block, cert, duration, _, err = fetcher.fetchBlock(context.Background(), next+1, up)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "requested block is not available")
@@ -110,13 +110,13 @@ func TestUGetBlockHTTP(t *testing.T) {
 	var block *bookkeeping.Block
 	var cert *agreement.Certificate
 	var duration time.Duration
-	block, cert, duration, err = fetcher.fetchBlock(context.Background(), next, net.GetPeers()[0])
+	block, cert, duration, _, err = fetcher.fetchBlock(context.Background(), next, net.GetPeers()[0])
 
 	require.NoError(t, err)
 	require.Equal(t, &b, block)
 	require.GreaterOrEqual(t, int64(duration), int64(0))
 
-	block, cert, duration, err = fetcher.fetchBlock(context.Background(), next+1, net.GetPeers()[0])
+	block, cert, duration, _, err = fetcher.fetchBlock(context.Background(), next+1, net.GetPeers()[0])
 
 	require.Error(t, errNoBlockForRound, err)
 	require.Contains(t, err.Error(), "No block available for given round")
@@ -131,7 +131,7 @@ func TestUGetBlockUnsupported(t *testing.T) {
 
 	fetcher := universalBlockFetcher{}
 	peer := ""
-	block, cert, duration, err := fetcher.fetchBlock(context.Background(), 1, peer)
+	block, cert, duration, _, err := fetcher.fetchBlock(context.Background(), 1, peer)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
 	require.Nil(t, block)
@@ -198,7 +198,7 @@ func TestRequestBlockBytesErrors(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	_, _, _, err = fetcher.fetchBlock(ctx, next, up)
+	_, _, _, _, err = fetcher.fetchBlock(ctx, next, up)
 	var wrfe errWsFetcherRequestFailed
 	require.True(t, errors.As(err, &wrfe), "unexpected err: %w", wrfe)
 	require.Equal(t, "context canceled", err.(errWsFetcherRequestFailed).cause)
@@ -208,14 +208,14 @@ func TestRequestBlockBytesErrors(t *testing.T) {
 	responseOverride := network.Response{Topics: network.Topics{network.MakeTopic(rpcs.BlockDataKey, make([]byte, 0))}}
 	up = makeTestUnicastPeerWithResponseOverride(net, t, &responseOverride)
 
-	_, _, _, err = fetcher.fetchBlock(ctx, next, up)
+	_, _, _, _, err = fetcher.fetchBlock(ctx, next, up)
 	require.True(t, errors.As(err, &wrfe))
 	require.Equal(t, "Cert data not found", err.(errWsFetcherRequestFailed).cause)
 
 	responseOverride = network.Response{Topics: network.Topics{network.MakeTopic(rpcs.CertDataKey, make([]byte, 0))}}
 	up = makeTestUnicastPeerWithResponseOverride(net, t, &responseOverride)
 
-	_, _, _, err = fetcher.fetchBlock(ctx, next, up)
+	_, _, _, _, err = fetcher.fetchBlock(ctx, next, up)
 	require.True(t, errors.As(err, &wrfe))
 	require.Equal(t, "Block data not found", err.(errWsFetcherRequestFailed).cause)
 }
@@ -258,26 +258,26 @@ func TestGetBlockBytesHTTPErrors(t *testing.T) {
 	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
 
 	ls.status = http.StatusBadRequest
-	_, _, _, err := fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
+	_, _, _, _, err := fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
 	var hre errHTTPResponse
 	require.True(t, errors.As(err, &hre))
 	require.Equal(t, "Response body '\x00'", err.(errHTTPResponse).cause)
 
 	ls.exceedLimit = true
-	_, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
+	_, _, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
 	require.True(t, errors.As(err, &hre))
 	require.Equal(t, "read limit exceeded", err.(errHTTPResponse).cause)
 
 	ls.status = http.StatusOK
 	ls.content = append(ls.content, "undefined")
-	_, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
+	_, _, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
 	var cte errHTTPResponseContentType
 	require.True(t, errors.As(err, &cte))
 	require.Equal(t, "undefined", err.(errHTTPResponseContentType).contentType)
 
 	ls.status = http.StatusOK
 	ls.content = append(ls.content, "undefined2")
-	_, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
+	_, _, _, _, err = fetcher.fetchBlock(context.Background(), 1, net.GetPeers()[0])
 	require.True(t, errors.As(err, &cte))
 	require.Equal(t, 2, err.(errHTTPResponseContentType).contentTypeCount)
 }
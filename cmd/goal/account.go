@@ -240,7 +240,7 @@ var accountCmd = &cobra.Command{
 			// Set the account with this name to be default
 			accountList.setDefault(defaultAccountName)
 			reportInfof(infoSetAccountToDefault, defaultAccountName)
-			os.Exit(0)
+			exitWithOutput(0)
 		}
 
 		// Return the help text
@@ -469,37 +469,43 @@ var listCmd = &cobra.Command{
 		// Special response if there are no addresses
 		if len(addrs) == 0 {
 			reportInfoln(infoNoAccounts)
-			os.Exit(0)
+			exitWithOutput(0)
 		}
 
 		accountInfoError := false
+		listed := make([]listedAccount, 0, len(addrs))
 
 		// For each address, request information about it from algod
 		for _, addr := range addrs {
 			response, _ := client.AccountInformationV2(addr.Addr)
 			// it's okay to proceed without algod info
 
-			// Display this information to the user
+			var multisigInfo *libgoal.MultisigInfo
 			if addr.Multisig {
-				multisigInfo, err := client.LookupMultisigAccount(wh, addr.Addr)
+				info, err := client.LookupMultisigAccount(wh, addr.Addr)
 				if err != nil {
-					fmt.Println("multisig lookup err")
 					reportErrorf(errorRequestFail, err)
 				}
+				multisigInfo = &info
+			}
+			listed = append(listed, accountList.listedAccount(addr.Addr, response, multisigInfo))
 
-				accountList.outputAccount(addr.Addr, response, &multisigInfo)
-			} else {
-				accountList.outputAccount(addr.Addr, response, nil)
+			if structuredOutput() {
+				continue
 			}
 
+			// Display this information to the user
+			accountList.outputAccount(addr.Addr, response, multisigInfo)
+
 			if listAccountInfo {
 				hasError := printAccountInfo(client, addr.Addr, response)
 				accountInfoError = accountInfoError || hasError
 			}
 		}
+		reportResult(listed, func() {})
 
 		if accountInfoError {
-			os.Exit(1)
+			exitWithOutput(1)
 		}
 	},
 }
@@ -517,9 +523,12 @@ var infoCmd = &cobra.Command{
 			reportErrorf(errorRequestFail, err)
 		}
 
-		hasError := printAccountInfo(client, accountAddress, response)
+		hasError := false
+		reportResult(response, func() {
+			hasError = printAccountInfo(client, accountAddress, response)
+		})
 		if hasError {
-			os.Exit(1)
+			exitWithOutput(1)
 		}
 	},
 }
@@ -767,13 +776,11 @@ var changeOnlineCmd = &cobra.Command{
 		checkTxValidityPeriodCmdFlags(cmd)
 
 		if accountAddress == "" && partKeyFile == "" {
-			fmt.Printf("Must specify one of --address or --partkeyfile\n")
-			os.Exit(1)
+			reportErrorln(errorOnlineStatusNoAccount)
 		}
 
 		if partKeyFile != "" && !online {
-			fmt.Printf("Going offline does not support --partkeyfile\n")
-			os.Exit(1)
+			reportErrorln(errorOfflinePartKeyFile)
 		}
 
 		dataDir := ensureSingleDataDir()
@@ -789,14 +796,12 @@ var changeOnlineCmd = &cobra.Command{
 		if partKeyFile != "" {
 			partdb, err := db.MakeErasableAccessor(partKeyFile)
 			if err != nil {
-				fmt.Printf("Cannot open partkey %s: %v\n", partKeyFile, err)
-				os.Exit(1)
+				reportErrorf(errorOpeningPartKey, partKeyFile, err)
 			}
 
 			partkey, err := algodAcct.RestoreParticipation(partdb)
 			if err != nil {
-				fmt.Printf("Cannot load partkey %s: %v\n", partKeyFile, err)
-				os.Exit(1)
+				reportErrorf(errorLoadingPartKey, partKeyFile, err)
 			}
 
 			part = &partkey.Participation
@@ -883,17 +888,7 @@ var installParticipationKeyCmd = &cobra.Command{
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, args []string) {
 		if !partKeyDeleteInput {
-			fmt.Println(
-				`The installpartkey command deletes the input participation file on
-successful installation.  Please acknowledge this by passing the
-"--delete-input" flag to the installpartkey command.  You can make
-a copy of the input file if needed, but please keep in mind that
-participation keys must be securely deleted for each round, to ensure
-forward security.  Storing old participation keys compromises overall
-system security.
-
-No --delete-input flag specified, exiting without installing key.`)
-			os.Exit(1)
+			reportErrorln(errorPartKeyDeleteInput)
 		}
 
 		dataDir := ensureSingleDataDir()
@@ -1129,7 +1124,7 @@ var importCmd = &cobra.Command{
 		//wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
 
 		if mnemonic == "" {
-			fmt.Println(infoRecoveryPrompt)
+			reportPromptf("%s\n", infoRecoveryPrompt)
 			reader := bufio.NewReader(os.Stdin)
 			resp, err := reader.ReadString('\n')
 			resp = strings.TrimSpace(resp)
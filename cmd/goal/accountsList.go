@@ -256,3 +256,26 @@ func (accountList *AccountsList) outputAccount(addr string, acctInfo generatedV2
 	}
 	fmt.Print("\n")
 }
+
+// listedAccount is the structured form of an account line of goal account list.
+type listedAccount struct {
+	Name     string                `json:"name"`
+	Address  string                `json:"address"`
+	Default  bool                  `json:"default,omitempty"`
+	Multisig *libgoal.MultisigInfo `json:"multisig,omitempty"`
+	// Account is missing when algod couldn't be reached.
+	Account *generatedV2.Account `json:"account,omitempty"`
+}
+
+func (accountList *AccountsList) listedAccount(addr string, acctInfo generatedV2.Account, multisigInfo *libgoal.MultisigInfo) listedAccount {
+	listed := listedAccount{
+		Name:     accountList.getNameByAddress(addr),
+		Address:  addr,
+		Default:  accountList.isDefault(addr),
+		Multisig: multisigInfo,
+	}
+	if acctInfo.Address != "" {
+		listed.Account = &acctInfo
+	}
+	return listed
+}
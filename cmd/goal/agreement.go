@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
+)
+
+var agreementCmd = &cobra.Command{
+	Use:   "agreement",
+	Short: "Show the current state of the agreement service",
+	Long:  "Show the current round, period and step of the agreement service, along with the proposals seen, the vote weight counted for them in each step compared to the bundle thresholds, the frozen and staged values and the pending timeouts. Useful for figuring out why a network isn't making progress.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(showAgreementState)
+	},
+}
+
+func showAgreementState(dataDir string) {
+	client := ensureAlgodClient(dataDir)
+	state, err := client.AgreementState()
+	if err != nil {
+		reportErrorf(errorAgreementState, err)
+	}
+
+	fmt.Printf("Round %d, period %d, step %s\n", state.Round, state.Period, stepName(state.Step))
+	fmt.Printf("Time in period: %s\n", time.Duration(state.TimeInPeriod).Truncate(time.Millisecond))
+	napping := ""
+	if state.Napping {
+		napping = " (napping)"
+	}
+	fmt.Printf("Next timeout: %s%s\n", time.Duration(state.Deadline), napping)
+	if state.FastRecoveryDeadline != 0 {
+		fmt.Printf("Next fast recovery timeout: %s\n", time.Duration(state.FastRecoveryDeadline))
+	}
+	if state.Period > 0 {
+		fmt.Printf("Last concluding step: %s\n", stepName(state.LastConcluding))
+	}
+	if state.Pinned != nil {
+		fmt.Printf("Pinned value: %s\n", proposalValueString(*state.Pinned))
+	}
+
+	fmt.Printf("Proposals:\n")
+	if len(state.Proposals) == 0 {
+		fmt.Printf("  none\n")
+	}
+	for _, proposal := range state.Proposals {
+		payload := "payload pending"
+		if proposal.PayloadReceived {
+			payload = "payload received"
+		}
+		fmt.Printf("  %s, %s\n", proposalValueString(proposal.Value), payload)
+	}
+
+	for _, period := range state.Periods {
+		fmt.Printf("Period %d:\n", period.Period)
+		if period.Frozen != nil {
+			final := ""
+			if period.FrozenFinal {
+				final = " (final)"
+			}
+			fmt.Printf("  frozen: %s%s\n", proposalValueString(*period.Frozen), final)
+		}
+		if period.Staged != nil {
+			fmt.Printf("  staged: %s\n", proposalValueString(*period.Staged))
+		}
+		for _, step := range period.Steps {
+			fmt.Printf("  step %s: threshold %d of %d", stepName(step.Step), step.Threshold, step.CommitteeSize)
+			if step.EquivocatorsWeight > 0 {
+				fmt.Printf(", equivocators weight %d", step.EquivocatorsWeight)
+			}
+			fmt.Printf("\n")
+			for _, tally := range step.Votes {
+				progress := ""
+				if step.Threshold > 0 {
+					progress = fmt.Sprintf(" (%.1f%%)", 100*float64(tally.Weight+step.EquivocatorsWeight)/float64(step.Threshold))
+				}
+				fmt.Printf("    %s: weight %d%s from %d voters\n", proposalValueString(tally.Value), tally.Weight, progress, tally.Voters)
+			}
+		}
+	}
+}
+
+// stepName returns the name of the given agreement step.
+func stepName(step uint64) string {
+	switch step {
+	case 0:
+		return "propose"
+	case 1:
+		return "soft"
+	case 2:
+		return "cert"
+	case 253:
+		return "late"
+	case 254:
+		return "redo"
+	case 255:
+		return "down"
+	default:
+		return fmt.Sprintf("next-%d", step-3)
+	}
+}
+
+// proposalValueString returns a short description of the given proposal-value.
+func proposalValueString(value private.AgreementProposalValue) string {
+	if value.Bottom {
+		return "bottom"
+	}
+	var digest crypto.Digest
+	copy(digest[:], value.BlockDigest)
+	return fmt.Sprintf("block %s by %s (period %d)", digest, value.OriginalProposer, value.OriginalPeriod)
+}
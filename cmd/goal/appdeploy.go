@@ -0,0 +1,642 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+var (
+	deployManifestFile string
+	deployLockFile     string
+	deployPlanOnly     bool
+)
+
+func init() {
+	appCmd.AddCommand(deployAppCmd)
+
+	deployAppCmd.Flags().StringVarP(&deployManifestFile, "manifest", "m", "", "YAML (or JSON) manifest describing the apps to deploy")
+	deployAppCmd.Flags().StringVar(&deployLockFile, "lock", "", "Lock file recording the IDs of the deployed apps per network (default: the manifest filename followed by .lock)")
+	deployAppCmd.Flags().BoolVar(&deployPlanOnly, "plan", false, "Only show the deployment plan, without submitting any transaction")
+	deployAppCmd.MarkFlagRequired("manifest")
+}
+
+// appManifest describes the apps deployed by goal app deploy.
+type appManifest struct {
+	Apps []appManifestApp `json:"apps"`
+}
+
+// appManifestSchema is the global or local state schema of an app.
+type appManifestSchema struct {
+	Ints       uint64 `json:"ints"`
+	ByteSlices uint64 `json:"byteslices"`
+}
+
+func (s appManifestSchema) stateSchema() basics.StateSchema {
+	return basics.StateSchema{NumUint: s.Ints, NumByteSlice: s.ByteSlices}
+}
+
+func (s appManifestSchema) String() string {
+	return fmt.Sprintf("%d ints/%d byteslices", s.Ints, s.ByteSlices)
+}
+
+// appManifestCall is an app call issued once the app is created.
+type appManifestCall struct {
+	From string `json:"from"`
+	// Args are encoded like the --app-arg flag, e.g. str:hello or int:1234.
+	Args          []string `json:"args"`
+	Accounts      []string `json:"accounts"`
+	ForeignApps   []uint64 `json:"foreign-apps"`
+	ForeignAssets []uint64 `json:"foreign-assets"`
+}
+
+// appManifestApp describes a single app of the manifest.
+type appManifestApp struct {
+	// Name identifies the app in the lock file.
+	Name    string `json:"name"`
+	Creator string `json:"creator"`
+	// Approval and Clear are TEAL source files, relative to the manifest.
+	Approval string `json:"approval"`
+	Clear    string `json:"clear"`
+	// Templates maps the TMPL_ variables of the TEAL sources to the text replacing them.
+	Templates    map[string]string `json:"templates"`
+	GlobalSchema appManifestSchema `json:"global-schema"`
+	LocalSchema  appManifestSchema `json:"local-schema"`
+	ExtraPages   uint32            `json:"extra-pages"`
+	// AppID is the app to update, when it was deployed before without the lock file.
+	AppID uint64            `json:"app-id"`
+	OptIn []string          `json:"opt-in"`
+	Calls []appManifestCall `json:"calls"`
+}
+
+var templateVariableRe = regexp.MustCompile(`TMPL_[A-Za-z0-9_]+`)
+
+// parseAppManifest decodes and validates a manifest.
+func parseAppManifest(data []byte) (manifest appManifest, err error) {
+	err = yaml.Unmarshal(data, &manifest)
+	if err != nil {
+		return
+	}
+	if len(manifest.Apps) == 0 {
+		return manifest, fmt.Errorf("the manifest has no apps")
+	}
+
+	names := make(map[string]bool)
+	checkAddress := func(app string, addr string) error {
+		_, err := basics.UnmarshalChecksumAddress(addr)
+		if err != nil {
+			return fmt.Errorf("app %s: invalid address '%s': %v", app, addr, err)
+		}
+		return nil
+	}
+	for _, app := range manifest.Apps {
+		if app.Name == "" {
+			return manifest, fmt.Errorf("every app needs a name")
+		}
+		if names[app.Name] {
+			return manifest, fmt.Errorf("app %s appears more than once", app.Name)
+		}
+		names[app.Name] = true
+
+		if app.Approval == "" || app.Clear == "" {
+			return manifest, fmt.Errorf("app %s: both the approval and the clear programs are required", app.Name)
+		}
+		for variable := range app.Templates {
+			if templateVariableRe.FindString(variable) != variable {
+				return manifest, fmt.Errorf("app %s: template variable '%s' should be of the form TMPL_NAME", app.Name, variable)
+			}
+		}
+		err = checkAddress(app.Name, app.Creator)
+		if err != nil {
+			return
+		}
+		for _, addr := range app.OptIn {
+			err = checkAddress(app.Name, addr)
+			if err != nil {
+				return
+			}
+		}
+		for _, call := range app.Calls {
+			err = checkAddress(app.Name, call.From)
+			if err != nil {
+				return
+			}
+			_, err = splitAppArgs(call.Args)
+			if err != nil {
+				return manifest, fmt.Errorf("app %s: %v", app.Name, err)
+			}
+		}
+	}
+	return
+}
+
+// substituteTemplates replaces the template variables of a TEAL source. Longer variables are replaced
+// first, so that a variable which is a prefix of another one doesn't clobber it.
+func substituteTemplates(source string, templates map[string]string) (string, error) {
+	variables := make([]string, 0, len(templates))
+	for variable := range templates {
+		variables = append(variables, variable)
+	}
+	sort.Slice(variables, func(i, j int) bool {
+		return len(variables[i]) > len(variables[j])
+	})
+	for _, variable := range variables {
+		source = strings.ReplaceAll(source, variable, templates[variable])
+	}
+	if missing := templateVariableRe.FindString(source); missing != "" {
+		return "", fmt.Errorf("template variable %s has no value", missing)
+	}
+	return source, nil
+}
+
+// programs assembles the approval and clear programs of the app, reading their sources relative to dir.
+func (app appManifestApp) programs(dir string) (approval []byte, clear []byte, err error) {
+	assemble := func(fname string) ([]byte, error) {
+		if !filepath.IsAbs(fname) {
+			fname = filepath.Join(dir, fname)
+		}
+		text, err := readFile(fname)
+		if err != nil {
+			return nil, err
+		}
+		source, err := substituteTemplates(string(text), app.Templates)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+		return assembleSource(fname, source), nil
+	}
+	approval, err = assemble(app.Approval)
+	if err != nil {
+		return
+	}
+	clear, err = assemble(app.Clear)
+	return
+}
+
+// appDeployLock records the apps deployed from a manifest, per network genesis ID and app name.
+type appDeployLock struct {
+	Networks map[string]map[string]appDeployLockEntry `json:"networks"`
+}
+
+type appDeployLockEntry struct {
+	AppID        uint64 `json:"app-id"`
+	ApprovalHash string `json:"approval-hash"`
+	ClearHash    string `json:"clear-hash"`
+}
+
+func loadAppDeployLock(fname string) (lock appDeployLock, err error) {
+	data, err := ioutil.ReadFile(fname)
+	if os.IsNotExist(err) {
+		return appDeployLock{}, nil
+	}
+	if err != nil {
+		return
+	}
+	err = yaml.Unmarshal(data, &lock)
+	return
+}
+
+func (lock *appDeployLock) lookup(network string, name string) (appDeployLockEntry, bool) {
+	entry, ok := lock.Networks[network][name]
+	return entry, ok
+}
+
+func (lock *appDeployLock) record(network string, name string, entry appDeployLockEntry) {
+	if lock.Networks == nil {
+		lock.Networks = make(map[string]map[string]appDeployLockEntry)
+	}
+	if lock.Networks[network] == nil {
+		lock.Networks[network] = make(map[string]appDeployLockEntry)
+	}
+	lock.Networks[network][name] = entry
+}
+
+// save writes the lock file through a temporary file, so that an interrupted deploy doesn't lose it.
+func (lock *appDeployLock) save(fname string) error {
+	data, err := yaml.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	tmp := fname + ".tmp"
+	err = ioutil.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, fname)
+}
+
+func programHash(program []byte) string {
+	return crypto.HashObj(logic.Program(program)).String()
+}
+
+// appDeployPlan is what needs to be submitted to bring an app of the manifest up to date.
+type appDeployPlan struct {
+	app      appManifestApp
+	appID    uint64
+	approval []byte
+	clear    []byte
+
+	create bool
+	update bool
+	optIns []string
+	calls  []appManifestCall
+}
+
+// planAppDeploy compares the app of the manifest, with the given assembled programs, against the deployed app
+// appID, if any. params holds the parameters of the deployed app, and optedIn the accounts already opted in to it.
+func planAppDeploy(app appManifestApp, appID uint64, approval []byte, clear []byte, params *generatedV2.ApplicationParams, optedIn map[string]bool) (plan appDeployPlan, err error) {
+	plan = appDeployPlan{
+		app:      app,
+		appID:    appID,
+		approval: approval,
+		clear:    clear,
+	}
+
+	if appID == 0 {
+		plan.create = true
+		plan.optIns = app.OptIn
+		plan.calls = app.Calls
+		return
+	}
+
+	// the schemas and the extra pages can't be changed once the app is created.
+	schema := func(s *generatedV2.ApplicationStateSchema) appManifestSchema {
+		if s == nil {
+			return appManifestSchema{}
+		}
+		return appManifestSchema{Ints: s.NumUint, ByteSlices: s.NumByteSlice}
+	}
+	if deployed := schema(params.GlobalStateSchema); deployed != app.GlobalSchema {
+		return plan, fmt.Errorf("the global schema of app %d is %s, it cannot be changed to %s", appID, deployed, app.GlobalSchema)
+	}
+	if deployed := schema(params.LocalStateSchema); deployed != app.LocalSchema {
+		return plan, fmt.Errorf("the local schema of app %d is %s, it cannot be changed to %s", appID, deployed, app.LocalSchema)
+	}
+	var extraPages uint64
+	if params.ExtraProgramPages != nil {
+		extraPages = *params.ExtraProgramPages
+	}
+	if extraPages != uint64(app.ExtraPages) {
+		return plan, fmt.Errorf("app %d has %d extra pages, it cannot be changed to %d", appID, extraPages, app.ExtraPages)
+	}
+
+	plan.update = !bytes.Equal(params.ApprovalProgram, approval) || !bytes.Equal(params.ClearStateProgram, clear)
+	for _, addr := range app.OptIn {
+		if !optedIn[addr] {
+			plan.optIns = append(plan.optIns, addr)
+		}
+	}
+	return
+}
+
+// upToDate is set when the plan has nothing to submit.
+func (plan appDeployPlan) upToDate() bool {
+	return !plan.create && !plan.update && len(plan.optIns) == 0 && len(plan.calls) == 0
+}
+
+// describe returns one line per step of the plan.
+func (plan appDeployPlan) describe() (lines []string) {
+	name := plan.app.Name
+	if !plan.create {
+		name = fmt.Sprintf("%s (app %d)", plan.app.Name, plan.appID)
+	}
+	if plan.upToDate() {
+		return []string{fmt.Sprintf("%s: up to date", name)}
+	}
+	if plan.create {
+		lines = append(lines, fmt.Sprintf("%s: create from %s with global schema %s, local schema %s and %d extra pages (approval hash %s, clear hash %s)",
+			name, plan.app.Creator, plan.app.GlobalSchema, plan.app.LocalSchema, plan.app.ExtraPages, programHash(plan.approval), programHash(plan.clear)))
+	}
+	if plan.update {
+		lines = append(lines, fmt.Sprintf("%s: update from %s to approval hash %s, clear hash %s", name, plan.app.Creator, programHash(plan.approval), programHash(plan.clear)))
+	}
+	for _, addr := range plan.optIns {
+		lines = append(lines, fmt.Sprintf("%s: opt in %s", name, addr))
+	}
+	for _, call := range plan.calls {
+		lines = append(lines, fmt.Sprintf("%s: call from %s with args [%s]", name, call.From, strings.Join(call.Args, ", ")))
+	}
+	return
+}
+
+// deployedApp fetches the parameters of a deployed app and the accounts of the manifest which opted in to it.
+func deployedApp(client libgoal.Client, appID uint64, optIn []string) (*generatedV2.ApplicationParams, map[string]bool, error) {
+	app, err := client.ApplicationInformation(appID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errorNoSuchApplication+": %v", appID, err)
+	}
+	optedIn := make(map[string]bool)
+	for _, addr := range optIn {
+		account, err := client.AccountInformationV2(addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if account.AppsLocalState == nil {
+			continue
+		}
+		for _, state := range *account.AppsLocalState {
+			if state.Id == appID {
+				optedIn[addr] = true
+			}
+		}
+	}
+	return &app.Params, optedIn, nil
+}
+
+// appDeployer submits the transactions of the deployment plans.
+type appDeployer struct {
+	client  libgoal.Client
+	dataDir string
+	wh      []byte
+	pw      []byte
+}
+
+func (d *appDeployer) fill(sender string, tx transactions.Transaction) (transactions.Transaction, uint64, error) {
+	fv, lv, err := d.client.ComputeValidityRounds(0, 0, 0)
+	if err != nil {
+		return tx, 0, err
+	}
+	tx, err = d.client.FillUnsignedTxTemplate(sender, fv, lv, 0, tx)
+	return tx, lv, err
+}
+
+// submit signs and broadcasts the transactions as a group, and waits for them to be committed.
+func (d *appDeployer) submit(txns []transactions.Transaction) (lastTxn transactions.Txid, err error) {
+	if d.wh == nil {
+		d.wh, d.pw = ensureWalletHandleMaybePassword(d.dataDir, walletName, true)
+	}
+	if len(txns) > 1 {
+		gid, err := d.client.GroupID(txns)
+		if err != nil {
+			return lastTxn, err
+		}
+		for i := range txns {
+			txns[i].Group = gid
+		}
+	}
+	stxns := make([]transactions.SignedTxn, len(txns))
+	for i, tx := range txns {
+		stxns[i], err = d.client.SignTransactionWithWallet(d.wh, d.pw, tx)
+		if err != nil {
+			return lastTxn, fmt.Errorf(errorSigningTX, err)
+		}
+	}
+	err = d.client.BroadcastTransactionGroup(stxns)
+	if err != nil {
+		return lastTxn, fmt.Errorf(errorBroadcastingTX, err)
+	}
+	lastTxn = txns[len(txns)-1].ID()
+	reportInfof("Issued %d transaction(s), last txid %s", len(txns), lastTxn)
+	return lastTxn, nil
+}
+
+// deploy creates the app if needed, then submits the update, opt-ins and initial calls in groups.
+// onCreate is called once the app is created, before the remaining groups are submitted.
+func (d *appDeployer) deploy(plan *appDeployPlan, onCreate func()) error {
+	app := plan.app
+	if plan.create {
+		tx, err := d.client.MakeUnsignedAppCreateTx(transactions.NoOpOC, plan.approval, plan.clear, app.GlobalSchema.stateSchema(), app.LocalSchema.stateSchema(), nil, nil, nil, nil, app.ExtraPages)
+		if err != nil {
+			return err
+		}
+		tx, lv, err := d.fill(app.Creator, tx)
+		if err != nil {
+			return err
+		}
+		txid, err := d.submit([]transactions.Transaction{tx})
+		if err != nil {
+			return err
+		}
+		txn, err := waitForCommit(d.client, txid.String(), lv)
+		if err != nil {
+			return err
+		}
+		if txn.TransactionResults == nil || txn.TransactionResults.CreatedAppIndex == 0 {
+			return fmt.Errorf("the app index created by %s is unknown", txid)
+		}
+		plan.appID = txn.TransactionResults.CreatedAppIndex
+		reportInfof("Created app %s with app index %d", app.Name, plan.appID)
+		onCreate()
+	}
+
+	var txns []transactions.Transaction
+	var senders []string
+	if plan.update {
+		tx, err := d.client.MakeUnsignedAppUpdateTx(plan.appID, nil, nil, nil, nil, plan.approval, plan.clear)
+		if err != nil {
+			return err
+		}
+		txns = append(txns, tx)
+		senders = append(senders, app.Creator)
+	}
+	for _, addr := range plan.optIns {
+		tx, err := d.client.MakeUnsignedAppOptInTx(plan.appID, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		txns = append(txns, tx)
+		senders = append(senders, addr)
+	}
+	for _, call := range plan.calls {
+		encodedArgs, err := splitAppArgs(call.Args)
+		if err != nil {
+			return err
+		}
+		args := make([][]byte, len(encodedArgs))
+		for i, arg := range encodedArgs {
+			args[i], err = parseAppArg(arg)
+			if err != nil {
+				return err
+			}
+		}
+		tx, err := d.client.MakeUnsignedAppNoOpTx(plan.appID, args, call.Accounts, call.ForeignApps, call.ForeignAssets)
+		if err != nil {
+			return err
+		}
+		txns = append(txns, tx)
+		senders = append(senders, call.From)
+	}
+
+	_, proto := getProto(protoVersion)
+	for start := 0; start < len(txns); start += proto.MaxTxGroupSize {
+		end := start + proto.MaxTxGroupSize
+		if end > len(txns) {
+			end = len(txns)
+		}
+		group := make([]transactions.Transaction, 0, end-start)
+		var lastValid uint64
+		for i := start; i < end; i++ {
+			tx, lv, err := d.fill(senders[i], txns[i])
+			if err != nil {
+				return err
+			}
+			group = append(group, tx)
+			lastValid = lv
+		}
+		txid, err := d.submit(group)
+		if err != nil {
+			return err
+		}
+		_, err = waitForCommit(d.client, txid.String(), lastValid)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var deployAppCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy or upgrade the applications described by a manifest",
+	Long: `Deploy or upgrade the applications described by a manifest. For each app, the TEAL sources are assembled after substituting their template variables, and compared against the deployed app: an app which doesn't exist yet is created and then called with the initial calls of the manifest, while a deployed app is updated when its programs changed. The accounts listed under opt-in are opted in to the app when they aren't already. The plan is shown before any transaction is submitted, and the IDs of the deployed apps are recorded per network in the lock file, which is used to find them in later deploys.
+
+Example manifest:
+
+apps:
+  - name: counter
+    creator: <address>
+    approval: counter.teal
+    clear: clear.teal
+    templates:
+      TMPL_OWNER: <address>
+    global-schema: {ints: 1, byteslices: 0}
+    local-schema: {ints: 0, byteslices: 0}
+    extra-pages: 0
+    opt-in: [<address>]
+    calls:
+      - from: <address>
+        args: ["str:init"]`,
+	Example: "goal app deploy -m contracts.yaml --plan",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data, err := readFile(deployManifestFile)
+		if err != nil {
+			reportErrorf(errorReadingDeployManifest, deployManifestFile, err)
+		}
+		manifest, err := parseAppManifest(data)
+		if err != nil {
+			reportErrorf(errorReadingDeployManifest, deployManifestFile, err)
+		}
+
+		lockFile := deployLockFile
+		if lockFile == "" {
+			lockFile = deployManifestFile + ".lock"
+		}
+		lock, err := loadAppDeployLock(lockFile)
+		if err != nil {
+			reportErrorf(errorReadingDeployLock, lockFile, err)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		network, err := client.GenesisID()
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		manifestDir := filepath.Dir(deployManifestFile)
+		plans := make([]appDeployPlan, 0, len(manifest.Apps))
+		for _, app := range manifest.Apps {
+			approval, clear, err := app.programs(manifestDir)
+			if err != nil {
+				reportErrorf(errorDeployingApp, app.Name, err)
+			}
+
+			appID := app.AppID
+			if appID == 0 {
+				if entry, ok := lock.lookup(network, app.Name); ok {
+					appID = entry.AppID
+				}
+			}
+			var params *generatedV2.ApplicationParams
+			var optedIn map[string]bool
+			if appID != 0 {
+				params, optedIn, err = deployedApp(client, appID, app.OptIn)
+				if err != nil {
+					reportErrorf(errorDeployingApp, app.Name, err)
+				}
+			}
+
+			plan, err := planAppDeploy(app, appID, approval, clear, params, optedIn)
+			if err != nil {
+				reportErrorf(errorDeployingApp, app.Name, err)
+			}
+			plans = append(plans, plan)
+		}
+
+		reportInfof(infoDeployPlan, network)
+		upToDate := true
+		for _, plan := range plans {
+			for _, line := range plan.describe() {
+				reportInfoln("  " + line)
+			}
+			upToDate = upToDate && plan.upToDate()
+		}
+
+		if deployPlanOnly {
+			return
+		}
+
+		recordPlan := func(plan appDeployPlan) {
+			lock.record(network, plan.app.Name, appDeployLockEntry{
+				AppID:        plan.appID,
+				ApprovalHash: programHash(plan.approval),
+				ClearHash:    programHash(plan.clear),
+			})
+			err := lock.save(lockFile)
+			if err != nil {
+				reportErrorf(errorWritingDeployLock, lockFile, err)
+			}
+		}
+
+		deployer := appDeployer{client: client, dataDir: dataDir}
+		for i := range plans {
+			plan := &plans[i]
+			if !plan.upToDate() {
+				err = deployer.deploy(plan, func() {
+					// record the created app right away, so that a failure later on doesn't lead to creating it again.
+					recordPlan(*plan)
+				})
+				if err != nil {
+					reportErrorf(errorDeployingApp, plan.app.Name, err)
+				}
+			}
+			recordPlan(*plan)
+		}
+		if upToDate {
+			reportInfoln(infoDeployUpToDate)
+		}
+		reportInfof(infoDeployLockWritten, lockFile)
+	},
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseAppManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr := basics.Address{1}.String()
+	manifest, err := parseAppManifest([]byte(fmt.Sprintf(`
+apps:
+  - name: counter
+    creator: %[1]s
+    approval: counter.teal
+    clear: clear.teal
+    templates:
+      TMPL_OWNER: %[1]s
+    global-schema: {ints: 1, byteslices: 2}
+    extra-pages: 1
+    opt-in: [%[1]s]
+    calls:
+      - from: %[1]s
+        args: ["str:init", "int:5"]
+`, addr)))
+	require.NoError(t, err)
+	require.Len(t, manifest.Apps, 1)
+	app := manifest.Apps[0]
+	require.Equal(t, "counter", app.Name)
+	require.Equal(t, appManifestSchema{Ints: 1, ByteSlices: 2}, app.GlobalSchema)
+	require.Equal(t, appManifestSchema{}, app.LocalSchema)
+	require.Equal(t, uint32(1), app.ExtraPages)
+	require.Equal(t, []string{addr}, app.OptIn)
+	require.Equal(t, []string{"str:init", "int:5"}, app.Calls[0].Args)
+
+	invalid := []string{
+		`apps: []`,
+		fmt.Sprintf("apps: [{name: a, creator: %s, approval: a.teal}]", addr),
+		fmt.Sprintf("apps: [{name: a, creator: %[1]s, approval: a.teal, clear: c.teal}, {name: a, creator: %[1]s, approval: a.teal, clear: c.teal}]", addr),
+		"apps: [{name: a, creator: nope, approval: a.teal, clear: c.teal}]",
+		fmt.Sprintf("apps: [{name: a, creator: %s, approval: a.teal, clear: c.teal, templates: {OWNER: x}}]", addr),
+		fmt.Sprintf("apps: [{name: a, creator: %[1]s, approval: a.teal, clear: c.teal, calls: [{from: %[1]s, args: [noencoding]}]}]", addr),
+	}
+	for _, manifest := range invalid {
+		_, err := parseAppManifest([]byte(manifest))
+		require.Error(t, err, manifest)
+	}
+}
+
+func TestSubstituteTemplates(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source, err := substituteTemplates("int TMPL_AMOUNT\nint TMPL_AMOUNT_MAX\n", map[string]string{
+		"TMPL_AMOUNT":     "1",
+		"TMPL_AMOUNT_MAX": "100",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "int 1\nint 100\n", source)
+
+	_, err = substituteTemplates("int TMPL_AMOUNT\nint TMPL_OTHER\n", map[string]string{"TMPL_AMOUNT": "1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "TMPL_OTHER")
+}
+
+func TestPlanAppDeploy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	creator := basics.Address{1}.String()
+	user := basics.Address{2}.String()
+	app := appManifestApp{
+		Name:         "counter",
+		Creator:      creator,
+		GlobalSchema: appManifestSchema{Ints: 1},
+		LocalSchema:  appManifestSchema{ByteSlices: 1},
+		OptIn:        []string{creator, user},
+		Calls:        []appManifestCall{{From: creator, Args: []string{"str:init"}}},
+	}
+	approval := []byte{2, 0x20, 1, 1}
+	clear := []byte{2, 0x81, 1}
+
+	// a new app is created, opted in to and called.
+	plan, err := planAppDeploy(app, 0, approval, clear, nil, nil)
+	require.NoError(t, err)
+	require.True(t, plan.create)
+	require.Equal(t, app.OptIn, plan.optIns)
+	require.Equal(t, app.Calls, plan.calls)
+	require.Len(t, plan.describe(), 4)
+
+	params := generatedV2.ApplicationParams{
+		ApprovalProgram:   approval,
+		ClearStateProgram: clear,
+		GlobalStateSchema: &generatedV2.ApplicationStateSchema{NumUint: 1},
+		LocalStateSchema:  &generatedV2.ApplicationStateSchema{NumByteSlice: 1},
+	}
+
+	// a deployed app matching the manifest is up to date, and the initial calls aren't repeated.
+	plan, err = planAppDeploy(app, 7, approval, clear, &params, map[string]bool{creator: true, user: true})
+	require.NoError(t, err)
+	require.True(t, plan.upToDate())
+	require.Equal(t, []string{"counter (app 7): up to date"}, plan.describe())
+
+	// changed programs and missing opt-ins.
+	params.ApprovalProgram = []byte{2, 0x20, 1, 0}
+	plan, err = planAppDeploy(app, 7, approval, clear, &params, map[string]bool{creator: true})
+	require.NoError(t, err)
+	require.False(t, plan.create)
+	require.True(t, plan.update)
+	require.Equal(t, []string{user}, plan.optIns)
+	require.Empty(t, plan.calls)
+
+	// the schemas and extra pages are immutable.
+	changed := app
+	changed.GlobalSchema.Ints = 2
+	_, err = planAppDeploy(changed, 7, approval, clear, &params, nil)
+	require.Error(t, err)
+	changed = app
+	changed.LocalSchema = appManifestSchema{}
+	_, err = planAppDeploy(changed, 7, approval, clear, &params, nil)
+	require.Error(t, err)
+	changed = app
+	changed.ExtraPages = 1
+	_, err = planAppDeploy(changed, 7, approval, clear, &params, nil)
+	require.Error(t, err)
+}
+
+func TestAppDeployLock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", "appdeploy")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fname := filepath.Join(dir, "manifest.yaml.lock")
+
+	lock, err := loadAppDeployLock(fname)
+	require.NoError(t, err)
+	_, ok := lock.lookup("testnet-v1.0", "counter")
+	require.False(t, ok)
+
+	entry := appDeployLockEntry{AppID: 12, ApprovalHash: "a", ClearHash: "c"}
+	lock.record("testnet-v1.0", "counter", entry)
+	require.NoError(t, lock.save(fname))
+
+	lock, err = loadAppDeployLock(fname)
+	require.NoError(t, err)
+	found, ok := lock.lookup("testnet-v1.0", "counter")
+	require.True(t, ok)
+	require.Equal(t, entry, found)
+	_, ok = lock.lookup("mainnet-v1.0", "counter")
+	require.False(t, ok)
+}
@@ -235,6 +235,22 @@ func parseAppArg(arg appCallArg) (rawValue []byte, parseErr error) {
 	return
 }
 
+// splitAppArgs splits application arguments of the form 'encoding:value'
+func splitAppArgs(args []string) (encodedArgs []appCallArg, err error) {
+	for _, arg := range args {
+		encodingValue := strings.SplitN(arg, ":", 2)
+		if len(encodingValue) != 2 {
+			return nil, fmt.Errorf("all arguments should be of the form 'encoding:value'")
+		}
+		encodedArg := appCallArg{
+			Encoding: encodingValue[0],
+			Value:    encodingValue[1],
+		}
+		encodedArgs = append(encodedArgs, encodedArg)
+	}
+	return
+}
+
 func parseAppInputs(inputs appCallInputs) (args [][]byte, accounts []string, foreignApps []uint64, foreignAssets []uint64) {
 	accounts = inputs.Accounts
 	foreignApps = inputs.ForeignApps
@@ -274,17 +290,9 @@ func getAppInputs() (args [][]byte, accounts []string, foreignApps []uint64, for
 		return processAppInputFile()
 	}
 
-	var encodedArgs []appCallArg
-	for _, arg := range appArgs {
-		encodingValue := strings.SplitN(arg, ":", 2)
-		if len(encodingValue) != 2 {
-			reportErrorf("all arguments should be of the form 'encoding:value'")
-		}
-		encodedArg := appCallArg{
-			Encoding: encodingValue[0],
-			Value:    encodingValue[1],
-		}
-		encodedArgs = append(encodedArgs, encodedArg)
+	encodedArgs, err := splitAppArgs(appArgs)
+	if err != nil {
+		reportErrorf(err.Error())
 	}
 
 	inputs := appCallInputs{
@@ -911,10 +919,9 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			// Encode local state to json, print, and exit
-			enc := protocol.EncodeJSON(kv)
-
-			// Print to stdout
-			os.Stdout.Write(enc)
+			reportResult(kv, func() {
+				os.Stdout.Write(protocol.EncodeJSON(kv))
+			})
 			return
 		}
 
@@ -943,10 +950,9 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			// Encode global state to json, print, and exit
-			enc := protocol.EncodeJSON(kv)
-
-			// Print to stdout
-			os.Stdout.Write(enc)
+			reportResult(kv, func() {
+				os.Stdout.Write(protocol.EncodeJSON(kv))
+			})
 			return
 		}
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/libgoal"
 )
@@ -590,6 +591,14 @@ func assetDecimalsFmt(amount uint64, decimals uint32) string {
 	return fmt.Sprintf("%d.%0*d", amount/pow, decimals, amount%pow)
 }
 
+// assetInfoResult is the structured result of goal asset info.
+type assetInfoResult struct {
+	v1.AssetParams
+	AssetID       uint64 `json:"asset-id"`
+	ReserveAmount uint64 `json:"reserve-amount"`
+	Issued        uint64 `json:"issued"`
+}
+
 var infoAssetCmd = &cobra.Command{
 	Use:   "info",
 	Short: "Look up current parameters for an asset",
@@ -621,6 +630,11 @@ var infoAssetCmd = &cobra.Command{
 
 		res := reserve.Assets[assetID]
 
+		if structuredOutput() {
+			reportResult(assetInfoResult{AssetParams: params, AssetID: assetID, ReserveAmount: res.Amount, Issued: params.Total - res.Amount}, nil)
+			return
+		}
+
 		fmt.Printf("Asset ID:         %d\n", assetID)
 		fmt.Printf("Creator:          %s\n", params.Creator)
 		reportInfof("Asset name:       %s\n", params.AssetName)
@@ -622,9 +622,7 @@ var rawsendCmd = &cobra.Command{
 				reportErrorf(fileWriteError, rejectsFilename, err.Error())
 			}
 			f.Close()
-			fmt.Printf("Rejected transactions written to %s\n", rejectsFilename)
-
-			os.Exit(1)
+			reportErrorf(errorRawTxRejected, rejectsFilename)
 		}
 	},
 }
@@ -655,7 +653,9 @@ var inspectCmd = &cobra.Command{
 				if err != nil {
 					reportErrorf(txDecodeError, txFilename, err)
 				}
-				fmt.Printf("%s[%d]\n%s\n\n", txFilename, count, string(protocol.EncodeJSON(sti)))
+				reportResult(inspectedTxn{File: txFilename, Index: count, Txn: sti}, func() {
+					fmt.Printf("%s[%d]\n%s\n\n", txFilename, count, string(protocol.EncodeJSON(sti)))
+				})
 				count++
 			}
 		}
@@ -692,11 +692,11 @@ func getProto(versArg string) (protocol.ConsensusVersion, config.ConsensusParams
 	}
 	proto, ok := config.Consensus[cvers]
 	if !ok {
-		fmt.Fprintf(os.Stderr, "Invalid consensus version. Possible versions:\n")
+		versions := make([]string, 0, len(config.Consensus))
 		for xvers := range config.Consensus {
-			fmt.Fprintf(os.Stderr, "\t%s\n", xvers)
+			versions = append(versions, string(xvers))
 		}
-		os.Exit(1)
+		reportErrorf(errorConsensusVersion, strings.Join(versions, "\n\t"))
 	}
 	return cvers, proto
 }
@@ -920,7 +920,12 @@ func assembleFile(fname string) (program []byte) {
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	return assembleSource(fname, string(text))
+}
+
+// assembleSource assembles the TEAL source text read from fname, which is used when reporting problems
+func assembleSource(fname string, text string) (program []byte) {
+	ops, err := logic.AssembleString(text)
 	if err != nil {
 		ops.ReportProblems(fname)
 		reportErrorf("%s: %s", fname, err)
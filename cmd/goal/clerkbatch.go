@@ -0,0 +1,712 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	batchPayoutsFile string
+	batchJournalFile string
+	batchAtomic      bool
+	batchMaxPending  int
+)
+
+// batchMaxPasses bounds the number of times expired payouts are submitted again.
+const batchMaxPasses = 3
+
+func init() {
+	clerkCmd.AddCommand(batchCmd)
+
+	batchCmd.Flags().StringVarP(&account, "from", "f", "", "Account address to send the payouts from (If not specified, uses default account)")
+	batchCmd.Flags().StringVarP(&batchPayoutsFile, "infile", "i", "", "CSV or JSON file listing the payouts")
+	batchCmd.Flags().StringVar(&batchJournalFile, "journal", "", "Journal recording the progress of the payouts (default: the payouts filename followed by .journal)")
+	batchCmd.Flags().BoolVar(&batchAtomic, "atomic", false, "Group the payouts atomically, in groups of the maximum transaction group size")
+	batchCmd.Flags().IntVar(&batchMaxPending, "max-pending", 256, "Maximum number of submitted payouts awaiting confirmation")
+	batchCmd.Flags().Uint64Var(&fee, "fee", 0, "The fee of each transaction (automatically determined by default), in microAlgos")
+	batchCmd.Flags().Uint64Var(&numValidRounds, "validrounds", 0, "The number of rounds for which each transaction is valid")
+	batchCmd.MarkFlagRequired("infile")
+}
+
+// batchPayout is a payment, or an asset transfer when Asset is set, listed in the input of goal clerk batch.
+type batchPayout struct {
+	Receiver string `json:"receiver"`
+	Amount   uint64 `json:"amount"`
+	Asset    uint64 `json:"asset,omitempty"`
+	Note     string `json:"note,omitempty"`
+}
+
+func (p batchPayout) validate() error {
+	_, err := basics.UnmarshalChecksumAddress(p.Receiver)
+	if err != nil {
+		return fmt.Errorf("invalid receiver %s: %v", p.Receiver, err)
+	}
+	if len(p.Note) > config.MaxTxnNoteBytes {
+		return fmt.Errorf("note of %d bytes exceeds %d bytes", len(p.Note), config.MaxTxnNoteBytes)
+	}
+	return nil
+}
+
+// parseBatchPayouts parses a JSON list of payouts when fname has a .json extension, and CSV records of
+// receiver, amount, and the optional asset and note otherwise. A CSV header line is skipped.
+func parseBatchPayouts(fname string, data []byte) (payouts []batchPayout, err error) {
+	if strings.EqualFold(filepath.Ext(fname), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&payouts)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+		for line := 1; ; line++ {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if line == 1 && strings.EqualFold(record[0], "receiver") {
+				continue
+			}
+			if len(record) < 2 || len(record) > 4 {
+				return nil, fmt.Errorf("line %d: expected receiver, amount, asset and note, got %d fields", line, len(record))
+			}
+			payout := batchPayout{Receiver: record[0]}
+			payout.Amount, err = strconv.ParseUint(record[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid amount %s", line, record[1])
+			}
+			if len(record) > 2 && record[2] != "" {
+				payout.Asset, err = strconv.ParseUint(record[2], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid asset %s", line, record[2])
+				}
+			}
+			if len(record) > 3 {
+				payout.Note = record[3]
+			}
+			payouts = append(payouts, payout)
+		}
+	}
+
+	if len(payouts) == 0 {
+		return nil, fmt.Errorf("no payouts listed")
+	}
+	for i, payout := range payouts {
+		err = payout.validate()
+		if err != nil {
+			return nil, fmt.Errorf("payout %d: %v", i, err)
+		}
+	}
+	return payouts, nil
+}
+
+// The statuses of the payouts recorded in the batch journal. A payout without status hasn't been submitted yet.
+const (
+	batchSubmitted = "submitted"
+	batchConfirmed = "confirmed"
+	batchExpired   = "expired"
+)
+
+// batchJournalHeader is the first line of a batch journal, binding it to the payouts it records.
+type batchJournalHeader struct {
+	Payouts string `json:"payouts"`
+	Count   int    `json:"count"`
+}
+
+// batchJournalEntry records a change of status of the payout at index Index of the payouts list.
+type batchJournalEntry struct {
+	Index      int    `json:"index"`
+	Status     string `json:"status"`
+	Txid       string `json:"txid"`
+	FirstValid uint64 `json:"first-valid,omitempty"`
+	LastValid  uint64 `json:"last-valid,omitempty"`
+	Round      uint64 `json:"round,omitempty"`
+}
+
+// batchJournal is an append-only log of JSON lines recording the progress of goal clerk batch. The transaction of a
+// payout is recorded as submitted, along with its validity rounds, and synced to disk before it is broadcast. This
+// way a payout interrupted by a crash is only submitted again once it is known not to have been confirmed.
+type batchJournal struct {
+	file *os.File
+	// payouts holds the latest entry of every payout.
+	payouts []batchJournalEntry
+}
+
+func batchPayoutsHash(data []byte) string {
+	return crypto.Hash(data).String()
+}
+
+// openBatchJournal opens the journal of the payouts in data, creating it if needed.
+func openBatchJournal(fname string, data []byte, count int) (*batchJournal, error) {
+	header := batchJournalHeader{Payouts: batchPayoutsHash(data), Count: count}
+	journal := &batchJournal{payouts: make([]batchJournalEntry, count)}
+
+	contents, err := ioutil.ReadFile(fname)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(contents) == 0 {
+		journal.file, err = os.OpenFile(fname, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, err
+		}
+		err = journal.append(header)
+		if err != nil {
+			journal.close()
+			return nil, err
+		}
+		return journal, nil
+	}
+
+	// a crash may have left an incomplete last line, which is discarded. Its payouts were never broadcast.
+	valid := bytes.LastIndexByte(contents, '\n') + 1
+	lines := bytes.Split(contents[:valid], []byte{'\n'})
+	var found batchJournalHeader
+	err = json.Unmarshal(lines[0], &found)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %v", err)
+	}
+	if found != header {
+		return nil, fmt.Errorf("the journal records other payouts than the ones listed")
+	}
+	for i, line := range lines[1:] {
+		if len(line) == 0 {
+			continue
+		}
+		var entry batchJournalEntry
+		err = json.Unmarshal(line, &entry)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+2, err)
+		}
+		if entry.Index < 0 || entry.Index >= count {
+			return nil, fmt.Errorf("line %d: payout %d out of range", i+2, entry.Index)
+		}
+		journal.payouts[entry.Index] = entry
+	}
+
+	journal.file, err = os.OpenFile(fname, os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	err = journal.file.Truncate(int64(valid))
+	if err == nil {
+		_, err = journal.file.Seek(int64(valid), io.SeekStart)
+	}
+	if err != nil {
+		journal.close()
+		return nil, err
+	}
+	return journal, nil
+}
+
+func (j *batchJournal) append(records ...interface{}) error {
+	var buf bytes.Buffer
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	_, err := j.file.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
+
+// record appends the entries to the journal and syncs it to disk.
+func (j *batchJournal) record(entries ...batchJournalEntry) error {
+	records := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		records[i] = entry
+	}
+	err := j.append(records...)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		j.payouts[entry.Index] = entry
+	}
+	return nil
+}
+
+// withStatus returns the indexes of the payouts with the given status.
+func (j *batchJournal) withStatus(status string) (indexes []int) {
+	for i, entry := range j.payouts {
+		if entry.Status == status {
+			indexes = append(indexes, i)
+		}
+	}
+	return
+}
+
+func (j *batchJournal) close() {
+	j.file.Close()
+}
+
+// batchSender submits the payouts and follows them until they are confirmed or expire.
+type batchSender struct {
+	client  libgoal.Client
+	dataDir string
+	wh      []byte
+	pw      []byte
+	from    string
+	payouts []batchPayout
+	journal *batchJournal
+}
+
+func (s *batchSender) transaction(payout batchPayout, fv, lv uint64) (tx transactions.Transaction, err error) {
+	if payout.Asset != 0 {
+		tx, err = s.client.MakeUnsignedAssetSendTx(payout.Asset, payout.Amount, payout.Receiver, "", "")
+		if err != nil {
+			return
+		}
+	} else {
+		receiver, err := basics.UnmarshalChecksumAddress(payout.Receiver)
+		if err != nil {
+			return tx, err
+		}
+		tx.Type = protocol.PaymentTx
+		tx.Receiver = receiver
+		tx.Amount = basics.MicroAlgos{Raw: payout.Amount}
+	}
+	if payout.Note != "" {
+		tx.Note = []byte(payout.Note)
+	}
+	return s.client.FillUnsignedTxTemplate(s.from, fv, lv, fee, tx)
+}
+
+// submit journals and broadcasts the payouts at the given indexes, as a group when they are submitted atomically.
+func (s *batchSender) submit(indexes []int) (lastValid uint64, err error) {
+	fv, lv, err := s.client.ComputeValidityRounds(0, 0, numValidRounds)
+	if err != nil {
+		return 0, err
+	}
+	txns := make([]transactions.Transaction, len(indexes))
+	for i, idx := range indexes {
+		txns[i], err = s.transaction(s.payouts[idx], fv, lv)
+		if err != nil {
+			return 0, fmt.Errorf("payout %d: %v", idx, err)
+		}
+	}
+	if batchAtomic && len(txns) > 1 {
+		gid, err := s.client.GroupID(txns)
+		if err != nil {
+			return 0, err
+		}
+		for i := range txns {
+			txns[i].Group = gid
+		}
+	}
+
+	if s.wh == nil {
+		s.wh, s.pw = ensureWalletHandleMaybePassword(s.dataDir, walletName, true)
+	}
+	stxns := make([]transactions.SignedTxn, len(txns))
+	entries := make([]batchJournalEntry, len(txns))
+	for i, tx := range txns {
+		stxns[i], err = s.client.SignTransactionWithWallet(s.wh, s.pw, tx)
+		if err != nil {
+			return 0, fmt.Errorf(errorSigningTX, err)
+		}
+		entries[i] = batchJournalEntry{
+			Index:      indexes[i],
+			Status:     batchSubmitted,
+			Txid:       tx.ID().String(),
+			FirstValid: uint64(tx.FirstValid),
+			LastValid:  uint64(tx.LastValid),
+		}
+	}
+	err = s.journal.record(entries...)
+	if err != nil {
+		return 0, err
+	}
+
+	if batchAtomic {
+		err = s.client.BroadcastTransactionGroup(stxns)
+	} else {
+		for _, stxn := range stxns {
+			_, err = s.client.BroadcastTransaction(stxn)
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf(errorBroadcastingTX, err)
+	}
+	reportInfof(infoBatchSubmitted, indexes[0], indexes[len(indexes)-1], lv)
+	return lv, nil
+}
+
+// blockPayset returns the transactions confirmed in the given round.
+func (s *batchSender) blockPayset(round uint64) ([]transactions.SignedTxnWithAD, error) {
+	block, err := s.client.BookkeepingBlock(round)
+	if err != nil {
+		return nil, err
+	}
+	return block.DecodePaysetFlat()
+}
+
+// scan looks for the transactions of the given payouts in the blocks they were valid for, fetching each of these
+// blocks once, and returns the rounds in which the transactions that were found were confirmed.
+func (s *batchSender) scan(indexes []int) (rounds map[string]uint64, err error) {
+	rounds = make(map[string]uint64)
+	if len(indexes) == 0 {
+		return
+	}
+	wanted := make(map[string]bool, len(indexes))
+	first, last := s.journal.payouts[indexes[0]].FirstValid, s.journal.payouts[indexes[0]].LastValid
+	for _, idx := range indexes {
+		entry := s.journal.payouts[idx]
+		wanted[entry.Txid] = true
+		if entry.FirstValid < first {
+			first = entry.FirstValid
+		}
+		if entry.LastValid > last {
+			last = entry.LastValid
+		}
+	}
+	for rnd := first; rnd <= last && len(rounds) < len(wanted); rnd++ {
+		payset, err := s.blockPayset(rnd)
+		if err != nil {
+			return nil, err
+		}
+		for _, txn := range payset {
+			if txid := txn.ID().String(); wanted[txid] {
+				rounds[txid] = rnd
+			}
+		}
+	}
+	return
+}
+
+// await waits until all the given payouts are either confirmed or expired, and journals their status.
+func (s *batchSender) await(indexes []int) error {
+	stat, err := s.client.Status()
+	if err != nil {
+		return err
+	}
+	for {
+		var pending []int
+		var lapsed []int
+		var resolved []batchJournalEntry
+		for _, idx := range indexes {
+			entry := s.journal.payouts[idx]
+			// the node keeps track of the confirmed transactions until they expire.
+			txn, err := s.client.ConfirmedTransactionInformation(entry.Txid)
+			switch {
+			case err == nil && txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0:
+				entry.Status = batchConfirmed
+				entry.Round = *txn.ConfirmedRound
+				resolved = append(resolved, entry)
+			case stat.LastRound >= entry.LastValid:
+				lapsed = append(lapsed, idx)
+			default:
+				pending = append(pending, idx)
+			}
+		}
+
+		// the lookup failing doesn't tell a missing transaction from an unreachable node, so the expiry of the
+		// lapsed payouts is only established by looking for them in the blocks they were valid for.
+		rounds, err := s.scan(lapsed)
+		if err != nil {
+			return fmt.Errorf("unable to tell whether the submitted payouts were confirmed: %v", err)
+		}
+		for _, idx := range lapsed {
+			entry := s.journal.payouts[idx]
+			if round, ok := rounds[entry.Txid]; ok {
+				entry.Status = batchConfirmed
+				entry.Round = round
+			} else {
+				entry.Status = batchExpired
+			}
+			resolved = append(resolved, entry)
+		}
+
+		err = s.journal.record(resolved...)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		indexes = pending
+		stat, err = s.client.WaitForRound(stat.LastRound)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// send submits the payouts at the given indexes in chunks, keeping at most batchMaxPending of them awaiting
+// confirmation.
+func (s *batchSender) send(indexes []int, chunkSize int) error {
+	var inflight [][]int
+	pending := 0
+	for start := 0; start < len(indexes); start += chunkSize {
+		end := start + chunkSize
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		chunk := indexes[start:end]
+		for len(inflight) > 0 && pending+len(chunk) > batchMaxPending {
+			err := s.await(inflight[0])
+			if err != nil {
+				return err
+			}
+			pending -= len(inflight[0])
+			inflight = inflight[1:]
+		}
+		_, err := s.submit(chunk)
+		if err != nil {
+			return err
+		}
+		inflight = append(inflight, chunk)
+		pending += len(chunk)
+	}
+	for _, chunk := range inflight {
+		err := s.await(chunk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batchTotal is the amount of an asset, or of microAlgos for asset 0, paid out by the listed payouts.
+type batchTotal struct {
+	Asset     uint64 `json:"asset"`
+	Expected  uint64 `json:"expected"`
+	Confirmed uint64 `json:"confirmed"`
+}
+
+// batchReport is the reconciliation of the payouts against the ledger.
+type batchReport struct {
+	Payouts   int `json:"payouts"`
+	Confirmed int `json:"confirmed"`
+	// Unconfirmed lists the payouts which aren't confirmed.
+	Unconfirmed []int `json:"unconfirmed,omitempty"`
+	// Mismatched lists the confirmed payouts whose transaction in the ledger doesn't match the payout.
+	Mismatched []int        `json:"mismatched,omitempty"`
+	Totals     []batchTotal `json:"totals"`
+}
+
+func (r batchReport) reconciled() bool {
+	return len(r.Unconfirmed) == 0 && len(r.Mismatched) == 0
+}
+
+// matchesPayout tells whether a confirmed transaction is the payout from sender.
+func matchesPayout(txn *transactions.SignedTxnWithAD, sender string, payout batchPayout) bool {
+	if txn == nil || txn.Txn.Sender.String() != sender || string(txn.Txn.Note) != payout.Note {
+		return false
+	}
+	if payout.Asset != 0 {
+		return txn.Txn.Type == protocol.AssetTransferTx && uint64(txn.Txn.XferAsset) == payout.Asset && txn.Txn.AssetReceiver.String() == payout.Receiver && txn.Txn.AssetAmount == payout.Amount
+	}
+	return txn.Txn.Type == protocol.PaymentTx && txn.Txn.Receiver.String() == payout.Receiver && txn.Txn.Amount.Raw == payout.Amount
+}
+
+// findPaysetTxn returns the transaction with the given txid among the given confirmed transactions, if any.
+func findPaysetTxn(payset []transactions.SignedTxnWithAD, txid string) *transactions.SignedTxnWithAD {
+	for i := range payset {
+		if payset[i].ID().String() == txid {
+			return &payset[i]
+		}
+	}
+	return nil
+}
+
+// reconcileBatch checks the confirmed payouts recorded by the journal against the transactions of the blocks they were
+// confirmed in, which the given function returns.
+func reconcileBatch(payouts []batchPayout, journal []batchJournalEntry, sender string, blockPayset func(round uint64) ([]transactions.SignedTxnWithAD, error)) (report batchReport, err error) {
+	report.Payouts = len(payouts)
+	totals := make(map[uint64]*batchTotal)
+	paysets := make(map[uint64][]transactions.SignedTxnWithAD)
+	for i, payout := range payouts {
+		total, ok := totals[payout.Asset]
+		if !ok {
+			total = &batchTotal{Asset: payout.Asset}
+			totals[payout.Asset] = total
+		}
+		total.Expected += payout.Amount
+
+		entry := journal[i]
+		if entry.Status != batchConfirmed {
+			report.Unconfirmed = append(report.Unconfirmed, i)
+			continue
+		}
+		payset, ok := paysets[entry.Round]
+		if !ok {
+			payset, err = blockPayset(entry.Round)
+			if err != nil {
+				return
+			}
+			paysets[entry.Round] = payset
+		}
+		if !matchesPayout(findPaysetTxn(payset, entry.Txid), sender, payout) {
+			report.Mismatched = append(report.Mismatched, i)
+			continue
+		}
+		report.Confirmed++
+		total.Confirmed += payout.Amount
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].Asset < report.Totals[j].Asset
+	})
+	return
+}
+
+func (r batchReport) print() {
+	for _, total := range r.Totals {
+		unit := "microAlgos"
+		if total.Asset != 0 {
+			unit = fmt.Sprintf("units of asset %d", total.Asset)
+		}
+		reportInfof("Paid %d of %d %s", total.Confirmed, total.Expected, unit)
+	}
+	for _, idx := range r.Unconfirmed {
+		reportWarnf("payout %d is not confirmed", idx)
+	}
+	for _, idx := range r.Mismatched {
+		reportWarnf("payout %d does not match its transaction in the ledger", idx)
+	}
+	if r.reconciled() {
+		reportInfof(infoBatchReconciled, r.Payouts)
+	}
+}
+
+var batchCmd = &cobra.Command{
+	Use:   "batch",
+	Short: "Send a list of payments and asset transfers",
+	Long: `Send the payments and asset transfers listed in a CSV or JSON file from one account. CSV records hold the receiver, the amount (in microAlgos, or in base units of the asset) and optionally the asset ID and a note, while a JSON file holds a list of objects with the receiver, amount, asset and note fields. An asset ID of 0 or none sends microAlgos.
+
+The payouts are signed with kmd and submitted in chunks of the maximum transaction group size, grouped atomically with --atomic, while no more than --max-pending payouts await confirmation. Every submitted transaction is recorded in the journal before it is broadcast, so running the same command again after an interruption resumes the payouts: a payout which was submitted is only sent again once it expired without being confirmed, which may take up to --validrounds rounds. Once all payouts are confirmed, they are reconciled against the transactions in the ledger.`,
+	Example: `goal clerk batch -f <address> -i payroll.csv
+
+payroll.csv:
+receiver,amount,asset,note
+<address>,1000000,,salary
+<address>,250,31566704,bonus`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data, err := readFile(batchPayoutsFile)
+		if err != nil {
+			reportErrorf(errorReadingBatchPayouts, batchPayoutsFile, err)
+		}
+		payouts, err := parseBatchPayouts(batchPayoutsFile, data)
+		if err != nil {
+			reportErrorf(errorReadingBatchPayouts, batchPayoutsFile, err)
+		}
+		if batchMaxPending < 1 {
+			reportErrorln("--max-pending must be positive")
+		}
+
+		journalFile := batchJournalFile
+		if journalFile == "" {
+			journalFile = batchPayoutsFile + ".journal"
+		}
+		journal, err := openBatchJournal(journalFile, data, len(payouts))
+		if err != nil {
+			reportErrorf(errorOpeningBatchJournal, journalFile, err)
+		}
+		defer journal.close()
+
+		dataDir := ensureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		accountList := makeAccountsList(dataDir)
+		if account == "" {
+			account = accountList.getDefaultAccount()
+		}
+		sender := batchSender{
+			client:  client,
+			dataDir: dataDir,
+			from:    accountList.getAddressByName(account),
+			payouts: payouts,
+			journal: journal,
+		}
+
+		submitted := journal.withStatus(batchSubmitted)
+		confirmed := journal.withStatus(batchConfirmed)
+		if len(submitted)+len(confirmed) > 0 {
+			reportInfof(infoBatchResuming, journalFile, len(confirmed), len(submitted))
+			err = sender.await(submitted)
+			if err != nil {
+				reportErrorf(errorBatchPayouts, err)
+			}
+		}
+
+		_, proto := getProto(protoVersion)
+		chunkSize := proto.MaxTxGroupSize
+		if chunkSize > batchMaxPending {
+			chunkSize = batchMaxPending
+		}
+		for pass := 0; pass < batchMaxPasses; pass++ {
+			remaining := append(journal.withStatus(""), journal.withStatus(batchExpired)...)
+			if len(remaining) == 0 {
+				break
+			}
+			sort.Ints(remaining)
+			if pass > 0 {
+				reportInfof(infoBatchExpired, len(remaining))
+			}
+			err = sender.send(remaining, chunkSize)
+			if err != nil {
+				reportErrorf(errorBatchPayouts, err)
+			}
+		}
+
+		report, err := reconcileBatch(payouts, journal.payouts, sender.from, sender.blockPayset)
+		if err != nil {
+			reportErrorf(errorBatchPayouts, err)
+		}
+		reportResult(report, report.print)
+		if !report.reconciled() {
+			reportErrorf(errorBatchUnreconciled, len(report.Unconfirmed)+len(report.Mismatched), report.Payouts)
+		}
+	},
+}
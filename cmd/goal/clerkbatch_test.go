@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseBatchPayouts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := basics.Address{1}.String()
+	b := basics.Address{2}.String()
+	expected := []batchPayout{
+		{Receiver: a, Amount: 1000},
+		{Receiver: b, Amount: 5, Asset: 12, Note: "bonus, q3"},
+	}
+
+	payouts, err := parseBatchPayouts("payouts.csv", []byte(fmt.Sprintf("receiver,amount,asset,note\n%s,1000\n%s,5,12,\"bonus, q3\"\n", a, b)))
+	require.NoError(t, err)
+	require.Equal(t, expected, payouts)
+
+	payouts, err = parseBatchPayouts("payouts.txt", []byte(fmt.Sprintf("%s,1000,,\n%s, 5, 12, \"bonus, q3\"\n", a, b)))
+	require.NoError(t, err)
+	require.Equal(t, expected, payouts)
+
+	payouts, err = parseBatchPayouts("payouts.JSON", []byte(fmt.Sprintf(`[{"receiver": "%s", "amount": 1000}, {"receiver": "%s", "amount": 5, "asset": 12, "note": "bonus, q3"}]`, a, b)))
+	require.NoError(t, err)
+	require.Equal(t, expected, payouts)
+
+	invalid := map[string]string{
+		"empty.csv":      "receiver,amount\n",
+		"receiver.csv":   "nope,1000\n",
+		"amount.csv":     fmt.Sprintf("%s,-1\n", a),
+		"asset.csv":      fmt.Sprintf("%s,1,x\n", a),
+		"fields.csv":     fmt.Sprintf("%s\n", a),
+		"unknown.json":   fmt.Sprintf(`[{"receiver": "%s", "amount": 1, "memo": "x"}]`, a),
+		"malformed.json": `[{"receiver": `,
+	}
+	for fname, data := range invalid {
+		_, err := parseBatchPayouts(fname, []byte(data))
+		require.Error(t, err, fname)
+	}
+}
+
+func TestBatchJournal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", "batch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fname := filepath.Join(dir, "payouts.csv.journal")
+	data := []byte("payouts")
+
+	journal, err := openBatchJournal(fname, data, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, journal.withStatus(""))
+	require.NoError(t, journal.record(
+		batchJournalEntry{Index: 0, Status: batchSubmitted, Txid: "A", FirstValid: 1, LastValid: 10},
+		batchJournalEntry{Index: 1, Status: batchSubmitted, Txid: "B", FirstValid: 1, LastValid: 10},
+	))
+	require.NoError(t, journal.record(batchJournalEntry{Index: 0, Status: batchConfirmed, Txid: "A", Round: 3}))
+	journal.close()
+
+	// simulate a crash in the middle of writing an entry.
+	f, err := os.OpenFile(fname, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"index": 2, "sta`)
+	require.NoError(t, err)
+	f.Close()
+
+	journal, err = openBatchJournal(fname, data, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, journal.withStatus(batchConfirmed))
+	require.Equal(t, []int{1}, journal.withStatus(batchSubmitted))
+	require.Equal(t, []int{2}, journal.withStatus(""))
+	require.Equal(t, uint64(10), journal.payouts[1].LastValid)
+
+	// the incomplete entry was discarded, so that new entries can be read back.
+	require.NoError(t, journal.record(batchJournalEntry{Index: 1, Status: batchExpired, Txid: "B"}))
+	journal.close()
+	journal, err = openBatchJournal(fname, data, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, journal.withStatus(batchExpired))
+	journal.close()
+
+	// the journal can't be used with other payouts.
+	_, err = openBatchJournal(fname, []byte("other payouts"), 3)
+	require.Error(t, err)
+	_, err = openBatchJournal(fname, data, 4)
+	require.Error(t, err)
+}
+
+func TestReconcileBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sender := basics.Address{9}
+	a := basics.Address{1}
+	b := basics.Address{2}
+	payouts := []batchPayout{
+		{Receiver: a.String(), Amount: 1000, Note: "salary"},
+		{Receiver: b.String(), Amount: 5, Asset: 12},
+		{Receiver: b.String(), Amount: 2000},
+		{Receiver: a.String(), Amount: 7, Asset: 12},
+	}
+	confirmed := func(txn transactions.Transaction) transactions.SignedTxnWithAD {
+		txn.Sender = sender
+		return transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: txn}}
+	}
+	txA := confirmed(transactions.Transaction{Type: protocol.PaymentTx, Header: transactions.Header{Note: []byte("salary")}, PaymentTxnFields: transactions.PaymentTxnFields{Receiver: a, Amount: basics.MicroAlgos{Raw: 1000}}})
+	txB := confirmed(transactions.Transaction{Type: protocol.AssetTransferTx, AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: 12, AssetReceiver: b, AssetAmount: 5}})
+	txD := confirmed(transactions.Transaction{Type: protocol.AssetTransferTx, AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: 12, AssetReceiver: b, AssetAmount: 7}})
+	journal := []batchJournalEntry{
+		{Index: 0, Status: batchConfirmed, Txid: txA.ID().String(), Round: 5},
+		{Index: 1, Status: batchConfirmed, Txid: txB.ID().String(), Round: 5},
+		{Index: 2, Status: batchExpired, Txid: "C"},
+		{Index: 3, Status: batchConfirmed, Txid: txD.ID().String(), Round: 6},
+	}
+	paysets := map[uint64][]transactions.SignedTxnWithAD{
+		5: {txA, txB},
+		6: {txD},
+	}
+	fetched := make(map[uint64]int)
+	blockPayset := func(round uint64) ([]transactions.SignedTxnWithAD, error) {
+		fetched[round]++
+		return paysets[round], nil
+	}
+
+	report, err := reconcileBatch(payouts, journal, sender.String(), blockPayset)
+	require.NoError(t, err)
+	require.False(t, report.reconciled())
+	require.Equal(t, 4, report.Payouts)
+	require.Equal(t, 2, report.Confirmed)
+	require.Equal(t, []int{2}, report.Unconfirmed)
+	require.Equal(t, []int{3}, report.Mismatched)
+	require.Equal(t, []batchTotal{{Asset: 0, Expected: 3000, Confirmed: 1000}, {Asset: 12, Expected: 12, Confirmed: 5}}, report.Totals)
+	require.Equal(t, map[uint64]int{5: 1, 6: 1}, fetched)
+
+	report, err = reconcileBatch(payouts[:2], journal[:2], sender.String(), blockPayset)
+	require.NoError(t, err)
+	require.True(t, report.reconciled())
+}
@@ -154,6 +154,9 @@ func main() {
 	}
 
 	if err := rootCmd.Execute(); err != nil {
+		if structuredOutput() {
+			reportError(errorCodeInvalidArguments, err.Error())
+		}
 		fmt.Println(err)
 		os.Exit(1)
 	}
@@ -167,8 +170,7 @@ var versionCmd = &cobra.Command{
 		onDataDirs(func(dataDir string) {
 			response, err := ensureAlgodClient(dataDir).AlgodVersions()
 			if err != nil {
-				fmt.Println(err)
-				os.Exit(1)
+				reportErrorln(err)
 			}
 			if !verboseVersionPrint {
 				fmt.Println(response.Versions)
@@ -202,8 +204,7 @@ var reportCmd = &cobra.Command{
 		fmt.Println()
 		data, err := exec.Command("uname", "-a").CombinedOutput()
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			reportErrorln(err)
 		}
 		fmt.Println(string(data))
 
@@ -215,8 +216,7 @@ var reportCmd = &cobra.Command{
 			}
 			genesis, err := readGenesis(dir)
 			if err != nil {
-				fmt.Println(err)
-				os.Exit(1)
+				reportErrorln(err)
 			}
 			fmt.Printf("Genesis ID from genesis.json: %s\n", genesis.ID())
 		}
@@ -490,7 +490,7 @@ func getWalletHandleMaybePassword(dataDir string, walletName string, getPassword
 }
 
 func ensurePasswordForWallet(walletName string) []byte {
-	fmt.Printf(infoPasswordPrompt, walletName)
+	reportPromptf(infoPasswordPrompt, walletName)
 	return ensurePassword()
 }
 
@@ -499,11 +499,21 @@ func ensurePassword() []byte {
 	if err != nil {
 		reportErrorf(errorFailedToReadPassword, err)
 	}
-	fmt.Printf("\n")
+	reportPromptf("\n")
 	return password
 }
 
+// reportPromptf asks the user for input. Prompts are written to stderr, so that stdout holds nothing but the output
+// of the command, which is a single document when a structured output format is used.
+func reportPromptf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
 func reportInfoln(args ...interface{}) {
+	if structuredOutput() {
+		output.messages = append(output.messages, printableLines(fmt.Sprint(args...))...)
+		return
+	}
 	for _, line := range strings.Split(fmt.Sprint(args...), "\n") {
 		printable, line := unicodePrintable(line)
 		if !printable {
@@ -518,6 +528,10 @@ func reportInfof(format string, args ...interface{}) {
 }
 
 func reportWarnln(args ...interface{}) {
+	if structuredOutput() {
+		output.warnings = append(output.warnings, printableLines(fmt.Sprint(args...))...)
+		return
+	}
 	fmt.Print("Warning: ")
 
 	for _, line := range strings.Split(fmt.Sprint(args...), "\n") {
@@ -536,6 +550,9 @@ func reportWarnf(format string, args ...interface{}) {
 
 func reportErrorln(args ...interface{}) {
 	outStr := fmt.Sprint(args...)
+	if structuredOutput() {
+		reportError(errorCode(outStr), outStr)
+	}
 	for _, line := range strings.Split(outStr, "\n") {
 		printable, line := unicodePrintable(line)
 		if !printable {
@@ -547,7 +564,7 @@ func reportErrorln(args ...interface{}) {
 }
 
 func reportErrorf(format string, args ...interface{}) {
-	reportErrorln(fmt.Sprintf(format, args...))
+	reportError(errorCode(format), fmt.Sprintf(format, args...))
 }
 
 // writeFile is a wrapper of ioutil.WriteFile which considers the special
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+var (
+	exportFromRound    uint64
+	exportToRound      uint64
+	exportDir          string
+	exportBundleRounds uint64
+)
+
+func init() {
+	exportBlocksCmd.Flags().Uint64Var(&exportFromRound, "from", 1, "The first round to export")
+	exportBlocksCmd.Flags().Uint64Var(&exportToRound, "to", 0, "The last round to export (defaults to the node's last round)")
+	exportBlocksCmd.Flags().StringVarP(&exportDir, "dir", "o", "", "The directory to write the block bundles into")
+	exportBlocksCmd.Flags().Uint64Var(&exportBundleRounds, "bundle-rounds", 1000, "The number of rounds in each block bundle")
+	exportBlocksCmd.MarkFlagRequired("dir")
+}
+
+var exportBlocksCmd = &cobra.Command{
+	Use:   "export-blocks",
+	Short: "Export a range of blocks into block bundle files",
+	Long:  "Export a range of blocks, along with their certificates, into compressed block bundle files. A node can then be bootstrapped off these files, without network access, by setting CatchupBlockBundlesDir to the directory holding them. Only the blocks retained by the node can be exported; exporting older blocks requires an archival node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := ensureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		if exportToRound == 0 {
+			status, err := client.Status()
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			exportToRound = status.LastRound
+		}
+		if exportFromRound == 0 || exportToRound < exportFromRound || exportBundleRounds == 0 {
+			reportErrorf(errorExportBlocksRange, exportFromRound, exportToRound)
+		}
+		if _, err := os.Stat(exportDir); err != nil {
+			reportErrorf(errorDirectoryNotExist, exportDir)
+		}
+
+		first, err := client.RawBlock(exportFromRound)
+		if err != nil {
+			reportErrorf(errorExportBlocksFetch, exportFromRound, err)
+		}
+		var entry rpcs.EncodedBlockCert
+		err = protocol.Decode(first, &entry)
+		if err != nil {
+			reportErrorf(errorExportBlocksFetch, exportFromRound, err)
+		}
+		header := rpcs.BlockBundleHeader{
+			GenesisID:   entry.Block.GenesisID(),
+			GenesisHash: entry.Block.GenesisHash(),
+		}
+
+		for rnd := exportFromRound; rnd <= exportToRound; rnd += exportBundleRounds {
+			header.FirstRound = basics.Round(rnd)
+			header.LastRound = basics.Round(rnd + exportBundleRounds - 1)
+			if header.LastRound > basics.Round(exportToRound) {
+				header.LastRound = basics.Round(exportToRound)
+			}
+			path := filepath.Join(exportDir, rpcs.BlockBundleFileName(header.GenesisID, header.FirstRound, header.LastRound))
+			if err := exportBlockBundle(client, header, path); err != nil {
+				reportErrorf(err.format, err.args...)
+			}
+			reportInfof(infoExportedBlocks, header.FirstRound, header.LastRound, path)
+		}
+	},
+}
+
+// exportBlocksError is an error of exportBlockBundle, along with the message format it's reported with.
+type exportBlocksError struct {
+	format string
+	args   []interface{}
+}
+
+func (e *exportBlocksError) Error() string {
+	return fmt.Sprintf(e.format, e.args...)
+}
+
+// exportBlockBundle writes the blocks described by the given header into a block bundle file. The bundle is written
+// into a temporary file first, so that a partially written bundle would never be mistaken for a complete one. Errors
+// are returned rather than reported, since reporting exits before the temporary file would be removed.
+func exportBlockBundle(client libgoal.Client, header rpcs.BlockBundleHeader, path string) *exportBlocksError {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return &exportBlocksError{errorExportBlocksWrite, []interface{}{path, err}}
+	}
+	defer os.Remove(tmpPath)
+	defer f.Close()
+
+	bw, err := rpcs.MakeBlockBundleWriter(f, header)
+	if err != nil {
+		return &exportBlocksError{errorExportBlocksWrite, []interface{}{path, err}}
+	}
+	for rnd := header.FirstRound; rnd <= header.LastRound; rnd++ {
+		encoded, err := client.RawBlock(uint64(rnd))
+		if err != nil {
+			return &exportBlocksError{errorExportBlocksFetch, []interface{}{rnd, err}}
+		}
+		err = bw.Add(encoded)
+		if err != nil {
+			return &exportBlocksError{errorExportBlocksWrite, []interface{}{path, err}}
+		}
+	}
+	err = bw.Close()
+	if err == nil {
+		err = f.Close()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		return &exportBlocksError{errorExportBlocksWrite, []interface{}{path, err}}
+	}
+	return nil
+}
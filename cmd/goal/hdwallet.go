@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var (
+	derivationPath string
+	xpubAddresses  uint32
+)
+
+func init() {
+	accountCmd.AddCommand(deriveCmd)
+	walletCmd.AddCommand(xpubCmd)
+
+	deriveCmd.Flags().StringVarP(&derivationPath, "path", "p", "", "BIP-32 path of the key to derive, such as m/44'/283'/0'/0/0")
+	deriveCmd.Flags().BoolVarP(&defaultAccount, "default", "f", false, "Set this account as the default one")
+	deriveCmd.MarkFlagRequired("path")
+
+	xpubCmd.Flags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to export the key from")
+	xpubCmd.Flags().StringVarP(&derivationPath, "path", "p", "m/44'/283'/0'/0", "BIP-32 path of the extended public key")
+	xpubCmd.Flags().Uint32VarP(&xpubAddresses, "addresses", "n", 0, "Number of addresses to derive from the extended public key")
+}
+
+var deriveCmd = &cobra.Command{
+	Use:   "derive [account name]",
+	Short: "Derive an account by BIP-32 path",
+	Long:  `Derives the key at a BIP-32 path in a wallet created with --hd, and adds it to the wallet. Accounts created with goal account new use the paths m/44'/283'/0'/0/i; deriving other paths recovers accounts created by hardware or mobile wallets restored from the same mnemonic.`,
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := ensureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		if len(args) == 0 {
+			accountName = accountList.getUnnamed()
+		} else {
+			accountName = args[0]
+		}
+
+		if ok, err := isValidName(accountName); !ok {
+			reportErrorln(err)
+		}
+		if accountList.isTaken(accountName) {
+			reportErrorf(errorNameAlreadyTaken, accountName)
+		}
+
+		path, err := crypto.ParseHDPath(derivationPath)
+		if err != nil {
+			reportErrorf(errorBadDerivationPath, err)
+		}
+
+		wh := ensureWalletHandle(dataDir, walletName)
+		client := ensureKmdClient(dataDir)
+		addr, err := client.DeriveAddress(wh, path.String())
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		accountList.addAccount(accountName, addr)
+		if defaultAccount {
+			accountList.setDefault(accountName)
+		}
+
+		reportInfof(infoDerivedAccount, addr, path)
+	},
+}
+
+// extendedPublicKeyResult is the result of goal wallet xpub
+type extendedPublicKeyResult struct {
+	Path              string              `json:"path"`
+	ExtendedPublicKey string              `json:"extended-public-key"`
+	Addresses         []derivedAddressRow `json:"addresses,omitempty"`
+}
+
+type derivedAddressRow struct {
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+// deriveWatchOnlyAddresses derives the addresses of the first n non-hardened
+// children of an extended public key
+func deriveWatchOnlyAddresses(xpub crypto.HDPublicKey, path crypto.HDPath, n uint32) ([]derivedAddressRow, error) {
+	rows := make([]derivedAddressRow, 0, n)
+	for i := uint32(0); i < n; i++ {
+		child, err := xpub.Child(i)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, derivedAddressRow{
+			Path:    append(path[:len(path):len(path)], i).String(),
+			Address: basics.Address(child.PublicKey).String(),
+		})
+	}
+	return rows, nil
+}
+
+var xpubCmd = &cobra.Command{
+	Use:   "xpub",
+	Short: "Export an extended public key for watch-only address generation",
+	Long:  `Exports the BIP32-Ed25519 extended public key at a path of a wallet created with --hd. The key is printed in hex, as the public key followed by the chain code. Anyone holding it can derive the addresses of its non-hardened children, but not their secret keys.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := crypto.ParseHDPath(derivationPath)
+		if err != nil {
+			reportErrorf(errorBadDerivationPath, err)
+		}
+		if xpubAddresses > crypto.HDHardened {
+			reportErrorf(errorBadDerivationPath, fmt.Sprintf("at most %d addresses can be derived", crypto.HDHardened))
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+		xpub, err := client.ExportExtendedPublicKey(wh, pw, path.String())
+		if err != nil {
+			reportErrorf(errorCouldntExportXpub, err)
+		}
+
+		addresses, err := deriveWatchOnlyAddresses(xpub, path, xpubAddresses)
+		if err != nil {
+			reportErrorf(errorCouldntExportXpub, err)
+		}
+
+		result := extendedPublicKeyResult{
+			Path:              path.String(),
+			ExtendedPublicKey: hex.EncodeToString(xpub.Bytes()),
+			Addresses:         addresses,
+		}
+		reportResult(result, func() {
+			fmt.Printf("Path:\t%s\n", result.Path)
+			fmt.Printf("Extended public key:\t%s\n", result.ExtendedPublicKey)
+			for _, row := range result.Addresses {
+				fmt.Printf("%s\t%s\n", row.Path, row.Address)
+			}
+		})
+	},
+}
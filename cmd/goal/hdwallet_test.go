@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDeriveWatchOnlyAddresses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed [64]byte
+	crypto.RandBytes(seed[:])
+	path := crypto.HDPath{44 + crypto.HDHardened, crypto.AlgorandCoinType + crypto.HDHardened, crypto.HDHardened, 0}
+	account, err := crypto.HDMasterKey(seed[:]).Derive(path)
+	require.NoError(t, err)
+
+	rows, err := deriveWatchOnlyAddresses(account.Public(), path, 3)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	for i, row := range rows {
+		require.Equal(t, crypto.AlgorandHDPath(0, uint32(i)).String(), row.Path)
+		key, err := account.Child(uint32(i))
+		require.NoError(t, err)
+		require.Equal(t, basics.Address(key.PublicKey()).String(), row.Address)
+	}
+	// the parent path is left untouched
+	require.Equal(t, "m/44'/283'/0'/0", path.String())
+
+	rows, err = deriveWatchOnlyAddresses(account.Public(), path, 0)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
@@ -40,6 +40,13 @@ type inspectSignedTxn struct {
 	AuthAddr basics.Address           `codec:"sgnr"`
 }
 
+// inspectedTxn is the result reported by goal clerk inspect for each transaction of the inspected files.
+type inspectedTxn struct {
+	File  string           `json:"file"`
+	Index int              `json:"index"`
+	Txn   inspectSignedTxn `json:"txn"`
+}
+
 // inspectMultisigSig is isomorphic to MultisigSig but uses different
 // types to print public keys using algorand's address format in JSON.
 type inspectMultisigSig struct {
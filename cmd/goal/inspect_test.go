@@ -89,3 +89,26 @@ func TestInspect(t *testing.T) {
 	_, err = inspectTxn(full)
 	require.NoError(t, err)
 }
+
+func TestInspectOutputDocument(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	defer func(results []interface{}, messages, warnings []string) {
+		output.results, output.messages, output.warnings = results, messages, warnings
+	}(output.results, output.messages, output.warnings)
+
+	var payment transactions.SignedTxn
+	payment.Txn.Type = protocol.PaymentTx
+	crypto.RandBytes(payment.Txn.Sender[:])
+	payment.Txn.Amount.Raw = 5
+	sti, err := inspectTxn(payment)
+	require.NoError(t, err)
+
+	output.results = []interface{}{inspectedTxn{File: "payment.tx", Index: 0, Txn: sti}}
+	output.messages = nil
+	output.warnings = nil
+	data, err := encodeOutputDocument(buildOutputDocument(nil), outputJSON)
+	require.NoError(t, err)
+	// the addresses are reported in their checksummed form, as in the table output.
+	require.JSONEq(t, `{"result": {"file": "payment.tx", "index": 0, "txn": {"txn": {"type": "pay", "snd": "`+payment.Txn.Sender.String()+`", "amt": 5}}}}`, string(data))
+}
@@ -34,6 +34,8 @@ const (
 	infoExportedKey                = "Exported key for account %s: \"%s\""
 	infoImportedNKeys              = "Imported %d key%s"
 	infoCreatedNewAccount          = "Created new account with address %s"
+	infoDerivedAccount             = "Derived account with address %s at path %s"
+	errorBadDerivationPath         = "Invalid derivation path: %s"
 	errorNameAlreadyTaken          = "The account name '%s' is already taken, please choose another."
 	errorNameDoesntExist           = "An account named '%s' does not exist."
 	infoSetAccountToDefault        = "Set account '%s' to be the default account"
@@ -46,6 +48,19 @@ const (
 	errExistingPartKey             = "Account already has a participation key valid at least until roundLastValid (%d) - current is %d"
 	errorSeedConversion            = "Got private key for account %s, but was unable to convert to seed: %s"
 	errorMnemonicConversion        = "Got seed for account %s, but was unable to convert to mnemonic: %s"
+	errorOnlineStatusNoAccount     = "Must specify one of --address or --partkeyfile"
+	errorOfflinePartKeyFile        = "Going offline does not support --partkeyfile"
+	errorOpeningPartKey            = "Cannot open partkey %s: %v"
+	errorLoadingPartKey            = "Cannot load partkey %s: %v"
+	errorPartKeyDeleteInput        = `The installpartkey command deletes the input participation file on
+successful installation.  Please acknowledge this by passing the
+"--delete-input" flag to the installpartkey command.  You can make
+a copy of the input file if needed, but please keep in mind that
+participation keys must be securely deleted for each round, to ensure
+forward security.  Storing old participation keys compromises overall
+system security.
+
+No --delete-input flag specified, exiting without installing key.`
 
 	// KMD
 	infoKMDStopped        = "Stopped kmd"
@@ -88,6 +103,18 @@ const (
 	errorCatchpointLabelParsingFailed = "The provided catchpoint is not a valid one"
 	errorCatchpointLabelMissing       = "A catchpoint argument is needed"
 	errorTooManyCatchpointLabels      = "The catchup command expect a single catchpoint"
+	errorPeerAdmin                    = "Peer administration request failed: %s"
+	errorPeerAddressInvalid           = "Provided peer '%s' is not a valid peer address : %v"
+	infoNoPeers                       = "The node is not connected to any peer"
+	errorAgreementState               = "Unable to retrieve the agreement state: %s"
+	errorExportBlocksRange            = "Invalid round range %d to %d; the genesis block can't be exported, as it has no certificate"
+	errorExportBlocksFetch            = "Unable to retrieve block %d: %s"
+	errorExportBlocksWrite            = "Unable to write block bundle %s: %s"
+	infoExportedBlocks                = "Exported rounds %d to %d into %s"
+	errorTokenAdmin                   = "Scoped API token request failed: %s"
+	infoTokenCreated                  = "Created API token '%s': %s"
+	infoTokenRevoked                  = "Revoked API token '%s'"
+	infoNoTokens                      = "The node has no scoped API tokens"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -100,6 +127,13 @@ const (
 	errorMarshalingState           = "failed to encode state: %s"
 	errorApprovProgArgsRequired    = "Exactly one of --approval-prog or --approval-prog-raw is required"
 	errorClearProgArgsRequired     = "Exactly one of --clear-prog or --clear-prog-raw is required"
+	errorReadingDeployManifest     = "Cannot read deployment manifest %s: %v"
+	errorReadingDeployLock         = "Cannot read deployment lock file %s: %v"
+	errorWritingDeployLock         = "Cannot write deployment lock file %s: %v"
+	errorDeployingApp              = "Cannot deploy app %s: %v"
+	infoDeployPlan                 = "Deployment plan for network %s:"
+	infoDeployUpToDate             = "All apps are up to date"
+	infoDeployLockWritten          = "Recorded the deployed apps in %s"
 
 	// Clerk
 	infoTxIssued               = "Sent %d MicroAlgos from account %s to address %s, transaction ID: %s. Fee set to %d"
@@ -117,6 +151,8 @@ const (
 	txNoFilesError             = "No input filenames specified"
 	soFlagError                = "-s is not meaningful without -o"
 	infoRawTxIssued            = "Raw transaction ID %s issued"
+	errorRawTxRejected         = "Rejected transactions written to %s"
+	errorConsensusVersion      = "Invalid consensus version. Possible versions:\n\t%s"
 	txPoolError                = "Transaction %s kicked out of local node pool: %s"
 	addrNoSigError             = "Exactly one of --address or --no-sig is required"
 	msigLookupError            = "Could not lookup multisig information: %s"
@@ -126,6 +162,14 @@ const (
 	noOutputFileError          = "--msig-params must be specified with an output file name (-o)"
 	infoAutoFeeSet             = "Automatically set fee to %d MicroAlgos"
 	errorTransactionExpired    = "Transaction %s expired before it could be included in a block"
+	errorReadingBatchPayouts   = "Cannot read payouts from %s: %s"
+	errorOpeningBatchJournal   = "Cannot use journal %s: %s"
+	errorBatchPayouts          = "Batch payout failed: %s"
+	errorBatchUnreconciled     = "%d of %d payouts could not be reconciled against the ledger"
+	infoBatchResuming          = "Resuming from journal %s: %d payouts confirmed, %d awaiting confirmation"
+	infoBatchSubmitted         = "Submitted payouts %d-%d, last valid round %d"
+	infoBatchExpired           = "%d payouts expired before being confirmed; submitting them again"
+	infoBatchReconciled        = "All %d payouts were confirmed and reconciled against the ledger"
 
 	loggingNotConfigured = "Remote logging is not currently configured and won't be enabled"
 	loggingNotEnabled    = "Remote logging is current disabled"
@@ -174,6 +218,7 @@ const (
 	errorCouldntCreateWallet     = "Couldn't create wallet: %s"
 	errorCouldntInitializeWallet = "Couldn't initialize wallet: %s"
 	errorCouldntExportMDK        = "Couldn't export master derivation key: %s"
+	errorCouldntExportXpub       = "Couldn't export extended public key: %s"
 	errorCouldntMakeMnemonic     = "Couldn't make mnemonic: %s"
 	errorCouldntListWallets      = "Couldn't list wallets: %s"
 	errorPasswordConfirmation    = "Password confirmation did not match"
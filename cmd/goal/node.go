@@ -68,6 +68,10 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(peersCmd)
+	nodeCmd.AddCommand(tokenCmd)
+	nodeCmd.AddCommand(agreementCmd)
+	nodeCmd.AddCommand(exportBlocksCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -122,8 +126,7 @@ var catchupCmd = &cobra.Command{
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		if abortCatchup == false && len(args) == 0 {
-			fmt.Println(errorCatchpointLabelMissing)
-			os.Exit(1)
+			reportErrorln(errorCatchpointLabelMissing)
 		}
 		onDataDirs(func(datadir string) { catchup(datadir, args) })
 	},
@@ -360,6 +363,13 @@ var statusCmd = &cobra.Command{
 	},
 }
 
+// nodeStatusResult is the structured result of goal node status.
+type nodeStatusResult struct {
+	generatedV2.NodeStatusResponse
+	GenesisID   string `json:"genesis-id,omitempty"`
+	GenesisHash []byte `json:"genesis-hash"`
+}
+
 func getStatus(dataDir string) {
 	const (
 		CUU = string("\033[A") // Cursor Up
@@ -376,6 +386,10 @@ func getStatus(dataDir string) {
 		if err != nil {
 			reportErrorf(errorNodeStatus, err)
 		}
+		if structuredOutput() {
+			reportResult(nodeStatusResult{NodeStatusResponse: stat, GenesisID: vers.GenesisID, GenesisHash: vers.GenesisHash[:]}, nil)
+			return
+		}
 		status := cleanupFmt + makeStatusString(stat) + "\n"
 		if vers.GenesisID != "" {
 			status = fmt.Sprintf("%sGenesis ID: %s\n", status, vers.GenesisID)
@@ -531,7 +545,7 @@ var waitCmd = &cobra.Command{
 					reportErrorf(errorNodeStatus, err)
 				}
 				if startRound != stat.LastRound {
-					os.Exit(0)
+					exitWithOutput(0)
 				}
 			}
 		}
@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// outputFormat is the format selected with the global --output flag.
+var outputFormat = outputTable
+
+// outputDocument is the single document written to stdout by a command run with a structured output format.
+type outputDocument struct {
+	Result   interface{}  `json:"result,omitempty"`
+	Messages []string     `json:"messages,omitempty"`
+	Warnings []string     `json:"warnings,omitempty"`
+	Error    *outputError `json:"error,omitempty"`
+}
+
+// outputError describes a failed command. Code is stable across releases and is meant to be matched by scripts,
+// while Message is the human readable error and may change.
+type outputError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+const (
+	errorCodeCommandFailed       = "command_failed"
+	errorCodeInvalidArguments    = "invalid_arguments"
+	errorCodeInvalidOutputFormat = "invalid_output_format"
+)
+
+// errorCodes maps the error messages reported through reportErrorf to their error codes. Messages which aren't
+// listed are reported as errorCodeCommandFailed.
+var errorCodes = map[string]string{
+	errorNoDataDirectory:              "no_data_directory",
+	errorOneDataDirSupported:          "too_many_data_directories",
+	errorRequestFail:                  "request_failed",
+	errorGenesisIDFail:                "genesis_id_unavailable",
+	errorDirectoryNotExist:            "directory_not_found",
+	errorParseAddr:                    "invalid_address",
+	errorNameAlreadyTaken:             "account_name_taken",
+	errorNameDoesntExist:              "account_not_found",
+	errorSigningTX:                    "signing_failed",
+	errorOnlineTX:                     "online_transaction_failed",
+	errorConstructingTX:               "transaction_construction_failed",
+	errorBroadcastingTX:               "broadcast_failed",
+	errorSeedConversion:               "invalid_seed",
+	errorMnemonicConversion:           "invalid_mnemonic",
+	errorOnlineStatusNoAccount:        "invalid_arguments",
+	errorOfflinePartKeyFile:           "invalid_arguments",
+	errorOpeningPartKey:               "partkey_unusable",
+	errorLoadingPartKey:               "partkey_unusable",
+	errorPartKeyDeleteInput:           "invalid_arguments",
+	errorKMDFailedToStart:             "kmd_start_failed",
+	errorKMDFailedToStop:              "kmd_stop_failed",
+	errorNodeCreationIPFailure:        "node_creation_failed",
+	errorNodeNotDetected:              "node_not_detected",
+	errorNodeStatus:                   "node_unavailable",
+	errorNodeFailedToStart:            "node_start_failed",
+	errorNodeRunning:                  "node_running",
+	errorNodeFailGenToken:             "token_generation_failed",
+	errorNodeCreation:                 "node_creation_failed",
+	errorNodeManagedBySystemd:         "node_managed_by_systemd",
+	errorKill:                         "node_kill_failed",
+	errorCloningNode:                  "node_clone_failed",
+	errorNodeFailedToShutdown:         "node_stop_failed",
+	errorCatchpointLabelParsingFailed: "invalid_catchpoint",
+	errorCatchpointLabelMissing:       "invalid_catchpoint",
+	errorTooManyCatchpointLabels:      "invalid_catchpoint",
+	errorPeerAdmin:                    "peer_admin_failed",
+	errorPeerAddressInvalid:           "invalid_peer_address",
+	errorAgreementState:               "agreement_state_failed",
+	errorExportBlocksRange:            "invalid_round_range",
+	errorExportBlocksFetch:            "block_fetch_failed",
+	errorExportBlocksWrite:            "file_write_failed",
+	errorTokenAdmin:                   "token_admin_failed",
+	errorLocalGlobal:                  "invalid_arguments",
+	errorLocalStateRequiresAccount:    "invalid_arguments",
+	errorAccountNotOptedInToApp:       "app_not_opted_in",
+	errorNoSuchApplication:            "app_not_found",
+	errorMarshalingState:              "encoding_failed",
+	errorApprovProgArgsRequired:       "invalid_arguments",
+	errorClearProgArgsRequired:        "invalid_arguments",
+	errorReadingDeployManifest:        "file_read_failed",
+	errorReadingDeployLock:            "file_read_failed",
+	errorWritingDeployLock:            "file_write_failed",
+	errorDeployingApp:                 "app_deploy_failed",
+	errorTransactionExpired:           "transaction_expired",
+	errorRawTxRejected:                "transactions_rejected",
+	errorConsensusVersion:             "invalid_consensus_version",
+	errorReadingBatchPayouts:          "file_read_failed",
+	errorOpeningBatchJournal:          "journal_unusable",
+	errorBatchPayouts:                 "batch_failed",
+	errorBatchUnreconciled:            "batch_unreconciled",
+	errorCreateNetwork:                "network_creation_failed",
+	errorLoadingNetwork:               "network_load_failed",
+	errorStartingNetwork:              "network_start_failed",
+	errorCouldntCreateWallet:          "wallet_error",
+	errorCouldntInitializeWallet:      "wallet_error",
+	errorCouldntExportMDK:             "wallet_error",
+	errorCouldntExportXpub:            "wallet_error",
+	errorBadDerivationPath:            "invalid_derivation_path",
+	errorCouldntMakeMnemonic:          "wallet_error",
+	errorCouldntListWallets:           "wallet_error",
+	errorPasswordConfirmation:         "password_mismatch",
+	errorBadMnemonic:                  "invalid_mnemonic",
+	errorBadRecoveredKey:              "invalid_mnemonic",
+	errorFailedToReadResponse:         "input_read_failed",
+	errorFailedToReadPassword:         "input_read_failed",
+}
+
+// structuredOutputAnnotation marks the commands reporting their results through reportResult, which are the only
+// ones supporting the json and yaml output formats.
+const structuredOutputAnnotation = "structured-output"
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", outputTable, "Output format: table, json or yaml. The json and yaml formats are supported by account list, account info, app read, asset info, clerk inspect, clerk batch, wallet xpub and node status")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		startOutput(cmd)
+	}
+	rootCmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
+		finishOutput()
+	}
+	supportStructuredOutput(listCmd, infoCmd, readStateAppCmd, infoAssetCmd, inspectCmd, batchCmd, xpubCmd, statusCmd)
+}
+
+// supportStructuredOutput marks the given commands as supporting the json and yaml output formats.
+func supportStructuredOutput(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		if cmd.Annotations == nil {
+			cmd.Annotations = make(map[string]string)
+		}
+		cmd.Annotations[structuredOutputAnnotation] = "true"
+	}
+}
+
+// output collects the results and messages of a command run with a structured output format.
+var output struct {
+	results  []interface{}
+	messages []string
+	warnings []string
+}
+
+func structuredOutput() bool {
+	return outputFormat == outputJSON || outputFormat == outputYAML
+}
+
+// startOutput checks the selected output format, which has to be table for the commands which don't report
+// structured results.
+func startOutput(cmd *cobra.Command) {
+	switch outputFormat {
+	case outputTable, outputJSON, outputYAML:
+	default:
+		format := outputFormat
+		outputFormat = outputJSON
+		reportError(errorCodeInvalidOutputFormat, fmt.Sprintf("Unsupported output format '%s'; expected table, json or yaml.", format))
+	}
+	if structuredOutput() && cmd.Annotations[structuredOutputAnnotation] == "" {
+		reportError(errorCodeInvalidOutputFormat, fmt.Sprintf("'%s' doesn't support the %s output format; only table is supported.", cmd.CommandPath(), outputFormat))
+	}
+}
+
+// buildOutputDocument returns the document for the collected results and messages, and the given error if any.
+func buildOutputDocument(outErr *outputError) outputDocument {
+	doc := outputDocument{
+		Messages: output.messages,
+		Warnings: output.warnings,
+		Error:    outErr,
+	}
+	switch len(output.results) {
+	case 0:
+	case 1:
+		doc.Result = output.results[0]
+	default:
+		doc.Result = output.results
+	}
+	return doc
+}
+
+func encodeOutputDocument(doc outputDocument, format string) ([]byte, error) {
+	data := protocol.EncodeJSONStrict(&doc)
+	if format == outputYAML {
+		return yaml.JSONToYAML(data)
+	}
+	return append(data, '\n'), nil
+}
+
+func writeOutputDocument(outErr *outputError) {
+	data, err := encodeOutputDocument(buildOutputDocument(outErr), outputFormat)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(data)
+}
+
+// finishOutput writes the document of a successful command when a structured output format is used.
+func finishOutput() {
+	if structuredOutput() {
+		writeOutputDocument(nil)
+	}
+}
+
+// exitWithOutput terminates goal with the given exit code, writing the document first when a structured output
+// format is used.
+func exitWithOutput(code int) {
+	finishOutput()
+	os.Exit(code)
+}
+
+// reportResult reports the result of a command: it is included in the output document when a structured output
+// format is used, and printTable prints it otherwise.
+func reportResult(result interface{}, printTable func()) {
+	if structuredOutput() {
+		output.results = append(output.results, result)
+		return
+	}
+	printTable()
+}
+
+// reportError reports a failure with the given error code and exits.
+func reportError(code string, message string) {
+	if structuredOutput() {
+		writeOutputDocument(&outputError{Code: code, Message: message})
+		os.Exit(1)
+	}
+	reportErrorln(message)
+}
+
+// printableLines splits a message into lines, omitting their non-printable characters.
+func printableLines(message string) (lines []string) {
+	for _, line := range strings.Split(message, "\n") {
+		_, line = unicodePrintable(line)
+		lines = append(lines, line)
+	}
+	return
+}
+
+// errorCode returns the error code of an error message, or of the format it was made from.
+func errorCode(format string) string {
+	if code, ok := errorCodes[format]; ok {
+		return code
+	}
+	return errorCodeCommandFailed
+}
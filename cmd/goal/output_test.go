@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestErrorCode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "no_data_directory", errorCode(errorNoDataDirectory))
+	require.Equal(t, "request_failed", errorCode(errorRequestFail))
+	require.Equal(t, errorCodeCommandFailed, errorCode(fmt.Sprintf(errorRequestFail, "boom")))
+	require.Equal(t, errorCodeCommandFailed, errorCode("something else"))
+
+	// codes are part of the interface of goal, and are kept in snake case.
+	for format, code := range errorCodes {
+		require.Regexp(t, "^[a-z]+(_[a-z]+)*$", code, format)
+	}
+}
+
+func TestOutputDocument(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	defer func(results []interface{}, messages, warnings []string) {
+		output.results, output.messages, output.warnings = results, messages, warnings
+	}(output.results, output.messages, output.warnings)
+
+	output.results = []interface{}{basics.TealKeyValue{"k": basics.TealValue{Type: basics.TealUintType, Uint: 5}}}
+	output.messages = []string{"done"}
+	output.warnings = nil
+
+	data, err := encodeOutputDocument(buildOutputDocument(nil), outputJSON)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result": {"k": {"tt": 2, "ui": 5}}, "messages": ["done"]}`, string(data))
+
+	data, err = encodeOutputDocument(buildOutputDocument(&outputError{Code: "app_not_found", Message: "no app"}), outputYAML)
+	require.NoError(t, err)
+	require.Equal(t, "error:\n  code: app_not_found\n  message: no app\nmessages:\n- done\nresult:\n  k:\n    tt: 2\n    ui: 5\n", string(data))
+
+	// results of several data directories are reported as a list.
+	output.results = append(output.results, "second")
+	output.messages = nil
+	data, err = encodeOutputDocument(buildOutputDocument(nil), outputJSON)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result": [{"k": {"tt": 2, "ui": 5}}, "second"]}`, string(data))
+}
+
+func TestStructuredOutputCommands(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "true", statusCmd.Annotations[structuredOutputAnnotation])
+	require.Equal(t, "true", batchCmd.Annotations[structuredOutputAnnotation])
+	require.Empty(t, nodeCmd.Annotations[structuredOutputAnnotation])
+	require.Empty(t, waitCmd.Annotations[structuredOutputAnnotation])
+}
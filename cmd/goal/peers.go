@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/network"
+)
+
+func init() {
+	peersCmd.AddCommand(peersDisconnectCmd)
+	peersCmd.AddCommand(peersPinCmd)
+	peersCmd.AddCommand(peersUnpinCmd)
+	peersCmd.AddCommand(peersReloadCmd)
+}
+
+var peersCmd = &cobra.Command{
+	Use:   "peers",
+	Short: "List and manage the peers the node is connected to",
+	Long:  "List the peers the node is currently connected to, along with their connection statistics. The subcommands allow disconnecting a peer, pinning or unpinning an outgoing relay connection and reloading the phonebook without restarting the node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(listPeers)
+	},
+}
+
+var peersDisconnectCmd = &cobra.Command{
+	Use:     "disconnect [peer address]",
+	Short:   "Disconnect a connected peer",
+	Long:    "Disconnect a connected peer. The peer address is the one listed by the peers command. A pinned peer would be reconnected, unless it is unpinned first.",
+	Example: "goal node peers disconnect r-aa.algorand-mainnet.network:4160",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := ensureAlgodClient(ensureSingleDataDir())
+		resp, err := client.DisconnectPeer(args[0])
+		if err != nil {
+			reportErrorf(errorPeerAdmin, err)
+		}
+		reportInfoln(resp.Message)
+	},
+}
+
+var peersPinCmd = &cobra.Command{
+	Use:     "pin [relay address]",
+	Short:   "Pin an outgoing relay connection",
+	Long:    "Pin an outgoing relay connection. The node would always maintain a connection to a pinned relay, in addition to its regular outgoing connections, and would not drop it based on its performance.",
+	Example: "goal node peers pin r-aa.algorand-mainnet.network:4160",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if _, err := network.ParseHostOrURL(args[0]); err != nil {
+			reportErrorf(errorPeerAddressInvalid, args[0], err)
+		}
+		client := ensureAlgodClient(ensureSingleDataDir())
+		resp, err := client.PinPeer(args[0])
+		if err != nil {
+			reportErrorf(errorPeerAdmin, err)
+		}
+		reportInfoln(resp.Message)
+	},
+}
+
+var peersUnpinCmd = &cobra.Command{
+	Use:     "unpin [relay address]",
+	Short:   "Unpin an outgoing relay connection",
+	Long:    "Unpin an outgoing relay connection. An existing connection to the relay is kept, but would be managed as any other outgoing connection.",
+	Example: "goal node peers unpin r-aa.algorand-mainnet.network:4160",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := ensureAlgodClient(ensureSingleDataDir())
+		resp, err := client.UnpinPeer(args[0])
+		if err != nil {
+			reportErrorf(errorPeerAdmin, err)
+		}
+		reportInfoln(resp.Message)
+	},
+}
+
+var peersReloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload the node phonebook",
+	Long:  "Reload the relay addresses from the phonebook.json file in the node data directory, and refresh the DNS bootstrap relay addresses. Existing connections are not affected.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			resp, err := client.ReloadPhonebook()
+			if err != nil {
+				reportErrorf(errorPeerAdmin, err)
+			}
+			reportInfoln(resp.Message)
+		})
+	},
+}
+
+func listPeers(dataDir string) {
+	client := ensureAlgodClient(dataDir)
+	resp, err := client.Peers()
+	if err != nil {
+		reportErrorf(errorPeerAdmin, err)
+	}
+	if len(resp.Peers) == 0 {
+		reportInfoln(infoNoPeers)
+		return
+	}
+	fmt.Printf("%-45s %-9s %-6s %-10s %-12s %-10s %-10s %s\n", "Address", "Direction", "Pinned", "Latency", "Connected", "Received", "Sent", "Priority")
+	for _, peer := range resp.Peers {
+		direction := "incoming"
+		if peer.Outgoing {
+			direction = "outgoing"
+		}
+		connected := time.Since(time.Unix(int64(peer.ConnectedSince), 0)).Truncate(time.Second)
+		latency := time.Duration(peer.Latency).Truncate(time.Microsecond)
+		fmt.Printf("%-45s %-9s %-6v %-10s %-12s %-10d %-10d %d\n", peer.Address, direction, peer.Pinned, latency, connected, peer.MessagesReceived, peer.MessagesSent, peer.PriorityWeight)
+		if peer.TelemetryGuid != nil || peer.InstanceName != nil {
+			fmt.Printf("    identity: %s/%s\n", strOrEmpty(peer.TelemetryGuid), strOrEmpty(peer.InstanceName))
+		}
+		if peer.PriorityAddress != nil {
+			fmt.Printf("    participation address: %s\n", *peer.PriorityAddress)
+		}
+	}
+}
+
+func strOrEmpty(str *string) string {
+	if str == nil {
+		return ""
+	}
+	return *str
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+var (
+	tokenName      string
+	tokenGroups    []string
+	tokenExpiresIn time.Duration
+	tokenRateLimit uint64
+)
+
+func init() {
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+
+	groupNames := make([]string, len(tokens.AllRouteGroups))
+	for i, g := range tokens.AllRouteGroups {
+		groupNames[i] = string(g)
+	}
+	tokenCreateCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Name of the new token")
+	tokenCreateCmd.Flags().StringSliceVarP(&tokenGroups, "groups", "g", []string{string(tokens.RouteGroupRead)}, fmt.Sprintf("Route groups the token may access (%s)", strings.Join(groupNames, ", ")))
+	tokenCreateCmd.Flags().DurationVar(&tokenExpiresIn, "expires-in", 0, "Duration after which the token expires, e.g. 720h (default: never)")
+	tokenCreateCmd.Flags().Uint64Var(&tokenRateLimit, "rate-limit", 0, "Maximum number of requests per second accepted with the token (default: unlimited)")
+	tokenCreateCmd.MarkFlagRequired("name")
+
+	tokenRevokeCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Name of the token to revoke")
+	tokenRevokeCmd.MarkFlagRequired("name")
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage scoped API tokens",
+	Long:  "Manage the scoped API tokens of a node. Unlike algod.token and algod.admin.token, a scoped token may only access some route groups: read (ledger and node status), submit (transaction submission), teal (compile and dryrun) and admin (private routes and pprof, implying all the others). A scoped token may also expire and be rate limited. Changes take effect on a running node within a second.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//Fall back
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Create a scoped API token",
+	Long:    "Create a scoped API token. The token is only displayed once: the node data directory only keeps its hash.",
+	Example: "goal node token create --name analytics --groups read --expires-in 720h --rate-limit 50",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := ensureSingleDataDir()
+		groups, err := tokens.ParseRouteGroups(tokenGroups)
+		if err != nil {
+			reportErrorf(errorTokenAdmin, err)
+		}
+		var expires *time.Time
+		if tokenExpiresIn > 0 {
+			t := time.Now().Add(tokenExpiresIn).UTC()
+			expires = &t
+		}
+		apiToken, err := tokens.CreateScopedToken(dataDir, tokenName, groups, expires, tokenRateLimit)
+		if err != nil {
+			reportErrorf(errorTokenAdmin, err)
+		}
+		reportInfof(infoTokenCreated, tokenName, apiToken)
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the scoped API tokens",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(listScopedTokens)
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke a scoped API token",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := ensureSingleDataDir()
+		err := tokens.RevokeScopedToken(dataDir, tokenName)
+		if err != nil {
+			reportErrorf(errorTokenAdmin, err)
+		}
+		reportInfof(infoTokenRevoked, tokenName)
+	},
+}
+
+func listScopedTokens(dataDir string) {
+	scoped, err := tokens.LoadScopedTokens(dataDir)
+	if err != nil {
+		reportErrorf(errorTokenAdmin, err)
+	}
+	if len(scoped) == 0 {
+		reportInfoln(infoNoTokens)
+		return
+	}
+	now := time.Now()
+	fmt.Printf("%-20s %-25s %-25s %-10s\n", "Name", "Groups", "Expires", "Rate limit")
+	for _, st := range scoped {
+		groups := make([]string, len(st.Groups))
+		for i, g := range st.Groups {
+			groups[i] = string(g)
+		}
+		expires := "never"
+		if st.Expires != nil {
+			expires = st.Expires.Format(time.RFC3339)
+			if st.Expired(now) {
+				expires += " (expired)"
+			}
+		}
+		rateLimit := "unlimited"
+		if st.RateLimit != 0 {
+			rateLimit = fmt.Sprintf("%d/s", st.RateLimit)
+		}
+		fmt.Printf("%-20s %-25s %-25s %-10s\n", st.Name, strings.Join(groups, ","), expires, rateLimit)
+	}
+}
@@ -28,10 +28,12 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 )
 
 var (
 	recoverWallet     bool
+	hdWallet          bool
 	defaultWalletName string
 )
 
@@ -44,6 +46,9 @@ func init() {
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	// Should the wallet derive its keys with BIP32-Ed25519?
+	newWalletCmd.Flags().BoolVar(&hdWallet, "hd", false, "Derive the wallet's keys along BIP-44 paths (m/44'/283'/...) with BIP32-Ed25519, like hardware and mobile wallets. The backup mnemonic is a 24 word BIP-39 phrase")
 }
 
 var walletCmd = &cobra.Command{
@@ -71,7 +76,7 @@ var walletCmd = &cobra.Command{
 			// Set this wallet to be the default
 			accountList.setDefaultWalletID(wid)
 			reportInfof(infoSetWalletToDefault, defaultWalletName)
-			os.Exit(0)
+			exitWithOutput(0)
 		}
 		cmd.HelpFunc()(cmd, args)
 	},
@@ -94,14 +99,18 @@ var newWalletCmd = &cobra.Command{
 		// Check if we should recover the wallet from a mnemonic
 		var mdk crypto.MasterDerivationKey
 		if recoverWallet {
-			fmt.Println(infoRecoveryPrompt)
+			reportPromptf("%s\n", infoRecoveryPrompt)
 			resp, err := reader.ReadString('\n')
 			resp = strings.TrimSpace(resp)
 			if err != nil {
 				reportErrorf(errorFailedToReadResponse, err)
 			}
 			var key []byte
-			key, err = passphrase.MnemonicToKey(resp)
+			if hdWallet {
+				key, err = passphrase.BIP39MnemonicToKey(resp)
+			} else {
+				key, err = passphrase.MnemonicToKey(resp)
+			}
 			if err != nil {
 				reportErrorf(errorBadMnemonic, err)
 			}
@@ -113,11 +122,11 @@ var newWalletCmd = &cobra.Command{
 		}
 
 		// Fetch a password for the wallet
-		fmt.Printf(infoChoosePasswordPrompt, walletName)
+		reportPromptf(infoChoosePasswordPrompt, walletName)
 		walletPassword := ensurePassword()
 
 		// Confirm the password
-		fmt.Printf(infoPasswordConfirmation)
+		reportPromptf(infoPasswordConfirmation)
 		passwordConfirmation := ensurePassword()
 
 		// Check the password confirmation
@@ -127,7 +136,11 @@ var newWalletCmd = &cobra.Command{
 
 		// Create the wallet
 		reportInfoln(infoCreatingWallet)
-		walletID, err := client.CreateWallet(walletName, walletPassword, mdk)
+		derivation := wallet.DerivationSequential
+		if hdWallet {
+			derivation = wallet.DerivationBIP32Ed25519
+		}
+		walletID, err := client.CreateWalletWithDerivation(walletName, walletPassword, mdk, derivation)
 		if err != nil {
 			reportErrorf(errorCouldntCreateWallet, err)
 		}
@@ -135,7 +148,7 @@ var newWalletCmd = &cobra.Command{
 
 		if !recoverWallet {
 			// Offer to print backup seed
-			fmt.Printf(infoBackupExplanation)
+			reportPromptf(infoBackupExplanation)
 			resp, err := reader.ReadString('\n')
 			resp = strings.TrimSpace(resp)
 			if err != nil {
@@ -159,7 +172,12 @@ var newWalletCmd = &cobra.Command{
 				}
 
 				// Convert the key to a mnemonic
-				mnemonic, err := passphrase.KeyToMnemonic(mdk[:])
+				var mnemonic string
+				if hdWallet {
+					mnemonic, err = passphrase.KeyToBIP39Mnemonic(mdk[:])
+				} else {
+					mnemonic, err = passphrase.KeyToMnemonic(mdk[:])
+				}
 				if err != nil {
 					reportErrorf(errorCouldntMakeMnemonic, err)
 				}
@@ -217,6 +235,9 @@ func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 		fmt.Println(strings.Repeat("#", 50))
 		fmt.Printf("Wallet:\t%s%s\n", w.Name, defaultIndicator)
 		fmt.Printf("ID:\t%s\n", w.ID)
+		if w.DerivationScheme != "" {
+			fmt.Printf("Keys:\t%s\n", w.DerivationScheme)
+		}
 	}
 	fmt.Println(strings.Repeat("#", 50))
 }
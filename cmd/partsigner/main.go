@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// partsigner serves the participation keys found in a directory to remote algod nodes, allowing these to vote and
+// propose without ever holding the participation secrets. It never signs two different votes for the same round,
+// period and step, even across restarts.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// stateFilename is the name of the database holding the anti-equivocation state, within the keys directory.
+const stateFilename = "partsigner.sqlite"
+
+var dirFlag = flag.String("d", "", "Directory containing the participation keys (*.partkey)")
+var addrFlag = flag.String("l", "127.0.0.1:8085", "Address to listen on")
+var tokenFileFlag = flag.String("t", "", "File containing the token the nodes must present")
+var certFileFlag = flag.String("cert", "", "TLS certificate file; required unless listening on a loopback address")
+var keyFileFlag = flag.String("key", "", "TLS private key file")
+
+func main() {
+	flag.Parse()
+
+	log := logging.Base()
+	log.SetLevel(logging.Info)
+
+	if *dirFlag == "" {
+		fmt.Fprintf(os.Stderr, "Must specify the participation keys directory with -d\n")
+		os.Exit(1)
+	}
+
+	if *tokenFileFlag == "" {
+		fmt.Fprintf(os.Stderr, "Must specify the token file with -t\n")
+		os.Exit(1)
+	}
+	token, err := util.GetFirstLineFromFile(*tokenFileFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to read token file %s: %v\n", *tokenFileFlag, err)
+		os.Exit(1)
+	}
+	if token == "" {
+		fmt.Fprintf(os.Stderr, "Token file %s is empty\n", *tokenFileFlag)
+		os.Exit(1)
+	}
+	if (*certFileFlag == "") != (*keyFileFlag == "") {
+		fmt.Fprintf(os.Stderr, "Must specify both -cert and -key\n")
+		os.Exit(1)
+	}
+	// the one-time signing keys, and the token, must not travel in the clear over the network.
+	if *certFileFlag == "" && !isLoopback(*addrFlag) {
+		fmt.Fprintf(os.Stderr, "Refusing to serve over plain HTTP on %s; specify -cert and -key, or listen on a loopback address\n", *addrFlag)
+		os.Exit(1)
+	}
+
+	keys, err := loadParticipationKeys(*dirFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	if len(keys) == 0 {
+		fmt.Fprintf(os.Stderr, "No participation keys were found in %s\n", *dirFlag)
+		os.Exit(1)
+	}
+
+	stateDB, err := db.MakeAccessor(filepath.Join(*dirFlag, stateFilename), false, false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to open state database: %v\n", err)
+		os.Exit(1)
+	}
+	defer stateDB.Close()
+
+	server, err := account.MakeRemoteSignerServer(keys, stateDB, token, log)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	log.Infof("serving %d participation keys on %s", len(keys), *addrFlag)
+	if *certFileFlag != "" {
+		err = http.ListenAndServeTLS(*addrFlag, *certFileFlag, *keyFileFlag, server)
+	} else {
+		err = http.ListenAndServe(*addrFlag, server)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// loadParticipationKeys restores all the participation keys found in the given directory.
+func loadParticipationKeys(dir string) ([]account.PersistedParticipation, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read directory %s: %v", dir, err)
+	}
+
+	var keys []account.PersistedParticipation
+	for _, info := range files {
+		if !config.IsPartKeyFilename(info.Name()) {
+			continue
+		}
+		handle, err := db.MakeErasableAccessor(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to open participation key %s: %v", info.Name(), err)
+		}
+		part, err := account.RestoreParticipation(handle)
+		if err != nil {
+			handle.Close()
+			return nil, fmt.Errorf("unable to restore participation key %s: %v", info.Name(), err)
+		}
+		keys = append(keys, part)
+	}
+	return keys, nil
+}
+
+// isLoopback returns true if the given listening address is bound to a loopback interface.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient follows the chain of compact certificates in order to
+// verify block headers, and the transactions within them, without running a node.
+//
+// The client starts out of a trusted block header at a compact cert round, whose
+// voters commitment is used for verifying the compact cert of the next compact cert
+// round. Once verified, that header becomes trusted in turn, and so on. Any other
+// header is verified by following the previous block hashes from it up to a trusted one.
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/compactcert/verify"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrRoundNotCertified is returned when a header is verified against a compact cert round the client didn't get to yet.
+var ErrRoundNotCertified = errors.New("the round isn't covered by the verified compact certs yet")
+
+// MaxProofHeaders is a bound on the number of block headers in a HeaderProof, which is at most the compact cert interval.
+const MaxProofHeaders = 1024
+
+// HeaderProof is the evidence needed for verifying a block header: the block headers from the requested round up to
+// the first compact cert round at or after it, along with the compact cert of that last header.
+type HeaderProof struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// CertRound is the compact cert round the headers lead to.
+	CertRound basics.Round `codec:"certrnd"`
+
+	// Cert is the compact cert of the block header of CertRound.
+	Cert compactcert.Cert `codec:"cert"`
+
+	// Headers are the consecutive block headers, starting at the requested round and ending at CertRound.
+	Headers []bookkeeping.BlockHeader `codec:"hdrs,allocbound=MaxProofHeaders"`
+}
+
+// Client keeps track of the block headers certified by compact certs.
+type Client struct {
+	// voters is the latest certified header, holding the commitment of the voters
+	// for the next compact cert.
+	voters bookkeeping.BlockHeader
+
+	// certified holds the hashes of all the headers certified so far, by round.
+	certified map[basics.Round]bookkeeping.BlockHash
+}
+
+// MakeClient creates a light client trusting the given block header, which has to be at a compact cert round.
+// The trusted header would typically be obtained out of band, e.g. from a node operated by the client's owner.
+func MakeClient(trusted bookkeeping.BlockHeader) (*Client, error) {
+	proto, ok := config.Consensus[trusted.CurrentProtocol]
+	if !ok {
+		return nil, fmt.Errorf("unsupported protocol %v", trusted.CurrentProtocol)
+	}
+	if proto.CompactCertRounds == 0 {
+		return nil, fmt.Errorf("compact certs aren't enabled by protocol %v", trusted.CurrentProtocol)
+	}
+	if trusted.Round%basics.Round(proto.CompactCertRounds) != 0 {
+		return nil, fmt.Errorf("trusted header %d isn't at a multiple of %d", trusted.Round, proto.CompactCertRounds)
+	}
+	if trusted.CompactCert[protocol.CompactCertBasic].CompactCertVoters.IsZero() {
+		return nil, fmt.Errorf("trusted header %d has no voters commitment", trusted.Round)
+	}
+	return &Client{
+		voters:    trusted,
+		certified: map[basics.Round]bookkeeping.BlockHash{trusted.Round: trusted.Hash()},
+	}, nil
+}
+
+// Latest returns the latest certified block header.
+func (c *Client) Latest() bookkeeping.BlockHeader {
+	return c.voters
+}
+
+// NextCertRound returns the round of the next compact cert the client expects.
+func (c *Client) NextCertRound() basics.Round {
+	return c.voters.Round + basics.Round(config.Consensus[c.voters.CurrentProtocol].CompactCertRounds)
+}
+
+// Advance verifies the compact cert of the next compact cert round, certifying the given block header.
+// Once verified, the header becomes trusted, and its voters commitment is used for verifying the following cert.
+func (c *Client) Advance(hdr bookkeeping.BlockHeader, cert compactcert.Cert) error {
+	if hdr.Round != c.NextCertRound() {
+		return fmt.Errorf("expecting a cert for round %d, but the header is for round %d", c.NextCertRound(), hdr.Round)
+	}
+
+	params, err := verify.CompactCertParams(c.voters, hdr)
+	if err != nil {
+		return err
+	}
+	verifier := compactcert.MkVerifier(params, c.voters.CompactCert[protocol.CompactCertBasic].CompactCertVoters)
+	err = verifier.Verify(&cert)
+	if err != nil {
+		return fmt.Errorf("invalid compact cert for round %d: %w", hdr.Round, err)
+	}
+
+	c.voters = hdr
+	c.certified[hdr.Round] = hdr.Hash()
+	return nil
+}
+
+// VerifyHeaderProof verifies the block headers of the given proof, advancing the client over its compact cert
+// if it is the next expected one, and returns the first header of the proof.
+func (c *Client) VerifyHeaderProof(proof HeaderProof) (bookkeeping.BlockHeader, error) {
+	if len(proof.Headers) == 0 {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("the proof has no headers")
+	}
+	last := proof.Headers[len(proof.Headers)-1]
+	if last.Round != proof.CertRound {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("the last header is for round %d rather than %d", last.Round, proof.CertRound)
+	}
+	if _, ok := c.certified[proof.CertRound]; !ok && proof.CertRound == c.NextCertRound() {
+		err := c.Advance(last, proof.Cert)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, err
+		}
+	}
+	err := c.VerifyHeaders(proof.Headers)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	return proof.Headers[0], nil
+}
+
+// VerifyHeaders verifies the given consecutive block headers, the last of which needs to have been certified already.
+// Each header is verified through the previous block hash of its successor.
+func (c *Client) VerifyHeaders(headers []bookkeeping.BlockHeader) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	last := headers[len(headers)-1]
+	expected, ok := c.certified[last.Round]
+	if !ok {
+		return fmt.Errorf("round %d: %w", last.Round, ErrRoundNotCertified)
+	}
+	for i := len(headers) - 1; i >= 0; i-- {
+		if headers[i].Hash() != expected {
+			return fmt.Errorf("header %d doesn't match the hash certified by its successor", headers[i].Round)
+		}
+		if i > 0 && headers[i-1].Round+1 != headers[i].Round {
+			return fmt.Errorf("header %d doesn't precede header %d", headers[i-1].Round, headers[i].Round)
+		}
+		expected = headers[i].Branch
+	}
+	return nil
+}
+
+// VerifyTransaction checks that the transaction with the given ID is included in the block of the given verified
+// header, using the proof of membership returned by /v2/blocks/{round}/transactions/{txid}/proof.
+// The proof is the concatenation of the Merkle tree digests, stibHash the hash of the SignedTxnInBlock,
+// and idx its position in the block.
+func VerifyTransaction(hdr bookkeeping.BlockHeader, txid transactions.Txid, stibHash crypto.Digest, idx uint64, proof []byte) error {
+	if config.Consensus[hdr.CurrentProtocol].PaysetCommit != config.PaysetCommitMerkle {
+		return fmt.Errorf("protocol %v doesn't commit to the transactions using a Merkle tree", hdr.CurrentProtocol)
+	}
+	if len(proof)%crypto.DigestSize != 0 {
+		return fmt.Errorf("proof length %d isn't a multiple of %d", len(proof), crypto.DigestSize)
+	}
+	digests := make([]crypto.Digest, len(proof)/crypto.DigestSize)
+	for i := range digests {
+		copy(digests[i][:], proof[i*crypto.DigestSize:])
+	}
+
+	leaf := bookkeeping.TxnMerkleLeafHash(txid, stibHash)
+	err := merklearray.Verify(hdr.TxnRoot, map[uint64]crypto.Digest{idx: leaf}, digests)
+	if err != nil {
+		return fmt.Errorf("transaction %v isn't included in block %d: %w", txid, hdr.Round, err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/compactcert/verify"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testChain is a chain of block headers, with the same set of voters committed at every compact cert round.
+type testChain struct {
+	key     *crypto.OneTimeSignatureSecrets
+	parts   []basics.Participant
+	voters  bookkeeping.CompactCertState
+	headers []bookkeeping.BlockHeader
+	blocks  map[basics.Round]bookkeeping.Block
+}
+
+func makeTestChain(t *testing.T, rounds basics.Round) *testChain {
+	c := &testChain{
+		key:    crypto.GenerateOneTimeSignatureSecrets(0, 1),
+		blocks: make(map[basics.Round]bookkeeping.Block),
+	}
+	total := basics.MicroAlgos{}
+	for i := 0; i < 10; i++ {
+		part := basics.Participant{PK: c.key.OneTimeSignatureVerifier, Weight: 1000000, KeyDilution: 10000}
+		c.parts = append(c.parts, part)
+		total.Raw += part.Weight
+	}
+	tree, err := merklearray.Build(ledgercore.ParticipantsArray(c.parts))
+	require.NoError(t, err)
+	c.voters = bookkeeping.CompactCertState{CompactCertVoters: tree.Root(), CompactCertVotersTotal: total}
+
+	interval := basics.Round(config.Consensus[protocol.ConsensusFuture].CompactCertRounds)
+	for rnd := basics.Round(0); rnd <= rounds; rnd++ {
+		var block bookkeeping.Block
+		block.BlockHeader.Round = rnd
+		block.CurrentProtocol = protocol.ConsensusFuture
+		block.BlockHeader.GenesisHash = crypto.Digest{1}
+		if rnd > 0 {
+			block.Branch = c.headers[rnd-1].Hash()
+		}
+		if rnd%interval == 0 {
+			block.CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{protocol.CompactCertBasic: c.voters}
+		}
+		if rnd == 5 {
+			for i := 0; i < 3; i++ {
+				txn := transactions.Transaction{
+					Type:   protocol.PaymentTx,
+					Header: transactions.Header{Sender: basics.Address{byte(i)}, FirstValid: 1, LastValid: 10, GenesisHash: block.BlockHeader.GenesisHash},
+				}
+				stib, err := block.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+				require.NoError(t, err)
+				block.Payset = append(block.Payset, stib)
+			}
+		}
+		block.TxnRoot, err = block.PaysetCommit()
+		require.NoError(t, err)
+		c.headers = append(c.headers, block.BlockHeader)
+		c.blocks[rnd] = block
+	}
+	return c
+}
+
+// cert builds the compact cert of the header of the given round, using the voters of the previous compact cert round.
+func (c *testChain) cert(t *testing.T, certRound basics.Round) compactcert.Cert {
+	interval := basics.Round(config.Consensus[protocol.ConsensusFuture].CompactCertRounds)
+	params, err := verify.CompactCertParams(c.headers[certRound-interval], c.headers[certRound])
+	require.NoError(t, err)
+	tree, err := merklearray.Build(ledgercore.ParticipantsArray(c.parts))
+	require.NoError(t, err)
+	builder, err := compactcert.MkBuilder(params, c.parts, tree)
+	require.NoError(t, err)
+	sig := c.key.Sign(basics.OneTimeIDForRound(params.SigRound, c.parts[0].KeyDilution), params.Msg)
+	for i := range c.parts {
+		require.NoError(t, builder.Add(uint64(i), sig, true))
+	}
+	cert, err := builder.Build()
+	require.NoError(t, err)
+	return *cert
+}
+
+func TestLightClientFollowsCerts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	interval := basics.Round(config.Consensus[protocol.ConsensusFuture].CompactCertRounds)
+	chain := makeTestChain(t, 2*interval)
+
+	_, err := MakeClient(chain.headers[1])
+	require.Error(t, err)
+
+	client, err := MakeClient(chain.headers[0])
+	require.NoError(t, err)
+	require.Equal(t, interval, client.NextCertRound())
+
+	// the headers can't be verified before the cert covering them
+	require.True(t, errors.Is(client.VerifyHeaders(chain.headers[5:interval+1]), ErrRoundNotCertified))
+
+	// a cert out of order, or tampered with, isn't accepted
+	require.Error(t, client.Advance(chain.headers[2*interval], chain.cert(t, 2*interval)))
+	cert := chain.cert(t, interval)
+	tampered := cert
+	tampered.SigCommit[0]++
+	require.Error(t, client.Advance(chain.headers[interval], tampered))
+	forged := chain.headers[interval]
+	forged.TimeStamp++
+	require.Error(t, client.Advance(forged, cert))
+
+	proof := HeaderProof{CertRound: interval, Cert: cert, Headers: chain.headers[5 : interval+1]}
+	var decoded HeaderProof
+	require.NoError(t, protocol.Decode(protocol.Encode(&proof), &decoded))
+	hdr, err := client.VerifyHeaderProof(decoded)
+	require.NoError(t, err)
+	require.Equal(t, chain.headers[5], hdr)
+	require.Equal(t, chain.headers[interval], client.Latest())
+
+	// once certified, the headers can be verified again without the cert
+	require.NoError(t, client.VerifyHeaders(chain.headers[1:interval+1]))
+	modified := append([]bookkeeping.BlockHeader(nil), chain.headers[1:interval+1]...)
+	modified[3].TxnRoot = crypto.Digest{2}
+	require.Error(t, client.VerifyHeaders(modified))
+	require.Error(t, client.VerifyHeaders(append(chain.headers[1:3:3], chain.headers[4:interval+1]...)))
+
+	// the following cert is verified using the voters of the newly trusted header
+	proof = HeaderProof{CertRound: 2 * interval, Cert: chain.cert(t, 2*interval), Headers: chain.headers[interval+7 : 2*interval+1]}
+	hdr, err = client.VerifyHeaderProof(proof)
+	require.NoError(t, err)
+	require.Equal(t, chain.headers[interval+7], hdr)
+	require.Equal(t, 3*interval, client.NextCertRound())
+}
+
+func TestLightClientVerifyTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	chain := makeTestChain(t, 5)
+	block := chain.blocks[5]
+	tree, err := block.TxnMerkleTree()
+	require.NoError(t, err)
+
+	for idx, stib := range block.Payset {
+		stxn, _, err := block.DecodeSignedTxn(stib)
+		require.NoError(t, err)
+		digests, err := tree.Prove([]uint64{uint64(idx)})
+		require.NoError(t, err)
+		var proof []byte
+		for _, d := range digests {
+			proof = append(proof, d[:]...)
+		}
+
+		require.NoError(t, VerifyTransaction(block.BlockHeader, stxn.ID(), stib.Hash(), uint64(idx), proof))
+		require.Error(t, VerifyTransaction(block.BlockHeader, stxn.ID(), stib.Hash(), uint64(idx+1), proof))
+		require.Error(t, VerifyTransaction(block.BlockHeader, transactions.Txid{}, stib.Hash(), uint64(idx), proof))
+		require.Error(t, VerifyTransaction(block.BlockHeader, stxn.ID(), stib.Hash(), uint64(idx), proof[1:]))
+		require.Error(t, VerifyTransaction(chain.headers[4], stxn.ID(), stib.Hash(), uint64(idx), proof))
+	}
+}
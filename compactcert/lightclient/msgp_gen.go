@@ -0,0 +1,222 @@
+package lightclient
+
+// Code generated by github.com/algorand/msgp DO NOT EDIT.
+
+import (
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/msgp/msgp"
+)
+
+// The following msgp objects are implemented in this file:
+// HeaderProof
+//      |-----> (*) MarshalMsg
+//      |-----> (*) CanMarshalMsg
+//      |-----> (*) UnmarshalMsg
+//      |-----> (*) CanUnmarshalMsg
+//      |-----> (*) Msgsize
+//      |-----> (*) MsgIsZero
+//
+
+// MarshalMsg implements msgp.Marshaler
+func (z *HeaderProof) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(3)
+	var zb0002Mask uint8 /* 4 bits */
+	if (*z).Cert.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).CertRound.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if len((*z).Headers) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "cert"
+			o = append(o, 0xa4, 0x63, 0x65, 0x72, 0x74)
+			o = (*z).Cert.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "certrnd"
+			o = append(o, 0xa7, 0x63, 0x65, 0x72, 0x74, 0x72, 0x6e, 0x64)
+			o = (*z).CertRound.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "hdrs"
+			o = append(o, 0xa4, 0x68, 0x64, 0x72, 0x73)
+			if (*z).Headers == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Headers)))
+			}
+			for zb0001 := range (*z).Headers {
+				o = (*z).Headers[zb0001].MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *HeaderProof) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*HeaderProof)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *HeaderProof) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).CertRound.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "CertRound")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Cert.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Cert")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Headers")
+				return
+			}
+			if zb0004 > MaxProofHeaders {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxProofHeaders))
+				err = msgp.WrapError(err, "struct-from-array", "Headers")
+				return
+			}
+			if zb0005 {
+				(*z).Headers = nil
+			} else if (*z).Headers != nil && cap((*z).Headers) >= zb0004 {
+				(*z).Headers = ((*z).Headers)[:zb0004]
+			} else {
+				(*z).Headers = make([]bookkeeping.BlockHeader, zb0004)
+			}
+			for zb0001 := range (*z).Headers {
+				bts, err = (*z).Headers[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Headers", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = HeaderProof{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "certrnd":
+				bts, err = (*z).CertRound.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CertRound")
+					return
+				}
+			case "cert":
+				bts, err = (*z).Cert.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Cert")
+					return
+				}
+			case "hdrs":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Headers")
+					return
+				}
+				if zb0006 > MaxProofHeaders {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(MaxProofHeaders))
+					err = msgp.WrapError(err, "Headers")
+					return
+				}
+				if zb0007 {
+					(*z).Headers = nil
+				} else if (*z).Headers != nil && cap((*z).Headers) >= zb0006 {
+					(*z).Headers = ((*z).Headers)[:zb0006]
+				} else {
+					(*z).Headers = make([]bookkeeping.BlockHeader, zb0006)
+				}
+				for zb0001 := range (*z).Headers {
+					bts, err = (*z).Headers[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Headers", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *HeaderProof) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*HeaderProof)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *HeaderProof) Msgsize() (s int) {
+	s = 1 + 8 + (*z).CertRound.Msgsize() + 5 + (*z).Cert.Msgsize() + 5 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Headers {
+		s += (*z).Headers[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *HeaderProof) MsgIsZero() bool {
+	return ((*z).CertRound.MsgIsZero()) && ((*z).Cert.MsgIsZero()) && (len((*z).Headers) == 0)
+}
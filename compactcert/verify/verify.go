@@ -14,7 +14,10 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
 
-package internal
+// Package verify holds the consensus rules of compact certs: the parameters a compact cert is built and verified
+// with, the signed weight it needs and its validation. It depends on the block headers only, so that it could be
+// used by light clients without the ledger.
+package verify
 
 import (
 	"fmt"
@@ -140,8 +143,8 @@ func CompactCertParams(votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockH
 	return
 }
 
-// validateCompactCert checks that a compact cert is valid.
-func validateCompactCert(certHdr bookkeeping.BlockHeader, cert compactcert.Cert, votersHdr bookkeeping.BlockHeader, nextCertRnd basics.Round, atRound basics.Round) error {
+// ValidateCompactCert checks that a compact cert is valid.
+func ValidateCompactCert(certHdr bookkeeping.BlockHeader, cert compactcert.Cert, votersHdr bookkeeping.BlockHeader, nextCertRnd basics.Round, atRound basics.Round) error {
 	proto := config.Consensus[certHdr.CurrentProtocol]
 
 	if proto.CompactCertRounds == 0 {
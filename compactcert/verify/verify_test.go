@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
 
-package internal
+package verify
 
 import (
 	"testing"
@@ -40,7 +40,7 @@ func TestValidateCompactCert(t *testing.T) {
 	var atRound basics.Round
 
 	// will definitely fail with nothing set up
-	err := validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err := ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	t.Log(err)
 	require.NotNil(t, err)
 
@@ -50,32 +50,32 @@ func TestValidateCompactCert(t *testing.T) {
 	proto.CompactCertRounds = 2
 	config.Consensus[certHdr.CurrentProtocol] = proto
 
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
 	certHdr.Round = 4
 	votersHdr.Round = 4
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
 	votersHdr.Round = 2
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
 	nextCertRnd = 4
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
 	votersHdr.CurrentProtocol = certHdr.CurrentProtocol
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
@@ -84,18 +84,18 @@ func TestValidateCompactCert(t *testing.T) {
 	cc := votersHdr.CompactCert[protocol.CompactCertBasic]
 	cc.CompactCertVotersTotal.Raw = 100
 	votersHdr.CompactCert[protocol.CompactCertBasic] = cc
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
 	cert.SignedWeight = 101
-	err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+	err = ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 	// still err, but a different err case to cover
 	t.Log(err)
 	require.NotNil(t, err)
 
-	// Above cases leave validateCompactCert() with 100% coverage.
+	// Above cases leave ValidateCompactCert() with 100% coverage.
 	// crypto/compactcert.Verify has its own tests
 }
 
@@ -66,6 +66,14 @@ const CrashFilename = "crash.sqlite"
 // It is used to track in-progress compact certificates.
 const CompactCertFilename = "compactcert.sqlite"
 
+// EvidenceFilename is the name of the equivocation evidence database file.
+// It holds the proofs of the equivocating votes observed by the agreement service.
+const EvidenceFilename = "evidence.sqlite"
+
+// CatchupPeerStatsFilename is the name of the catchup peer statistics database file.
+// It holds the block download performance measured for the peers we caught up from.
+const CatchupPeerStatsFilename = "catchuppeers.sqlite"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitally, otherwise, only the most recents blocks
@@ -417,6 +417,60 @@ type Local struct {
 	// message before it can be used for calculating the data exchange rate. Setting this to zero
 	// would use the default values. The threshold is defined in units of bytes.
 	TransactionSyncSignificantMessageThreshold uint64 `version[17]:"0"`
+
+	// TransactionSyncSetReconciliation allows the transaction sync to exchange set reconciliation filters rather than bloom filters
+	// with peers that support these, falling back to bloom filters when the pending transactions of the two peers differ too much.
+	// It should only be enabled once the peers of the node are running a version that understands the advertisement.
+	TransactionSyncSetReconciliation bool `version[19]:"false"`
+
+	// EnableGossipCompression advertises support for per-message compression during the websocket handshake, and
+	// compresses large outgoing gossip messages sent to peers that have advertised it as well. Peers that don't
+	// support the compression keep receiving uncompressed messages.
+	EnableGossipCompression bool `version[19]:"true"`
+
+	// GossipCompressionThreshold is the minimal size, in bytes, of an outgoing gossip message before it would be
+	// considered for compression. Smaller messages are sent uncompressed.
+	GossipCompressionThreshold uint64 `version[19]:"2048"`
+
+	// GossipCompressionTags is a comma separated list of the message tags that would be compressed when sent to
+	// peers supporting compression. The supported tags are PP ( proposal payloads ) and TX ( transactions ).
+	GossipCompressionTags string `version[19]:"PP,TX"`
+
+	// OutgoingRelayExplorationSlots is the number of outgoing connection slots that a non-relay node keeps for trying
+	// out relays it hasn't measured yet. The remaining slots are filled with the best scored relays, as measured by
+	// their message arrival latency and throughput. Setting it to zero disables the periodic rotation of the worst
+	// scored connection.
+	OutgoingRelayExplorationSlots int `version[19]:"1"`
+
+	// EnableQUICTransport enables the QUIC gossip transport. When enabled, a relay accepts QUIC connections on the UDP
+	// port matching its NetAddress, and outgoing connections are attempted over QUIC before falling back to websockets.
+	// Over QUIC, votes, proposals, transactions and catchup messages are each sent over their own stream.
+	// The QUIC transport is only included in the builds made with the quic build tag ( i.e. make GOTAGSCUSTOM=quic ).
+	EnableQUICTransport bool `version[19]:"false"`
+
+	// ParticipationSignerEndpoint is the address of a remote participation signer ( e.g. https://10.0.0.5:8080 ) holding
+	// participation keys on behalf of this node. When set, the node votes and proposes using the keys held by the remote
+	// signer, in addition to the ones found in the genesis directory. The token used to authenticate against the remote
+	// signer is read from the partsigner.token file in the data directory. The remote signer only serves plain HTTP on
+	// loopback addresses.
+	ParticipationSignerEndpoint string `version[19]:""`
+
+	// CatchupBlockBundlesDir is a directory holding block bundles, as written by goal node export-blocks. When set, the
+	// catchup service imports the blocks found in these bundles, authenticating and validating them just like blocks
+	// fetched from peers, before it starts syncing from the network.
+	CatchupBlockBundlesDir string `version[19]:""`
+
+	// VoteVerificationBatchWindow is how long incoming agreement votes are collected before their one-time signatures are
+	// verified together as a batch. A batch is verified earlier once it is full. If the batch fails to verify, each of its
+	// votes is verified once on its own. Since signatures are not yet batch verified any faster than one by one, it
+	// defaults to zero, which verifies every vote as soon as it arrives.
+	VoteVerificationBatchWindow time.Duration `version[19]:"0"`
+
+	// EnableAccountHistory records the state of every account modified by each round as the rounds are committed to the
+	// ledger, so that account, asset and application state can be queried as of any round committed since the history
+	// was enabled. It is intended for archival nodes, since answering these queries requires the block headers of the
+	// requested rounds. Disabling it discards the recorded history.
+	EnableAccountHistory bool `version[19]:"false"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
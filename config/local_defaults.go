@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    18,
+	Version:                                    19,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AnnounceParticipationKey:                   true,
@@ -32,6 +32,7 @@ var defaultLocal = Local{
 	CatchpointFileHistoryLength:                365,
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
+	CatchupBlockBundlesDir:                     "",
 	CatchupBlockDownloadRetryAttempts:          1000,
 	CatchupBlockValidateMode:                   0,
 	CatchupFailurePeerRefreshRate:              10,
@@ -47,6 +48,7 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAccountHistory:                       false,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
@@ -56,6 +58,7 @@ var defaultLocal = Local{
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
 	EnableGossipBlockService:                   true,
+	EnableGossipCompression:                    true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
@@ -63,6 +66,7 @@ var defaultLocal = Local{
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
+	EnableQUICTransport:                        false,
 	EnableRequestLogger:                        false,
 	EnableTopAccountsReporting:                 false,
 	EnableVerbosedTransactionSyncLogging:       false,
@@ -70,6 +74,8 @@ var defaultLocal = Local{
 	FallbackDNSResolverAddress:                 "",
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
+	GossipCompressionTags:                      "PP,TX",
+	GossipCompressionThreshold:                 2048,
 	GossipFanout:                               4,
 	IncomingConnectionsLimit:                   800,
 	IncomingMessageFilterBucketCount:           5,
@@ -90,7 +96,9 @@ var defaultLocal = Local{
 	OptimizeAccountsDatabaseOnStartup:          false,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	OutgoingRelayExplorationSlots:              1,
 	ParticipationKeysRefreshInterval:           60000000000,
+	ParticipationSignerEndpoint:                "",
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      10,
 	PriorityPeers:                              map[string]bool{},
@@ -106,6 +114,7 @@ var defaultLocal = Local{
 	TLSKeyFile:                                 "",
 	TelemetryToLog:                             true,
 	TransactionSyncDataExchangeRate:            0,
+	TransactionSyncSetReconciliation:           false,
 	TransactionSyncSignificantMessageThreshold: 0,
 	TxPoolExponentialIncreaseFactor:            2,
 	TxPoolSize:                                 15000,
@@ -114,4 +123,5 @@ var defaultLocal = Local{
 	TxSyncTimeoutSeconds:                       30,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              30000,
+	VoteVerificationBatchWindow:                0,
 }
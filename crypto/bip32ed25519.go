@@ -0,0 +1,352 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// This file implements the BIP32-Ed25519 hierarchical deterministic key derivation of Khovratovich and Law, with the
+// master key derivation used by Ledger devices, so that the keys derived along the BIP-44 paths of Algorand match
+// the ones of hardware wallets restored from the same BIP-39 seed. Unlike SLIP-0010, BIP32-Ed25519 supports the
+// derivation of non-hardened child public keys from a parent extended public key.
+
+//msgp:ignore HDPrivateKey HDPublicKey HDPath
+
+const (
+	// HDHardened is the first hardened child index. Hardened children can only be derived from a private key.
+	HDHardened uint32 = 1 << 31
+
+	// AlgorandCoinType is the SLIP-0044 coin type registered for Algorand.
+	AlgorandCoinType uint32 = 283
+)
+
+var (
+	errHDHardenedPublicDerivation = errors.New("hardened child keys can't be derived from a public key")
+	errHDInvalidKey               = errors.New("derived key is invalid")
+)
+
+// HDPrivateKey is a BIP32-Ed25519 extended private key.
+type HDPrivateKey struct {
+	// Scalar is the little-endian secret scalar (kL) of the key.
+	Scalar [32]byte
+	// Prefix (kR) is the secret from which signature nonces are derived.
+	Prefix    [32]byte
+	ChainCode [32]byte
+}
+
+// HDPublicKey is a BIP32-Ed25519 extended public key, from which the non-hardened child public keys can be derived.
+type HDPublicKey struct {
+	PublicKey PublicKey
+	ChainCode [32]byte
+}
+
+// hdScalarMultBase returns scalar * B, without clamping the scalar.
+func hdScalarMultBase(scalar [32]byte) (point [32]byte, ok bool) {
+	ok = C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&point[0]), (*C.uchar)(&scalar[0])) == 0
+	return
+}
+
+func hdPointAdd(p, q [32]byte) (r [32]byte, ok bool) {
+	ok = C.crypto_core_ed25519_add((*C.uchar)(&r[0]), (*C.uchar)(&p[0]), (*C.uchar)(&q[0])) == 0
+	return
+}
+
+// hdScalarReduce reduces a 512-bit little-endian integer modulo the group order.
+func hdScalarReduce(wide []byte) (s [32]byte) {
+	var buf [64]byte
+	copy(buf[:], wide)
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&s[0]), (*C.uchar)(&buf[0]))
+	return
+}
+
+func hdScalarAdd(a, b [32]byte) (s [32]byte) {
+	C.crypto_core_ed25519_scalar_add((*C.uchar)(&s[0]), (*C.uchar)(&a[0]), (*C.uchar)(&b[0]))
+	return
+}
+
+// hdScalarMul returns a * b modulo the group order. The 512-bit product is computed in constant time.
+func hdScalarMul(a, b [32]byte) [32]byte {
+	var x, y [4]uint64
+	for i := range x {
+		x[i] = binary.LittleEndian.Uint64(a[8*i:])
+		y[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	var product [8]uint64
+	for i := range x {
+		var carry uint64
+		for j := range y {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var c uint64
+			lo, c = bits.Add64(lo, product[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			product[i+j] = lo
+			carry = hi
+		}
+		product[i+len(y)] = carry
+	}
+	var wide [64]byte
+	for i, limb := range product {
+		binary.LittleEndian.PutUint64(wide[8*i:], limb)
+	}
+	return hdScalarReduce(wide[:])
+}
+
+// hdAdd returns a + b modulo 2^256, for little-endian integers.
+func hdAdd(a, b [32]byte) (sum [32]byte) {
+	var carry uint16
+	for i := range sum {
+		carry += uint16(a[i]) + uint16(b[i])
+		sum[i] = byte(carry)
+		carry >>= 8
+	}
+	return
+}
+
+// hdTimes8 returns 8 * z[:28] as a 32-byte little-endian integer.
+func hdTimes8(z []byte) (r [32]byte) {
+	var carry byte
+	for i := 0; i < 28; i++ {
+		r[i] = z[i]<<3 | carry
+		carry = z[i] >> 5
+	}
+	r[28] = carry
+	return
+}
+
+// HDMasterKey derives the master extended private key from a seed, such as a BIP-39 seed.
+func HDMasterKey(seed []byte) HDPrivateKey {
+	hmacKey := []byte("ed25519 seed")
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	// the third highest bit of the scalar must be clear, which keeps the derived scalars from overflowing.
+	for i[31]&0x20 != 0 {
+		mac.Reset()
+		mac.Write(i)
+		i = mac.Sum(nil)
+	}
+
+	var k HDPrivateKey
+	copy(k.Scalar[:], i[:32])
+	copy(k.Prefix[:], i[32:])
+	k.Scalar[0] &= 0xf8
+	k.Scalar[31] &= 0x7f
+	k.Scalar[31] |= 0x40
+
+	chain := hmac.New(sha256.New, hmacKey)
+	chain.Write([]byte{0x01})
+	chain.Write(seed)
+	copy(k.ChainCode[:], chain.Sum(nil))
+	return k
+}
+
+// PublicKey returns the public key of k.
+func (k *HDPrivateKey) PublicKey() PublicKey {
+	point, _ := hdScalarMultBase(k.Scalar)
+	return PublicKey(point)
+}
+
+// Public returns the extended public key of k.
+func (k *HDPrivateKey) Public() HDPublicKey {
+	return HDPublicKey{PublicKey: k.PublicKey(), ChainCode: k.ChainCode}
+}
+
+// hdChildHMACs returns the HMACs of the chain code over the tagged child derivation data.
+func hdChildHMACs(chainCode [32]byte, zTag byte, chainTag byte, data []byte, index uint32) (z []byte, c []byte) {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+
+	mac := hmac.New(sha512.New, chainCode[:])
+	mac.Write([]byte{zTag})
+	mac.Write(data)
+	mac.Write(idx[:])
+	z = mac.Sum(nil)
+
+	mac.Reset()
+	mac.Write([]byte{chainTag})
+	mac.Write(data)
+	mac.Write(idx[:])
+	c = mac.Sum(nil)
+	return
+}
+
+// Child derives the child extended private key at index. Indexes from HDHardened on derive hardened children.
+func (k *HDPrivateKey) Child(index uint32) (child HDPrivateKey, err error) {
+	var z, c []byte
+	if index >= HDHardened {
+		z, c = hdChildHMACs(k.ChainCode, 0x00, 0x01, append(k.Scalar[:], k.Prefix[:]...), index)
+	} else {
+		pk := k.PublicKey()
+		z, c = hdChildHMACs(k.ChainCode, 0x02, 0x03, pk[:], index)
+	}
+
+	var zr [32]byte
+	copy(zr[:], z[32:])
+	child.Scalar = hdAdd(hdTimes8(z), k.Scalar)
+	child.Prefix = hdAdd(zr, k.Prefix)
+	copy(child.ChainCode[:], c[32:])
+	if _, ok := hdScalarMultBase(child.Scalar); !ok {
+		return HDPrivateKey{}, errHDInvalidKey
+	}
+	return child, nil
+}
+
+// Derive derives the extended private key at path from k.
+func (k HDPrivateKey) Derive(path HDPath) (HDPrivateKey, error) {
+	var err error
+	for _, index := range path {
+		k, err = k.Child(index)
+		if err != nil {
+			return HDPrivateKey{}, err
+		}
+	}
+	return k, nil
+}
+
+// Child derives the non-hardened child extended public key at index.
+func (k HDPublicKey) Child(index uint32) (child HDPublicKey, err error) {
+	if index >= HDHardened {
+		return HDPublicKey{}, errHDHardenedPublicDerivation
+	}
+	z, c := hdChildHMACs(k.ChainCode, 0x02, 0x03, k.PublicKey[:], index)
+
+	offset, ok := hdScalarMultBase(hdTimes8(z))
+	if !ok {
+		return HDPublicKey{}, errHDInvalidKey
+	}
+	point, ok := hdPointAdd(k.PublicKey, offset)
+	if !ok {
+		return HDPublicKey{}, errHDInvalidKey
+	}
+	child.PublicKey = PublicKey(point)
+	copy(child.ChainCode[:], c[32:])
+	return child, nil
+}
+
+// Bytes returns the 64-byte encoding of k: the public key followed by the chain code.
+func (k HDPublicKey) Bytes() []byte {
+	return append(append([]byte{}, k.PublicKey[:]...), k.ChainCode[:]...)
+}
+
+// HDPublicKeyFromBytes decodes an extended public key encoded by HDPublicKey.Bytes.
+func HDPublicKeyFromBytes(b []byte) (k HDPublicKey, err error) {
+	if len(b) != len(k.PublicKey)+len(k.ChainCode) {
+		return HDPublicKey{}, fmt.Errorf("extended public key must be %d bytes", len(k.PublicKey)+len(k.ChainCode))
+	}
+	copy(k.PublicKey[:], b)
+	copy(k.ChainCode[:], b[len(k.PublicKey):])
+	return k, nil
+}
+
+// SignBytes signs a message directly, without first hashing. The signature is a standard Ed25519 signature
+// verified by the public key of k.
+// Caller is responsible for domain separation.
+func (k *HDPrivateKey) SignBytes(message []byte) (sig Signature) {
+	pk := k.PublicKey()
+
+	h := sha512.New()
+	h.Write(k.Prefix[:])
+	h.Write(message)
+	r := hdScalarReduce(h.Sum(nil))
+	point, _ := hdScalarMultBase(r)
+
+	h.Reset()
+	h.Write(point[:])
+	h.Write(pk[:])
+	h.Write(message)
+	hram := hdScalarReduce(h.Sum(nil))
+	s := hdScalarAdd(hdScalarMul(hram, k.Scalar), r)
+
+	copy(sig[:32], point[:])
+	copy(sig[32:], s[:])
+	return
+}
+
+// Sign produces a cryptographic Signature of a Hashable message.
+func (k *HDPrivateKey) Sign(message Hashable) Signature {
+	return k.SignBytes(hashRep(message))
+}
+
+// HDPath is a BIP-32 derivation path, the list of the child indexes leading from the master key to a key.
+type HDPath []uint32
+
+// AlgorandHDPath returns the BIP-44 path m/44'/283'/account'/0/index of the address index of an account.
+func AlgorandHDPath(account uint32, index uint32) HDPath {
+	return HDPath{44 + HDHardened, AlgorandCoinType + HDHardened, account + HDHardened, 0, index}
+}
+
+// ParseHDPath parses a path such as m/44'/283'/0'/0/0, where hardened indexes are marked with ' or h.
+func ParseHDPath(s string) (HDPath, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path %s must start with m", s)
+	}
+	path := make(HDPath, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		offset := uint32(0)
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			offset = HDHardened
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %s in derivation path %s", part, s)
+		}
+		path = append(path, uint32(index)+offset)
+	}
+	return path, nil
+}
+
+// String formats the path, marking hardened indexes with '.
+func (p HDPath) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range p {
+		if index >= HDHardened {
+			fmt.Fprintf(&sb, "/%d'", index-HDHardened)
+		} else {
+			fmt.Fprintf(&sb, "/%d", index)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,248 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeHDMasterKey() HDPrivateKey {
+	var seed [64]byte
+	RandBytes(seed[:])
+	return HDMasterKey(seed[:])
+}
+
+func TestHDSignMatchesEd25519(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// an Ed25519 key is an extended key whose scalar and prefix are the halves of the hashed seed
+	var seed Seed
+	RandBytes(seed[:])
+	h := sha512.Sum512(seed[:])
+	var k HDPrivateKey
+	copy(k.Scalar[:], h[:32])
+	copy(k.Prefix[:], h[32:])
+	k.Scalar[0] &= 0xf8
+	k.Scalar[31] &= 0x7f
+	k.Scalar[31] |= 0x40
+
+	secrets := GenerateSignatureSecrets(seed)
+	require.Equal(t, secrets.SignatureVerifier, k.PublicKey())
+
+	msg := []byte("test message")
+	require.Equal(t, secrets.SignBytes(msg), k.SignBytes(msg))
+	require.Equal(t, secrets.SignBytes(nil), k.SignBytes(nil))
+}
+
+func hdFromHex(t *testing.T, s string) (b [32]byte) {
+	n, err := hex.Decode(b[:], []byte(s))
+	require.NoError(t, err)
+	require.Equal(t, len(b), n)
+	return
+}
+
+// TestHDKnownAnswers pins derivations from the seed 00 01 .. 3f to values
+// computed by a separate implementation of BIP32-Ed25519, written from the
+// paper (Khovratovich and Law, with the master key construction Ledger
+// devices use) on top of textbook Ed25519 arithmetic checked against the
+// RFC 8032 test vectors.
+func TestHDKnownAnswers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	seed := make([]byte, 64)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	master := HDMasterKey(seed)
+
+	vectors := []struct {
+		path      string
+		scalar    string
+		prefix    string
+		chainCode string
+		publicKey string
+	}{
+		{"m",
+			"78c6fea3905478df64979029b7c59fcd8227e1c1f8ddb1a85ef779c4d0da7250",
+			"eb473adff635018770de7d2fa416c43701424dcdc04b6b7f0bd6f83085ed4489",
+			"9df09053416e9189f3208dd7911d12a10c870372b5dbe3d2166aeddafe40e6eb",
+			"1da32e4e1006db0e34a34de26e0527d407e3f917597c7d34c1c5007905860fe3"},
+		{"m/0'",
+			"50fea1b7d0dd70b0e6669172ae40d02d28613286c26875f869c4de56d4da7250",
+			"fbed2ff5c489eb069eb866fe97129d50ba151c2d339ed0cbb9f57d639df4bb32",
+			"05dfd0e217350e59f966ab618320ac40498558ef1b21fb6b96c230693fb6a983",
+			"30cfbb3e84a1e78e0aec73abab1e52f6f9c40df3b5b29ed1defb1d1b264ac785"},
+		{"m/0'/1",
+			"3880e5382adf3d74978ae3d563020b571a80d7fa9593e301611e6e75d9da7250",
+			"66fb355d0e901254e04597ec4ccfadd1bb6e6bf5c4dcb1e4487c71f1cd29560f",
+			"2d86f567b523f58460eb60f12b890dc59c8592dcf00b695c2e04ef31ad1843cd",
+			"866a8abbf7a9e506d8850487686982c15ecba81b83b8451a0701cc756c1ff45b"},
+		{"m/44'/283'/0'/0/0",
+			"a03f82f7335284407c72e455c6143b73efe07f91618761dab2191ce0e6da7250",
+			"f711f3d71a35e98095bfb2a053b1fce0fb8b76f5b8ff00d4cba5a40f157e64dc",
+			"1da8a5b391b0443a630b78a49ce9e4cd1cc8d0e4606e5052fa3297ded23a1fcc",
+			"cefa8c73f63bd2230d3aff6031923c5f9e0534432cb13172bef66d9e49fc4700"},
+		{"m/44'/283'/0'/0/1",
+			"0024f49f5380aa39eed36661ad5eb427cd6c0c14136e06e2e3dc3923ecda7250",
+			"0ea2469d463f8362ddca8ea89cb620dfc774f99a76ffec1cdb367a6da1a77189",
+			"c42e4167df7f4afa5221b17a76a70dad6c5aa6d3ae04a636c604079ab67d4909",
+			"9b81d78755056225ec7c884e0ea9dcd9edf73f22e62c17592d240b94dc3b5728"},
+	}
+	for _, v := range vectors {
+		path, err := ParseHDPath(v.path)
+		require.NoError(t, err)
+		k, err := master.Derive(path)
+		require.NoError(t, err)
+		require.Equal(t, hdFromHex(t, v.scalar), k.Scalar, v.path)
+		require.Equal(t, hdFromHex(t, v.prefix), k.Prefix, v.path)
+		require.Equal(t, hdFromHex(t, v.chainCode), k.ChainCode, v.path)
+		require.Equal(t, PublicKey(hdFromHex(t, v.publicKey)), k.PublicKey(), v.path)
+	}
+
+	// Soft children of the account's extended public key match the private
+	// derivations
+	account, err := master.Derive(HDPath{44 + HDHardened, AlgorandCoinType + HDHardened, HDHardened, 0})
+	require.NoError(t, err)
+	xpub := account.Public()
+	for i, v := range vectors[3:] {
+		child, err := xpub.Child(uint32(i))
+		require.NoError(t, err)
+		require.Equal(t, PublicKey(hdFromHex(t, v.publicKey)), child.PublicKey, v.path)
+		require.Equal(t, hdFromHex(t, v.chainCode), child.ChainCode, v.path)
+	}
+
+	// Signatures are deterministic
+	k, err := master.Derive(AlgorandHDPath(0, 0))
+	require.NoError(t, err)
+	sig := k.SignBytes([]byte("test message"))
+	require.Equal(t, "8bf9cb31562015bb56de2cbcee787f3663268282e1d9925c71fb3359ac501dff677b3e57fc84a03aefc343691c909b2f329e091f33c28c61280696ddc2fb5105", hex.EncodeToString(sig[:]))
+}
+
+func TestHDDeriveSignVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	master := makeHDMasterKey()
+	require.Zero(t, master.Scalar[31]&0x20)
+
+	for index := uint32(0); index < 8; index++ {
+		k, err := master.Derive(AlgorandHDPath(0, index))
+		require.NoError(t, err)
+		pk := k.PublicKey()
+
+		msg := TestingHashable{data: []byte{byte(index)}}
+		sig := k.Sign(msg)
+		require.True(t, pk.Verify(msg, sig))
+		require.False(t, pk.Verify(TestingHashable{data: []byte{byte(index) + 1}}, sig))
+	}
+}
+
+func TestHDPublicDerivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	master := makeHDMasterKey()
+	account, err := master.Derive(HDPath{44 + HDHardened, AlgorandCoinType + HDHardened, HDHardened, 0})
+	require.NoError(t, err)
+	xpub := account.Public()
+
+	for index := uint32(0); index < 8; index++ {
+		privateChild, err := account.Child(index)
+		require.NoError(t, err)
+		publicChild, err := xpub.Child(index)
+		require.NoError(t, err)
+		require.Equal(t, privateChild.Public(), publicChild)
+	}
+
+	_, err = xpub.Child(HDHardened)
+	require.Error(t, err)
+
+	decoded, err := HDPublicKeyFromBytes(xpub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, xpub, decoded)
+	_, err = HDPublicKeyFromBytes(xpub.Bytes()[1:])
+	require.Error(t, err)
+}
+
+func TestHDHardenedDerivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	master := makeHDMasterKey()
+	hardened, err := master.Child(HDHardened)
+	require.NoError(t, err)
+	normal, err := master.Child(0)
+	require.NoError(t, err)
+	require.NotEqual(t, hardened.PublicKey(), normal.PublicKey())
+
+	again, err := master.Child(HDHardened)
+	require.NoError(t, err)
+	require.Equal(t, hardened, again)
+}
+
+func TestHDScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var one, a, b [32]byte
+	one[0] = 1
+	RandBytes(a[:])
+	RandBytes(b[:])
+	a = hdScalarReduce(a[:])
+	b = hdScalarReduce(b[:])
+	require.Equal(t, a, hdScalarMul(a, one))
+	require.Equal(t, hdScalarMul(a, b), hdScalarMul(b, a))
+
+	// (a+1)*b == a*b + b
+	require.Equal(t, hdScalarAdd(hdScalarMul(a, b), b), hdScalarMul(hdScalarAdd(a, one), b))
+
+	// a*B + b*B == (a+b)*B
+	pa, ok := hdScalarMultBase(a)
+	require.True(t, ok)
+	pb, ok := hdScalarMultBase(b)
+	require.True(t, ok)
+	sum, ok := hdPointAdd(pa, pb)
+	require.True(t, ok)
+	expected, ok := hdScalarMultBase(hdScalarAdd(a, b))
+	require.True(t, ok)
+	require.Equal(t, expected, sum)
+}
+
+func TestHDPath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path, err := ParseHDPath("m/44'/283'/1'/0/7")
+	require.NoError(t, err)
+	require.Equal(t, AlgorandHDPath(1, 7), path)
+	require.Equal(t, "m/44'/283'/1'/0/7", path.String())
+
+	path, err = ParseHDPath("m/44h/283h")
+	require.NoError(t, err)
+	require.Equal(t, HDPath{44 + HDHardened, AlgorandCoinType + HDHardened}, path)
+
+	path, err = ParseHDPath("m")
+	require.NoError(t, err)
+	require.Empty(t, path)
+	require.Equal(t, "m", path.String())
+
+	for _, bad := range []string{"", "44'/283'", "m/", "m/x", "m/-1", "m/2147483648", "m/1''"} {
+		_, err = ParseHDPath(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// ErrInvalidProof is returned when a proof is malformed, or doesn't lead to the element it is being verified against.
+var ErrInvalidProof = errors.New("invalid merkle trie proof")
+
+// ErrProofRootMismatch is returned when a proof is well formed, but doesn't hash to the expected root.
+var ErrProofRootMismatch = errors.New("merkle trie proof does not match the root hash")
+
+// ProofChild describes a single child of a non-leaf node within a Proof.
+type ProofChild struct {
+	// HashIndex is the byte of the element that selects this child.
+	HashIndex byte `codec:"i"`
+	// Leaf is set when the child is a leaf node.
+	Leaf bool `codec:"l"`
+	// Hash is the hash of a non-leaf child, or the remainder of the element held by a leaf child.
+	Hash []byte `codec:"h"`
+}
+
+// ProofNode describes a non-leaf node within a Proof by listing all of its children.
+type ProofNode struct {
+	Children []ProofChild `codec:"c"`
+}
+
+// Proof is a proof of inclusion or exclusion of a single element in the trie. It contains the
+// nodes on the path from the root towards the element, which allows the verifier to recalculate
+// the root hash without having access to the rest of the trie. Exclusion is proven for the exact
+// element only: when the elements are hashes of some content, such as the ledger's balances trie,
+// a proof can't show that no element exists for a given key.
+type Proof struct {
+	// Path lists the non-leaf nodes from the root down, where the node at depth i is the child
+	// of the node at depth i-1 selected by the i-1 byte of the element.
+	Path []ProofNode `codec:"p"`
+	// RootLeaf is the element stored in the root, when the trie contains a single element.
+	RootLeaf []byte `codec:"r"`
+}
+
+// Prove generates a proof of inclusion or exclusion of the given element. Any pending
+// changes are committed first, so that the proof would match the value returned by RootHash.
+func (mt *Trie) Prove(d []byte) (*Proof, error) {
+	proof := &Proof{}
+	if mt.root == storedNodeIdentifierNull {
+		return proof, nil
+	}
+	if len(d) != mt.elementLength {
+		return nil, ErrMismatchingElementLength
+	}
+	if mt.cache.modified {
+		if _, err := mt.Commit(); err != nil {
+			return nil, err
+		}
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return nil, err
+	}
+	if pnode.leaf() {
+		proof.RootLeaf = append([]byte{}, pnode.hash...)
+		return proof, nil
+	}
+
+	for depth := 0; depth < len(d); depth++ {
+		proofNode := ProofNode{Children: make([]ProofChild, len(pnode.children))}
+		var next *node
+		for i, child := range pnode.children {
+			childNode, err := mt.cache.getNode(child.id)
+			if err != nil {
+				return nil, err
+			}
+			proofNode.Children[i] = ProofChild{
+				HashIndex: child.hashIndex,
+				Leaf:      childNode.leaf(),
+				Hash:      append([]byte{}, childNode.hash...),
+			}
+			if child.hashIndex == d[depth] {
+				next = childNode
+			}
+		}
+		proof.Path = append(proof.Path, proofNode)
+		if next == nil || next.leaf() {
+			break
+		}
+		pnode = next
+	}
+	return proof, nil
+}
+
+// VerifyProof verifies that the proof was generated for the given element by a trie with the given
+// root hash. It returns whether the element is included in the trie, or an error if the proof
+// doesn't match the element or the root hash.
+func VerifyProof(root crypto.Digest, d []byte, proof *Proof) (included bool, err error) {
+	if len(proof.Path) == 0 {
+		if len(proof.RootLeaf) == 0 {
+			// the trie is empty.
+			if root != (crypto.Digest{}) {
+				return false, ErrProofRootMismatch
+			}
+			return false, nil
+		}
+		if crypto.Hash(append([]byte{0}, proof.RootLeaf...)) != root {
+			return false, ErrProofRootMismatch
+		}
+		return bytes.Equal(proof.RootLeaf, d), nil
+	}
+	if len(proof.Path) > len(d) {
+		return false, ErrInvalidProof
+	}
+
+	depth := len(proof.Path) - 1
+	child, err := proof.Path[depth].child(d[depth])
+	if err != nil {
+		return false, err
+	}
+	if child != nil {
+		if !child.Leaf {
+			// the path ends before reaching a leaf or a missing child.
+			return false, ErrInvalidProof
+		}
+		included = bytes.Equal(child.Hash, d[depth+1:])
+	}
+
+	digest := proof.Path[depth].hash(d[:depth])
+	for depth--; depth >= 0; depth-- {
+		child, err = proof.Path[depth].child(d[depth])
+		if err != nil {
+			return false, err
+		}
+		if child == nil || child.Leaf || !bytes.Equal(child.Hash, digest[:]) {
+			return false, ErrInvalidProof
+		}
+		digest = proof.Path[depth].hash(d[:depth])
+	}
+
+	if crypto.Hash(append([]byte{1}, digest[:]...)) != root {
+		return false, ErrProofRootMismatch
+	}
+	return included, nil
+}
+
+// child returns the child selected by the given byte, or nil if there is no such child.
+// The children are expected to be sorted by their hash index, as they are in the trie.
+func (pn *ProofNode) child(hashIndex byte) (*ProofChild, error) {
+	if len(pn.Children) == 0 {
+		return nil, ErrInvalidProof
+	}
+	var selected *ProofChild
+	for i := range pn.Children {
+		if i > 0 && pn.Children[i-1].HashIndex >= pn.Children[i].HashIndex {
+			return nil, ErrInvalidProof
+		}
+		if pn.Children[i].HashIndex == hashIndex {
+			selected = &pn.Children[i]
+		}
+	}
+	return selected, nil
+}
+
+// hash calculates the hash of the non-leaf node at the given path, the same way node.calculateHash does.
+func (pn *ProofNode) hash(path []byte) crypto.Digest {
+	hashAccumulator := make([]byte, 0, 1+len(path)+len(pn.Children)*(3+crypto.DigestSize))
+	hashAccumulator = append(hashAccumulator, byte(len(path)))
+	hashAccumulator = append(hashAccumulator, path...)
+	for _, child := range pn.Children {
+		if child.Leaf {
+			hashAccumulator = append(hashAccumulator, byte(0))
+		} else {
+			hashAccumulator = append(hashAccumulator, byte(1))
+		}
+		hashAccumulator = append(hashAccumulator, byte(len(child.Hash)))
+		hashAccumulator = append(hashAccumulator, child.HashIndex)
+		hashAccumulator = append(hashAccumulator, child.Hash...)
+	}
+	return crypto.Hash(hashAccumulator)
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProofEmptyAndSingleElementTrie(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, _ := MakeTrie(nil, defaultTestMemoryConfig)
+	element := crypto.Hash([]byte{1})
+	other := crypto.Hash([]byte{2})
+
+	proof, err := mt.Prove(element[:])
+	require.NoError(t, err)
+	included, err := VerifyProof(crypto.Digest{}, element[:], proof)
+	require.NoError(t, err)
+	require.False(t, included)
+
+	added, err := mt.Add(element[:])
+	require.NoError(t, err)
+	require.True(t, added)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	proof, err = mt.Prove(element[:])
+	require.NoError(t, err)
+	included, err = VerifyProof(root, element[:], proof)
+	require.NoError(t, err)
+	require.True(t, included)
+
+	proof, err = mt.Prove(other[:])
+	require.NoError(t, err)
+	included, err = VerifyProof(root, other[:], proof)
+	require.NoError(t, err)
+	require.False(t, included)
+
+	_, err = VerifyProof(crypto.Digest{}, element[:], proof)
+	require.Equal(t, ErrProofRootMismatch, err)
+
+	_, err = mt.Prove(element[:4])
+	require.Equal(t, ErrMismatchingElementLength, err)
+}
+
+func TestProofInclusionAndExclusion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var memoryCommitter InMemoryCommitter
+	mt, _ := MakeTrie(&memoryCommitter, defaultTestMemoryConfig)
+	hashes := make([]crypto.Digest, 2000)
+	for i := 0; i < len(hashes); i++ {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+	}
+	for i := 0; i < len(hashes)/2; i++ {
+		added, err := mt.Add(hashes[i][:])
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	// evict everything, so that the proofs would be generated from the committed pages.
+	_, err = mt.Evict(true)
+	require.NoError(t, err)
+
+	for i := 0; i < len(hashes); i++ {
+		proof, err := mt.Prove(hashes[i][:])
+		require.NoError(t, err)
+
+		// the proof should survive encoding, as it would when being sent to a client.
+		var decoded Proof
+		err = protocol.DecodeReflect(protocol.EncodeReflect(proof), &decoded)
+		require.NoError(t, err)
+
+		included, err := VerifyProof(root, hashes[i][:], &decoded)
+		require.NoError(t, err)
+		require.Equalf(t, i < len(hashes)/2, included, "element %d", i)
+	}
+}
+
+func TestProofTampering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, _ := MakeTrie(nil, defaultTestMemoryConfig)
+	hashes := make([]crypto.Digest, 500)
+	for i := 0; i < len(hashes); i++ {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+		mt.Add(hashes[i][:])
+	}
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	proof, err := mt.Prove(hashes[17][:])
+	require.NoError(t, err)
+	require.True(t, len(proof.Path) > 1)
+
+	// a proof can't be used for a different element.
+	_, err = VerifyProof(root, hashes[18][:], proof)
+	require.Error(t, err)
+
+	// dropping the included leaf from its parent has to change the root.
+	bottom := &proof.Path[len(proof.Path)-1]
+	saved := bottom.Children
+	for i, child := range bottom.Children {
+		if child.HashIndex == hashes[17][len(proof.Path)-1] {
+			bottom.Children = append(append([]ProofChild{}, saved[:i]...), saved[i+1:]...)
+			break
+		}
+	}
+	_, err = VerifyProof(root, hashes[17][:], proof)
+	require.Error(t, err)
+	bottom.Children = saved
+
+	// modifying a sibling hash has to change the root.
+	proof.Path[0].Children[0].Hash[0]++
+	_, err = VerifyProof(root, hashes[17][:], proof)
+	require.Error(t, err)
+	proof.Path[0].Children[0].Hash[0]--
+
+	// truncating the path isn't a valid exclusion proof.
+	truncated := &Proof{Path: proof.Path[:len(proof.Path)-1]}
+	_, err = VerifyProof(root, hashes[17][:], truncated)
+	require.Equal(t, ErrInvalidProof, err)
+
+	included, err := VerifyProof(root, hashes[17][:], proof)
+	require.NoError(t, err)
+	require.True(t, included)
+}
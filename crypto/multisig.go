@@ -125,6 +125,15 @@ func MultisigAddrGenWithSubsigs(version uint8, threshold uint8,
 
 // MultisigSign is for each device individually signs the digest
 func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []PublicKey, sk SecretKey) (sig MultisigSig, err error) {
+	return multisigSign(msg, addr, version, threshold, pk, sk.SignatureVerifier, sk.Sign)
+}
+
+// MultisigSignHD is MultisigSign for a hierarchical deterministic key
+func MultisigSignHD(msg Hashable, addr Digest, version, threshold uint8, pk []PublicKey, sk *HDPrivateKey) (sig MultisigSig, err error) {
+	return multisigSign(msg, addr, version, threshold, pk, sk.PublicKey(), sk.Sign)
+}
+
+func multisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []PublicKey, signer PublicKey, sign func(Hashable) Signature) (sig MultisigSig, err error) {
 	if version != 1 {
 		err = errUnknownVersion
 		return
@@ -146,10 +155,10 @@ func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []Publ
 	sig.Threshold = threshold
 	sig.Subsigs = make([]MultisigSubsig, len(pk))
 
-	// check if sk.pk exist in the pk list
+	// check if the signer exists in the pk list
 	keyexist := len(pk)
 	for i := 0; i < len(pk); i++ {
-		if sk.SignatureVerifier == pk[i] {
+		if signer == pk[i] {
 			keyexist = i
 		}
 	}
@@ -161,8 +170,8 @@ func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []Publ
 	// form the multisig
 	for i := 0; i < len(pk); i++ {
 		sig.Subsigs[i].Key = pk[i]
-		if sk.SignatureVerifier == pk[i] {
-			sig.Subsigs[i].Sig = sk.Sign(msg)
+		if signer == pk[i] {
+			sig.Subsigs[i].Sig = sign(msg)
 		}
 	}
 	return
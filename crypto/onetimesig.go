@@ -333,6 +333,27 @@ func (v OneTimeSignatureVerifier) Verify(id OneTimeSignatureIdentifier, message
 	return true
 }
 
+// EnqueueBatch adds the ed25519 signatures that make up a OneTimeSignature
+// on some Hashable to a BatchVerifier, instead of checking them immediately.
+//
+// A successful BatchVerifier.Verify implies that Verify would have returned
+// true for every signature enqueued this way.
+func (v OneTimeSignatureVerifier) EnqueueBatch(batch *BatchVerifier, id OneTimeSignatureIdentifier, message Hashable, sig OneTimeSignature) {
+	offsetID := OneTimeSignatureSubkeyOffsetID{
+		SubKeyPK: sig.PK,
+		Batch:    id.Batch,
+		Offset:   id.Offset,
+	}
+	batchID := OneTimeSignatureSubkeyBatchID{
+		SubKeyPK: sig.PK2,
+		Batch:    id.Batch,
+	}
+
+	batch.EnqueueSignature(SignatureVerifier(v), batchID, Signature(sig.PK2Sig))
+	batch.EnqueueSignature(SignatureVerifier(batchID.SubKeyPK), offsetID, Signature(sig.PK1Sig))
+	batch.EnqueueSignature(SignatureVerifier(offsetID.SubKeyPK), message, Signature(sig.Sig))
+}
+
 // DeleteBeforeFineGrained deletes ephemeral keys before (but not including) the given id.
 func (s *OneTimeSignatureSecrets) DeleteBeforeFineGrained(current OneTimeSignatureIdentifier, numKeysPerBatch uint64) {
 	s.mu.Lock()
@@ -138,3 +138,29 @@ func testOneTimeSignVerifyNewStyle(t *testing.T, c *OneTimeSignatureSecrets, c2
 		t.Errorf("bigJumpID.Batch++ does not verify")
 	}
 }
+
+func TestOneTimeSignEnqueueBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	c := GenerateOneTimeSignatureSecrets(0, 1000)
+	c2 := GenerateOneTimeSignatureSecrets(0, 1000)
+
+	bv := MakeBatchVerifierDefaultSize()
+	for i := 0; i < 10; i++ {
+		id := randID()
+		s := randString()
+		c.OneTimeSignatureVerifier.EnqueueBatch(bv, id, s, c.Sign(id, s))
+	}
+	if bv.GetNumberOfEnqueuedSignatures() != 30 {
+		t.Fatalf("expected 30 enqueued signatures, got %d", bv.GetNumberOfEnqueuedSignatures())
+	}
+	if err := bv.Verify(); err != nil {
+		t.Errorf("correct signatures failed to batch verify: %v", err)
+	}
+
+	id := randID()
+	s := randString()
+	c.OneTimeSignatureVerifier.EnqueueBatch(bv, id, s, c2.Sign(id, s))
+	if bv.Verify() == nil {
+		t.Errorf("wrong master key incorrectly batch verified")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// BIP-39 encodes the entropy big-endian, followed by a checksum of one bit per
+// 32 bits of entropy, so a 32-byte key takes 24 words.
+const (
+	bip39ChecksumLenBits  = keyLenBytes * 8 / 32
+	bip39MnemonicLenWords = (keyLenBytes*8 + bip39ChecksumLenBits) / bitsPerWord
+	bip39SeedIterations   = 2048
+	bip39SeedLenBytes     = 64
+)
+
+var errWrongBIP39MnemonicLen = fmt.Errorf("BIP-39 mnemonic must be %d words", bip39MnemonicLenWords)
+
+// KeyToBIP39Mnemonic converts a 32-byte key into the 24 word BIP-39 mnemonic
+// that uses the key as its entropy.
+func KeyToBIP39Mnemonic(key []byte) (string, error) {
+	if len(key) != keyLenBytes {
+		return "", errWrongKeyLen
+	}
+
+	// The entropy is followed by the first byte of its SHA-256
+	chk := sha256.Sum256(key)
+	data := append(append([]byte{}, key...), chk[0])
+
+	words := make([]string, bip39MnemonicLenWords)
+	for i := range words {
+		var index uint32
+		for bit := i * bitsPerWord; bit < (i+1)*bitsPerWord; bit++ {
+			index = index<<1 | uint32(data[bit/8]>>(7-bit%8))&1
+		}
+		words[i] = wordlist[index]
+	}
+	return strings.Join(words, sepStr), nil
+}
+
+// BIP39MnemonicToKey converts a 24 word BIP-39 mnemonic into its 32-byte
+// entropy. It returns an error if the checksum is incorrect, if the number of
+// words is unexpected, or if one of the words is not found in the words list.
+func BIP39MnemonicToKey(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) != bip39MnemonicLenWords {
+		return nil, errWrongBIP39MnemonicLen
+	}
+
+	data := make([]byte, keyLenBytes+1)
+	for i, w := range words {
+		index := indexOf(wordlist, w)
+		if index == -1 {
+			return nil, fmt.Errorf("%s is not in the words list", w)
+		}
+		for b := 0; b < bitsPerWord; b++ {
+			bit := i*bitsPerWord + b
+			data[bit/8] |= byte((index>>(bitsPerWord-1-b))&1) << (7 - bit%8)
+		}
+	}
+
+	key := data[:keyLenBytes]
+	chk := sha256.Sum256(key)
+	if chk[0] != data[keyLenBytes] {
+		return nil, errWrongChecksum
+	}
+	return key, nil
+}
+
+// BIP39Seed derives the 64-byte BIP-39 seed of a mnemonic, protected by an
+// optional passphrase.
+func BIP39Seed(mnemonic string, passphrase string) []byte {
+	normalized := strings.Join(strings.Fields(mnemonic), sepStr)
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase), bip39SeedIterations, bip39SeedLenBytes, sha512.New)
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP39Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// vectors from the BIP-39 reference implementation, all with passphrase TREZOR
+	vectors := []struct {
+		entropy  []byte
+		mnemonic string
+		seed     string
+	}{
+		{
+			bytes.Repeat([]byte{0x00}, 32),
+			strings.Repeat("abandon ", 23) + "art",
+			"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+		},
+		{
+			bytes.Repeat([]byte{0x80}, 32),
+			strings.Repeat("letter advice cage absurd amount doctor acoustic avoid ", 2) + "letter advice cage absurd amount doctor acoustic bless",
+			"c0c519bd0e91a2ed54357d9d1ebef6f5af218a153624cf4f2da911a0ed8f7a09e2ef61af0aca007096df430022f7a2b6fb91661a9589097069720d015e4e982f",
+		},
+		{
+			bytes.Repeat([]byte{0xff}, 32),
+			strings.Repeat("zoo ", 23) + "vote",
+			"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+		},
+	}
+	for _, v := range vectors {
+		m, err := KeyToBIP39Mnemonic(v.entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.mnemonic, m)
+
+		key, err := BIP39MnemonicToKey(m)
+		require.NoError(t, err)
+		require.Equal(t, v.entropy, key)
+
+		require.Equal(t, v.seed, hex.EncodeToString(BIP39Seed(m, "TREZOR")))
+	}
+}
+
+func TestBIP39GenerateAndRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	key := make([]byte, 32)
+	for i := 0; i < 1000; i++ {
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		m, err := KeyToBIP39Mnemonic(key)
+		require.NoError(t, err)
+		recovered, err := BIP39MnemonicToKey(m)
+		require.NoError(t, err)
+		require.Equal(t, key, recovered)
+	}
+}
+
+func TestBIP39InvalidMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := KeyToBIP39Mnemonic(make([]byte, 16))
+	require.Error(t, err)
+
+	_, err = BIP39MnemonicToKey(strings.Repeat("abandon ", 24))
+	require.Equal(t, errWrongChecksum, err)
+
+	_, err = BIP39MnemonicToKey(strings.Repeat("abandon ", 23))
+	require.Equal(t, errWrongBIP39MnemonicLen, err)
+
+	_, err = BIP39MnemonicToKey(strings.Repeat("abandon ", 23) + "algorand")
+	require.Error(t, err)
+}
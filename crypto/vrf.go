@@ -139,3 +139,56 @@ func (pk VrfPubkey) verifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
 func (pk VrfPubkey) Verify(p VrfProof, message Hashable) (bool, VrfOutput) {
 	return pk.verifyBytes(p, hashRep(message))
 }
+
+// VrfBatchVerifier enqueues VRF proofs to be verified together.
+// libsodium does not expose a batched ECVRF verification, so proofs are
+// checked one after the other. Unlike BatchVerifier, each proof gets its own
+// result, so an invalid proof never forces the valid ones to be checked again.
+type VrfBatchVerifier struct {
+	publicKeys []VrfPubkey
+	proofs     []VrfProof
+	messages   []Hashable
+}
+
+// MakeVrfBatchVerifier creates a VrfBatchVerifier with room for hint proofs.
+func MakeVrfBatchVerifier(hint int) *VrfBatchVerifier {
+	if hint < minBatchVerifierAlloc {
+		hint = minBatchVerifierAlloc
+	}
+	return &VrfBatchVerifier{
+		publicKeys: make([]VrfPubkey, 0, hint),
+		proofs:     make([]VrfProof, 0, hint),
+		messages:   make([]Hashable, 0, hint),
+	}
+}
+
+// EnqueueProof adds a proof of message under pk to the batch.
+func (b *VrfBatchVerifier) EnqueueProof(pk VrfPubkey, proof VrfProof, message Hashable) {
+	b.publicKeys = append(b.publicKeys, pk)
+	b.proofs = append(b.proofs, proof)
+	b.messages = append(b.messages, message)
+}
+
+// GetNumberOfEnqueuedProofs returns the number of proofs currently enqueued onto the batch verifier.
+func (b *VrfBatchVerifier) GetNumberOfEnqueuedProofs() int {
+	return len(b.proofs)
+}
+
+// Verify checks every enqueued proof. The VRF outputs and whether each proof
+// is valid are returned in the order the proofs were enqueued; the output of
+// an invalid proof is left zero.
+func (b *VrfBatchVerifier) Verify() ([]VrfOutput, []bool, error) {
+	if b.GetNumberOfEnqueuedProofs() == 0 {
+		return nil, nil, ErrZeroTranscationsInBatch
+	}
+
+	outputs := make([]VrfOutput, len(b.proofs))
+	valid := make([]bool, len(b.proofs))
+	for i := range b.proofs {
+		valid[i], outputs[i] = b.publicKeys[i].Verify(b.proofs[i], b.messages[i])
+		if !valid[i] {
+			outputs[i] = VrfOutput{}
+		}
+	}
+	return outputs, valid, nil
+}
@@ -117,3 +117,62 @@ func BenchmarkVrfVerify(b *testing.B) {
 		_, _ = pks[i].verifyBytes(proofs[i], strs[i])
 	}
 }
+
+func TestVrfBatchVerifier(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const n = 20
+	bv := MakeVrfBatchVerifier(n)
+	expected := make([]VrfOutput, n)
+	for i := 0; i < n; i++ {
+		pk, sk := VrfKeygen()
+		msg := randString()
+		proof, ok := sk.Prove(msg)
+		if !ok {
+			t.Fatalf("failed to construct VRF proof")
+		}
+		ok, expected[i] = pk.Verify(proof, msg)
+		if !ok {
+			t.Fatalf("failed to verify VRF proof")
+		}
+		bv.EnqueueProof(pk, proof, msg)
+	}
+	if bv.GetNumberOfEnqueuedProofs() != n {
+		t.Fatalf("expected %d enqueued proofs, got %d", n, bv.GetNumberOfEnqueuedProofs())
+	}
+
+	outputs, valid, err := bv.Verify()
+	if err != nil {
+		t.Fatalf("batch verification failed: %v", err)
+	}
+	for i := range expected {
+		if !valid[i] {
+			t.Errorf("proof %d was rejected", i)
+		}
+		if outputs[i] != expected[i] {
+			t.Errorf("output %d does not match individual verification", i)
+		}
+	}
+
+	// a proof over a different message is rejected on its own
+	pk, sk := VrfKeygen()
+	proof, _ := sk.Prove(randString())
+	bv.EnqueueProof(pk, proof, randString())
+	outputs, valid, err = bv.Verify()
+	if err != nil {
+		t.Fatalf("batch verification failed: %v", err)
+	}
+	if valid[n] || outputs[n] != (VrfOutput{}) {
+		t.Errorf("expected proof %d to be rejected", n)
+	}
+	for i := range expected {
+		if !valid[i] || outputs[i] != expected[i] {
+			t.Errorf("proof %d was not accepted alongside an invalid proof", i)
+		}
+	}
+
+	_, _, err = MakeVrfBatchVerifier(0).Verify()
+	if err != ErrZeroTranscationsInBatch {
+		t.Errorf("expected %v, got %v", ErrZeroTranscationsInBatch, err)
+	}
+}
@@ -356,6 +356,23 @@ type rawFormat struct {
 	Format string `url:"format"`
 }
 
+type accountExcludeParams struct {
+	Exclude string `url:"exclude"`
+}
+
+type roundParams struct {
+	Round uint64 `url:"round"`
+}
+
+type accountStateProofParams struct {
+	Round uint64 `url:"round"`
+}
+
+type accountResourcesParams struct {
+	Limit uint64 `url:"limit,omitempty"`
+	Next  string `url:"next,omitempty"`
+}
+
 // TransactionsByAddr returns all transactions for a PK [addr] in the [first,
 // last] rounds range.
 func (client RestClient) TransactionsByAddr(addr string, first, last, max uint64) (response v1.TransactionList, err error) {
@@ -387,6 +404,12 @@ func (client RestClient) AssetInformationV2(index uint64) (response generatedV2.
 	return
 }
 
+// AssetInformationV2AtRound gets the AssetInformationResponse associated with the passed asset index as of the given round
+func (client RestClient) AssetInformationV2AtRound(index uint64, round uint64) (response generatedV2.Asset, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/assets/%d", index), roundParams{round})
+	return
+}
+
 // ApplicationInformation gets the ApplicationInformationResponse associated
 // with the passed application index
 func (client RestClient) ApplicationInformation(index uint64) (response generatedV2.Application, err error) {
@@ -394,6 +417,13 @@ func (client RestClient) ApplicationInformation(index uint64) (response generate
 	return
 }
 
+// ApplicationInformationAtRound gets the ApplicationInformationResponse associated
+// with the passed application index as of the given round
+func (client RestClient) ApplicationInformationAtRound(index uint64, round uint64) (response generatedV2.Application, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d", index), roundParams{round})
+	return
+}
+
 // AccountInformation also gets the AccountInformationResponse associated with the passed address
 func (client RestClient) AccountInformation(address string) (response v1.Account, err error) {
 	err = client.get(&response, fmt.Sprintf("/v1/account/%s", address), nil)
@@ -406,6 +436,62 @@ func (client RestClient) AccountInformationV2(address string) (response generate
 	return
 }
 
+// AccountInformationV2AtRound gets the AccountData associated with the passed address as of the given round
+func (client RestClient) AccountInformationV2AtRound(address string, round uint64) (response generatedV2.Account, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s", address), roundParams{round})
+	return
+}
+
+// AccountInformationV2WithExclude gets the AccountData associated with the passed address,
+// leaving out the resources selected by exclude ("all" or "none")
+func (client RestClient) AccountInformationV2WithExclude(address string, exclude string) (response generatedV2.Account, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s", address), accountExcludeParams{exclude})
+	return
+}
+
+// AccountAssetInformation gets the holding and created parameters of an asset for the passed address
+func (client RestClient) AccountAssetInformation(address string, assetID uint64) (response generatedV2.AccountAssetResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/assets/%d", address, assetID), nil)
+	return
+}
+
+// AccountAssetsInformation gets a page of the asset holdings of the passed address
+func (client RestClient) AccountAssetsInformation(address string, limit uint64, next string) (response generatedV2.AccountAssetsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/assets", address), accountResourcesParams{limit, next})
+	return
+}
+
+// AccountCreatedAssets gets a page of the assets created by the passed address
+func (client RestClient) AccountCreatedAssets(address string, limit uint64, next string) (response generatedV2.AccountCreatedAssetsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-assets", address), accountResourcesParams{limit, next})
+	return
+}
+
+// AccountApplicationInformation gets the local state and created parameters of an application for the passed address
+func (client RestClient) AccountApplicationInformation(address string, applicationID uint64) (response generatedV2.AccountApplicationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/applications/%d", address, applicationID), nil)
+	return
+}
+
+// AccountApplicationsInformation gets a page of the application local states of the passed address
+func (client RestClient) AccountApplicationsInformation(address string, limit uint64, next string) (response generatedV2.AccountApplicationsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/applications", address), accountResourcesParams{limit, next})
+	return
+}
+
+// AccountCreatedApplications gets a page of the applications created by the passed address
+func (client RestClient) AccountCreatedApplications(address string, limit uint64, next string) (response generatedV2.AccountCreatedApplicationsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-applications", address), accountResourcesParams{limit, next})
+	return
+}
+
+// AccountStateProof gets the AccountData associated with the passed address at the given catchpoint round,
+// along with its proof against the catchpoint label
+func (client RestClient) AccountStateProof(address string, round uint64) (response privateV2.AccountStateProofResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/proof", address), accountStateProofParams{round})
+	return
+}
+
 // Blob represents arbitrary blob of data satisfying v1.RawResponse interface
 type Blob []byte
 
@@ -422,6 +508,13 @@ func (client RestClient) RawAccountInformationV2(address string) (response []byt
 	return
 }
 
+// ConfirmedTransactionInformation gets information about a transaction confirmed within the node's transaction tail
+func (client RestClient) ConfirmedTransactionInformation(transactionID string) (response generatedV2.PendingTransactionResponse, err error) {
+	transactionID = stripTransaction(transactionID)
+	err = client.get(&response, fmt.Sprintf("/v2/transactions/%s", transactionID), nil)
+	return
+}
+
 // TransactionInformation gets information about a specific transaction involving a specific account
 func (client RestClient) TransactionInformation(accountAddress, transactionID string) (response v1.Transaction, err error) {
 	transactionID = stripTransaction(transactionID)
@@ -523,6 +616,57 @@ func (client RestClient) Catchup(catchpointLabel string) (response privateV2.Cat
 	return
 }
 
+// AgreementState retrieves a snapshot of the agreement service state
+func (client RestClient) AgreementState() (response privateV2.AgreementStateResponse, err error) {
+	err = client.get(&response, "/v2/agreement", nil)
+	return
+}
+
+type equivocationsParams struct {
+	MinRound uint64 `url:"min-round"`
+	Limit    uint64 `url:"limit"`
+}
+
+// Equivocations retrieves up to limit of the equivocations observed by the node, starting at minRound
+func (client RestClient) Equivocations(minRound, limit uint64) (response privateV2.EquivocationsResponse, err error) {
+	err = client.get(&response, "/v2/equivocations", equivocationsParams{MinRound: minRound, Limit: limit})
+	return
+}
+
+type peerAddressParams struct {
+	Address string `url:"address"`
+}
+
+// Peers retrieves the list of the peers the node is currently connected to
+func (client RestClient) Peers() (response privateV2.PeersResponse, err error) {
+	err = client.get(&response, "/v2/peers", nil)
+	return
+}
+
+// DisconnectPeer disconnects the peer with the given address
+func (client RestClient) DisconnectPeer(address string) (response privateV2.PeerAdminResponse, err error) {
+	err = client.submitForm(&response, "/v2/peers", peerAddressParams{Address: address}, "DELETE", false, true)
+	return
+}
+
+// PinPeer asks the node to always maintain a connection to the given relay address
+func (client RestClient) PinPeer(address string) (response privateV2.PeerAdminResponse, err error) {
+	err = client.submitForm(&response, "/v2/peers/pinned", peerAddressParams{Address: address}, "POST", false, true)
+	return
+}
+
+// UnpinPeer removes the given relay address from the pinned peers
+func (client RestClient) UnpinPeer(address string) (response privateV2.PeerAdminResponse, err error) {
+	err = client.submitForm(&response, "/v2/peers/pinned", peerAddressParams{Address: address}, "DELETE", false, true)
+	return
+}
+
+// ReloadPhonebook asks the node to reload its phonebook
+func (client RestClient) ReloadPhonebook() (response privateV2.PeerAdminResponse, err error) {
+	err = client.submitForm(&response, "/v2/peers/phonebook/reload", nil, "POST", false, true)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -604,3 +748,9 @@ func (client RestClient) Proof(txid string, round uint64) (response generatedV2.
 	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/transactions/%s/proof", round, txid), nil)
 	return
 }
+
+// BlockHeaderProof gets the compact cert covering the given round, along with the block headers leading to it.
+func (client RestClient) BlockHeaderProof(round uint64) (response generatedV2.BlockHeaderProofResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/header-proof", round), nil)
+	return
+}
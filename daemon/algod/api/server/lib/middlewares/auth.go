@@ -68,30 +68,7 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			return next(ctx)
 		}
 
-		// Grab the apiToken from the HTTP header, or as a bearer token
-		providedToken := []byte(ctx.Request().Header.Get(auth.header))
-		if len(providedToken) == 0 {
-			// Accept tokens provided in a bearer token format.
-			authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
-			if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
-				providedToken = []byte(authentication[1])
-			}
-		}
-
-		// Handle debug routes with /urlAuth/:token prefix.
-		if ctx.Param(TokenPathParam) != "" {
-			// For debug routes, we place the apiToken in the path itself
-			providedToken = []byte(ctx.Param("token"))
-
-			// Internally, pprof matches exact routes and won't match our APIToken.
-			// We need to rewrite the requested path to exclude the token prefix.
-			// https://git.io/fp2NO
-			authPrefix := fmt.Sprintf(urlAuthFormatter, providedToken)
-			// /urlAuth/[token string]/debug/pprof/ => /debug/pprof/
-			newPath := strings.TrimPrefix(ctx.Request().URL.Path, authPrefix)
-			ctx.SetPath(newPath)
-			ctx.Request().URL.Path = newPath
-		}
+		providedToken := extractToken(ctx, auth.header)
 
 		// Check the tokens in constant time
 		for _, tokenBytes := range auth.tokens {
@@ -104,3 +81,33 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
+
+// extractToken returns the API token provided with a request, either in the
+// given header, as a bearer token, or in the /urlAuth/:token path prefix.
+func extractToken(ctx echo.Context, header string) []byte {
+	// Grab the apiToken from the HTTP header, or as a bearer token
+	providedToken := []byte(ctx.Request().Header.Get(header))
+	if len(providedToken) == 0 {
+		// Accept tokens provided in a bearer token format.
+		authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
+		if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
+			providedToken = []byte(authentication[1])
+		}
+	}
+
+	// Handle debug routes with /urlAuth/:token prefix.
+	if ctx.Param(TokenPathParam) != "" {
+		// For debug routes, we place the apiToken in the path itself
+		providedToken = []byte(ctx.Param("token"))
+
+		// Internally, pprof matches exact routes and won't match our APIToken.
+		// We need to rewrite the requested path to exclude the token prefix.
+		// https://git.io/fp2NO
+		authPrefix := fmt.Sprintf(urlAuthFormatter, providedToken)
+		// /urlAuth/[token string]/debug/pprof/ => /debug/pprof/
+		newPath := strings.TrimPrefix(ctx.Request().URL.Path, authPrefix)
+		ctx.SetPath(newPath)
+		ctx.Request().URL.Path = newPath
+	}
+	return providedToken
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// ExpiredTokenMessage is the message set when an expired scoped token is used.
+const ExpiredTokenMessage = "API Token expired"
+
+// ForbiddenRouteMessage is the message set when a token is not allowed to access a route.
+const ForbiddenRouteMessage = "API Token not permitted for this route"
+
+// RateLimitedMessage is the message set when a token exceeds its request rate limit.
+const RateLimitedMessage = "API Token rate limit exceeded"
+
+// scopedTokensRefreshInterval is how often the scoped tokens file is checked for changes.
+const scopedTokensRefreshInterval = time.Second
+
+var apiTokenRequests = metrics.MakeCounter(metrics.AlgodAPITokenRequests)
+var apiTokenRejected = metrics.MakeCounter(metrics.AlgodAPITokenRejected)
+
+type staticToken struct {
+	name   string
+	token  []byte
+	groups []tokens.RouteGroup
+}
+
+// tokenBucket implements a requests-per-second rate limit with a burst of one second worth of requests.
+type tokenBucket struct {
+	rate      float64
+	available float64
+	last      time.Time
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	if b.rate == 0 {
+		return true
+	}
+	if b.last.IsZero() {
+		b.available = b.rate
+	} else {
+		b.available += now.Sub(b.last).Seconds() * b.rate
+		if b.available > b.rate {
+			b.available = b.rate
+		}
+	}
+	b.last = now
+	if b.available < 1 {
+		return false
+	}
+	b.available--
+	return true
+}
+
+type scopedTokenState struct {
+	tokens.ScopedToken
+	bucket tokenBucket
+}
+
+// ScopedAuth authenticates requests against a set of static tokens and the
+// scoped tokens stored in the algod datadir, allowing each token access to
+// some route groups only. The scoped tokens file is reloaded when it changes,
+// so tokens can be created and revoked while algod is running.
+type ScopedAuth struct {
+	header  string
+	dataDir string
+	log     logging.Logger
+	static  []staticToken
+
+	mu        sync.Mutex
+	scoped    map[string]*scopedTokenState // by token hash
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+}
+
+// MakeScopedAuth creates a ScopedAuth reading scoped tokens from dataDir. If
+// dataDir is empty, only static tokens are accepted.
+func MakeScopedAuth(header string, dataDir string, log logging.Logger) *ScopedAuth {
+	auth := &ScopedAuth{
+		header:  header,
+		dataDir: dataDir,
+		log:     log,
+		scoped:  make(map[string]*scopedTokenState),
+	}
+	auth.mu.Lock()
+	auth.refresh(time.Now())
+	auth.mu.Unlock()
+	return auth
+}
+
+// AddStaticToken registers a token which never expires and is not rate
+// limited, such as the ones in algod.token and algod.admin.token.
+func (auth *ScopedAuth) AddStaticToken(name, token string, groups ...tokens.RouteGroup) {
+	auth.static = append(auth.static, staticToken{name: name, token: []byte(token), groups: groups})
+}
+
+// refresh reloads the scoped tokens if the tokens file changed.
+// auth.mu must be held.
+func (auth *ScopedAuth) refresh(now time.Time) {
+	if auth.dataDir == "" || now.Sub(auth.lastCheck) < scopedTokensRefreshInterval {
+		return
+	}
+	auth.lastCheck = now
+
+	var modTime time.Time
+	var size int64
+	stat, err := os.Stat(filepath.Join(auth.dataDir, tokens.AlgodScopedTokensFilename))
+	if err == nil {
+		modTime = stat.ModTime()
+		size = stat.Size()
+	} else if !os.IsNotExist(err) {
+		auth.log.Warnf("ScopedAuth: unable to stat scoped tokens file: %v", err)
+		return
+	}
+	if modTime.Equal(auth.modTime) && size == auth.size {
+		return
+	}
+
+	scoped, err := tokens.LoadScopedTokens(auth.dataDir)
+	if err != nil {
+		auth.log.Warnf("ScopedAuth: unable to load scoped tokens, keeping the previous ones: %v", err)
+		return
+	}
+	auth.modTime = modTime
+	auth.size = size
+
+	updated := make(map[string]*scopedTokenState, len(scoped))
+	for _, st := range scoped {
+		state := &scopedTokenState{ScopedToken: st}
+		// keep the rate limit state of tokens which did not change
+		if prev, has := auth.scoped[st.Hash]; has && prev.RateLimit == st.RateLimit {
+			state.bucket = prev.bucket
+		}
+		state.bucket.rate = float64(st.RateLimit)
+		updated[st.Hash] = state
+	}
+	auth.scoped = updated
+}
+
+func (auth *ScopedAuth) reject(name, reason string, code int, message string) error {
+	apiTokenRejected.Inc(map[string]string{"token": name, "reason": reason})
+	return echo.NewHTTPError(code, message)
+}
+
+// Middleware returns an echo middleware which requires a token allowed to
+// access the route group returned by groupOf for each request.
+func (auth *ScopedAuth) Middleware(groupOf func(ctx echo.Context) tokens.RouteGroup) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			// OPTIONS responses never require auth
+			if ctx.Request().Method == "OPTIONS" {
+				return next(ctx)
+			}
+
+			group := groupOf(ctx)
+			providedToken := extractToken(ctx, auth.header)
+			if len(providedToken) == 0 {
+				return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
+			}
+
+			// Check the static tokens in constant time
+			for _, st := range auth.static {
+				if subtle.ConstantTimeCompare(providedToken, st.token) != 1 {
+					continue
+				}
+				allowed := tokens.ScopedToken{Groups: st.groups}.Allows(group)
+				if !allowed {
+					// the static tokens only ever had access to their own routes
+					return auth.reject(st.name, "route", http.StatusUnauthorized, InvalidTokenMessage)
+				}
+				apiTokenRequests.Inc(map[string]string{"token": st.name})
+				return next(ctx)
+			}
+
+			// Scoped tokens are looked up by hash, so the lookup doesn't leak the token
+			now := time.Now()
+			auth.mu.Lock()
+			auth.refresh(now)
+			state, has := auth.scoped[tokens.HashAPIToken(string(providedToken))]
+			if !has {
+				auth.mu.Unlock()
+				return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
+			}
+			name := state.Name
+			if state.Expired(now) {
+				auth.mu.Unlock()
+				return auth.reject(name, "expired", http.StatusUnauthorized, ExpiredTokenMessage)
+			}
+			if !state.Allows(group) {
+				auth.mu.Unlock()
+				return auth.reject(name, "route", http.StatusForbidden, ForbiddenRouteMessage)
+			}
+			if !state.bucket.take(now) {
+				auth.mu.Unlock()
+				return auth.reject(name, "ratelimit", http.StatusTooManyRequests, RateLimitedMessage)
+			}
+			auth.mu.Unlock()
+
+			apiTokenRequests.Inc(map[string]string{"token": name})
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+func callScopedAuth(auth *ScopedAuth, group tokens.RouteGroup, token string) error {
+	handler := auth.Middleware(func(echo.Context) tokens.RouteGroup { return group })(success)
+	req, _ := http.NewRequest("GET", "N/A", nil)
+	if token != "" {
+		req.Header.Set(testAPIHeader, token)
+	}
+	ctx := e.NewContext(req, nil)
+	ctx.SetPath("")
+	return handler(ctx)
+}
+
+func requireHTTPError(t *testing.T, code int, err error) {
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok, "expected an HTTP error, got %v", err)
+	require.Equal(t, code, httpErr.Code)
+}
+
+func TestScopedAuthStaticTokens(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	auth := MakeScopedAuth(testAPIHeader, "", logging.TestingLog(t))
+	auth.AddStaticToken("admin", "admintoken", tokens.RouteGroupAdmin)
+	auth.AddStaticToken("api", "apitoken", tokens.RouteGroupRead, tokens.RouteGroupSubmit)
+
+	require.Equal(t, errSuccess, callScopedAuth(auth, tokens.RouteGroupRead, "apitoken"))
+	require.Equal(t, errSuccess, callScopedAuth(auth, tokens.RouteGroupSubmit, "apitoken"))
+	require.Equal(t, invalidTokenError, callScopedAuth(auth, tokens.RouteGroupAdmin, "apitoken"))
+	require.Equal(t, invalidTokenError, callScopedAuth(auth, tokens.RouteGroupTeal, "apitoken"))
+
+	for _, group := range tokens.AllRouteGroups {
+		require.Equal(t, errSuccess, callScopedAuth(auth, group, "admintoken"))
+	}
+
+	require.Equal(t, invalidTokenError, callScopedAuth(auth, tokens.RouteGroupRead, "invalid_token"))
+	require.Equal(t, invalidTokenError, callScopedAuth(auth, tokens.RouteGroupRead, ""))
+}
+
+func TestScopedAuthScopedTokens(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dataDir, err := ioutil.TempDir("", "scopedauth")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+
+	readToken, err := tokens.CreateScopedToken(dataDir, "reader", []tokens.RouteGroup{tokens.RouteGroupRead}, nil, 0)
+	require.NoError(t, err)
+	expired := time.Now().Add(-time.Minute)
+	expiredToken, err := tokens.CreateScopedToken(dataDir, "expired", []tokens.RouteGroup{tokens.RouteGroupRead}, &expired, 0)
+	require.NoError(t, err)
+	limitedToken, err := tokens.CreateScopedToken(dataDir, "limited", []tokens.RouteGroup{tokens.RouteGroupSubmit}, nil, 2)
+	require.NoError(t, err)
+
+	_, err = tokens.CreateScopedToken(dataDir, "reader", []tokens.RouteGroup{tokens.RouteGroupRead}, nil, 0)
+	require.Error(t, err)
+
+	auth := MakeScopedAuth(testAPIHeader, dataDir, logging.TestingLog(t))
+
+	require.Equal(t, errSuccess, callScopedAuth(auth, tokens.RouteGroupRead, readToken))
+	requireHTTPError(t, http.StatusForbidden, callScopedAuth(auth, tokens.RouteGroupSubmit, readToken))
+	requireHTTPError(t, http.StatusForbidden, callScopedAuth(auth, tokens.RouteGroupAdmin, readToken))
+	requireHTTPError(t, http.StatusUnauthorized, callScopedAuth(auth, tokens.RouteGroupRead, expiredToken))
+
+	require.Equal(t, errSuccess, callScopedAuth(auth, tokens.RouteGroupSubmit, limitedToken))
+	require.Equal(t, errSuccess, callScopedAuth(auth, tokens.RouteGroupSubmit, limitedToken))
+	requireHTTPError(t, http.StatusTooManyRequests, callScopedAuth(auth, tokens.RouteGroupSubmit, limitedToken))
+
+	// revoking a token takes effect without restarting
+	require.NoError(t, tokens.RevokeScopedToken(dataDir, "reader"))
+	require.Error(t, tokens.RevokeScopedToken(dataDir, "reader"))
+	auth.mu.Lock()
+	auth.lastCheck = time.Time{}
+	auth.mu.Unlock()
+	require.Equal(t, invalidTokenError, callScopedAuth(auth, tokens.RouteGroupRead, readToken))
+
+	scoped, err := tokens.LoadScopedTokens(dataDir)
+	require.NoError(t, err)
+	require.Len(t, scoped, 2)
+	for _, st := range scoped {
+		require.NotEqual(t, limitedToken, st.Hash)
+		require.NotEqual(t, expiredToken, st.Hash)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	b := tokenBucket{rate: 10}
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		require.True(t, b.take(now))
+	}
+	require.False(t, b.take(now))
+	require.True(t, b.take(now.Add(100*time.Millisecond)))
+	require.False(t, b.take(now.Add(100*time.Millisecond)))
+
+	unlimited := tokenBucket{}
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimited.take(now))
+	}
+}
@@ -102,16 +102,38 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 // TokenHeader is the header where we put the token.
 const TokenHeader = "X-Algo-API-Token"
 
+// publicRouteGroup returns the route group of a public (non-admin) route,
+// which determines the scoped API tokens allowed to access it.
+func publicRouteGroup(ctx echo.Context) tokens.RouteGroup {
+	switch ctx.Path() {
+	case "/v2/transactions", apiV1Tag + "/transactions":
+		if ctx.Request().Method == http.MethodPost {
+			return tokens.RouteGroupSubmit
+		}
+	case "/v2/teal/compile", "/v2/teal/dryrun":
+		return tokens.RouteGroupTeal
+	}
+	return tokens.RouteGroupRead
+}
+
+func adminRouteGroup(echo.Context) tokens.RouteGroup {
+	return tokens.RouteGroupAdmin
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener) *echo.Echo {
+// Besides apiToken and adminAPIToken, the scoped API tokens stored in dataDir are accepted.
+func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, dataDir string, listener net.Listener) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
-	adminAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken})
-	apiAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken})
+	auth := middlewares.MakeScopedAuth(TokenHeader, dataDir, logger)
+	auth.AddStaticToken(tokens.AlgodAdminTokenFilename, adminAPIToken, tokens.RouteGroupAdmin)
+	auth.AddStaticToken(tokens.AlgodTokenFilename, apiToken, tokens.RouteGroupRead, tokens.RouteGroupSubmit, tokens.RouteGroupTeal)
+	adminAuthenticator := auth.Middleware(adminRouteGroup)
+	apiAuthenticator := auth.Middleware(publicRouteGroup)
 
 	e := echo.New()
 
@@ -26,6 +26,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 type TestSuite struct {
@@ -204,3 +205,28 @@ func TestTestSuite(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	suite.Run(t, new(TestSuite))
 }
+
+func TestPublicRouteGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	tests := []struct {
+		method string
+		path   string
+		group  tokens.RouteGroup
+	}{
+		{http.MethodGet, "/v2/status", tokens.RouteGroupRead},
+		{http.MethodGet, "/v2/accounts/:address", tokens.RouteGroupRead},
+		{http.MethodGet, "/v2/transactions/params", tokens.RouteGroupRead},
+		{http.MethodPost, "/v2/transactions", tokens.RouteGroupSubmit},
+		{http.MethodPost, "/v1/transactions", tokens.RouteGroupSubmit},
+		{http.MethodPost, "/v2/teal/compile", tokens.RouteGroupTeal},
+		{http.MethodPost, "/v2/teal/dryrun", tokens.RouteGroupTeal},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, test.path, nil)
+		ctx := e.NewContext(req, nil)
+		ctx.SetPath(test.path)
+		assert.Equal(t, test.group, publicRouteGroup(ctx), "%s %s", test.method, test.path)
+	}
+}
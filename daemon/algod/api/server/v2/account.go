@@ -21,12 +21,105 @@ import (
 	"errors"
 	"math"
 	"sort"
+	"strconv"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data/basics"
 )
 
+// accountToPrivate converts v2.generated.Account to the identically shaped v2.generated.private.Account,
+// for the routes of the private API which return account data.
+func accountToPrivate(account generated.Account) private.Account {
+	converted := private.Account{
+		Address:                     account.Address,
+		Amount:                      account.Amount,
+		AmountWithoutPendingRewards: account.AmountWithoutPendingRewards,
+		AppsTotalExtraPages:         account.AppsTotalExtraPages,
+		AuthAddr:                    account.AuthAddr,
+		PendingRewards:              account.PendingRewards,
+		RewardBase:                  account.RewardBase,
+		Rewards:                     account.Rewards,
+		Round:                       account.Round,
+		SigType:                     account.SigType,
+		Status:                      account.Status,
+	}
+	if account.AppsTotalSchema != nil {
+		schema := private.ApplicationStateSchema(*account.AppsTotalSchema)
+		converted.AppsTotalSchema = &schema
+	}
+	if account.Participation != nil {
+		participation := private.AccountParticipation(*account.Participation)
+		converted.Participation = &participation
+	}
+	if account.Assets != nil {
+		assets := make([]private.AssetHolding, len(*account.Assets))
+		for i, holding := range *account.Assets {
+			assets[i] = private.AssetHolding(holding)
+		}
+		converted.Assets = &assets
+	}
+	if account.CreatedAssets != nil {
+		createdAssets := make([]private.Asset, len(*account.CreatedAssets))
+		for i, asset := range *account.CreatedAssets {
+			createdAssets[i] = private.Asset{
+				Index:  asset.Index,
+				Params: private.AssetParams(asset.Params),
+			}
+		}
+		converted.CreatedAssets = &createdAssets
+	}
+	if account.AppsLocalState != nil {
+		localStates := make([]private.ApplicationLocalState, len(*account.AppsLocalState))
+		for i, state := range *account.AppsLocalState {
+			localStates[i] = private.ApplicationLocalState{
+				Id:       state.Id,
+				KeyValue: tealKeyValueStoreToPrivate(state.KeyValue),
+				Schema:   private.ApplicationStateSchema(state.Schema),
+			}
+		}
+		converted.AppsLocalState = &localStates
+	}
+	if account.CreatedApps != nil {
+		createdApps := make([]private.Application, len(*account.CreatedApps))
+		for i, app := range *account.CreatedApps {
+			params := private.ApplicationParams{
+				ApprovalProgram:   app.Params.ApprovalProgram,
+				ClearStateProgram: app.Params.ClearStateProgram,
+				Creator:           app.Params.Creator,
+				ExtraProgramPages: app.Params.ExtraProgramPages,
+				GlobalState:       tealKeyValueStoreToPrivate(app.Params.GlobalState),
+			}
+			if app.Params.GlobalStateSchema != nil {
+				schema := private.ApplicationStateSchema(*app.Params.GlobalStateSchema)
+				params.GlobalStateSchema = &schema
+			}
+			if app.Params.LocalStateSchema != nil {
+				schema := private.ApplicationStateSchema(*app.Params.LocalStateSchema)
+				params.LocalStateSchema = &schema
+			}
+			createdApps[i] = private.Application{Id: app.Id, Params: params}
+		}
+		converted.CreatedApps = &createdApps
+	}
+	return converted
+}
+
+func tealKeyValueStoreToPrivate(kv *generated.TealKeyValueStore) *private.TealKeyValueStore {
+	if kv == nil {
+		return nil
+	}
+	converted := make(private.TealKeyValueStore, len(*kv))
+	for i, entry := range *kv {
+		converted[i] = private.TealKeyValue{
+			Key:   entry.Key,
+			Value: private.TealValue(entry.Value),
+		}
+	}
+	return &converted
+}
+
 // AccountDataToAccount converts basics.AccountData to v2.generated.Account
 func AccountDataToAccount(
 	address string, record *basics.AccountData, assetsCreators map[basics.AssetIndex]string,
@@ -38,14 +131,7 @@ func AccountDataToAccount(
 		// Empty is ok, asset may have been deleted, so we can no
 		// longer fetch the creator
 		creator := assetsCreators[curid]
-		holding := generated.AssetHolding{
-			Amount:   holding.Amount,
-			AssetId:  uint64(curid),
-			Creator:  creator,
-			IsFrozen: holding.Frozen,
-		}
-
-		assets = append(assets, holding)
+		assets = append(assets, assetHoldingToGenerated(curid, holding, creator))
 	}
 	sort.Slice(assets, func(i, j int) bool {
 		return assets[i].AssetId < assets[j].AssetId
@@ -82,15 +168,7 @@ func AccountDataToAccount(
 
 	appsLocalState := make([]generated.ApplicationLocalState, 0, len(record.AppLocalStates))
 	for appIdx, state := range record.AppLocalStates {
-		localState := convertTKVToGenerated(&state.KeyValue)
-		appsLocalState = append(appsLocalState, generated.ApplicationLocalState{
-			Id:       uint64(appIdx),
-			KeyValue: localState,
-			Schema: generated.ApplicationStateSchema{
-				NumByteSlice: state.Schema.NumByteSlice,
-				NumUint:      state.Schema.NumUint,
-			},
-		})
+		appsLocalState = append(appsLocalState, appLocalStateToGenerated(appIdx, &state))
 	}
 	sort.Slice(appsLocalState, func(i, j int) bool {
 		return appsLocalState[i].Id < appsLocalState[j].Id
@@ -129,6 +207,60 @@ func AccountDataToAccount(
 	}, nil
 }
 
+// assetHoldingToGenerated converts a basics.AssetHolding to generated.AssetHolding
+func assetHoldingToGenerated(idx basics.AssetIndex, holding basics.AssetHolding, creator string) generated.AssetHolding {
+	return generated.AssetHolding{
+		Amount:   holding.Amount,
+		AssetId:  uint64(idx),
+		Creator:  creator,
+		IsFrozen: holding.Frozen,
+	}
+}
+
+// appLocalStateToGenerated converts a basics.AppLocalState to generated.ApplicationLocalState
+func appLocalStateToGenerated(idx basics.AppIndex, state *basics.AppLocalState) generated.ApplicationLocalState {
+	return generated.ApplicationLocalState{
+		Id:       uint64(idx),
+		KeyValue: convertTKVToGenerated(&state.KeyValue),
+		Schema: generated.ApplicationStateSchema{
+			NumByteSlice: state.Schema.NumByteSlice,
+			NumUint:      state.Schema.NumUint,
+		},
+	}
+}
+
+// maxAccountResourcesPageSize is the largest number of resources returned in a single
+// page by the paginated account resource endpoints. It is also the default page size.
+const maxAccountResourcesPageSize = 1000
+
+// paginateIndices sorts the indices of an account's resources, and returns the page
+// of at most limit indices following the one named by the next token, along with the
+// next token of the page after it, if there is one.
+func paginateIndices(indices []uint64, limit *uint64, next *string) (page []uint64, nextToken *string, err error) {
+	pageSize := uint64(maxAccountResourcesPageSize)
+	if limit != nil && *limit > 0 && *limit < pageSize {
+		pageSize = *limit
+	}
+
+	var after uint64
+	if next != nil && *next != "" {
+		after, err = strconv.ParseUint(*next, 10, 64)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	start := sort.Search(len(indices), func(i int) bool { return indices[i] > after })
+	page = indices[start:]
+	if uint64(len(page)) > pageSize {
+		page = page[:pageSize]
+		token := strconv.FormatUint(page[len(page)-1], 10)
+		nextToken = &token
+	}
+	return page, nextToken, nil
+}
+
 func convertTKVToGenerated(tkv *basics.TealKeyValue) *generated.TealKeyValueStore {
 	if tkv == nil || len(*tkv) == 0 {
 		return nil
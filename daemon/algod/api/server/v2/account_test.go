@@ -203,3 +203,36 @@ func TestAccount(t *testing.T) {
 		}
 	})
 }
+
+func TestPaginateIndices(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	indices := []uint64{7, 3, 11, 5, 1}
+	limit := uint64(2)
+
+	page, next, err := paginateIndices(indices, &limit, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, page)
+	require.NotNil(t, next)
+	require.Equal(t, "3", *next)
+
+	page, next, err = paginateIndices(indices, &limit, next)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 7}, page)
+	require.NotNil(t, next)
+	require.Equal(t, "7", *next)
+
+	page, next, err = paginateIndices(indices, &limit, next)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, page)
+	require.Nil(t, next)
+
+	page, next, err = paginateIndices(indices, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5, 7, 11}, page)
+	require.Nil(t, next)
+
+	bad := "not-a-round"
+	_, _, err = paginateIndices(indices, &limit, &bad)
+	require.Error(t, err)
+}
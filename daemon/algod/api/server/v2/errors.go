@@ -24,6 +24,15 @@ var (
 	errFailedRetrievingNodeStatus              = "failed retrieving node status"
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latests block header"
 	errFailedParsingFormatOption               = "failed to parse the format option"
+	errFailedParsingExcludeOption              = "failed to parse the exclude option"
+	errFailedParsingNextToken                  = "failed to parse the next token"
+	errAccountAssetDoesNotExist                = "the account neither holds nor created the asset"
+	errAccountAppDoesNotExist                  = "the account neither opted in to nor created the application"
+	errNoCatchpointAccountProof                = "no catchpoint account proof available for the account at the given round"
+	errAccountHistoryUnavailable               = "the account state is not available for the requested round"
+	errRoundAfterLatest                        = "the requested round is after the latest round"
+	errFailedGeneratingAccountProof            = "failed to generate the account proof"
+	errCatchpointAccountProofBusy              = "another catchpoint is being prepared for proofs, please retry later"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseTransaction                = "failed to parse transaction"
 	errFailedToParseBlock                      = "failed to parse block"
@@ -38,4 +47,13 @@ var (
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
+	errFailedRetrievingPeers                   = "failed retrieving peers"
+	errFailedToDisconnectPeer                  = "failed to disconnect peer : %v"
+	errFailedToPinPeer                         = "failed to pin peer : %v"
+	errFailedToUnpinPeer                       = "failed to unpin peer : %v"
+	errFailedToReloadPhonebook                 = "failed to reload phonebook : %v"
+	errFailedRetrievingAgreementState          = "failed retrieving agreement state"
+	errFailedRetrievingEquivocations           = "failed retrieving equivocations"
+	errCompactCertsNotSupported                = "protocol does not support compact certs"
+	errCompactCertNotAvailable                 = "the compact cert covering the round is not available yet"
 )
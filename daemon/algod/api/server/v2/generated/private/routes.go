@@ -17,12 +17,36 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get an account's data and its proof against a catchpoint.
+	// (GET /v2/accounts/{address}/proof)
+	AccountStateProof(ctx echo.Context, address string, params AccountStateProofParams) error
+	// Gets the current state of the agreement service.
+	// (GET /v2/agreement)
+	GetAgreementState(ctx echo.Context) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Gets the equivocations observed by this node.
+	// (GET /v2/equivocations)
+	GetEquivocations(ctx echo.Context, params GetEquivocationsParams) error
+	// Disconnects a peer.
+	// (DELETE /v2/peers)
+	DisconnectPeer(ctx echo.Context, params DisconnectPeerParams) error
+	// Gets the currently connected peers.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
+	// Reloads the phonebook.
+	// (POST /v2/peers/phonebook/reload)
+	ReloadPhonebook(ctx echo.Context) error
+	// Unpins an outgoing peer connection.
+	// (DELETE /v2/peers/pinned)
+	UnpinPeer(ctx echo.Context, params UnpinPeerParams) error
+	// Pins an outgoing peer connection.
+	// (POST /v2/peers/pinned)
+	PinPeer(ctx echo.Context, params PinPeerParams) error
 
 	// (POST /v2/register-participation-keys/{address})
 	RegisterParticipationKeys(ctx echo.Context, address string, params RegisterParticipationKeysParams) error
@@ -36,6 +60,74 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// AccountStateProof converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountStateProof(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"round":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountStateProofParams
+	// ------------- Required query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument round is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountStateProof(ctx, address, params)
+	return err
+}
+
+// GetAgreementState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementState(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementState(ctx)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 
@@ -96,6 +188,213 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// GetEquivocations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEquivocations(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":    true,
+		"min-round": true,
+		"limit":     true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEquivocationsParams
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetEquivocations(ctx, params)
+	return err
+}
+
+// DisconnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DisconnectPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectPeer(ctx, params)
+	return err
+}
+
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
+// ReloadPhonebook converts echo context to params.
+func (w *ServerInterfaceWrapper) ReloadPhonebook(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReloadPhonebook(ctx)
+	return err
+}
+
+// UnpinPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnpinPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnpinPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnpinPeer(ctx, params)
+	return err
+}
+
+// PinPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) PinPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PinPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PinPeer(ctx, params)
+	return err
+}
+
 // RegisterParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) RegisterParticipationKeys(ctx echo.Context) error {
 
@@ -225,8 +524,16 @@ func RegisterHandlers(router interface {
 		Handler: si,
 	}
 
+	router.GET("/v2/accounts/:address/proof", wrapper.AccountStateProof, m...)
+	router.GET("/v2/agreement", wrapper.GetAgreementState, m...)
 	router.DELETE("/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST("/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET("/v2/equivocations", wrapper.GetEquivocations, m...)
+	router.DELETE("/v2/peers", wrapper.DisconnectPeer, m...)
+	router.GET("/v2/peers", wrapper.GetPeers, m...)
+	router.POST("/v2/peers/phonebook/reload", wrapper.ReloadPhonebook, m...)
+	router.DELETE("/v2/peers/pinned", wrapper.UnpinPeer, m...)
+	router.POST("/v2/peers/pinned", wrapper.PinPeer, m...)
 	router.POST("/v2/register-participation-keys/:address", wrapper.RegisterParticipationKeys, m...)
 	router.POST("/v2/shutdown", wrapper.ShutdownNode, m...)
 
@@ -235,136 +542,200 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPbtrLov4LROTP5eKJk56On8UznPDdOW7+maSZ2++49cW4DkSsJNQmwAGhJzfX/",
-	"fgcLgARJUJI/Tno75/yUWAQWi93FYnexWHwapaIoBQeu1ejo06ikkhagQeJfNE1FxXXCMvNXBiqVrNRM",
-	"8NGR/0aUlowvRuMRM7+WVC9H4xGnBTRtTP/xSMJvFZOQjY60rGA8UukSCmoA601pWteQ1slCJA7EsQVx",
-	"ejK63vKBZpkEpfpY/sjzDWE8zasMiJaUK5qaT4qsmF4SvWSKuM6EcSI4EDEnetlqTOYM8kxN/CR/q0Bu",
-	"glm6wYendN2gmEiRQx/Pl6KYMQ4eK6iRqhlCtCAZzLHRkmpiRjC4+oZaEAVUpksyF3IHqhaJEF/gVTE6",
-	"ej9SwDOQyK0U2BX+dy4BfodEU7kAPfowjk1urkEmmhWRqZ066ktQVa4VwbY4xwW7Ak5Mrwn5oVKazIBQ",
-	"Tt5985I8ffr0hZlIQbWGzAnZ4Kya0cM52e6jo1FGNfjPfVmj+UJIyrOkbv/um5c4/pmb4L6tqFIQXyzH",
-	"5gs5PRmagO8YESHGNSyQDy3pNz0ii6L5eQZzIWFPntjG98qUcPw/lCsp1emyFIzrCF8IfiX2c1SHBd23",
-	"6bAagVb70lBKGqDvD5IXHz4djg8Prv/y/jj5h/vz+dPrPaf/soa7gwLRhmklJfB0kywkUFwtS8r79Hjn",
-	"5EEtRZVnZEmvkPm0QFXv+hLT16rOK5pXRk5YKsVxvhCKUCdGGcxplWviByYVz42aMtCctBOmSCnFFcsg",
-	"Gxvtu1qydElSqiwIbEdWLM+NDFYKsiFZi89uy2K6Dkli8LoVPXBC/3uJ0cxrByVgjdogSXOhINFix/bk",
-	"dxzKMxJuKM1epW62WZHzJRAc3Hywmy3SjhuZzvMN0cjXjFBFKPFb05iwOdmIiqyQOTm7xP5uNoZqBTFE",
-	"Q+a09lGzeIfI1yNGhHgzIXKgHInn112fZHzOFpUERVZL0Eu350lQpeAKiJj9Cqk2bP9/Zz++IUKSH0Ap",
-	"uoC3NL0kwFORDfPYDRrbwX9VwjC8UIuSppfx7TpnBYug/ANds6IqCK+KGUjDL78/aEEk6EryIYQsxB1y",
-	"VtB1f9BzWfEUmdsM2zLUjCgxVeZ0MyGnc1LQ9VcHY4eOIjTPSQk8Y3xB9JoPGmlm7N3oJVJUPNvDhtGG",
-	"YcGuqUpI2ZxBRmooWzBxw+zCh/Gb4dNYVgE6HsggOvUoO9DhsI7IjFm65gsp6QICkZmQn5zmwq9aXAKv",
-	"FRyZbfBTKeGKiUrVnQZwxKG3m9dcaEhKCXMWkbEzRw6jPWwbp14LZ+CkgmvKOGRG8yLSQoPVRIM4BQNu",
-	"d2b6W/SMKvji2dAG3nzdk/tz0eX6Vo7vxW1slNglGdkXzVe3YONmU6v/Hs5fOLZii8T+3GMkW5ybrWTO",
-	"ctxmfjX882SoFCqBFiH8xqPYglNdSTi64I/NXyQhZ5ryjMrM/FLYn36ocs3O2ML8lNufXosFS8/YYoCY",
-	"Na5Rbwq7FfYfAy+ujvU66jS8FuKyKsMJpS2vdLYhpydDTLYwbyqYx7UrG3oV52vvady0h17XjBxAcpB2",
-	"JTUNL2EjwWBL0zn+s56jPNG5/N38U5Z5jKZGgN1Gi0EBFyx4534zP5klD9YnMFBYSg1Rp7h9Hn0KEPqr",
-	"hPnoaPSXaRMpmdqvaurgmhGvx6PjBs79j9T0tPPrODLNZ8K45Q42HVuf8P7xMVCjmKCh2sHh61ykl7fC",
-	"oZSiBKmZ5ePMwOmvFARPlkAzkCSjmk4ap8raWQPyjh2/w37oJYGMbHE/4n9oTsxnswqp9uabMV2ZMkac",
-	"CAJNmbH47D5iRzIN0BIVpLBGHjHG2Y2wfNkMbhV0rVHfO7J86EKLcOeVtSsJ9vCTMFNvvMbjmZC3k5eO",
-	"IHDS+MKEGqi19Wtm3uYsNq3KxNEnYk/bBh1ATfixr1ZDCnXBx2jVosKZpv8EKigD9T6o0AZ031QQRcly",
-	"uIf1uqRq2Z+EMXCePiFn3x0/P3zyy5PnX5gdupRiIWlBZhsNijx0+wpRepPDo/7MUMFXuY5D/+KZ96Da",
-	"cHdSCBGuYe+zos7BaAZLMWLjBQa7E7mRFb8HEoKUQkZsXhQdLVKRJ1cgFROR8MVb14K4FkYPWbu787vF",
-	"lqyoImZsdMcqnoGcxChv/Czc0jUUatdGYUGfr3lDGweQSkk3PQ7Y+UZm58bdhydt4nvrXpESZKLXnGQw",
-	"qxbhHkXmUhSEkgw7okJ8IzI401RX6h60QAOsQcYwIkSBzkSlCSVcZGZBm8Zx/TAQy8QgCsZ+dKhy9NLu",
-	"PzMw1nFKq8VSE2NWihhrm44JTS1TEtwr1IDrV/vstpUdzsbJcgk025AZACdi5vwr5/nhJCmGZbQ/cXHa",
-	"qUGr9glaeJVSpKAUZIk7XtqJmm9nuay30AkRR4TrUYgSZE7lLZHVQtN8B6LYJoZubU44p7SP9X7Db2Ng",
-	"d/CQjVQaH9NKgbFdzOrOQcMQCfekyRVIdM7+qfzzg9yWfVU5cHTiduBzVpjlSzjlQkEqeKaiwHKqdLJr",
-	"2ZpGLTPBzCBYKbGVioAHAgSvqdLWRWc8Q5PRqhscB/vgEMMID+4oBvLPfjPpw06NnuSqUvXOoqqyFFJD",
-	"FpsDh/WWsd7Auh5LzAPY9falBakU7II8RKUAviOWnYklENUuRlTHsPqTw3C82Qc2UVK2kGgIsQ2RM98q",
-	"oG4YPh5AxPgXdU8UHKY6klPHrMcjpUVZmvWnk4rX/YbIdGZbH+ufmrZ94aK60euZADO69jg5zFeWsvbg",
-	"YEmNbYeQSUEvzd6ElpqNJfRxNosxUYynkGyTfLMsz0yrcAnsWKQDRrI7mgxG6yyOjvxGhW5QCHZwYWjC",
-	"Axb7WxsBPw/i5vdgtUSgGkmjnKDp5uNqZnMIm8CapjrfGJWrl7AhK5BAVDUrmNb2SKNt1GhRJiGAqBO1",
-	"ZUTnxtrosTdJ9/GrzxBUML2+cToe2S10O37nnU20RQ63eZdC5JPd0tcjRhSDfYzgY1IKw3XmTtD8MUvO",
-	"lO4h6TZUjGHUC/mBapEZZ0D+U1QkpRyNgUpDrZ2ExCWPW4EZwSjTekxmd92GQpBDAdbGwS+PH3cn/vix",
-	"4zlTZA4rf+xsGnbJ8fgxWuxvhdJ3XgEd0VyfRpQMupZGY0VShYwDOdnpZiLcvbzLAPTpiR8QF5MyGsVO",
-	"XAoxv4fZsmwdO2zIYB2bqeMcGowPjHW1UaAn0Y2wNAhGzhtBXubojYp5RyJJAUZU1JKVBmRzNrLR0Mqr",
-	"+K+Hfz96f5z8gya/HyQv/s/0w6dn148e9358cv3VV//d/unp9VeP/v7XmPGgNJvFIxffUbU0mDrNsean",
-	"3MYe50Jak3PjdjIx/9x4d0TMMNNTPpjSPkL3NsYQxgm1zEaZM4ZKvrmHTcYCIhJKCQpVQmjgK/tVzMO0",
-	"Cid5aqM0FH0f2Xb9ZcBCeOf3156UCp4zDkkhOGyimYSMww/4MdbbqqWBzrhBDPXt2h8t/DtotcfZh5l3",
-	"pS9yO1BDb+skj3tgfhduJzwSJpSgewd5SShJc4bOn+BKyyrVF5yieRmIayS06o3mYYfjpW8S93AiDogD",
-	"dcGpMjSsjc5o2GwOEXfyGwDvd6hqsQClO8bNHOCCu1aMk4ozjWMVhl+JZVgJEuObE9uyoBsypzn6R7+D",
-	"FGRW6fZ2j+feShv3xcZqzDBEzC841SQH48r9wPj5GsH542UvMxz0SsjLmgpxnb8ADoqpJK5Iv7VfUZ+6",
-	"6S+dbsUkRPvZ65vPvQF43GOnsg7z0xNnCp+eoL3TRGl6uH82171gPIkK2fkSSME4Jvd0ZIs8NFabF6BH",
-	"TbzHcf2C6zU3gnRFc5ZRfTtx6Kq43lq0q6MjNS1GdDwxP9cPsSO0hUhKml7iCcpowfSymk1SUUy9CzBd",
-	"iNodmGYUCsHxWzalJZuqEtLp1eEOc+wO+opE1NX1eOS0jrr3s1oHODah7ph1DMT/rQV58O2rczJ1nFIP",
-	"bIqGBR2crUe8NndDoBXkNpO3KcY2R+WCX/ATmDPOzPejC55RTaczqliqppUC+TXNKU9hshDkiDiQJ1TT",
-	"C95T8YO3ADCB0mFTVrOcpeQy3IqbpWkzO/sQLi7eGwG5uPjQi5j2N043VHSN2gGSFdNLUenEpa4lElZU",
-	"ZhHUVZ26hJBt4um2UcfEwbYS6VLjHPy4qqZlqZJcpDRPlKYa4tMvy9xMPxBDRbATnrgTpYX0StBoRosN",
-	"8veNcDFjSVc+77FSoMjHgpbvGdcfSHJRHRw8BXJclq8NzDODx0ena4xMbkpo+fd75ko0wGK+PU7cGlSw",
-	"1pImJV2Aik5fAy2R+7hRFxiWznOC3UKa1OeNCKqZgKfHMAMsHjfOBsHJndle/g5CfAr4CVmIbYx2aoKF",
-	"t+WXAfWdyI2Q3ZpdAYwolyq9TMzajs5KGRH3nKlTkxdGJ/sIrmILbhaBy+KeAUmXkF5ChgmlUJR6M251",
-	"94cEbofzqoMpm3htkz4wOxBDITMgVZlRZwNQvummaSnQ2uemvYNL2JyLJrnwJnlZ1+NRalOhEyMzQwsV",
-	"JTXYjIywhsvWwegy3x04GUxpWZJFLmZudddicVTLhe8zvJDtDnkPizgmFDUZtsh7SWWEEFb4B0hwi4ka",
-	"eHcS/dj0Sio1S1lp579fFtrbVh8DZNfmEt1OxLy7a/SUelSJ2cbJjKr4BgLmi+GHWUPd8zg/ko0q4gwm",
-	"BC/vOcGd5WiL1EeBdmVTiUaXn7a9jTSEWlxKQPJmV/dotCkSmg9LqvwFBLyn4RfMXhvt0KFFfehkpMif",
-	"OqG/11hOzIybwxUdov9w1uxpcJQUXMaoc2K9YusuhnGdH23vRfrcWZ8w67NkR+MbZbyORy67IcYOwdHK",
-	"yCCHhZ24bewFxaH2QAUMMnj8OJ/njANJYqdSVCmRMnuDpNHlbgwwRuhjQmyAh+wNISbGAdoYLUfA5I0I",
-	"1yZf3ARJDgzD69TDxjh78DfsjjY3F1SdebvTDO3rjmYRjZsEcsvGfhRqPIqqpCEPodWK2CYz6LlUMRE1",
-	"qqkfl+lHfxTkgNtx0tKsyWUsWmesCkAxPPPdAreBPGRzs8k/Cg5NJCyY0tD4zWa1+kDQ541dXAkNyZxJ",
-	"pRN02aPTM42+UWgMfmOaxtVPi1TE3nBjWVz74LCXsEkylldxbrtxvz8xw76p/SdVzS5hg5sM0HRJZngj",
-	"0+xCreFNmy1D25PZrRN+bSf8mt7bfPeTJdPUDCyF0J0x/iRS1dEn2xZTRABjwtHn2iBJt6gX9H1OINex",
-	"xNvAJ0Ov1ihMmxk+GDXoLabMw95mfgVYDGteCyk6l8DQ3ToLhidxlGeE6eBCYz9LcGAN0LJk2brjw1uo",
-	"A8d2aMDfwFC3Fn/kKGpUA9tBgcBfjyWiSPAxB8vSYM+0V1N5OLfJXpQx1ldIkEAhhEMx5Qsr9AllRBtv",
-	"/+6i1TnQ/HvY/Gza4nRG1+PR3Vz+GK0dxB20fluzN0pnjGVbF7AVwbshyWlZSnFF88QFRoZEU4orJ5rY",
-	"3MdRPrOqi7vf56+OX7916BvfMwcqbahs66ywXfmnmZXxiIUcWCD+4raxVr3vbA2xgPn1bZgwmLJagrsk",
-	"G9hyRos54bLLqwmUBUvRBVfm8SO1naESF9OzU9wS24OyDu01HrGN7LWjefSKsty7oh7bgeMvnFwTT72x",
-	"VggB3DkqGAR3k3tVN73VHV8djXTt0EnhWFuu8Rb2proigncTi4wJiR4uimpBN0aCbHC6r5x4VSRm+SUq",
-	"Z2k8bMFnyggHtzFf05hg4wFj1ECs2MARAq9YAMs0U3uclnWQDMaIEhNDSltoNxOuxFDF2W8VEJYB1+aT",
-	"xFXZWahmXfoyFf3t1NgO/bEcYFuyogF/FxvDgBqyLhCJ7QZGGGHuoXtSO5x+onVo3PwQBAZvcFAVjtjb",
-	"ErccMjn5cNJsT/uX7UhxWBGor/+MYNjb47vLEfmwxdIiOjBGtLzQ4G5xPLxTmN432COaLQHRDTeDsS0+",
-	"kisRAVPxFeW2WojpZ2noeiuwMQPTayUkpt0riJ7SM5XMpfgd4p7s3DAqkvvoSInmIvaeRNKZu0q0jso0",
-	"daA8fUM8BkV7yJILPpL2QeLACkcpD0LneI/VB7got2JtK5u0jq/jiyNMOZla+M3icDj30nRyuprR2CVf",
-	"Y1AZnI6bQ5pWKE4L4jt7LrioYSN7wXlP3ZbZXPUSZJOg3L8XdUvj6M8l8hmkrKB53ErKkPrtmzkZWzBb",
-	"HqZSENQfcYBsXS0rRa6Giz0Ga0hzOicH46DCkeNGxq6YYrMcsMWhbTGjCnetOtxadzHTA66XCps/2aP5",
-	"suKZhEwvlSWsEqQ2YNGVq2PfM9ArAE4OsN3hC/IQo/6KXcEjQ0Vni4yODl9gWor94yC22bk6UNv0SoaK",
-	"5f87xRKXYzz2sDDMJuWgTqL3JmzxvmEVtmU12a77rCVs6bTe7rVUUE4XED/NLXbgZPsiNzFo2KELz2zl",
-	"KaWl2BCm4+ODpkY/DaSmGfVn0SCpKAqmC7OAtCBKFEaemuIidlAPzpaxchf+PV7+Ix6xlNZtgK7D/HkD",
-	"xHYvj80aD8Le0ALaZB0Taq8X5ay5wOkU4oSc+kuKWAGhLnxgaWPGMlNHk86wEC96M67Riar0PPmSpEsq",
-	"aWrU32QI3WT2xbNI1Yf2RW9+M8Q/O90lKJBXcdLLAbH31oTrSx5ywZPCaJTsUZMKGqzK6HVtoWkeT2rx",
-	"Gr2b07Qd9L4GqIGSDIpb1RI3GmjqOwke3wLwjqJYz+dG8njjmX12yaxkXDxoZTj007vXzsoohIxdWW+W",
-	"u7M4JGjJ4Arza+JMMjDvyAuZ78WFu2D/x56yNB5AbZb5tRxzBL6uWJ793KS2dwrnSMrTZfSMY2Y6/tJU",
-	"+qqnbNdx9Ib0knIOeRSc3TN/8XtrZPf/Vew7TsH4nm27BXHsdDuTaxBvo+mR8gMa8jKdmwFCqrZzfevk",
-	"sHwhMoLjNNdxGynr1/gJioP8VoHSsaqj+MHmVWIsy/gFtjYFAZ6hVT0h39pKvUsgrRuaaM2yosrtbT/I",
-	"FiBdkLUqc0GzMTFwzl8dvyZ2VNvHVlS0tTEWaMy1Z9GJYQR39/dLdfKlsuJpmPvD2Z4XZmatNF7eVZoW",
-	"ZSzD3rQ49w0wjT+M66KZF1JnQk6sha28/WYHMfIwZ7IwlmkNzep4lAnzH61pukTTtaVNhkV+/6IuXipV",
-	"UNywrhNXX7/HdWfwdnVdbFmXMRHGv1gxZQu0whW0k/rrGy7OdfJJ/u3pyYpzKylRHb3tBtZtyO6Rs4f3",
-	"PvQbxaxD+BsaLkpUMoWb1rg5w17RO8Tdgjm9qob2NmFdVcwX3k4pF5yleIM3KAlbo+yKve5zLrLHZedu",
-	"WMovcbdCI4srWqanTg9yVBws3OMVoSNcPzAbfDVMtdJh/9RYVXRJNVmAVk6zQTb2pZhcvIRxBa6cAtb9",
-	"DfSkkK2zJtSQ0ePLpA5z31CMMMV3wAD+xnx749wjTMu7ZBwNIUc2lwFoIxpYi1Ib64lpshCg3HzaV3LV",
-	"e9NngtdSM1h/mPjalQjDHtWYadtzyT6oY39K6U4FTduXpi3BY5nm51Y6sR30uCzdoNEbtTWHY8WkBgkc",
-	"OW1KfLg/IG4NP4S2Rdy2phfgfmoEDa7wcBJK3Id7glHX5eoU2LuieWUlClsQm9YTvQbGeASN14xDU1k1",
-	"skGk0S0BGYPrdaCfSiXV1gTcS6edA83xRDKm0JR2Idq7guowGEmCc/RjDLOxKSk2oDjqBo3hRvmmLuhq",
-	"pDswJl5iJWlHyH6BMLSqnBGVYeJmp2RYTHEYxe2L7bU3gP4y6NtEtruW1K6cm+xEQxdeUhGzN1+tIa3s",
-	"gbuwtSFoWZIUb5AG+0U0osmUcZ6KWR7JfTupPwZ1+DDJdrbBf2MVO4ZJ4k7Eb5yT5Y+/seONDdY2pJ65",
-	"aYQpUWxxSzY3/e+Vz7lYtBH5vAGFrWs8FJnY6n5l1GZ4B7JXC8Yq1vqKIqYhCV+kFZ2m+nJNe02iIo86",
-	"pU29ze1O+XDlzDGq/oFkxHfN7Xtqdxd7xjCUkpgOZtBS7dLjNSXNVff+wrTlLmMQbD6DLbNpn6yIxleG",
-	"chhsCoP53Ou9n13UszIR9laC+uSYPkLf+8w7UlLmDtCaFdunrMvR7WdN75O91zC4OwmX+YpAYjPpV1Ia",
-	"FvAT0JTlqq4HWb9uEJy3GnuuW49l5W6mYOpw7Zr6Oyqg/G8+y96OYl/NaKqeYSBgRWXmW0R3Nr9pJgMZ",
-	"IN2cSpu6yuJIz+uRWXN82k8rjFybxOPyNBeK8UUylFXRPrGsw30PlI3Log+BJaoQrzlIV+1Q+0dJEi38",
-	"ces2PLaRwtXEvg0R1GBVHYvc4N2md83lLawVQe2TNC7mHE6QSCiowU4GV6yGx9xG7Jf2u8+j87UCOpU5",
-	"InC9vCY770j5g3OmekQMpX5OnMrdnZ93G5OCcW6LyarYfStuSBk6m6UUWZXaWH+4MMCbXntfGdyiSqKG",
-	"QNqfZU+n53iB9nWQ7XwJm6nVq+mS8uYmc3tZ25qydg7B3ZwOt+/V2orvafnCTmBxL3j+kcbSeFQKkScD",
-	"3uVp/9pYdw1csvQSMmL2Dn/kNFDLjTxEp6YOH66WG19FtSyBQ/ZoQogxt4pSb3wksV2VpDM4f6C3jb/G",
-	"UbPK3uR0dtzkgsdPS+0jT3fUbx7Mdq1mXz2841AWyPaB9JoPqDa6ilQ23PeBgEhsr2OgBEJlsYhZKbe8",
-	"TrPX+u7bchHRDxOhdxjRly3Dz96778TzhIR7NgCDQMYNDcB+ive+08N5oFarFPTnuTcDWrQdoP0+hG+8",
-	"lz5xh50OPdvH6YhfXzbd0euxBMEL9gRRJR8PPxIJc/fi3OPHOMDjx2PX9OOT9mfjgjx+HF2Zn83fab1D",
-	"4MaNSczPQ+c/9oxj4Kixw4+K5dkuwWgdHDfFr/Bo9Bd3xP6HlN/6xaYu95eqq0R0k0hLlwlImMhcW4MH",
-	"QwVHwnucBrtukbNf3GzSSjK9wVsO3qNiv0Rvj34L3L3G4B63qXNFXaqifVfNZS4s6tbNU1jfCvs8RWH2",
-	"eoy9aSzj+mpNizIHt1C+ejD7Gzz98ll28PTwb7MvD54fpPDs+YuDA/riGT188fQQnnz5/NkBHM6/eDF7",
-	"kj159mT27MmzL56/SJ8+O5w9++LF3x74d6gsos0bT/+BNeqS47enyblBtqEJLdn3sLFVqYwY+3pXNMWV",
-	"aHySfHTkf/q/foVNUlEET+e6X0cujWW01LpUR9PparWahF2mC/TREi2qdDn14/Sr5r49rY/YbWo0ctSe",
-	"nhpRQKY6UTjGb+9enZ2T47enk0ZgRkejg8nB5BDLSpbAaclGR6On+BOuniXyfeqEbXT06Xo8mi6B5nrp",
-	"/ihAS5b6T2pFFwuQE1f4y/x09WTqT+imn5x/er3tWzsf24UVgg5BhZjpp5aTn4VwsX7K9JPPVQ8+2bcD",
-	"pp/QTxv8vY3GJ71m2fXUV4h1PVwN7umnpij+tV0dOcTObmwqBA1q6I+NH41vBSn7q1kQPgOTqfYbCjV3",
-	"TzPDVdPrZf1AQPgk+vt/0QeEP3TeU3tycPAv9jLUsxvOeKst3IpwR6ryfU0z4rODcOzDzzf2Kcf7+Eah",
-	"Eauwr8ej559z9qfciDzNCbYM8ub7rP+JX3Kx4r6l2V2roqBy45exaikF/+wH6nC6UOgZSXZFNYw+oOsd",
-	"Ox4bUC74BNeNlQu+K/Zv5fK5lMuf48G1Jzdc4H/+Gf9bnf7Z1OmZVXf7q1NnytkE1KktNN5YeL62Tb/g",
-	"S9uaHdLJztUhD/EkmMPqkTu7smAjxYPqhEGR2XiKL0TrL1sEZzxtnf3OAW3VqfoeNmqXAj9fAvnowCcs",
-	"+4gX5TB9ZEyEJB9pnge/YUFRb7ZP4vq+KSiz80XlZoHG0JoD+Gt7mJXv3mcxG9kl+NJDlgatk4x+VmZT",
-	"tnwOg6/q2+rOoQZzInh4cHAQS+fu4uxiPxZjjPGvRJLDFeR9Vg8h0alAtO0N6sFXuvqFo0K/OyJ1+EbU",
-	"DJpaUoNPcrerId0EuxPBH2iyosyduAVReftsW8G0f63epnm7S0X1HhF/4TwxIGO4NDeZ77p5//neW7ne",
-	"ouzUstKZWPFhxYV1GGjuLjLi1cI63KAF8QBqTTUh/vnhfOPfzycUE85FpZt4kOnsz2Y6z3HVZW8XjOMA",
-	"uMpxFHtjlwbn4+6ZrL4SPHOYvbGvinX0XvR1b4tjfN3HFv1dZalvaGzllS9C2fp7akTemKv21cQEKdQP",
-	"aWig+dSlGnd+tQmBwY/tp6Miv07rIhjRj91ATeyri6P4Rk2ENIw4IqfqWOP7D4bgeK/QMbEJoB1Np3hy",
-	"vBRKT0dG4bSDa+HHDzWNP3nOe1pff7j+nwAAAP//2qdPyGGOAAA=",
+	"H4sIAAAAAAAC/+y9e3PcNrIo/lVQc05VEv+GkvxIzlpVW+entZOs7yZeleXk3nti3wRD9sxgxQG4AChp",
+	"4qvvfqsbAAmS4AxHUpxNHf9la4hHo9FoNPr5YZarTaUkSGtmpx9mFdd8AxY0/cXzXNXSZqLAvwowuRaV",
+	"FUrOTsM3ZqwWcjWbzwT+WnG7ns1nkm9gdhr3n880/LMWGorZqdU1zGcmX8OG48B2W2HrZqSbbKUyP8SZ",
+	"G+LVy9ntjg+8KDQYM4Ty77LcMiHzsi6AWc2l4Tl+Muxa2DWza2GY78yEZEoCU0tm153GbCmgLMxRWOQ/",
+	"a9DbaJV+8vEl3bYgZlqVMITzhdoshIQAFTRANRvCrGIFLKnRmluGMyCsoaFVzADX+Zotld4DqgMihhdk",
+	"vZmd/jQzIAvQtFs5iCv671ID/AqZ5XoFdvZ+nlrc0oLOrNgklvbKY1+DqUtrGLWlNa7EFUiGvY7Y97Wx",
+	"bAGMS/bmmxfs6dOnz3EhG24tFJ7IRlfVzh6vyXWfnc4KbiF8HtIaL1dKc1lkTfs337yg+S/8Aqe24sZA",
+	"+rCc4Rf26uXYAkLHBAkJaWFF+9ChfuyROBTtzwtYKg0T98Q1ftBNief/XXcl5zZfV0pIm9gXRl+Z+5zk",
+	"YVH3XTysAaDTvkJMaRz0p5Ps+fsPj+ePT27/7aez7L/8n18+vZ24/BfNuHswkGyY11qDzLfZSgOn07Lm",
+	"coiPN54ezFrVZcHW/Io2n2+I1fu+DPs61nnFyxrpRORanZUrZRj3ZFTAktelZWFiVssSjKHRPLUzYVil",
+	"1ZUooJgzIdn1WuRrlnPjhqB27FqUJdJgbaAYo7X06nYcptsYJQjXnfBBC/rXRUa7rj2YgBviBlleKgOZ",
+	"VXuup3DjcFmw+EJp7ypz2GXF3q6B0eT4wV22hDuJNF2WW2ZpXwvGDeMsXE1zJpZsq2p2TZtTikvq71eD",
+	"WNswRBptTucexcM7hr4BMhLIWyhVApeEvHDuhiiTS7GqNRh2vQa79neeBlMpaYCpxT8gt7jt/+Pi76+Z",
+	"0ux7MIav4JznlwxkrorxPfaTpm7wfxiFG74xq4rnl+nruhQbkQD5e34jNvWGyXqzAI37Fe4Hq5gGW2s5",
+	"BpAbcQ+dbfjNcNK3upY5bW47bUdQQ1ISpir59oi9WrINv/nzydyDYxgvS1aBLIRcMXsjR4U0nHs/eJlW",
+	"tSwmyDAWNyy6NU0FuVgKKFgzyg5I/DT74BHyMHhaySoCR8g94Ag5DRwJNwmawaOLX1jFVxCRzBH7wXMu",
+	"+mrVJciGwbHFlj5VGq6Eqk3TaQRGmnq3eC2VhazSsBQJGrvw6EDu4dp49rrxAk6upOVCQsGEdEArC44T",
+	"jcIUTbj7MTO8ohfcwFfPZrf7vlYAOht92yDqsUXgqnNkjxoqpW2EYgBtWCmM3f98mfpCQ3xPJMyl6hPk",
+	"TmKcRIjUKHPcInFl41fPS9ISXaf/hFXHcxuxytzPAxoTq7d4yy1FSTfgP5C0AhpqQ/ypg4hwJxqxktzW",
+	"Gk7fyUf4F8vYheWy4LrAXzbup+/r0ooLscKfSvfTd2ol8guxGkFmA2vyoUfdNu4fHC99U9ib5HvmO6Uu",
+	"6ypeUN55MC+27NXLsU12Yx56Zs4aMo0fPG9vwiPo0B72ptnIESBHcVdxbHgJWw0ILc+X9M/NkuiJL/Wv",
+	"+E9VlSmcIgF7GYDOtNdjnFVVKXKO2HvjP+NXZEzgXi68bXFMl/zphwi2SqsKtBVuUF5VWalyXmbGcksj",
+	"/buG5ex09m/HrcLn2HU3x9Hk32GvC+qEMrKTuzJeVQeMcY6ylpmNcwnkXPSJ+INjxSSlCel2D2lIGKah",
+	"hCsuiXWlGEFzcn/yM7X4duIV4vt2nsCxeRgkmxjLIyw6GpJRa+Zau6uGRNOVk0YtbMydt8qvm2vNtzN/",
+	"XWd07Q7B+sGAQ33FV0LSYHOUUiXb8EvkVFwqklgRw2BsuLgdvDRoq6nyt78Xr48Gz9mPSgbDPZlPIw1j",
+	"wD4ETdBzbq1KFEj37iU2/qtvG582/H1S53/Zk4bAmYfG59gBwzYstLnjseptxafTRKepi/1pR+mFo+IH",
+	"ZrbRTTRCBnnQEUQT35vHfqKFQAudDTiMEh6KGXT44z4qoDb3YAafdn6w8w7t0/b+Ttu9c1PcuN1ZSPo5",
+	"10otH+Ku8TNMBWS3aQE3ouQLKINBsW1Mf1YINbvmhq1AgnZEu+JCGpvcb2qfnsbr+oLSkLWmWW79RPNw",
+	"P9LcoUHBLZ874Jp1JsB1y0B1L37YgL4sA/xqORgwqFCiARa85DIHw6wWdAxbXcfWQsdO8n8+/89TtI/w",
+	"7NeT7Pn/d/z+w7PbLx4Nfnxy++c//9/uT09v//zFf/77gUclArJRTOy7FP3+z3umHtqffYdjpQE24An3",
+	"TjTbN2AZySuzVrbZijAFM6CvRA6ODI5m8x65F8CLUkhII8aKTWMKdxo8sQFV2zlyDm7FFena8esCVkJK",
+	"JK1AOWSAsKwCLZQzZkgulYFcSWdF72N4PltyYzMNuboCvc0OBA07I7e0AluxMMxvDHKJIOeKFF5e2E9x",
+	"AL0Cg0cRKqaB5+tWx9hoP92s6Vkkr6rk6P8zMirEG8QE2Ui4LNSGKQlzrxZ2VwF3ba+UBWxooHMDNHaN",
+	"+czBNHJoOthKw+2+jWot6WMf++7eamxfCKRh16CBGQA5/foOJ+DcrSFxkVdCSiimD6RVpQwvf0QLn2fG",
+	"9MPY+sJnZjXPL9st76z0Dgvy46aWtIvJ9WcdbhfS5+7O2CLdFwkvEzLbRTLYhkHJK5SSjJA5PNRB7HHn",
+	"hnWM8pThyW1PWUP3LQHHmz1vFdSIr8HSx7j+PXV7k98qOFnvfmg/xxIgbtvdVR4TZOcEJPihD8NfSpVf",
+	"/hV4AfqhpLgctM32Csee3BY4P1sTAAx7OtW8t53g8nhu6UOa9F3P7ADZzM+VEMri2RgvlVy174EYTsOW",
+	"Wm3oZ/+UCFYVVlfhhmvXouRHl7j674d2R3oYS52XeYoHxaih80wPKrfq+W5klcAdihUT9qghugegNJpn",
+	"uOt/iWkKBeKjWX+ZaXtFdBjocQE68az4O/2Hl2GHc26DLwH6UQjDhGEq8nos3CMUEeNmcve+RYRsnMcB",
+	"Qwo9CMoX7eSD7XZombK1X/sz4TbMLwKX3rownS2Utg8hJ8tY1Oc4auOKMRSNqWldZR4/CecO16A3UOsL",
+	"u+dA9IYfuTZaLFxY/htgwVgeAX8PLHQHemgsqE0lSniA87rmZj1cBFrbnz5hF389+/Lxk5+ffPkV3g2V",
+	"VivNNwyZpWGfe0siM3ZbwhfJJyYZetOjf/Ws4f6dcfdiiABuxp7ELAE5g8MYc85rCN1LvdX1Q9gVQWul",
+	"Ew4BRDpW5arMrkAboRIKsnPfgvkWTJigCOn+7qAl1YiqvGaklsWIHgydfnCySZK0G/rtjWxx05Wjezvg",
+	"1ptYnZ93yp50kR9cTejVl9kbyQpY1KuOeo7ud84K6kgM8et/1uJK3UebPrxUIR6TqQWqC1rZR6oiwRI6",
+	"fSZjPYZ+P8o7U0yVEPYvBid+rQpAzUv9EDhsB2v3FCeLd5Iv8EXOCQDSw9QmzWZ3KBHDk6ijP3TX+AJQ",
+	"ssl5vVpbJ/ylTkjbMeO5Q3RGV+7I67X1w3Ot3HTO97XUwIstWwBIphbeZyrCMuPkamk7WsS6SgrPEVyV",
+	"VjkYg0pmp13bC1po1wrDY3giwAngZhZmFFtyfUdgrbK83AMotUmB20hlPY1AR5c4YfpdG9ifPN5Grt2z",
+	"AamAWUWXRQkWxlA4EScokuNz4zfdvzDJXbevrkbCIbwg81ZsoKdx2KH526v7N521GFxBzI8SJ5UGHnm7",
+	"fseNfeMVZAVJ3o7d0DzUh6YYB3j0YsaRfwx38nDsXEkD0tSmuaBNXTknw9QayEY2OtdruGnmUsto7EYK",
+	"sIrVBvaNPIalaHyPLLcShyBuG4uYV5oOF0cu9ngPbJOo7ADRImIXIBehVYTd6772dgiIMC2iHeEI06Oc",
+	"SF9rrKoqPH82q2XTbwxNF671mf2hbTskLm5bvl4owNltgMlDfu0w64IB1twwD0cwepLA65zwhjCT9oyU",
+	"gdkuysdjeYGt4iOw55COvDV8uFE0W+9w9Og3SXSjRLBnF8YWPPLwOQfQZ8VGyAcxE3lH4Y2QwljNvaFk",
+	"7Ok3+uQ73zHM1IffngcfTvFQMm4pjO2plsstnjUJOR4rxIoZLp9+nizeIsBOENwr3LqBRxdOYQxvo+CH",
+	"B0BDYlQyEUlGIAYPZJQG4iZww3PEFSf+ufVmmHqxEdZCMUSZVVUWD5BUPuyY0at/TMcoMkUfdUFDRctL",
+	"GUeczLQbvrc9qamDjmCyU6qcYIQYICMJwZS3zRmrFO668GFQIVamIewYyJbCmyiGAj4zHTTTCtj/VjXL",
+	"uSTpr7bQXEdKE4+34egIE80pvMm1wRCUZJVqsPPoUX/hjx75PReGLeE6xA4+ejREx6NH9NI9V8be+wT0",
+	"SPPmVeJWIZVMZHmKkYSKl6O9fIzGnfRAjYZ+9TJMSIfJGKHcE/+h7B+iuEmFZRRwk1qp3zl6IXyG4vTW",
+	"wIitY8TI8X3fHSQafQNIKmYtqo/v9GGsWKQ1fn/lZo2Qes5xI19Jp7NfKu3eGFsvuqjl72w6wc1sXUua",
+	"JU0huvPUhgjJuNtsojmUTMvtA1wybiCmodJgiCXELzrjvqplHBvrKc9sjYXNUCniuv48IhK+CQLVgEqV",
+	"LIWEbKMkbJPpIISE7+ljqrdjSyOd6YIY69sXODvw98DqzjNlM++LX9rtiA2dN5G6DyFo9cbt6cPiqGB6",
+	"z0NZMc7yUtBrX0ljdZ3bd5LTeyIi14RJIrySxl+YL0KT9JM28eL0Q72TnDxSmldGUt28hIRU/A1AeGia",
+	"erVyJtlOAhGAd9K3EpLVUjhHuw3uV+Y2rAJNdoEj13LDt2yJ0a1WsV9BK7aobfe6p+BFY/G96pRzOA1T",
+	"y3eSWzR7Gsu+F6jsxuFCIF6gGQn2WunLBgsjblkA2Ybry/3hCN8AfO8a3s5nK5BghMnS/Pdb95XYsMfa",
+	"2rNk/L/vHNjUx743AuyiGIX81UsvQb96SWJSq80bwP7RVDwYxpukTXJCEJICu3skyT6XyjZ090WrF/TE",
+	"8k6ifcIqzGwgCm7vRkV9zjg4wu5Q9aimsxG9F3tY6/uUxXqlMjRn0+t1thJ2XS+OcrU5Di+H45VqXhHH",
+	"BYeNkvStOOaVODYV5MdXj/dIcfdgcyzB5W7nM8+szIP74/iBUwvqz9noysLfVrHPvv36LTv2O2U+o930",
+	"Q0fBi4nHnvvQNYbg4l16GeeJ9k6+ky9hKST5bZ6+k+gtcbzgRuTmuDag/+L8ho9Wip0yP+RLbvk7ObgZ",
+	"RqOkY/fkql6UImeXsE0dTZfVYzjCu3c/IYG8e/d+oFkf3retd/DwjLoJMnRUUbXNfNqCTMM11ylXSdOE",
+	"rdPI1HvnrHPmx6Yf/fjMj5/m8P2AufTyq6rE5XdCa6iT8/g2VunABIUJ0ND+vlbetqD5dch5URsw7JcN",
+	"r34S0r5n2bv65OQpsLOqakMaf/G8BmlyW/0G8ZG0cCeHwY3VPMMQEZNcvgVe0e7T/b4h80VZMuoW46Qx",
+	"79NQ7QICPsY3wMFxsMcfLe7C9Qr5p9JLoE+0hdQGuVOrVL7rfkUxc3ferj1xd7y2a8qAkFyVQRIPO9Ok",
+	"pXFBFF7Tj5omPAQ+g88CWL4GdMmlZCKwqex23unec0QNrEMYl3TH+VhRZgjSoGAynqrgXgbgctuPgzdg",
+	"bXDxewOXsH2r2sQShwS+d8OxzdhBJUqNLiMk1vjY+jH6m+8Nkwgpryq2KtXCn+6GLE4bugh9xg+yuyEf",
+	"4BCniGJfHBghgusEIqjDGArusFAc716kn1oeRTLkonLrnxaNdN7pg4Psu1yS1wm6PnVvjQFTTzIx1zhD",
+	"b6fkdgB+wf3AM9S324aZnDKSVnDEKHGjJ9xFSbJIYzL2oQyahK6wbLnaBVqaSkDL9lYPYHQxEosPa25C",
+	"8qliHh2YSRftbxivtystyavI5Bgl4mqSjgTG1j8M8yY3jsuJGZKThIwkIQ3JbH5QSpH5zHvBpLZDSZIy",
+	"Cihh5RbuGveizD4z0QYhHH9fLkshgWUp6yU3RuXChde1vNzPASiEPmLM6YXY5BFSZByBTUp2Gpi9VvHZ",
+	"lKtDgJQgSCvPw9ikno/+hv1K6ja7jxdv94qhQ97RHqI4AIK2cai8amIzz/tsLPlC6LRirskCBk+qFIky",
+	"IRPqnKHSyEAJdB1nHc6aXcI2LVUAkeFF6BY9G9jnYomX/BeRrUXDShgL7bsZT2vQH31c3QVGTGVLodGg",
+	"jU/25PKw0TeGhMFvsGma/XRQxVx2QzESPETTXsI2K0RZp3fbz/u3lzjt6+b9ZOrFJWzpksHYOLagbJxq",
+	"2Zse2+yY2lnwdy74O7fg7/iDrXcaLWFTnFgrZXtz/EGoqsdPdh2mBAGmiGO4a6Mo3cFe6O3zEkqbcneI",
+	"3mQuCLrAhke7tAaDw1SEsXeJXxEU45zXjZRcSy9UMSkeuBWg+MqMkKsyxE8mwyeHa1xq9SvIu8c6uv7Z",
+	"Ukhe7g5DdS1dHtR58O2mwTL6rQ3RKdU1GMtyDYUzbjv3uGBl98F/+L6SimF0D2iWr7lcweHRqu6bV9qM",
+	"RT/y1X3CQY2FsawkLoiVKBaKhs/5naN+4Y82mvawoNALC9Vex5POJkZRjg7y3aTpV5v0KvLfmjjXxXYk",
+	"CH0sALbneMO3peJFFqTs3fTm4oY2eGeEF3af4CKJPU051O6uO99D8gD6MPwk/P4YIBlDcuYPlqAz44zU",
+	"3H8NUVRDlNLPWSHQKpUmUfetIcPBeB9Xhlkoa9Vm18a7GDeEldQ3A/y4W72JczdkSrtSznyRpgKlxQqP",
+	"RjaBkTTx6S0JIpmFIcptg8I0s2nncs10eraePirIvG7qxGQtOPsfBR2aaFA+REMK2J20fDEaRj7gg81l",
+	"hizI3W4tB+xbfTfkdgaZEb+OmLjgpnIufe4xT9t9DQIDIyJmm96RJnhEaZO5TuOL6I3a9KXT6DbJBBp0",
+	"fzGr8PVEysEurR4ai49fdl5kdq3BYFDxfvhbYaLLMiWA0/ooXbhs2Xj+0W2klkUJjT5oHJ201bsTI7i5",
+	"DAHkBNzgrmChmrup3W1SQK6BI6ge8MMvyB+Vhbd4Vvbekj1CS1NGG/zfIDuseefZaMGYcECW9MxPiE/9",
+	"kzM8Lfe60dxK9N7wjUIYK2Ru+0q5dkOHt3GaWqaet5wbe8DAvZ2ldrNmdc20yR2LtM87nxaCvOq4LJiw",
+	"UYb5YYjXyMOUV5UobnqGNTdqGlc0hblDJtaBW9msGWwPBiIjWiqKQIOJk5rGiixXK0DGazuahJl+0tTo",
+	"lR5PJUzvPo8Qhe/NSecAA2b/BluiflrO7HY+u58dLoVrP+IeXJ8325vEMzmYOLtMx6x+IMp5hZnn3JWO",
+	"1sox0tTqypMmNQ/GzY8sEaZtYm+/Pvvu3IOPBqESuHb2652ronbVH2ZVGvDi2S0ekgo5GLScdjTa/CYj",
+	"RGzhvF6Dr1oQKViRi3nicsertV634wWL5zLtHrfXfukN7W6JOwzuUDX29tZMRZ17JnZ+xUUZ7EMB2jRL",
+	"cIublg87yRXiAe5tqo88LrIHZTeD050+HS117eFJ8Vw76ipsXOkQw5TsBwmgvgNncKSKb7EFeI+RIXOS",
+	"9SbD45eZUuRpW6JcGCQO6RwxsDGjxiMaYhyxFiN+PbIW0VjYbEqOqR6Q0RxJZIZszmO4WyivFaul+GcN",
+	"4X0vQDc5F6ODSpKhrxs0vE5RdhjO5QemPtHw95Ex4uzT/RuPgNgtYMRuHwNwXzZWoLDQxl+FyyBxHuo9",
+	"Fs84uBJ3eH55+vDU7Dx31133jbhE25D/IWG4ch7768OFV6XPCzUyR7Le2+htcTZ+U2DvA+6I9kogcOPL",
+	"YE6kykujEsPU8prLJimvx6HvbcAZ8rDXtdIUM20g6TorTNbqsgcbtcSNSsQxeVSSuEi9UxqgPhNtTKVt",
+	"Yb6A3xiOUdIek+Sij6zr3TdywonKI38WyuUUrM5cOrJ2paY6PqXpwxG1MMdu/PZweJgHypeSXy94fpkW",
+	"qBCms1ZT1bGPW8VC5zY7u1pGJHTEIiespq1wgcYV6DbYcEAMdxWO/lgkX0AuNsk0k+/e/VQQ9vvv8pVw",
+	"9bpqA1FBKD+QK3ToqMgX1XKa8xY1r5bsZB6VnPO7UYgrYcSiBGrx2LVArx5aW6OlDF1weSDt2lDzJxOa",
+	"r2tZaCjs2jjEGsUaAdapP4JDygLsNYBkJ9Tu8XP2ObniGHEFXyAWvSwyO338nHzF3R8nqcvOF+bbxVcK",
+	"YixB+5ymY/JFcmM4nRWNmlY1u2qq4yxsx2lyXaecJWrpud7+s7Thkq8g7WK52QOT60u7SZb8Hl5k4UoB",
+	"GqvVlhLwpeYHy5E/jcSLIPtzYDCnoCPDklXMqA3SU1tSyU0ahnN1Bd093MAVPpLfU5Musfdg/rgWD3eX",
+	"p1ZN3mmv+Qa6aKVCXxT0FSerdAzxiL0KGWYoC2CT/M/hBufCpZNIh1tIyc6EtPSIqu0y+xMaWjXPrY+M",
+	"T4KbLb56lsh82E12Jg8D/KPjXQNliUqiXo+QfZAmfF+MoJHZRiCr/6IN64pOZWpiMlEkp7WBo/cDDXYP",
+	"PVUAxVGyUXKrO+TGI059L8KTOwa8Jyk26zmIHg9e2UenzFqnyYPXuEM/vPnOSxkbpVP5xtrj7iUODVYL",
+	"uIJidJNwzHvuhS4n7cJ9oP+d89g2L4BGLAtnOfUQ+EstyuLHNky1Z5bXXObrpOPRAjv+3NY3bJbsznEy",
+	"vdWaSwllcjh3Z/4c7tbE7f8PNXWejZAT2/Ytzm65vcW1gHfBDECFCRG9wpY4QYzVbgBeE7GBwXyM5mlz",
+	"KbVUNsxzGyXIpHTKqTLQ9MEFO1mq8qi0z8/IQBYkVR+xb13p9DWwTrYVkmbFpi5d5g4oVqC9krWu0GFk",
+	"znAc1P4yN6vr40rcuvyQKxLmuqtIVi+ZnrYmqmOSio2aPs7uYA1ctbGUeclYvqlSYa/Y4m1owERPr0ti",
+	"XoydI/bSSdgmyG9uEqSHpdAblEyb0RyPJ5rA/1jrajBY1eEm4yQ/PbFpoEoTVZv1/88bSnTnDuH2uU1d",
+	"atM5o+JA18K4itlwBd1I2wBGW/jCRd52l6drl01fyENqBjWZ0g5Fe7dwg5I7IOsh/kDBxaha53BontcL",
+	"6pUiykHS2EEtV5cZpMms/X0oFMylkiInz5uoRncDsq++PcUuMiFxUbryjJn5E5o4XMlUtY3PvsfiaPLa",
+	"+ayDuKFiNvqKm+qow/1pqQYJKlxWYI3nbBgn49MRe32JkAZ8LjwkophPKt2xNY2UrcHSpo2a+0Ayori7",
+	"EQH4G/z22j+P8AiyS+EKBXi0Bc8z0mhQBV6L0pOwbKXA+PV00+uYn7DPEaWYKeDm/VGo2EtjOFMNLtvZ",
+	"JYdDnQUrpbcKYtsX2JaRWab9uRPj5yY9qyo/aYoTmGaHUwmVRxGcsDZlQd0fIbcZPx5tB7ntdC+g+xQJ",
+	"Da7IOAkV3cMDwmhyU/eSzKPyyFEUtWDO1z6FlHTJoe+EhLaedOKCyJNXAm0MndeRfibX6Lk6maehUbLx",
+	"0ukzNGO9iva+Q/U22FdMqfJZmGN8G9u02iOMo2nQCm7oExcOBVJ3JEy8oNL+HpHDJNkkVXkhqqBoql7a",
+	"7BTjQMYdEs53L4DhMRjKRK671TyHTt8JN9FYFHquUvLm1zeQU6wEw+/+eDOcPeYuSaoqhOHGwGaR8jN7",
+	"2XyMctHjFuOLF/9NZd8bR4m3iB8cKBHM39TxYIG1O9JA3ERiyjAe8m7b3PZ/0H0u1aoLyMdVKOw84zHJ",
+	"pE53J3172vkWC1nKHLxRKijLrhR5xZIN5VqxQiyXEITZjhtocP7E29fXdPHO1u6Up9wcw5zTyu6E1m3J",
+	"HbK6+zyUzveyV0bGQ4/xWV0f7I+vD5rgle5BxBdQKXKCnFY1mstvV/0y3K3+HkUIaL08717CbPQmm1q6",
+	"aeJKDeDzapKLfcqVOy087XbQPgTA3lFsiDouRJYqP+ZW5UFJHlqtlY6zCQ2SsTppqEn2Q3SvQnUh0nQ0",
+	"aSp6hSO5Y/cJO06TNfiuGYDnJK+NhPW9adPfcScSOsPgWHBfPhqLyq0PNLectbnmhtTj6rSkRnBOSPSd",
+	"9Z2QI6XomOOR8zvCz2zMhXn3Y2bwNKSxdyI0eLQNAfpbcJdlFRfe6t1es0PM+mjXYfzxFJfbdoP7i/Ax",
+	"pKMhU22OuVQd1Hqz4brh1UsAPNjLEEfQf5k1SRuE7H/zOXLPyrIdiWtgsFxCTmVE6aeQN20esugWTF1R",
+	"oU1VdsdbaVVXhooZRgn/klcadcDkZlkFmpzM0oxmCdAAMEwp5mb0oRxWYVrXTkQHTRQHYlbODyDgZDDU",
+	"Efsv0CpkAO7jigkjP7NsWZcjaQQ3UAg+ZVmuYW91GxWC+8bAG3P9wzTtLtZp/9QeEwdP7XQe15RdaCls",
+	"+3jH2X1AVoM9XpZjw5k9Y6TvcTfOvszWHReRg05BelalyiznFc+F3R40XZJ6UCIzbAFLsgf5umTGEWmI",
+	"6+zS6TQCGN6tidOVJs5x2hnBeR8pKfYV5YlP8S8X09PNTe/Mkk3lEF8A4mh69jsnIVLOfmpxxL5Rmqna",
+	"rhQi0s/m96b1iSoExyeihpJvQ1env3OxWrnaDLsD07BRFthaGXtaKZ2WnpoVukoIaZhrKW5IiU9c09d5",
+	"mHcLeLSzUwAmGLRWCrMeC7wU0lgucxhRAL6l2k2uibMV24A8Db4MR1HrQJFrLguz5pcwkubTgsy3Y1yO",
+	"m1o3VTutFhWtNajV3c5PqUEd3rg7Aqa7pzF0aKKi2/o7OOueWQxIO3kG4710do8dSHF3pHdvq8mtkffr",
+	"ooykBmhKPY8PH58QmsB16hS2cuaasQDiSgulhd1mu09iJwFI66XoIUBlL0oR19IlTWdYItapK5NE1ky6",
+	"K4YuNGriPnvhQmH2z0wavPS2Wcr9b/U2W9VjMVxNG/btD69e3uc0jZreiPRgpTw19NPRBqvbIWmS+uyp",
+	"Pcup49Y/HBFBN6Q33Kh2Rel7ol+xY/wd9xIsF6VpCs0lBAuyNfQv3pAYIdRDcWbTkNQMTPgtpGVys5Ti",
+	"EuJySmSkpthm3yKpdQ0K3WwkOqEf70fNmEgDvWxmFq1r7zDkbUixzpU7LxVetNmYx39PdgmuKJ8Z5zPk",
+	"ZD3QHq4laF9GDVvi2JBZtfvkODh2ocIXyr4LEsxo9QYH3GgyvDdttj96onBKfse9P1S8QLzkuZD+JbEz",
+	"K+A+ZL9w30OMVwju7WWAT4wb6HV/ne/g1C3MAIkx1S+Z1yzsjx27i7pbSOmKfZpUgj4JOgaOUpkVde7u",
+	"n/hgQDALTFa77WAlSSV1PlzlQHVRUsbV76JI3EvYHjv1gcvRY5qtjKF3xSrdGqKbu7fbD2oJSKtuypVb",
+	"wOpB4Pw9Ffn+1TFi+Xw1zDPYPwOXgrL24N0R3CFHagaxz0kV37i2XK+3oTxjVYGE4osjxs6kc0APXi7d",
+	"NPa9yVFlsGP+G5q1qF3qT6+uPHon0568dB3re/K3MMxuruZ0r/ecyg2yeyJ7MyLxYGbaYQWtqQXcE34n",
+	"/TxGLVE5KFJSyh3zr00rGDxQWSZIPw7S3aMrvuzoN12i5p6vidLwwHrOyMh+oJ5zGH48dXm0DuJqtYHh",
+	"OidvQAe3I7ifgvhWST9E7rhu3S6m6NbT+W6xOyn3HUKw0REjUNkvj39hGpagSSf66BFN8OjR3Df95Un3",
+	"cy2kffQoeTI/mlq/Uyfez5uimB/HHkjO/27EDba3H+gxu48wOk7NbbUUctv92bt//y71Wn52r9Cxp+NB",
+	"XgD9TSDEJNbamTyaKnJXnuCpPHyjhn2lyyav8eVIEfjhRSV+TqYbxeo0rlr+GjjeLk0cow+js+oSmhwO",
+	"q6Z1bcIr/FvFS4qxwrue/EIsmUK+vuFYJdoflD9/tvgPePqnZ8XJ08f/sfjTyZcnOTz78vnJCX/+jD9+",
+	"/vQxPPnTl89O4PHyq+eLJ8WTZ08Wz548++rL5/nTZ48Xz756/h+fzeYzgSA7QGchBmr2v8g0kp2dv8re",
+	"IrAtTngl/gZbV8YEyTgUSOE5nUR8k5Sz0/DT/x9OGJZ+aYcPv858iMVsbW1lTo+Pr6+vj+Iuxyt6o2VW",
+	"1fn6OMwzrM54/qpx/3Zhu7SjTlWEpHA0a0nhjL69+friLTs7f3U0i9Qas5Ojk6PHOL6qQPJKzE5nT+kn",
+	"Oj1r2vdjT2yz0w+389nxGnhp1/6PDVgt8vDJXPPVCvSRrxSDP109OQ7eo8cf/Pv0dte34+jaMJMbHn/o",
+	"vPeLPVOE/P37mhx/CIHYuweM6iNMg31QSWC8aVP+cJWyPzrX/8j3JcruS2HCcSlw7+Li/Pu7nhVkd+fe",
+	"JYHHBcTnzKqVU1m6ND9s06+8OBjHV8JoiqO76Uu+gNIFR0a/LkXZ1nciCCnfvVXILFrHcCUjhSuNQel+",
+	"PRR0BuJRiXdSHgcls0KYS98Q4aNw4Eb0B2847YE0Z8ZndmkOmufNIXp7IyQeKM/duPShTUoCM3W+bqcS",
+	"hsCxrhCRFRs4YlQlkdBNgXxNvUoy+EblrlFLQPrAPjIXLsmEYVaLaAqyBONFASa5NZp75TOXrq2nMzCn",
+	"MRU5Y0dTextuhGlCxyMgtuioYRhnz06eIWNpGBAWPu0kTD73pSSjZGWnP6Uq9aYqJhHLRn4UcdRGb9re",
+	"mFbXECfRau9/vNNPsufvP3z5p9uUSjb12OmfmwDHP2vQ2xaQ8G0cjIF58D22dtoQYrBPTk7uUXeVt6Ww",
+	"JkbdtCsbsUjjOW28iVo0+AR4aklP+PYe98d9xFSQLN6acpULW+8ebtSx9ZrrE7IL7G/XmQDXLSNkstnL",
+	"tITcecR+hzDhcR1jnzYnWKHb+mBt56jE686K8M8OJNCdT+yOf1iiOtz3vEQ8I0EENzntTyCB8vjjgfJK",
+	"Upp44vJOLCQInn08CF6rzr0U35Jz5v9qqHgft/5cqpb+e9eOIO0vegEWX+Aqv/yYW/5K4jHiJaOWbvqn",
+	"H2/6tw1fa6UDNH5SZFyMQGG8kpbu2iiPx/CU/iAvpbqWYUX4onJ+YvRestFd+5nxQlNI50mQBBEqFmro",
+	"lHNULv80q7S44hZm72/HJcdO1h1voItEzeAZOypbvvFyImdG8sqsVZs/ZZBmnZj26TA4b+BQ3c3P751M",
+	"hh7QbfrVKDGtXcOmSWXfDuYToLgZMKG+ey96x5GO25PYgKpdrrWurPIt2CiZNCf2fq9LeuBmMw2FCa9X",
+	"4EU6QIhs3SLkuvBOW36Jc/KhIadBr/ldwEq4IM1e+YZQ9WCK38eSyqFCjqLjNjsQNOzsbP3YioVhfmOQ",
+	"qdxHrkLRrDGRR1MueiQqpsFFjIaqEBquhKpNlCZ8OIvkVbXXmyTeIIpNZfjuVxumJMx90iRfY6xNI48N",
+	"exbCiQUoutgac+TDb2aXi79J1vto09G7IAYyTuNhvoNrvltDysrU+NDczcd/T7hB87kpIzFWLeJusQYH",
+	"hhkMZj0kRXvoPJ4eHQkPdUu7SAbbMCh5ZaCI3p0PcBD7sT+BdYzylOHJbU9ZHKXgCXgkXsFnTO8ufVTM",
+	"/b1ly/9OUtfZ4AL0OcN8LoH7iFdddXdTxyh57+6WqibqGMe1heTKa44/EEWO/n7sFNJZeC6nG3UEug/2",
+	"RhS3x70eJC3W1fGHVmy8degrwcKo/rCj7xMW3bo0JQ70JW5CxjJhWOf52FP4YK8XDoK9uh43EAsjJXQ8",
+	"nZnG9SvNq7zTPlL+kObn8fzxye2/NYqgx/Mvn95OTJjwohmXXTSv84kN3z+sGCnj5whtUhNIlShf4nYi",
+	"i8Kjen5QrkFvINYgY09aot7w/wrKg7/wgoVsOp84+h0Z6Jk7/B19ut/sNLOcz6pkOPkIc/FxFgcylwvs",
+	"9Ym5fCzmQpv0EMylO9ADM5cnBx7wP/6KP7HTPxo7vXDsbjo79aIcROkPzF4lne2lQ+gGlitpmFpQ6tAd",
+	"tRJjV8hOMSz3ImVfo96tmcSFz1kftj+IOnfaN+/0cQXaZcpVrmR2UgH3dWfBe1i8zyeJx4wyg3FLeZya",
+	"xNNtct6NkFn7kE+Y8JoGs51mu4GV8HuX7zny2w/AWOWN7GNTlmIj7Ow3tRIOH/e7ySEd4Dcgwml+lFGv",
+	"vW5O3SlSbG9+p8V84lj3fkHvQnIc/DbGwlzOyWPKb7ttH6kVNJXe0q/Sl8L4cCgTh+H54NTWULByAmYI",
+	"MT0L8XOuFYU1Ux7Xpv+c1bIEY5ggBWwtfQfyJRkypRYOjKHdx5L6Ma8URNsEnXnKpLWzUhg7xhumuDb0",
+	"RYkHlgTDMjZCCmMdQnZIRqMS0fmOYabKRZ/koX8VYzTt5mtl2TfBGv9HZG4xb+FtgHDiUbtX3sJTTBmr",
+	"6Uw3EbvCRPX0W9Zl1bxn6BQ6DjA2llthrMjT5knEvpk9uFAQ1hApTDtQ09qGR75h4RPDwZoMBPukATfw",
+	"Jw39b3Gd79zf0UucWhxXayVhodTlsQbMsEpEoNJJq/G7m5FoOu/mcwgZE/F7M6pzAxWyPUXkFFEIDblV",
+	"ejv3Qb9LDeTbiM1evr5gC6UsXi1Vf47hGXJwnYcZZ/+Nr8xPp+jwUxSTdUO2Uw5OlAciLe3+IKsQoeEE",
+	"2g4pH1F0I7p0dTOfOJ0pt6EdE4ZdQmXnbFHbVvh1dXIKxl3OU+fTlMjDMjwvBNYnufeT3PtJ7vVy77k7",
+	"yn9QBubZDJft8Sdy7/KA6dad8x1My0dPcNve544j8fKabw3bcEFxaR1ourxN2COP8OiDaeIjCq2qqmUv",
+	"Ud8KNBlNZA5so6TwuXO6zO38E2v7xNo+mTgeiLWc34mxeDFJw0oYCzrrpIHKsKpfJ2RwzMjs41DY50oz",
+	"DRKuv/BvBTdsL7vUJRULjN4ZLnTMaxK9K32USKf/hnCDnsdj/g22Zgoj+cUPn4niF6qUSfnjyY//F16W",
+	"0W+Ur9G3Nkd3j4CaFOi0BAh1O6ksl6kXG2GbHNQOj/6kxx5Iw7Ispl6twJC3NsAYL1wCdAwfDcE9Pjk5",
+	"mU8wu/gAewexz/GdlXAF5XCrx4DAzAWFKGufzv4Qq49zFzbBDRYBaFMDtkFRCaq7FmVJxjA89Ee74sky",
+	"nCCjdgdC91JhepNrLny0SLtfiDFX6yqkvHQmKl9VsLkHUkBJleGQKVjaUsYPG9Zmb14lfFQpVizyQ43X",
+	"h1GP+xOu0biTjEzR0G2BbDocxsXN397u4GpmXdtCXctxxkWF2HnpX2hkiG1CTa1iYYDWOMf+7rNkl9sQ",
+	"rMN440/esB/sHBLg9GQws/bPwpWQNAGdcprFWU55ZMyMnHl7njgesteqgCHfS9GPhzF97lOH/r60NBQr",
+	"du5VSI7a+fsYSR79b3w6VsLQ0JHTAi+Pfa2h3q+uIkj0YzdbbuLX46YKfvJjP4Yn9dU7ho406n5sc1TE",
+	"OR9oG5tsDz+9x90gy5/f4TaFwenxMeXuWitjj2e38/ib6X1832zAh0AWYSNu39/+vwEAGg8AcBDwAAA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -20,18 +20,39 @@ type ServerInterface interface {
 	// Get account information.
 	// (GET /v2/accounts/{address})
 	AccountInformation(ctx echo.Context, address string, params AccountInformationParams) error
+	// Get the application local states of an account.
+	// (GET /v2/accounts/{address}/applications)
+	AccountApplicationsInformation(ctx echo.Context, address string, params AccountApplicationsInformationParams) error
+	// Get an account's local state and parameters of an application.
+	// (GET /v2/accounts/{address}/applications/{application-id})
+	AccountApplicationInformation(ctx echo.Context, address string, applicationId uint64, params AccountApplicationInformationParams) error
+	// Get the asset holdings of an account.
+	// (GET /v2/accounts/{address}/assets)
+	AccountAssetsInformation(ctx echo.Context, address string, params AccountAssetsInformationParams) error
+	// Get an account's holding and parameters of an asset.
+	// (GET /v2/accounts/{address}/assets/{asset-id})
+	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
+	// Get the applications created by an account.
+	// (GET /v2/accounts/{address}/created-applications)
+	AccountCreatedApplications(ctx echo.Context, address string, params AccountCreatedApplicationsParams) error
+	// Get the assets created by an account.
+	// (GET /v2/accounts/{address}/created-assets)
+	AccountCreatedAssets(ctx echo.Context, address string, params AccountCreatedAssetsParams) error
 	// Get a list of unconfirmed transactions currently in the transaction pool by address.
 	// (GET /v2/accounts/{address}/transactions/pending)
 	GetPendingTransactionsByAddress(ctx echo.Context, address string, params GetPendingTransactionsByAddressParams) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
-	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	GetApplicationByID(ctx echo.Context, applicationId uint64, params GetApplicationByIDParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
-	GetAssetByID(ctx echo.Context, assetId uint64) error
+	GetAssetByID(ctx echo.Context, assetId uint64, params GetAssetByIDParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
+	// Get the compact cert covering a round, along with the block headers leading to it.
+	// (GET /v2/blocks/{round}/header-proof)
+	GetBlockHeaderProof(ctx echo.Context, round uint64) error
 	// Get a Merkle proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetProof(ctx echo.Context, round uint64, txid string, params GetProofParams) error
@@ -62,6 +83,9 @@ type ServerInterface interface {
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Get a recently confirmed transaction.
+	// (GET /v2/transactions/{txid})
+	ConfirmedTransactionInformation(ctx echo.Context, txid string, params ConfirmedTransactionInformationParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -73,8 +97,10 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty": true,
-		"format": true,
+		"pretty":  true,
+		"format":  true,
+		"round":   true,
+		"exclude": true,
 	}
 
 	// Check for unknown query parameters.
@@ -107,11 +133,349 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+	if paramValue := ctx.QueryParam("exclude"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude", ctx.QueryParams(), &params.Exclude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
 }
 
+// AccountApplicationsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountApplicationsInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountApplicationsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountApplicationsInformation(ctx, address, params)
+	return err
+}
+
+// AccountApplicationInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"round":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountApplicationInformationParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
+	return err
+}
+
+// AccountAssetsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAssetsInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAssetsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountAssetsInformation(ctx, address, params)
+	return err
+}
+
+// AccountAssetInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"round":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAssetInformationParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
+	return err
+}
+
+// AccountCreatedApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedApplications(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedApplicationsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedApplications(ctx, address, params)
+	return err
+}
+
+// AccountCreatedAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedAssets(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedAssetsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedAssets(ctx, address, params)
+	return err
+}
+
 // GetPendingTransactionsByAddress converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Context) error {
 
@@ -171,6 +535,7 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -191,8 +556,20 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
 	ctx.Set("api_key.Scopes", []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationByIDParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetApplicationByID(ctx, applicationId, params)
 	return err
 }
 
@@ -201,6 +578,7 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -221,8 +599,20 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 
 	ctx.Set("api_key.Scopes", []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetByIDParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetAssetByID(ctx, assetId)
+	err = w.Handler.GetAssetByID(ctx, assetId, params)
 	return err
 }
 
@@ -269,6 +659,36 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	return err
 }
 
+// GetBlockHeaderProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockHeaderProof(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockHeaderProof(ctx, round)
+	return err
+}
+
 // GetProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 
@@ -578,6 +998,49 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 	return err
 }
 
+// ConfirmedTransactionInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmedTransactionInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameter("simple", false, "txid", ctx.Param("txid"), &txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfirmedTransactionInformationParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ConfirmedTransactionInformation(ctx, txid, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -596,10 +1059,17 @@ func RegisterHandlers(router interface {
 	}
 
 	router.GET("/v2/accounts/:address", wrapper.AccountInformation, m...)
+	router.GET("/v2/accounts/:address/applications", wrapper.AccountApplicationsInformation, m...)
+	router.GET("/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
+	router.GET("/v2/accounts/:address/assets", wrapper.AccountAssetsInformation, m...)
+	router.GET("/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET("/v2/accounts/:address/created-applications", wrapper.AccountCreatedApplications, m...)
+	router.GET("/v2/accounts/:address/created-assets", wrapper.AccountCreatedAssets, m...)
 	router.GET("/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.GET("/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
 	router.GET("/v2/blocks/:round", wrapper.GetBlock, m...)
+	router.GET("/v2/blocks/:round/header-proof", wrapper.GetBlockHeaderProof, m...)
 	router.GET("/v2/blocks/:round/transactions/:txid/proof", wrapper.GetProof, m...)
 	router.GET("/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET("/v2/status", wrapper.GetStatus, m...)
@@ -610,179 +1080,245 @@ func RegisterHandlers(router interface {
 	router.GET("/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.GET("/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET("/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.GET("/v2/transactions/:txid", wrapper.ConfirmedTransactionInformation, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3fbtrIo/lXw0zlrNckRJefV3XitrvNz47T13UmaFbs9+544t4XIkYRtEuAGQFtq",
-	"rr/7XRgAJEiCkvzIq8d/JRbxGAwGg3lh5sMoFUUpOHCtRvsfRiWVtAANEv+iaSoqrhOWmb8yUKlkpWaC",
-	"j/b9N6K0ZHwxGo+Y+bWkejkajzgtoGlj+o9HEv5VMQnZaF/LCsYjlS6hoGZgvS5N63qkVbIQiRviwA5x",
-	"dDi63PCBZpkEpfpQ/sLzNWE8zasMiJaUK5qaT4pcML0keskUcZ0J40RwIGJO9LLVmMwZ5Jma+EX+qwK5",
-	"DlbpJh9e0mUDYiJFDn04n4tixjh4qKAGqt4QogXJYI6NllQTM4OB1TfUgiigMl2SuZBbQLVAhPACr4rR",
-	"/ruRAp6BxN1KgZ3jf+cS4E9INJUL0KP349ji5hpkolkRWdqRw74EVeVaEWyLa1ywc+DE9JqQV5XSZAaE",
-	"cvL2x+fk8ePHz8xCCqo1ZI7IBlfVzB6uyXYf7Y8yqsF/7tMazRdCUp4ldfu3Pz7H+Y/dAndtRZWC+GE5",
-	"MF/I0eHQAnzHCAkxrmGB+9CiftMjciian2cwFxJ23BPb+FY3JZz/s+5KSnW6LAXjOrIvBL8S+znKw4Lu",
-	"m3hYDUCrfWkwJc2g7/aSZ+8/PBw/3Lv8t3cHyX+7P58+vtxx+c/rcbdgINowraQEnq6ThQSKp2VJeR8f",
-	"bx09qKWo8ows6TluPi2Q1bu+xPS1rPOc5pWhE5ZKcZAvhCLUkVEGc1rlmviJScVzw6bMaI7aCVOklOKc",
-	"ZZCNDfe9WLJ0SVKq7BDYjlywPDc0WCnIhmgtvroNh+kyRImB61r4wAV9ucho1rUFE7BCbpCkuVCQaLHl",
-	"evI3DuUZCS+U5q5SV7usyMkSCE5uPtjLFnHHDU3n+Zpo3NeMUEUo8VfTmLA5WYuKXODm5OwM+7vVGKwV",
-	"xCANN6d1j5rDO4S+HjIiyJsJkQPliDx/7voo43O2qCQocrEEvXR3ngRVCq6AiNk/IdVm2//X8S+viZDk",
-	"FShFF/CGpmcEeCqy4T12k8Zu8H8qYTa8UIuSpmfx6zpnBYuA/IquWFEVhFfFDKTZL38/aEEk6EryIYDs",
-	"iFvorKCr/qQnsuIpbm4zbUtQM6TEVJnT9YQczUlBV9/vjR04itA8JyXwjPEF0Ss+KKSZubeDl0hR8WwH",
-	"GUabDQtuTVVCyuYMMlKPsgESN802eBi/GjyNZBWA4wcZBKeeZQs4HFYRmjFH13whJV1AQDIT8qvjXPhV",
-	"izPgNYMjszV+KiWcM1GputMAjDj1ZvGaCw1JKWHOIjR27NBhuIdt49hr4QScVHBNGYfMcF4EWmiwnGgQ",
-	"pmDCzcpM/4qeUQXfPhm6wJuvO+7+XHR3feOO77Tb2CixRzJyL5qv7sDGxaZW/x2Uv3BuxRaJ/bm3kWxx",
-	"Yq6SOcvxmvmn2T+PhkohE2ghwl88ii041ZWE/VP+wPxFEnKsKc+ozMwvhf3pVZVrdswW5qfc/vRSLFh6",
-	"zBYDyKxhjWpT2K2w/5jx4uxYr6JKw0shzqoyXFDa0kpna3J0OLTJdsyrEuZBrcqGWsXJymsaV+2hV/VG",
-	"DgA5iLuSmoZnsJZgoKXpHP9ZzZGe6Fz+af4pyzyGU0PA7qJFo4AzFrx1v5mfzJEHqxOYUVhKDVKneH3u",
-	"fwgA+ncJ89H+6N+mjaVkar+qqRvXzHg5Hh0049z+TE1Pu76OItN8Jozb3cGmY6sT3j48ZtQoJCiodmD4",
-	"IRfp2bVgKKUoQWpm93FmxumfFByeLIFmIElGNZ00SpWVswboHTv+jP1QSwIZueJ+wf/QnJjP5hRS7cU3",
-	"I7oyZYQ4ERiaMiPx2XvEzmQaoCQqSGGFPGKEsytB+byZ3DLomqO+c2h53x0tsjsvrFxJsIdfhFl6ozUe",
-	"zIS8Hr10CIGTRhcm1IxaS79m5e2dxaZVmTj8RORp26AzUGN+7LPVEEPd4WO4amHhWNOPgAVlRr0NLLQH",
-	"um0siKJkOdzCeV1Stewvwgg4jx+R458Pnj589Pujp9+aG7qUYiFpQWZrDYrcc/cKUXqdw/3+ypDBV7mO",
-	"j/7tE69BtcfdiiEEuB57lxN1AoYzWIwRay8w0B3Ktaz4LaAQpBQyIvMi6WiRijw5B6mYiJgv3rgWxLUw",
-	"fMjK3Z3fLbTkgipi5kZ1rOIZyEkM80bPwitdQ6G2XRR26JMVb3DjBqRS0nVvB+x6I6tz8+6yJ23ke+le",
-	"kRJkolecZDCrFuEdReZSFISSDDsiQ3wtMjjWVFfqFrhAM1gDjNmIEAQ6E5UmlHCRmQNtGsf5w4AtE40o",
-	"aPvRIcvRS3v/zMBIxymtFktNjFgpYlvbdExoajclwbtCDah+tc5uW9nprJ0sl0CzNZkBcCJmTr9ymh8u",
-	"kqJZRnuPi+NODVi1TtCCq5QiBaUgS5x7aStovp3dZb0BTwg4AlzPQpQgcyqvCawWmuZbAMU2MXBrccIp",
-	"pX2od5t+0wZ2Jw+3kUqjY1oqMLKLOd05aBhC4Y44OQeJytlH3T8/yXW3ryoHXCfuBj5hhTm+hFMuFKSC",
-	"Zyo6WE6VTrYdW9OoJSaYFQQnJXZSceABA8FLqrRV0RnPUGS07AbnwT44xTDAgzeKGfk3f5n0x04Nn+Sq",
-	"UvXNoqqyFFJDFlsDh9WGuV7Dqp5LzIOx6+tLC1Ip2DbyEJaC8R2y7Eosgqh2NqLahtVfHJrjzT2wjqKy",
-	"BUSDiE2AHPtWAXZD8/EAIEa/qHsi4TDVoZzaZj0eKS3K0pw/nVS87jeEpmPb+kD/2rTtExfVDV/PBJjZ",
-	"tYfJQX5hMWsdB0tqZDscmRT0zNxNKKlZW0IfZnMYE8V4CskmyjfH8ti0Co/AlkM6ICQ712QwW+dwdOg3",
-	"SnSDRLBlF4YWPCCxv7EW8JPAbn4LUktkVENplBMU3bxdzVwOYRNY0VTna8Ny9RLW5AIkEFXNCqa1dWm0",
-	"hRotyiQcIKpEbZjRqbHWeuxF0l306mMcKlheXzgdj+wVuhm+k84l2kKHu7xLIfLJdurrISMKwS5C8AEp",
-	"hdl15jxo3s2SM6V7QLoLFW0Y9UH+RrXQjCsg/1tUJKUchYFKQ82dhMQjj1eBmcEw03pOZm/dBkOQQwFW",
-	"xsEvDx50F/7ggdtzpsgcLrzb2TTsouPBA5TY3wilb3wCOqS5OoowGVQtDceKhAoZBXKyVc3EcXfSLoOh",
-	"jw79hHiYlOEoduFSiPktrJZlq5izIYNVbKVu51Bg/MZIV2sFehK9CEsDYMTfCPIsR21UzDsUSQowpKKW",
-	"rDRDNr6RtYZWXMX/ufef++8Okv+myZ97ybP/mL7/8OTy/oPej48uv//+/7Z/enz5/f3//PeY8KA0m8Ut",
-	"Fz9TtTSQOs6x4kfc2h7nQlqRc+1uMjH/1HB3SMxspsd8sKRdiO5NbEMYJ9RuNtKcEVTy9S1cMnYgIqGU",
-	"oJAlhAK+sl/FPAyrcJSn1kpD0deRbdffBySEt/5+7VGp4DnjkBSCwzoaScg4vMKPsd6WLQ10xgtiqG9X",
-	"/mjB3wGrPc8um3lT/OJuB2zoTR3kcQub3x23Yx4JA0pQvYO8JJSkOUPlT3ClZZXqU05RvAzINWJa9ULz",
-	"sMLx3DeJazgRBcQNdcqpMjishc6o2WwOEXXyRwCvd6hqsQClO8LNHOCUu1aMk4ozjXMVZr8Su2ElSLRv",
-	"TmzLgq7JnOaoH/0JUpBZpdvXPfq9lTbqi7XVmGmImJ9yqkkORpV7xfjJCofz7mVPMxz0hZBnNRbiPH8B",
-	"HBRTSZyR/mS/Ij91y1863opBiPaz5zef+gLwsMe8sg7yo0MnCh8dorzTWGl6sH8y1b1gPIkS2ckSSME4",
-	"Bvd0aIvcM1KbJ6D7jb3H7fop1ytuCOmc5iyj+nrk0GVxvbNoT0eHalob0dHE/Frfx1xoC5GUND1DD8po",
-	"wfSymk1SUUy9CjBdiFodmGYUCsHxWzalJZuqEtLp+cMt4tgN+BWJsKvL8chxHXXrvlo3cGxB3TlrG4j/",
-	"WwvyzU8vTsjU7ZT6xoZo2KED33pEa3MvBFpGbrN4G2JsY1RO+Sk/hDnjzHzfP+UZ1XQ6o4qlalopkD/Q",
-	"nPIUJgtB9okb8pBqesp7LH7wFQAGUDpoymqWs5SchVdxczRtZGd/hNPTd4ZATk/f9yym/YvTTRU9o3aC",
-	"5ILppah04kLXEgkXVGYR0FUduoQj28DTTbOOiRvbUqQLjXPjx1k1LUuV5CKleaI01RBfflnmZvkBGSqC",
-	"ndDjTpQW0jNBwxktNLi/r4WzGUt64eMeKwWK/FHQ8h3j+j1JTqu9vcdADsrypRnz2MDxh+M1hibXJbT0",
-	"+x1jJZrBYro9LtwKVLDSkiYlXYCKLl8DLXH38aIu0Cyd5wS7hTip/Y04VLMAj4/hDbBwXDkaBBd3bHv5",
-	"NwjxJeAn3EJsY7hTYyy87n6ZoX4WuSGya29XMEZ0lyq9TMzZjq5KGRL3O1OHJi8MT/YWXMUW3BwCF8U9",
-	"A5IuIT2DDANKoSj1etzq7p0E7obzrIMpG3htgz4wOhBNITMgVZlRJwNQvu6GaSnQ2semvYUzWJ+IJrjw",
-	"KnFZl+NRakOhE0MzQwcVKTW4jAyxhsfWjdHdfOdwMpDSsiSLXMzc6a7JYr+mC99n+CDbG/IWDnGMKGo0",
-	"bKD3ksoIIizxD6DgGgs1492I9GPLK6nULGWlXf9uUWhvWn3MINsul+h1IubdW6PH1KNMzDZOZlTFLxAw",
-	"X8x+mDPU9cf5maxVEVcwIfh4zxHuLEdZpHYF2pNNJQpdftn2NdIQaHEqAcmbW92D0cZIKD4sqfIPEPCd",
-	"hj8wO120Q06L2ulkqMh7nVDfayQnZubN4ZwO4X84avYocCUFjzHqmFjP2LqHYVzHR9t3kT521gfM+ijZ",
-	"0fhKEa/jkYtuiG2H4ChlZJDDwi7cNvaE4kD7RgUbZOD4ZT7PGQeSxLxSVCmRMvuCpOHlbg4wQugDQqyB",
-	"h+w8QoyMA7DRWo4Dk9ciPJt8cRUgOTA0r1M/NtrZg79hu7W5eaDqxNutYmifdzSHaNwEkNtt7FuhxqMo",
-	"SxrSEFqtiG0yg55KFSNRw5r6dpm+9UdBDngdJy3OmpzFrHVGqgAkw2PfLVAbyD02N5f8/cBpImHBlIZG",
-	"bzan1RuCPq3t4lxoSOZMKp2gyh5dnmn0o0Jh8EfTNM5+Wqgi9oUby+LcB6c9g3WSsbyK77ab9++HZtrX",
-	"tf6kqtkZrPGSAZouyQxfZJpbqDW9abNhauuZ3bjgl3bBL+mtrXc3WjJNzcRSCN2Z4yuhqg4/2XSYIgQY",
-	"I47+rg2idAN7Qd3nEHIdC7wNdDLUag3DtJHhg1aD3mHK/NibxK8AimHOa0eKriUQdDeugqEnjvKMMB08",
-	"aOxHCQ6cAVqWLFt1dHg76oDbDgX4KwjqVuKPuKJG9WBbMBDo67FAFAne5mC3NLgz7dNUHq5tshNmjPQV",
-	"IiRgCOFUTPnECn1EGdLG17/bcHUCNP87rH8zbXE5o8vx6GYqfwzXbsQtuH5Tb28Uz2jLtipgy4J3RZTT",
-	"spTinOaJM4wMkaYU5440sbm3o3xiVhdXv09eHLx848A3umcOVFpT2cZVYbvyq1mV0YiFHDgg/uG2kVa9",
-	"7mwFsWDz69cwoTHlYgnukWwgyxku5ojLHq/GUBYcRWdcmcddaltNJc6mZ5e4wbYHZW3aazRia9lrW/Po",
-	"OWW5V0U9tAPuL1xcY0+9MlcIB7ixVTAw7ia3ym56pzt+Ohrq2sKTwrk2POMt7Et1RQTvBhYZERI1XCTV",
-	"gq4NBVnjdJ858apIzPFLVM7SuNmCz5QhDm5tvqYxwcYDwqgZsWIDLgResWAs00zt4C3rABnMEUUmmpQ2",
-	"4G4mXIqhirN/VUBYBlybTxJPZeegmnPp01T0r1MjO/TncgPblBXN8DeRMcxQQ9IFArFZwAgtzD1wD2uF",
-	"0y+0No2bHwLD4BUcVeGMvStxg5PJ0YejZuvtX7YtxWFGoD7/M4RhX49vT0fkzRZLC+jAHNH0QoO3xcHw",
-	"TWF6X+GOaK4EBDe8DMY2+UiuRGSYil9QbrOFmH4Wh663AmszML0uhMSwewVRLz1TyVyKPyGuyc7NRkVi",
-	"Hx0qUVzE3pNIOHOXidZWmSYPlMdvCMcgaQ9JcsFH0nYkDpxwpPLAdI7vWL2Bi3JL1jazSct9HT8cYcjJ",
-	"1I7fHA4Hcy9MJ6cXMxp75GsEKgPTQeOkaZnitCC+s98FZzVsaC/w99RtmY1VL0E2Acr9d1HXFI6+LpLP",
-	"IGUFzeNSUobYb7/MydiC2fQwlYIg/4gbyObVslTkcrhYN1iDmqM52RsHGY7cbmTsnCk2ywFbPLQtZlTh",
-	"rVWbW+suZnnA9VJh80c7NF9WPJOQ6aWyiFWC1AIsqnK17XsG+gKAkz1s9/AZuYdWf8XO4b7BopNFRvsP",
-	"n2FYiv1jL3bZuTxQm/hKhozlvxxjidMxuj3sGOaScqNOou8mbPK+YRa24TTZrrucJWzpuN72s1RQThcQ",
-	"9+YWW2CyfXE30WjYwQvPbOYppaVYE6bj84Omhj8NhKYZ9mfBIKkoCqYLc4C0IEoUhp6a5CJ2Uj+cTWPl",
-	"Hvx7uPxHdLGUVm2ArsL8aQ3E9i6PrRodYa9pAW20jgm1z4ty1jzgdAxxQo78I0XMgFAnPrC4MXOZpaNI",
-	"Z7YQH3ozrlGJqvQ8+Y6kSyppatjfZAjcZPbtk0jWh/ZDb341wD853iUokOdx1MsBsvfShOtL7nHBk8Jw",
-	"lOx+EwoanMroc22haR4PavEcvRvTtHnoXQVQM0oySG5Vi9xowKlvRHh8w4A3JMV6PVeixyuv7JNTZiXj",
-	"5EErs0O/vn3ppIxCyNiT9ea4O4lDgpYMzjG+Jr5JZswb7oXMd9qFm0D/eb0sjQZQi2X+LMcUgR8qlme/",
-	"NaHtncQ5kvJ0GfVxzEzH35tMX/WS7TmOvpBeUs4hjw5n78zf/d0auf3/KXadp2B8x7bdhDh2uZ3FNYC3",
-	"wfRA+QkNepnOzQQhVtuxvnVwWL4QGcF5mue4DZX1c/wEyUH+VYHSsayj+MHGVaIty+gFNjcFAZ6hVD0h",
-	"P9lMvUsgrReaKM2yosrtaz/IFiCdkbUqc0GzMTHjnLw4eEnsrLaPzahoc2MsUJhrr6Jjwwje7u8W6uRT",
-	"ZcXDMHcfZ3NcmFm10vh4V2lalLEIe9PixDfAMP7QrotiXoidCTm0Erby8pudxNDDnMnCSKb1aJbHI02Y",
-	"/2hN0yWKri1uMkzyuyd18VSpguSGdZ64+vk9njsDt8vrYtO6jIkw+sUFUzZBK5xDO6i/fuHiVCcf5N9e",
-	"nqw4t5QS5dGbXmBdB+0eOOu896bfKGQdxF9RcFGikilcNcfNMfaKviHuJszpZTW0rwnrrGI+8XZKueAs",
-	"xRe8QUrYGmSX7HUXv8gOj527Zil/xN0JjRyuaJqeOjzIYXEwcY9nhA5xfcNs8NVsqqUO+6fGrKJLqskC",
-	"tHKcDbKxT8Xk7CWMK3DpFDDvb8AnhWz5mpBDRt2XSW3mviIZYYjvgAD8o/n22qlHGJZ3xjgKQg5tLgLQ",
-	"WjQwF6U20hPTZCFAufW0n+Sqd6bPBJ+lZrB6P/G5K3EM66oxy7Z+yf5QB95L6byCpu1z05agW6b5uRVO",
-	"bCc9KEs3afRFbb3DsWRSgwiOeJsSb+4PkFuPH462gdw2hhfgfWoIDc7ROQkl3sM9wqjzcnUS7J3TvLIU",
-	"hS2IDeuJPgNjPALGS8ahyawauSDS6JWAG4PndaCfSiXVVgTciaedAM3RIxljaEo7E+1Nh+psMKIE1+jn",
-	"GN7GJqXYAOOoGzSCG+XrOqGroe5AmHiOmaQdIvsJwlCqckJUhoGbnZRhMcZhGLdPtte+APrHoC8T2e5a",
-	"UntyrnITDT14SUVM3nyxgrSyDndhc0PQsiQpviAN7ouoRZMpozwVszwS+3ZYfwzy8GGQ7WyN/8Yydgyj",
-	"xHnErxyT5d3f2PHKAmt7pJ64aYgpUWxxzW1u+t/qPudi0Qbk0xoUNp7xkGRip/uFYZvhG8heLhjLWOsn",
-	"ihiGJHySVlSa6sc17TOJjDyqlDb5Njcr5cOZM8fI+geCEd82r++pvV2sj2EoJDEdjKCl2oXHa0qap+79",
-	"g2nTXcZGsPEMNs2mLVkRta8MxTDYEAbzudd7N7moJ2Xi2BsR6oNj+gD93UfekZIy50BrTmwfsy5Gtx81",
-	"vUv0XrPB3UW4yFccJLaSfialYQI/BE1Zrup8kHV1g8DfauS5bj6WC/cyBUOHa9XUv1EB5X/zUfZ2Fls1",
-	"o8l6hoaACyoz3yJ6s/lLMxmIAOnGVNrQVRYHel7PzBr3aT+sMPJsEt3laS4U44tkKKqi7bGszX3fKGuX",
-	"RR0CU1QhXHOQLtuh9kVJEi28u3UTHJtQ4XJiXwcJajCrjgVu8G3T2+bxFuaKoLYkjbM5hwskEgpqoJPB",
-	"E6vhOTch+7n97uPofK6ATmaOyLieXpOtb6S845ypHhJDqp8Tx3K3x+ddR6RgnNtksir23oobVIbKZilF",
-	"VqXW1h8eDPCi185PBjewkqggkPZX2ePpOT6gfRlEO5/Bemr5arqkvHnJ3D7WNqesXUPwNqez27cqbcXv",
-	"tHxhF7C4FTg/p7A0HpVC5MmAdnnUfzbWPQNnLD2DjJi7w7ucBnK5kXuo1NTmw4vl2mdRLUvgkN2fEGLE",
-	"raLUa29JbGcl6UzOv9Gb5l/hrFllX3I6OW5yyuPeUlvk6Yb8zQ+zmavZqoc3nMoOsnkiveIDrI1eRDIb",
-	"7logIGLb6wgoAVFZKGJSyjWf0+x0vvuyXIT0w0DoLUL0WUvws+/uO/Y8IeGWBcDAkHFFAbAf4r3r8nAd",
-	"yNUqBf117rwBLdwO4H4XxDfaSx+5w0qHnu2idMSfL5vuqPVYhOADe4Kgkj8e/kEkzF3FuQcPcIIHD8au",
-	"6R+P2p+NCvLgQfRkfjJ9p1WHwM0bo5jfhvw/1scx4Grs7EfF8mwbYbQcx03yK3SN/u5c7J8l/dbvNnS5",
-	"f1RdJqKrWFq6m4CIiay1NXkwVeAS3sEb7LpFfL942aSVZHqNrxy8RsV+j74e/Qm4q8bgitvUsaIuVNHW",
-	"VXORC4u6dVMK6ydhy1MU5q5H25vGNK4vVrQoc3AH5ftvZn+Dx989yfYeP/zb7Lu9p3spPHn6bG+PPntC",
-	"Hz57/BAefff0yR48nH/7bPYoe/Tk0ezJoyffPn2WPn7ycPbk22d/+8bXobKANjWe/oE56pKDN0fJiQG2",
-	"wQkt2d9hbbNSGTL2+a5oiifR6CT5aN//9P/7EzZJRRGUznW/jlwYy2ipdan2p9OLi4tJ2GW6QB0t0aJK",
-	"l1M/Tz9r7puj2sVuQ6NxR6331JACbqojhQP89vbF8Qk5eHM0aQhmtD/am+xNHmJayRI4Ldlof/QYf8LT",
-	"s8R9nzpiG+1/uByPpkuguV66PwrQkqX+k7qgiwXIiUv8ZX46fzT1HrrpB6efXppRF7H3HzZYIPAQ9/Nh",
-	"ja20hnZfX14xSLmgXCaGMZnZlw7EiY88Qx+uVfkMa6uRdZQFhbqDilDjVp3xd19R6cxYZupYYrFYMfT6",
-	"LfBwMbygXrCvEfz0u8tIqND7ToGzR3t7H6Go2bg1isfLNaujPblFENs24hsD2h2uxxVe0dzQDdQFb0e4",
-	"oIdf7YKOOL66N2yLWLZ8OR49/Yp36Iibg0Nzgi2DYPs+K/yVn3FxwX1LcyVXRUHlGi/cIN1XKFpdDrLc",
-	"9jMXZ60d5sMQ5EgPUi21rEWztaezMVF1UYdSMmEEBywPnUEqgeI1LyRG9DTZ1p1lAGwVi1cH/0B78auD",
-	"f5DvyVDp3GB6q5G3mfhPoCPVAH5YN+UfN3L0z8Umx19steGv58676VVzV1Piq60psQPTvtvdu4ohX23F",
-	"kK9bJF3VTxQp4YInHFPPnQMJzFp3MuoXLaM+3Xv81a7mGOQ5S4GcQFEKSSXL1+RXXsd030wEr3lOxYMo",
-	"+438p+feaqToQHwP0uBOP7QiGbLtxpNWSEM2Jkw3kmG8AHeQIdS95xk3yYAoz2wsrg+OU2OfFAetddYf",
-	"a/dj3EuZM4kJ6YGb5of10eEucnlrTUGujphs3sLX1cr6f1SLxbWLo3/MG6AHxw80I/7Rz0fmzbsx0yd7",
-	"Tz4dBOEuvBaa/IiBHh+ZpX9UO0GcrAJmg6mmpx98Wo8dGIxLmdNmLd2K+jGmYk7o2L3jdUV9au++4SeW",
-	"EdqsRX2uYWbYlV/0s/rEOEWTyeRL4RE21XaELrvoveMLd3zhRnyhS1ANR7DVlacfMJItZAe9I4ll5f5C",
-	"jpIgx7kUhU+yKcgcdLq0tZK6vuwIW/FPy4Z5yqYELDfmLx3vOm5R/wE6rsX5azExyI5RPNjxZ+s+vRyP",
-	"UpAR4vvFx7mbz2yOsVj1s0GfZwgf29fVw+tX9y43CVPEEKgWxEWzE7OLV4LyeTN537eOaLmeNekOwTdB",
-	"cI+pvXBJEOzxcov42g0fwW1JEvIaxSE84P7V3F/R7PExb+SPvaDXggOBFVNY+8DS4p27sRYX6iK6dehy",
-	"WB9tQHRoOx0/6BXLLqd1md0hoeKNqwa7UahobmrGgxL4oXmFliVQqa59SW93h510Zjw6DJP1izrUidCm",
-	"2G4EFIOXK3oS/2MXN+Jf11t3VxH6riL09SpCf1KVuQnIsazK+4lkh2t8Vn1afxZ9+rXgCd62wLWX/Fpo",
-	"+Xy6NT5AaFXN8mlmuLC1qIVEISHkA2qy0/UKg66EFlPBkM5hMnaXbUp1uqzK6Qf8DwaDXjZhlzan0tSa",
-	"2Tbdt7b29uhWAyju6qV/BfXSP78J70biaGe1Eso6CA299Uj/zWnxdYr6xXvakcmuuVpWOhMXQRxzUw9u",
-	"8CTZFrd6kl6LDOy47Vj+fo5AisENLv65f4BqHhF/2+Wx2bSzz+yYcg8TU1otltrmh40mn647JjS1hJ9Y",
-	"dWDba2fbyr/qOwdCcwk0W5MZACdiZhbd7CsuslPRznHC+KPdBq5SihSUgiwJE8NtAq2OKkd7oN6AJwQc",
-	"Aa5nIUqQOZXXBNayhM2AdjOi1uDWVh936vtQ7zb9pg3sTh5uI5XQFGnXAqNqcnAFeyMo3BEnKKqyj7x/",
-	"fpLrbl9VYu6xyLNz+/WEFfhEjlMuFKSCZyo6GJYd23ZsseJ+sBYFNt22PymfsrK/rZM29CLMjPxb/R6s",
-	"N3ZTH7HOCmglLciiCZdhtWGu17Cq5xLzSO1Flwx+28hDWArGr/MEBukpdGCRMMNFFnfB8hx9s3G5owVE",
-	"g4hNgBz7VgF2Q7V/ABCmGkTXb87blBMkaldalKU5fzqpeN1vCE3HtvWB/rVp2ycuFwiOfD0ToEIx20F+",
-	"YTFrU4AuqSIODlLQMyehL1w8dh9mcxgTxXjqKvkN5W5gBRybVuER2HJIu0JeePxb56xzODr0GyW6QSLY",
-	"sgtDC46JlV+EEHhVLa9rP/iIZs+2WB2IV41Yaf+eXlCmk7mQ9sZMsMhExIPanv2/KNOutInTgbVwZktX",
-	"psIyFDdOkABXhcGsrvawT7XAikjUlZnqRyF3ctg2tlUtiFkYqbhm/rkd1qj3MuaX5/28k57vpOc76flO",
-	"er6Tnu+k5zvp+U56/tjS8+eJwCRJ4vm0f14Te1xDRl+lhP8VvV/5lA9OGqG/FvlRSTAiujnHGyMzNNB8",
-	"6tLOows9mmTZhniHKexTMx3jpMwp1q9baf/QGEvXBUVsfO5kmz/J8BrT4PEjcvzzwdOHj35/9PRbw32W",
-	"to5O2PaeLyql9DqH+y6CrU5w4kPZgFNM0oyRbNRrP6mPcrDS/JzlQJRB1gtsfgjnkBtR3vo6iVFG+urR",
-	"CdD8uUOO5Uqg9A8iW3cIx6x/iqhok0zjMGecykgi9T6h9JCsBRZTcJUBehrU5a3GTMTjBPobtm2vBmqI",
-	"Rcl7E71sjQtwNXDc2Lv4yMyeenQSl4T9s7JsghA5MmvY0xcTSd/N8OsODrY1UoU7f19r1LtHfPTg4bEd",
-	"+wyoBAsaW4pbJabRAnji2EIyE9naFxt2NR1aXNYm2x9msjaTPbhSIe4Y3FP3DZtFjK50y9QTLXYUFAZr",
-	"0rN+HsZp07xv5JvXp452Faobx0x2h+tzjSDo4p6QZCFFVd63ZW35GlXioqR87c1gRlbEMlaYrxrjvG+X",
-	"U9dJVnt8dvcqTKG+go/2u79btGBqVleCKbM1mOIZELuVgrZjvKmDsS3rnc//GanZM1Chp7+JfpddoGNt",
-	"+ittNuRI5YxOnYy7x1X/I66EN1KcM6M4RzlsPwqrYQiTrTeDDFgWXg2dVBv+bmjz07f04qRVzWQ3nrpK",
-	"nOB5Y6l0CSiQ1VJaJC+JuS+loFlKFb4fccXNPrLEqldHEbsDgon5pfqRvuYCn2wVLHHcneTJdqS3mxAT",
-	"wCibSPPzSpdNtOmBe67TwsadKeCvYgr4wR8+RSjm5O4czqDg4A5sil7oFY9yqSl6CYcj3oID8ca2vFXf",
-	"XW/4tguvcWE6FwTkJaEkzRk6KARXWlapPuUUTaCdhOUd95437A6LUs99k7gVPmIkd0OdcopFqmvDaFSk",
-	"mkOs/B6Al9hUtViA0h1OPAc45a4V401BbMz/nti4T3NdG44+sS0LuiZzLJolyJ8gBZkZLSLMWYIGRaVZ",
-	"njt/opmGiPkpp5rkYJj+K2YEOjOctznVPnJX6NJjYaCuhc0oO1Db/if7FR8tuOV7uxGat+xnHw09/jx5",
-	"nxOWDUJ+dOjyiR0dYoqYxpPYg/2TuZcKxpMokZkb33nku7RF7hkZzxPQ/cYn6Xb9lBthWguCjJ7q65FD",
-	"1w3QO4v2dHSoprURHW+BX+v72FvWhUiMyoiFtEYLppfVDDMv+zeu04Wo37tOMwqF4Pgtm9KSTVUJ6fT8",
-	"4Rb54Ab8ikTY1d3N/dcx4od0YE5LvfFYkKi79wP38i2kb/2yc7ZuDVG6y5B6lyH1LofmXYbUu929y5B6",
-	"lz/0Ln/o/9T8oZONEqLLubE1o1/rpTHW96dNldaagYfNWrn/+m5JpieEnGANTGruADgHSXOSUmUFI24j",
-	"5Qq2WGqiqjQFyPZPedKCJBWFm/he81+r5p5We3uPgezd7/axdouA8/b7oqiKn2yJ5u/J6eh01BtJQiHO",
-	"wWUCC2sC2l5bh/3/6nF/6ZUXRSsMGld8FUOiqvmcpcyiPBdGGViITnwfF/gFpAHOJpogTNukq4hPjIt0",
-	"0Tnt0oVtobt/v1+h8M1Bh1zukpp8/Go3m+qp3pQHbhy7xxDvWManYBmfnWn8hfKv3aVa+8IWFDpSW7lU",
-	"byBJ1RXjYoXonYzUVGQMKxziDVfXNnz33vBxBfLcX35Nwb796RSznS+F0tORuZraxfzCj+Z+oAs7grtc",
-	"SsnOMVPi+8v/FwAA///mV+UM0eoAAA==",
+	"H4sIAAAAAAAC/+y9e3PctpIo/lXwm92qJN6h5FeyJ65K7U+xkxPtiR2X7WQfUW4OhuyZwREH4AFASZNc",
+	"f/db3QBIkARnqIdlO9FftoZ4NBqN7kajH7/PcrWplARpzezJ77OKa74BC5r+4nmuamkzUeBfBZhci8oK",
+	"JWdPwjdmrBZyNZvPBP5acbuezWeSb2D2JO4/n2n4Zy00FLMnVtcwn5l8DRuOA9ttha2bkS6ylcr8EEdu",
+	"iONns7c7PvCi0GDMEMofZLllQuZlXQCzmkvDc/xk2Lmwa2bXwjDfmQnJlASmlsyuO43ZUkBZmIOwyH/W",
+	"oLfRKv3k40t624KYaVXCEM6narMQEgJU0ADVbAizihWwpEZrbhnOgLCGhlYxA1zna7ZUeg+oDogYXpD1",
+	"Zvbk55kBWYCm3cpBnNF/lxrgN8gs1yuws1/mqcUtLejMik1iacce+xpMXVrDqC2tcSXOQDLsdcCe18ay",
+	"BTAu2atvn7JHjx59iQvZcGuh8EQ2uqp29nhNrvvsyazgFsLnIa3xcqU0l0XWtH/17VOa/7Vf4NRW3BhI",
+	"H5Yj/MKOn40tIHRMkJCQFla0Dx3qxx6JQ9H+vICl0jBxT1zjG92UeP73uis5t/m6UkLaxL4w+src5yQP",
+	"i7rv4mENAJ32FWJK46A/38++/OX3B/MH99/+y89H2f/6Pz9/9Hbi8p824+7BQLJhXmsNMt9mKw2cTsua",
+	"yyE+Xnl6MGtVlwVb8zPafL4hVu/7MuzrWOcZL2ukE5FrdVSulGHck1EBS16XloWJWS1LMIZG89TOhGGV",
+	"VmeigGLOhGTna5GvWc6NG4LasXNRlkiDtYFijNbSq9txmN7GKEG4roQPWtCHi4x2XXswARfEDbK8VAYy",
+	"q/aIpyBxuCxYLFBaWWUuJ6zYmzUwmhw/OGFLuJNI02W5ZZb2tWDcMM6CaJozsWRbVbNz2pxSnFJ/vxrE",
+	"2oYh0mhzOnIUD+8Y+gbISCBvoVQJXBLywrkbokwuxarWYNj5GuzayzwNplLSAFOLf0Bucdv/8/UPL5jS",
+	"7DkYw1fwkuenDGSuivE99pOmJPg/jMIN35hVxfPTtLguxUYkQH7OL8Sm3jBZbxagcb+CfLCKabC1lmMA",
+	"uRH30NmGXwwnfaNrmdPmttN2FDUkJWGqkm8P2PGSbfjFV/fnHhzDeFmyCmQh5IrZCzmqpOHc+8HLtKpl",
+	"MUGHsbhhkdQ0FeRiKaBgzSg7IPHT7INHyMvB02pWEThC7gFHyGngSLhI0AweXfzCKr6CiGQO2I+ec9FX",
+	"q05BNgyOLbb0qdJwJlRtmk4jMNLUu9VrqSxklYalSNDYa48O5B6ujWevG6/g5EpaLiQUTEgHtLLgONEo",
+	"TNGEuy8zQxG94Aa+eDx7u+9rBaCz0bsNoh5bBK46R/aooVLaRigG0IaVwtj915epNzTE90TCXKo+Qe4k",
+	"xkmESI0yxy0SIhu/el6S1ug6/SesOp7biFXmfh7QmFi9QSm3FCVJwH8gaQU01Ib4UwcRQSYasZLc1hqe",
+	"nMh7+BfL2GvLZcF1gb9s3E/P69KK12KFP5Xup+/VSuSvxWoEmQ2syYseddu4f3C8tKSwF8n7zPdKndZV",
+	"vKC8c2FebNnxs7FNdmNe9swcNWQaX3jeXIRL0GV72ItmI0eAHMVdxbHhKWw1ILQ8X9I/F0uiJ77Uv+E/",
+	"VVWmcIoE7HUAOtPejnFUVaXIOWLvlf+MX5Exgbu58LbFIQn5J79HsFVaVaCtcIPyqspKlfMyM5ZbGulf",
+	"NSxnT2b/ctgafA5dd3MYTf499npNnVBHdnpXxqvqEmO8RF3LzMa5BHIu+kT8wbFi0tKEdLuHNCQM01DC",
+	"GZfEulKMoDm5P/uZWnw79Qrx/XaewLG5GSSbGMsjLDoaklFr5lo7UUOq6cppoxY25spb5dfNtebbmRfX",
+	"GYndIVg/GnCor/hKSBpsjlqqZBt+ipyKS0UaK2IYjA2C28FLg7aWKi/9vXp9MLjO3ioZDPdkPo00jAF7",
+	"EzRB17m1KlEh3buX2Pg73zY+bfj7pM4f7ElD4MxN43PsgGEbFtpc8Vj1tuLuNNFp6mJ/2lF66qj4hplt",
+	"JIlGyCAPNoJo4mvz2DtaCLTQ2YDLUcJNMYMOf9xHBdTmGszgbucHO+/QPm3vr7TdOzfFjdudhbSfl1qp",
+	"5U3IGj/DVEB2Py3gRpR8AWV4UGwb058VQs3OuWErkKAd0a64kMYm95vap6fxtr5gNGTt0yy3fqJ5kI80",
+	"d2hQcMvnDrhmnQlw3TLQ3IsfNqBPywC/Wg4GDCaUaIAFL7nMwTCrBR3D1taxtdB5J/k/n/7HE3wf4dlv",
+	"97Mv/+3wl98fv/3s3uDHh2+/+ur/dn969Parz/7jXy95VCIgG8PEPqHo93/ee+qh/dl3OFYaYAOecK9E",
+	"s/0HLCN5ZdbKNlsRpmAG9JnIwZHBwWzeI/cCeFEKCWnEWLFpnsKdBU9sQNV2jpyDW3FGtnb8uoCVkBJJ",
+	"K1AOPUBYVoEWyj1mSC6VgVxJ94rex/B8tuTGZhpydQZ6m10SNOyM3NIKbMXCMO8Y5BJBzhUZvLyyn+IA",
+	"egUGjyJUTAPP162NsbF+ulnTs0heVcnR/yt6VIg3iAl6I+GyUBumJMy9WdiJAu7anikL2NBARwI07xrz",
+	"mYNp5NB0sJWG230btVrSxz72ndxq3r4QSMPOQQMzAHK6+A4n4KVbQ0KQV0JKKKYPpFWlDC9/whc+z4zp",
+	"h7H1hc/Map6ftlveWekVFuTHTS1pF5PrzzrcLqTP3Z2xRbovEl4mZLaLZLANg5JXqCUZIXO4qYPY484N",
+	"6xjlKcOT256yhu5bAo43e94aqBFfg6WPcf1r2vYm31Vwsp58aD/HGiBu29VNHhN05wQk+KEPw9elyk+/",
+	"A16AviktLgdts73KsSe3Bc7P1gQAw57ONO/fTnB5PLf0IU36rmd2Cd3Mz5VQyuLZGC+VXLX3gRhOw5Za",
+	"behnf5UIryqsroKEa9ei5K1rXP37Q7sjPYylzss8xYNi1NB5pguVW/V8N7JK4A7Figl70BDdDVAazTPc",
+	"9a9jmkKF+GDWX2b6vSI6DHS5AJ24VvxA/+Fl2OGc2+BLgH4UwjBhmIq8Hgt3CUXEuJmc3LeIkI3zOGBI",
+	"oZeC8mk7+WC7HVqmbO03/ky4DfOLwKW3LkxHC6XtTejJMlb1OY7auGIMVWNqWleZx0/CucM16A3U+sLu",
+	"ORC94UfERouF15a/AywYyyPgr4GF7kA3jQW1qUQJN3Be19ysh4vA1/ZHD9nr744+f/Dw14eff4GyodJq",
+	"pfmGIbM07FP/ksiM3ZbwWfKKSQ+96dG/eNxw/864ezFEADdjT2KWgJzBYYw55zWE7pne6vom3hVBa6UT",
+	"DgFEOlblqszOQBuhEgayl74F8y2YMMEQ0v3dQUumEVV5y0gtixE7GDr94GSTNGk39JsL2eKmq0f3dsCt",
+	"N7E6P++UPekiP7ia0K0vsxeSFbCoVx3zHMl3zgrqSAzxm3/W4kxdx5o+FKoQj8nUAs0Fre4jVZFgCZ0+",
+	"k7EeQ78f5Z0ppmoI+xeDE79QBaDlpb4JHLaDtXuKk8U7yRd4I+cEANlhapNmszuMiOFK1LEfOjG+ANRs",
+	"cl6v1tYpf6kT0nbMeO4QnZHIHbm9tn54rpWbzvm+lhp4sWULAMnUwvtMRVhmnFwtbceKWFdJ5TmCq9Iq",
+	"B2PQyOysa3tBC+1aZXgMTwQ4AdzMwoxiS66vCKxVlpd7AKU2KXAbraxnEejYEidMv2sD+5PH28i1uzYg",
+	"FTCrSFiUYGEMhRNxgio5Xjfe6f6FSa66fXU1Eg7hFZk3YgM9i8MOy99e27/prMXgCmJ+lDipNPDI3fV7",
+	"buwrbyArSPN27IbmoT40xTjAo4IZR/4pyOTh2LmSBqSpTSOgTV05J8PUGuiNbHSuF3DRzKWW0diNFmAV",
+	"qw3sG3kMS9H4HlluJQ5B3DYvYt5oOlwcudijHNgmUdkBokXELkBeh1YRds/71tshIMK0iHaEI0yPciJ7",
+	"rbGqqvD82ayWTb8xNL12rY/sj23bIXFx2/L1QgHObgNMHvJzh1kXDLDmhnk4wqMnKbzOCW8IM1nPyBiY",
+	"7aJ8PJavsVV8BPYc0pG7hg83imbrHY4e/SaJbpQI9uzC2IJHLj4vAfRRsRHyRp6JvKPwRkhhrOb+oWTs",
+	"6jd65Xu5Y5ipF789Fz6c4qZ03FIY2zMtl1s8axJyPFaIFTNcPv08Wb1FgJ0iuFe5dQOPLpzCGN5EwQ83",
+	"gIbEqPREJBmBGDyQURuIm8AFzxFXnPjn1j/D1IuNsBaKIcqsqrJ4gKTxYceM3vxjOo8iU+xRr2moaHmp",
+	"xxGnM+2G701Pa+qgIzzZKVVOeIQYICMJwZS7zRGrFO668GFQIVamIewYyJbCmyiGAj4xHTTTCtj/qJrl",
+	"XJL2V1toxJHSxONtODrCRHMK/+TaYAhKepVqsHPvXn/h9+75PReGLeE8xA7euzdEx717dNN9qYy99gno",
+	"kebFcUKqkEkmenmKkYSGl4O9fIzGnXRBjYY+fhYmpMNkjFDuin9T7x+iuEiFZRRwkVqp3zm6IXyC6vTW",
+	"wMhbx8gjx/O+O0g0+gaQVMxaVLfv9GGsWKQtft9xs0ZIPee4kMfS2eyXSrs7xtarLmr5np9OcDNb15Jm",
+	"SVOI7mVqQ4Rk3G020RxqpuX2BoSMG4hpqDQYYgnxjc64r2oZx8Z6yjNbY2EzNIq4rr+OqISvgkI1oFIl",
+	"SyEh2ygJ22Q6CCHhOX1M9XZsaaQzCYixvn2FswN/D6zuPFM287r4pd2O2NDLJlL3JhSt3rg9e1gcFUz3",
+	"eSgrxlleCrrtK2msrnN7IjndJyJyTTxJhFvS+A3zaWiSvtImbpx+qBPJySOluWUkzc1LSGjF3wKEi6ap",
+	"Vyv3JNtJIAJwIn0rIVkthXO02+B+ZW7DKtD0LnDgWm74li0xutUq9htoxRa17Yp7Cl40Fu+rzjiH0zC1",
+	"PJHc4rOnsey5QGM3DhcC8QLNSLDnSp82WBhxywLINlyf7g9H+BbguWv4dj5bgQQjTJbmv391X4kNe6yt",
+	"PUvG//vOgU3dttwIsItiFPLjZ16DPn5GalJrzRvAfmsmHgzjTdImOSEISYHdPZJkn0plG7r7rLULemI5",
+	"kfg+YRVmNhAFt1ejoj5nHBxhd6h6VNPZiN6NPaz1l9SL9Upl+JxNt9fZSth1vTjI1eYw3BwOV6q5RRwW",
+	"HDZK0rfikFfi0FSQH5492KPFXYPNsQSXezufeWZlbtwfxw+cWlB/zsZWFv62in3y12/esEO/U+YT2k0/",
+	"dBS8mLjsuQ/dxxBcvEsv4zzRTuSJfAZLIclv88mJRG+JwwU3IjeHtQH9tfMbPlgp9oT5IZ9xy0/kQDKM",
+	"RknH7slVvShFzk5hmzqaLqvHcISTk5+RQE5OfhlY1ofytvUOHp5RN0GGjiqqtplPW5BpOOc65SppmrB1",
+	"Gpl675x1zvzY9KMfn/nx0xy+HzCXXn5Vlbj8TmgNdXIe38YqHZigMAEa2t8Xyr8taH4ecl7UBgz7+4ZX",
+	"Pwtpf2HZSX3//iNgR1XVhjT+3fMapMlt9Q7iI2nhTg+DC6t5hiEiJrl8C7yi3Sf5vqHni7Jk1C3GSfO8",
+	"T0O1Cwj4GN8AB8elPf5oca9dr5B/Kr0E+kRbSG2QO7VG5avuVxQzd+Xt2hN3x2u7pgwIyVUZJPGwM01a",
+	"GhdE4S39aGnCQ+Az+CyA5WtAl1xKJgKbym7nne49R9TAOoRxSXecjxVlhiALCibjqQrudQAut/04eAPW",
+	"Bhe/V3AK2zeqTSxxmcD3bji2GTuoRKmRMEJijY+tH6O/+f5hEiHlVcVWpVr4092QxZOGLkKf8YPsJOQN",
+	"HOIUUeyLAyNEcJ1ABHUYQ8EVForjXYv0U8ujSIZcVG7906KRXnb64CD7hEtSnKDrU1dqDJh6kom5xhl6",
+	"OyW3A/AL7geeof67bZjJGSNpBQeMEjd6wl2UpIs0T8Y+lEGT0hWWLVe7QEtTCWjZSvUARhcjsfqw5iYk",
+	"nyrm0YGZJGjfYbzerrQkx9GTY5SIq0k6Ehhb/zDMm9w4LidmSE4SMpKENCSz+aVSisxn3gsmtR1KkpZR",
+	"QAkrt3DXuBdl9omJNgjh+GG5LIUElqVeL7kxKhcuvK7l5X4OQCX0HmPOLsQmj5Ai4whsMrLTwOyFis+m",
+	"XF0GSAmCrPI8jE3m+ehv2G+kbrP7ePV2rxo65B3tIYoDIGgbh8arJjbzZZ+NJW8InVbMNVnA4EqVIlEm",
+	"ZMKcMzQaGSiBxHHW4azZKWzTWgUQGb4O3aJrA/tULFHIfxa9tWhYCWOhvTfjaQ32o9u1XWDEVLYUGh+0",
+	"8cqeXB42+taQMvgtNk2znw6qmMtuKEaCh2jaU9hmhSjr9G77ef/2DKd90dyfTL04hS0JGYyNYwvKxqmW",
+	"vemxzY6p3Qv+zgV/7xb8Pb+x9U6jJWyKE2ulbG+Oj4Sqevxk12FKEGCKOIa7NorSHeyF7j7PoLQpd4fo",
+	"TuaCoAtseLDLajA4TEUYe5f6FUExznndSMm19EIVk+qBWwGqr8wIuSpD/GQyfHK4xqVWv4G8eqyj658t",
+	"heTl7jBU19LlQZ0H324aLKPf2hCdUp2DsSzXULjHbeceF17ZffAf3q+kYhjdA5rlay5XcPloVffNG23G",
+	"oh/56jrhoMbCWFYSF8RKFAtFw+f8zlG/8EcbTXu5oNDXFqq9jiedTYyiHB3ku0nTrzbpVeS/NXGui+1I",
+	"EPpYAGzP8YZvS8WLLGjZu+nNxQ1tUGaEG3af4CKNPU051O6qO99D8gD6MPwk/P4UIBlDcuYPlqAz4x6p",
+	"uf8aoqiGKKWfs0Lgq1SaRN23hgwH492uDrNQ1qrNro13MW4IK5lvBvhxUr2Jczf0lHam3PNFmgqUFis8",
+	"GtkERtLEp7ckiGQWhii3DQrTzKadyzXT6dl69qig87qpE5O14Oy/FHRookH5EA0pYHfS8uvRMPIBH2yE",
+	"GbIgJ91aDth/9d2Q2xlkRvw28sQFF5Vz6XOXedrucxAYGBEx2/SONMEjSpvMdRpfRG/Upi+dRrdJJtCg",
+	"+4tZhbcnMg52afWysfj4Zacgs2sNBoOK98PfKhNdlikBnNVH6cJly8bzj24jtSxKaOxB4+ikrd6dGMHN",
+	"ZQggp+AGdwUL1dxN7aRJAbkGjqB6wC8vIH9SFt7gWdkrJXuElqaMNvi/QXZY886z0YIx4YAs6ZqfUJ/6",
+	"J2d4Wq4l0dxK9N7wjUIYK2Ru+0a5dkOH0jhNLVPPW86NvcTAvZ2ldrNmdc20yR2LrM87rxaCvOq4LJiw",
+	"UYb5YYjXyMWUV5UoLnoPa27UNK5oCnOFTKwDt7JZM9geDESPaKkoAg0mTmoaG7JcrQAZr+1gEmb6SVOj",
+	"W3o8lTA9eR4hCu+bk84BBsz+DbZE/bSc2dv57HrvcClc+xH34Ppls71JPJODiXuX6TyrXxLlvMLMc06k",
+	"42vlGGlqdeZJk5qHx81b1gjTb2Jvvjn6/qUHHx+ESuDavV/vXBW1qz6aVWlAwbNbPSQTcnjQctbRaPOb",
+	"jBDxC+f5GnzVgsjAilzME5c7Xu3rdTteePFcpt3j9r5f+od2t8QdD+5QNe/t7TMVde49sfMzLsrwPhSg",
+	"TbMEt7hp+bCTXCEe4NpP9ZHHRXaj7GZwutOno6WuPTwpnmtHXYWNKx1imJL9IAG0d+AMjlTxLrYA7zEy",
+	"ZE6y3mR4/DJTijz9ligXBolDOkcMbMyo8YiFGEesxYhfj6xFNBY2m5JjqgdkNEcSmSGb8xjuFspbxWop",
+	"/llDuN8L0E3Oxeigkmbo6wYNxSnqDsO5/MDUJxr+OjpGnH26L/EIiN0KRuz2MQD3WfMKFBba+KtwGTTO",
+	"y3qPxTMOROIOzy9PH56anefuuuu+EZdoG/I/JAxXzmN/fbhwq/R5oUbmSNZ7G5UWR+OSAntfQka0IoHA",
+	"jYXBnEiVl0YlhqnlOZdNUl6PQ9/bgHvIw17nSlPMtIGk66wwWWvLHmzUEjcqEcfkUUnqIvVOWYD6TLR5",
+	"Km0L8wX8xnCMkvaYJhd9ZF3vvpETTlQe+bNQLqfw6sylI2tXaqrjU5o+HFELc+jGbw+Hh3lgfCn5+YLn",
+	"p2mFCmE6ai1Vnfdxq1jo3GZnV8uIhA5Y5ITVtBUu0LgC3QYbDojhqsrRx0XyBeRik0wzeXLyc0HY79/L",
+	"V8LV66oNRAWh/ECu0KGjIl9Uy1nOW9QcL9n9eVRyzu9GIc6EEYsSqMUD1wK9emhtjZUydMHlgbRrQ80f",
+	"Tmi+rmWhobBr4xBrFGsUWGf+CA4pC7DnAJLdp3YPvmSfkiuOEWfwGWLR6yKzJw++JF9x98f9lLDzhfl2",
+	"8ZWCGEuwPqfpmHyR3BjOZkWjpk3NrprqOAvbcZpc1ylniVp6rrf/LG245CtIu1hu9sDk+tJu0kt+Dy+y",
+	"cKUAjdVqSwn4UvOD5cifRuJFkP05MJgz0NHDklXMqA3SU1tSyU0ahnN1BZ0cbuAKH8nvqUmX2Lsw3+6L",
+	"h5PlqVWTd9oLvoEuWqnQFwV9xckqHUM8YMchwwxlAWyS/znc4Fy4dFLpcAsp2ZmQli5RtV1mf8GHVs1z",
+	"6yPjk+Bmiy8eJzIfdpOdycsBfut410BZopKo1yNkH7QJ3xcjaGS2EcjqP2vDuqJTmZqYniiS09rA0fuB",
+	"BruHnqqA4ijZKLnVHXLjEae+FuHJHQNekxSb9VyKHi+9slunzFqnyYPXuEM/vvreaxkbpVP5xtrj7jUO",
+	"DVYLOINidJNwzGvuhS4n7cJ1oH/PeWybG0CjloWznLoIfF2LsvipDVPtPctrLvN10vFogR1/besbNkt2",
+	"5ziZ3mrNpYQyOZyTmb8G2ZqQ/v9QU+fZCDmxbf/F2S23t7gW8C6YAagwIaJX2BIniLHaDcBrIjYwmI/R",
+	"PG0upZbKhnluowSZlE45VQaaPrhgJ0tVHpX2+RkZyIK06gP2V1c6fQ2sk22FtFmxqUuXuQOKFWhvZK0r",
+	"dBiZMxwHrb/Mzer6uBK3Lj/kipS57iqS1Uump62J6pikYqOmj7M7WANXbSxlXjKWb6pU2Cu2eBMaMNGz",
+	"65KaF2PngD1zGrYJ+pubBOlhKfQGNdNmNMfjiSbwP9a6GgxWdbjJOMlPT2waqNJE1Wb9//OGEt25Q7h9",
+	"blOX2nTOqDjQuTCuYjacQTfSNoDRFr5wkbfd5enaZdMX8jI1g5pMaZdFe7dwg5I7IOsh/pKKi1G1zuGy",
+	"eV5fU68UUQ6Sxg5qubrMIE1m7eehUDCXSoqcPG+iGt0NyL769pR3kQmJi9KVZ8zMn9DE4Uqmqm189j0W",
+	"R5PXzmcdxA0Ns9FX3FRHHe5PSzVI0OCyAms8Z8M4GZ+O2NtLhDTgc+EhEcV8UunOW9NI2RosbdqYuS9J",
+	"RhR3N6IAf4vfXvjrER5BdipcoQCPtuB5RhYNqsBrUXsSlq0UGL+ebnod8zP2OaAUMwVc/HIQKvbSGO6p",
+	"Bpft3iWHQx2FV0r/Kohtn2JbRs8y7c+dGD836VFV+UlTnMA0O5xKqDyK4MRrUxbM/RFym/Hj0XaQ2073",
+	"ApKnSGhwRo+TUJEcHhBGk5u6l2QejUeOoqgFc772KaSkSw59LyS09aQTAiJPigTaGDqvI/1MrtFzdTJP",
+	"w0fJxkunz9CM9Sba6w7V22BfMaXKZ2GO8W1s02qPMI6mQau4oU9cOBRI3ZEy8ZRK+3tEDpNkk1bllaiC",
+	"oql6abNTjAMZd0g43xUAw2Mw1Ilcd6t5Dp2+EyTRWBR6rlL65jcXkFOsBMPv/ngznD3mLkmqKoThxsBm",
+	"kfIze9Z8jHLR4xbjjRf/TWXfG0eJfxG/dKBEeP6mjpdWWLsjDdRNJKYM4yGvts1t/xvd51KtuoDcrkFh",
+	"5xmPSSZ1ujvp29POt1jIUubgH6WCsexMkVcsvaGcK1aI5RKCMttxAw3Onyh9fU0X72ztTnnKzTHMOa3s",
+	"TmjdltyhV3efh9L5XvbKyHjoMT6r64N9+/agCV7pHkS8AZUiJ8hpVaO5/HbVL8Pd6u9RhIDWy/PqJcxG",
+	"JdnU0k0TV2oAr1eTXOxTrtxp5Wm3g/ZlAOwdxYao40JkqfJjblUelOSh1VrpOJvQIBmr04aaZD9E9ypU",
+	"FyJLR5Omolc4kjt2n3jHabIGXzUD8Jz0tZGwvldt+jvuVEL3MDgW3JePxqJy6wPNLWdtrrkh9bg6LakR",
+	"nBMSfWd9J+TIKDrmeOT8jvAzG3Nh3n2ZGVwNaeydCA0ebUOA/hbcZVnFhX/1bsXsELM+2nUYfzzF5bbd",
+	"4P4ifAzpaMhUm2MuVQe13my4bnj1EgAP9jLEEfRvZk3SBiH733yO3KOybEfiGhgsl5BTGVH6KeRNm4cs",
+	"ugVTZ1RoU5Xd8VZa1ZWhYoZRwr+kSKMOmNwsq0CTk1ma0SwBGgCGKcXcjD6UwypM69qJ6KCJ4kDMyvkB",
+	"BJwMhjpg/wtahQzAfVwxYeQnli3rciSN4AYKwacsyzXsrW6jQnDfGHhjrn+Ypt3FOu2f2mPi0lM7m8c5",
+	"ZRdaCtte3nF2H5DVYI+X5dhwZs8YaTnuxtmX2brjInKpU5CeVakyy3nFc2G3l5ouST2okRm2gCW9B/m6",
+	"ZMYRaYjr7NLpNAIYytbE6UoT5zjtjOC8j5QU+4ryxKf4l4vp6eamd8+STeUQXwDiYHr2O6chUs5+anHA",
+	"vlWaqdquFCLSz+b3pvWJKgTHK6KGkm9DV2e/c7FaudoMuwPTsFEW2FoZ+6RSOq09NSt0lRDSMNdSXJAR",
+	"n7imr/Mw7xbwaGenAEww+FopzHos8FJIY7nMYcQA+IZqN7km7q3YBuRp8GU4iloHilxzWZg1P4WRNJ8W",
+	"ZL4d43Lc1Lqp2mm1qGitwazudn5KDepwx90RMN09jaFDExXd1t/BWffMYkDayTMY76Wze+xAirsjvXtb",
+	"TW6NvF8XZSQ1QFPqeXz4+ITQBK5Tp7CVe64ZCyCutFBa2G22+yR2EoC0XooeAjT2ohZxLl3SdIYlYp25",
+	"MklkzaS7YuhCoybusxcuFGb/xKTBS2+bpdz/Vm+zVT0Ww9W0YX/98fjZdU7T6NMbkR6slKeGfjra8Op2",
+	"mTRJffbUnuXUcesfjoigG9IbblS7orSc6FfsGL/HPQPLRWmaQnMJxYLeGvqCNyRGCPVQ3LNpSGoGJvwW",
+	"0jK5WUpxCnE5JXqkpthm3yJpdQ0G3WwkOqEf70fNmEgDvWxmFq1r7zDkbUixzpU7LxUK2mzM47+nuwRX",
+	"lE+M8xlyuh5oD9cStC+jhi1xbMis2n1yHBy7UOELZV8FCWa0eoMDbjQZ3qs22x9dUTglv+PeHypeIAp5",
+	"LqS/SezMCrgP2U/d9xDjFYJ7exngE+MGet1f5zs4dQszQGJM9UvmLQv7Y8euYu4WUrpinyaVoE+CjoGj",
+	"VGZFnTv5Ex8MCM8Ck81uO1hJ0kidD1c5MF2UlHH1+ygS9xS2h8584HL0mGYrY+hdsUq3hkhy93b7Rl8C",
+	"0qabcuUWsLoRON+nId/fOkZePo+HeQb7Z+BUUNYelB3BHXKkZhD7lEzxjWvL+XobyjNWFUgoPjtg7Eg6",
+	"B/Tg5dJNY9+bHE0GO+a/oFmL2qX+9ObKgxOZ9uQlcayvyd/CMLu5mrO9XnMqN8juiezFiMaDmWmHFbSm",
+	"FnBP+J308xi1ROWgSGkpV8y/Nq1g8MBkmSD9OEh3j634tGPfdImae74mSsMN2zmjR/ZL2jmH4cdTl0fr",
+	"IK5WGxiuc/IGdHA7gvspiG+N9EPkjtvW7WKKbT2d7xa7k3HfIQQbHTAClf39wd+ZhiVosoneu0cT3Ls3",
+	"903//rD7uRbS3ruXPJm3Ztbv1In386Yo5qexC5Lzvxtxg+3tB3rM7iOMjlNzWy2F3HZ/9e7f76Vey6/u",
+	"Fjp2dbyUF0B/EwgxibV2Jo+mityVJ3gqD++oYV9J2OQ13hwpAj/cqMSvyXSjWJ3GVctfA0fp0sQx+jA6",
+	"q06hyeGwalrXJtzC/6p4STFWKOvJL8TSU8g3FxyrRPuD8tUni3+HR395XNx/9ODfF3+5//n9HB5//uX9",
+	"+/zLx/zBl48ewMO/fP74PjxYfvHl4mHx8PHDxeOHj7/4/Mv80eMHi8dffPnvn8zmM4EgO0BnIQZq9t/0",
+	"NJIdvTzO3iCwLU54Jf4GW1fGBMk4FEjhOZ1EvJOUsyfhp/8/nDAs/dIOH36d+RCL2drayjw5PDw/Pz+I",
+	"uxyu6I6WWVXn68Mwz7A648vjxv3bhe3SjjpTEZLCwawlhSP69uqb12/Y0cvjg1lk1pjdP7h/8ADHVxVI",
+	"XonZk9kj+olOz5r2/dAT2+zJ72/ns8M18NKu/R8bsFrk4ZM556sV6ANfKQZ/Ont4GLxHD3/399O3OOoq",
+	"9ZrmHNkj7+VhAZW509bIJ8k5qndcBIxP3T1nCxeFz7z6KAvyL3ZXPjObzxpkYZHGkNz1uGVUIZGAy6z0",
+	"5OdEva+lWNW6V1S5edd2h4kJw/7z9Q8vmNLsubPYvIz8Qw4CQf6zBr1tCcZBMYtTAoVs597T1zuaJFKd",
+	"v50PFXIyriBs5EUdbh9tAGOTFjMFTPP438AyfPFIFV1NFb+h8ZG0osPRmMBa5md1DfGELStH9nw/+/KX",
+	"3z//y9vZhLX/1xokM0AWYV6WcYRxiGedt6YM/N3MY9UlTv3kwiOaxm2jQbZEroGVsLTtDaOljK47u1Te",
+	"+y2BeLigfUuSAS9LbKgkpEjgl/ksTEdn+OH9+zdW2amJ33g774wSKPIKA+FQj28QxK4TyrUB7Q834MfP",
+	"eYknFomiTTr0+P6Dj3ZBx5ISZKPAYE4g0oIef7QLehMdziaNm1Q2Cj0JXFG7oKvAFXHln3/EtHksLWjJ",
+	"S0Yto+QDQ/H7ozyV6lyGlqgGOucWUvKimkSxOv92VMwfxhxyVOa/8kKcU2qthpGOceCOp2cnY2fc5fjZ",
+	"AXvJ/RsXPak7HbTJNQxnQtXGTWkVW4FtmjLPkZMKQmRhMJdQFp671BCRiT/IYqu8GjMmBEqxEfaS0vdN",
+	"WEpAqZ/tgP1ooF2owwm+/omifXVskBM6jQCGQ6TgGpfF70spuK40HLwsdSrXmf0vSx0KDolscW9uvrxc",
+	"S+yJ8218jtSKr4SkweYuFHvDT+lBRFI0YGCBgTQcvI5amrdbT1/hpneJsL+bKD00jAfq7UmYPVXl+B1r",
+	"G/vVgzmjU82Ujg7iu9YZpgn5z28TMTcom6YLjcki6/D3ziNysffemmJvLlmT7Emneeru+kk3z+xQEs6b",
+	"vHzxaK3gCc+2AYo2A9Ug08w+4XYJ2fZnvl0edTe2k+IwBUyHnnbCNEDCjQuxfvXVK4mfqDLkFdJD36aI",
+	"+IDFQT8CWRQfhix4lxe+nTe0UIBOVaHGuWJS6TGG9jHLLS5H2D+y+l4V0d6b2i4x1lQovcydq2MV23XT",
+	"8llD39Udi4C/u139OW9X5KYWiHCXp1poc8UL1Z4C0H/Se1QX+3eXqD/hJWqHFJggcw5/D7mLr3dd8iJm",
+	"7KLk4euIruh6RL0nXoyC7+q4LLq7DE2+DA0zvafAaLNbv78LUMzpLissOoXgL5Wy/u7G0954PK3c3XWa",
+	"DM9meMsJxfr/EPebwLXTdxvHiHdImMjYcAPvSqZB9GI7etO5jTelpw6M+Gnp7sbz57nxRFQ9ct9Jepxc",
+	"9xnp7tLTya8bNuDuyvOnfjcaEQrTxNKVjW6TRNG7NboFIeTWcCd+/nzip6HeHQKI2lzD4HYndAZCJxy4",
+	"O7Hzp7S0XUXgVFqp5S4H+27BLR+bP26O8/CUwuX8TCYJWkT5WIxLo7DYNkkeKGtIVDKb5NacWV3L3HEO",
+	"NwVI+u/zo/+m7ADPj/6bfYVln4KRj1KvJqZ38ZddwfVXsMMwY/P19qjhtTtl2AdjNnvTIGkseZEKNbMI",
+	"aRt+8dUYyi7kqHzb8ItLit0PN8LhuhKwlzR5SEW4KDyMKKNwP4ZRr4bBBc8xiwUnZWzr0jOYetEWvOoK",
+	"WquqPfmyjnbO6PFtUrlyLxt4O5TCVE9kXz6vXnGgDjp8Jq+x7F098TdARhKCX1KhaXvdpe9296Pd3WGk",
+	"G6sUnmlBlQ9aeRJkVQdIH99YbgO4IzkFDtj/qJriEX0GxVT9TppBmGhO/4jUYojyDEnbYOfevf7C793z",
+	"ey4MW8I5cVAuqWEfHffuHcw++jCYi6ZYImdSyUzCilPmyiiI+Q8XF/PHig75/P6jj3Y1r0GfiRzYG9hU",
+	"SnMtyi37UTYhPtd7yGh4Ti2jej87+U+f8URadKTghxTVkUZ/HS/knivhnAnbKovxp/juSs/WlFrRFRub",
+	"s1DQ3cU8UvEPn7nfzEPFfvzkfdbcFs0H9fwPUnp7ZIb+env87AN9VP9IHHsn2/sT0jVNDrPbtjh8zQsW",
+	"iqD9+V6D4114oSz79jbCDt/pm2+arCKWd1lXocZZoMvN3I+7+Rie0Lmva0qFNresySjFy8COwaQZFc7w",
+	"AbOoD9jdZoItOHEU+jt6x4ruWNG1WFGfoFomREm2zeHvdPZiDjTgAl9jyz9QPpDoOUOrTXjPUGwJNl+7",
+	"tO/9lE0JthJ41jhP2VUD/4ZfsQjoRA1gWotPS0S12Scmq6OO31E/cvgDnSC+H0LVEvwslggrNJUb3/i0",
+	"5lTvWITqx03hYzcTNvDJSXw+X4a7eCkon7aTD1NIlapDE5cxo90h+DoIHjC1b9wJ98fLL+Jjt/hE0pJl",
+	"7AVpYHTAQ+HCuzwoH9aCXigJDC6EoQoXjhbvMpx0nmUdUoICv6ILiFfe06rDoWN/WXiW3e3+007hupm2",
+	"EkI0F6urkLJ1KTSVmN5UPLfECX0TTi/yfGlBM2Hn/TpynR7kH8QtK7mxfmIsyVGW6jwk4WscZTx03Kwh",
+	"As6z4CbL35wsYpjZPy8FSFc1/wy0WDq/G9cqOCbFS0MIqeC+ro0vtBJVUkhrX05SvCQM71HEBhXbYmwj",
+	"UqncwZWVmnesyCCas6m16DorazdosR0QQDrTb59w99cz9HNRj7am4YDceqQ4Qu+9TEsRzbdr8T5rt5nb",
+	"s++p0+5ID2NTxP6bPmpyhYdErhgPJSd3IqsEXvjC7eLd6ws7fIHcFt2OWN8ph+17uRm/UDIjuQnSV8aa",
+	"d/bVZRNDAxM5x8yZ0syO7n1by6Kbg2z7PoM9YLL4hB3i8yaJPS1uO85Nv9sLUbw97MveoavQ5USHkFHB",
+	"p2hCxqsKuDY3Jz6St/N4xuNnsdehahLoMu7Y8AgoiJdLeiz92xR3pT+uV1BXEIviIlnaAC6C9I03yT/4",
+	"EaVSRaPtaEWUEWn7HPRpCW5Le54NbAObhSvNdPs1gI0VC9QFhxB/x80aIfUeKBfyWH7d6M5OE8TD3BDp",
+	"e5ThuJkB89GSpgjwl6kNEZLxUB/xwxDJJG86XONOSJOhpYOW9yxc59EDVVMrLNIb8PeYD5iDa4lj3hmM",
+	"jiUfJ2MvbHNu83VdHf5O/6EU429bzwSIarKb9ucSihXoQ/e+tksMv3YtbtR/043JdFsAIk5272DCA/xc",
+	"5FpRCdzArs3WWNgMfPl8119HrmGvvJAfsnYlSyEh2yiZypP/A319Th9TvZ1P2Ehn8s4b69u/sXTg74HV",
+	"nWcKB7wufg8+jIe0axmFeqttSgn6q7aj//YQVQA6Oh7052G1VhIWSp0eaigVLwbfQ8VI/6uGFbIynXXq",
+	"I2ansO3m0vfNzbq2hTqPMu+bpujr6HF0LW70OL5QBbhxu9Un4nf6UDaQKlyaAETvFDb8ZyQoyW9J286Z",
+	"uITxpbRyXqN5iqwKyYKwTceM5+70uMq7e0sZu1ahDtUZMF5q4MWWLQAkUwtcdK+MJ3dlLpsi/Y7LpsvM",
+	"tXBVWuVgDMYJ+SCPfaCFdpHhbgRPBDgB3MzCjGJLrq8IrOMruwG1PSfjBtzmAUfIEainTb9rA/uTx9vI",
+	"tbNHCV9YEdlOCRbGUDgRJ6QGi3e8f2GSq25fXWVWpEoTP3Vf34gN9MoCJwcrubHZvmOLjeK1tFWm3UlJ",
+	"VzU2o0bR77mxr/ydPa76RvNQH5piHODRIq848k9NBaPB2LmSBqSpTVPmqLH+pNZAYY6jc72Ai2YutYzG",
+	"btREq1htYN/IY1iKxvfIMnFB1bi8NQ6XWNy5KEvy7NqO17wPQLSI2AXI69Aqwm5sUhgBRJgW0Z06zMkC",
+	"ycaqqsLzZ7NaNv3G0PTatT6yP7Zth8Tlg9lwTlYo6Jj+POTnDrOuYsiaG+bhCHGr5ErrYsqGMONhdFWA",
+	"s12Uj8fyNbaKj8CeQ9rXFOPj3zlnvcPRo98k0Y0SwZ5dGFvwaCzsxxZ02rdNvMMXzK5uHqlXrW7q/j48",
+	"58Kio4OTmBk9IyacoXrVdLjwzo3+3sis8iZR/xBJAzA/jq/U3D58+oAcB0IICsXdH7784VTfKj3J96q1",
+	"21rFcGGsllaEAlF43hod88NzZLrTnu+05zvt+U57vtOe77TnO+35Tnt+19rz+wmmYFkW+HQIEU4FCLO7",
+	"tDLvOAb3NoNmW6W/UfnpkoAqOp7jnU6WFnhJCxIlCddKmdEAMar3bVStc2A5Tickq0ouJLNwYUOyFLbg",
+	"Br543Diz+XhWX/EbeQ02ePSQvf7u6PMHD399+PkX5AZJSXE6bT/1SWaYsdsSPvPO6E1J3uCVDhIx6J3S",
+	"ebj95MGDwmnzS1ECM4isb6j5MziDUlWg3Tsqw8vI8HqEldCfeuQ4rgTGfq2KbY9wcP2HhIouybSP8UJy",
+	"vU28oA9d2fpItgqPsd+i4Q3q7Y36Y6R9EIYbtm+vkmm/KGovPfoYvez1OSCAm7En+QoCLwM62SvX772y",
+	"bEYQeTJr2dMHExTXbdkcHGorlQ3n72MNYAuITx48OrZzpMmizoEJa5inuIsMG61AZp4tZAtVbDNv7XDj",
+	"dLlsobe6luNM9psLyGs8SwSJPwafms+QzRJGL2zH1FPAol6tkMMPzRbI74HGw9Cy98M4n7n17uKbV6cO",
+	"N3gTQ3rd8If+cEOuETl0fKo0W2lVV5/5QgpbuhJvKi63wQyGuuKmLh0OXcjWzXJq544ytHvOZ+E6Nn6T",
+	"e+lbxPcVF5zQ+92hhZ1z48veQ8FqWYzkdcTEaDjZpEyVbug3F7Jlwd18Sj1G79abWJ2fdwrrD7vsNqE1",
+	"/VWgM3sh3YnqnCaycHDmju7BXZz0n0MkvPT5TdMcdujh1TKEg72SQUcsi0RDL11YkA1dfvqKn0ccaDJP",
+	"vci84nltrRTdbrcWGi0tkVsN5aVWvMi5oVBQCfZc6dN3rLHai+OE3YHAjErXxHCiAD/Yq1jSuJP0ya4X",
+	"uZ+QktgZ42Lh36t22XqyHvnI2w427kwBfxRTwNfh8BnGmebn/cPprH50JiewKX5uL2SSSx1WrszOmMdb",
+	"dCB8QZ4bfbsbDN99wosqnrgnCCgrxpvwSSWN1XVuTyQnE2i0sGG6ycawO65KPQ1N0lb4hJHcD3UiOdVk",
+	"aQyjSZVqCYknj28BgsZm6tXKBffFm70EOJG+lZCslsLSXBuRa5U551EU18jRD1zLDd+yJS/Jhv8baMUW",
+	"tY3HNM6gaCya2N17Ik7D1PJEcstK4May5wIVOhwu2JyaN3JHdw0W0kEbS4Bsw/Xp/nJP3wI8dw3fzmcr",
+	"kGCEydLGi7+6rxRH4bEWzE34f985OGjfduBHgF0Uo5AfP/OpVI+fUXa89gFyAPutvUpthMyStEnhrO4h",
+	"v0+S7FOpbEN3n7VPmZ5YTiTq4FYxkg/cXo2K+q8HgyPsDlWPajob0XtkCGv9JZXNYqUyvGnyFf6+EnZd",
+	"Lw5ytTkMWS4OV6rJeHFYcNgoSd+KQ16JQ1NBfnj2YI9acQ02xxJc7k7g/3Fs/zEd4GlpNt6Fg/b2fkSc",
+	"30Dm+g87Xf1ez6a75PB3yeHv0offJYe/29275PB3qdPvUqf/WVOnH+zUEH0akL1phONRBaVR4kxD7mZu",
+	"GHjcrJNwePiaKewBY2/WoIF8YA2cgcZHfG6cYuQrpW0oUZap8xygeHIisw4kudr4iT9t/+uuuSf1/fuP",
+	"gN3/rN/HmTsizjvsS6oqfaIXKvYVO5mdzAYjadioM/C5QKl5UdMTs+u1d9j/rxn3Bz3YOjTekE1mzasK",
+	"UKyZerkUuXAop2QwfKV6boFS0RfQCJzLfeEynFF9d2GcO6XbFcZ9AHxK6R7K90vUcj/qkctdnpWbUbB3",
+	"8anhht0cD9w59tv5Hct4DyzjvTONP1AG1rtkqx/YguL310429WtoUr6gQJ6yO43oSJN0I4ox8+yty2iC",
+	"voY52zzzIDv8c37x5kJ+L5Y+DsLMO6F+8SBR0jdhDcMs89tn3HLGTcTJ4hxaB+xHWYpTF0CUWGrjMjZ3",
+	"Z7sJJCgAWzMlE4/rZzgIxfxEfHutVb1aRzlIWccacL5Wxi/YUaeP+fAhaxWnyC+uU/wHCsd90oynr6s8",
+	"DZi+mrbyEeWfv1XF6s+krdwd4ts+xHfaw532cNvaw53R7M9hNGtumUmbWVD2nO8AJRvDMSCv8YWTFARe",
+	"iV+xqPWTn39BMWhAnwXdodbl7MlsbW315PCQSvitlbGHs7fz+JvpfUThzlduBC+lKy3OqDDGL2//3wC+",
+	"irH71FsBAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
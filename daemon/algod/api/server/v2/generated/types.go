@@ -104,6 +104,83 @@ type AccountStateDelta struct {
 	Delta StateDelta `json:"delta"`
 }
 
+// AgreementPeriod defines model for AgreementPeriod.
+type AgreementPeriod struct {
+
+	// A proposal-value, identifying a proposed block.
+	Frozen *AgreementProposalValue `json:"frozen,omitempty"`
+
+	// Whether the frozen value, the proposal-value with the lowest credential seen in the period, can no longer change.
+	FrozenFinal bool `json:"frozen-final"`
+
+	// The period number.
+	Period uint64 `json:"period"`
+
+	// A proposal-value, identifying a proposed block.
+	Staged *AgreementProposalValue `json:"staged,omitempty"`
+
+	// The votes counted in each of the steps of the period.
+	Steps []AgreementStep `json:"steps"`
+}
+
+// AgreementProposal defines model for AgreementProposal.
+type AgreementProposal struct {
+
+	// Whether the block matching the proposal-value was received.
+	PayloadReceived bool `json:"payload-received"`
+
+	// A proposal-value, identifying a proposed block.
+	Value AgreementProposalValue `json:"value"`
+}
+
+// AgreementProposalValue defines model for AgreementProposalValue.
+type AgreementProposalValue struct {
+
+	// The digest of the proposed block.
+	BlockDigest []byte `json:"block-digest"`
+
+	// Whether this is the empty proposal-value, which next votes may vote for.
+	Bottom bool `json:"bottom"`
+
+	// The period in which the block was originally proposed.
+	OriginalPeriod uint64 `json:"original-period"`
+
+	// The address of the account which originally proposed the block.
+	OriginalProposer string `json:"original-proposer"`
+}
+
+// AgreementStep defines model for AgreementStep.
+type AgreementStep struct {
+
+	// The expected total vote weight of the step.
+	CommitteeSize uint64 `json:"committee-size"`
+
+	// The vote weight of the equivocating accounts, which counts toward any proposal-value.
+	EquivocatorsWeight uint64 `json:"equivocators-weight"`
+
+	// The step number.
+	Step uint64 `json:"step"`
+
+	// The vote weight a single proposal-value needs in order to form a bundle in this step.
+	Threshold uint64 `json:"threshold"`
+
+	// The proposal-values voted for in the step, ordered by decreasing weight.
+	Votes []AgreementVoteTally `json:"votes"`
+}
+
+// AgreementVoteTally defines model for AgreementVoteTally.
+type AgreementVoteTally struct {
+
+	// A proposal-value, identifying a proposed block.
+	Value AgreementProposalValue `json:"value"`
+
+	// The number of distinct accounts which voted for the proposal-value.
+	Voters uint64 `json:"voters"`
+
+	// The vote weight cast for the proposal-value.
+	Weight uint64 `json:"weight"`
+}
+
 // Application defines model for Application.
 type Application struct {
 
@@ -313,6 +390,28 @@ type DryrunTxnResult struct {
 	Logs             *[][]byte            `json:"logs,omitempty"`
 }
 
+// Equivocation defines model for Equivocation.
+type Equivocation struct {
+
+	// The msgpack encoded evidence, holding both signed votes along with the voting key of the account.
+	Evidence []byte `json:"evidence"`
+
+	// The period of the conflicting votes.
+	Period uint64 `json:"period"`
+
+	// The two proposal-values the account voted for.
+	Proposals []AgreementProposalValue `json:"proposals"`
+
+	// The round of the conflicting votes.
+	Round uint64 `json:"round"`
+
+	// The address of the equivocating account.
+	Sender string `json:"sender"`
+
+	// The step of the conflicting votes.
+	Step uint64 `json:"step"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Data    *string `json:"data,omitempty"`
@@ -340,6 +439,65 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// FeeMarket defines model for FeeMarket.
+type FeeMarket struct {
+
+	// The fee per byte a new transaction group needs to pay in order to evict the lowest paying pending transaction group. Zero if the transaction pool isn't full.
+	EvictionFeePerByte uint64 `json:"eviction-fee-per-byte"`
+
+	// The median fee per byte among the pending transaction groups.
+	MedianFeePerByte uint64 `json:"median-fee-per-byte"`
+
+	// The lowest fee per byte among the pending transaction groups that would fit into the next block. Zero if all the pending transactions would fit into the next block.
+	NextBlockFeePerByte uint64 `json:"next-block-fee-per-byte"`
+
+	// The number of transactions pending in the transaction pool.
+	PendingTransactions uint64 `json:"pending-transactions"`
+
+	// The number of transactions the transaction pool holds before it starts evicting the lowest paying transaction groups.
+	PoolCapacity uint64 `json:"pool-capacity"`
+}
+
+// PeerStatus defines model for PeerStatus.
+type PeerStatus struct {
+
+	// The peer address. For outgoing connections this is the dialed relay address, and for incoming connections the remote host:port.
+	Address string `json:"address"`
+
+	// The unix time, in seconds, at which the connection was established.
+	ConnectedSince uint64 `json:"connected-since"`
+
+	// The instance name the peer reported during the handshake.
+	InstanceName *string `json:"instance-name,omitempty"`
+
+	// The measured round trip time to the peer, in nanoseconds.
+	Latency uint64 `json:"latency"`
+
+	// The number of messages received from the peer.
+	MessagesReceived uint64 `json:"messages-received"`
+
+	// The number of messages sent to the peer.
+	MessagesSent uint64 `json:"messages-sent"`
+
+	// Whether the connection was initiated by this node.
+	Outgoing bool `json:"outgoing"`
+
+	// Whether the peer address was pinned by the node operator.
+	Pinned bool `json:"pinned"`
+
+	// The participation address the peer proved ownership of, if any.
+	PriorityAddress *string `json:"priority-address,omitempty"`
+
+	// The priority weight associated with the peer's participation address.
+	PriorityWeight uint64 `json:"priority-weight"`
+
+	// The telemetry GUID the peer reported during the handshake.
+	TelemetryGuid *string `json:"telemetry-guid,omitempty"`
+
+	// The negotiated network protocol version.
+	Version string `json:"version"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -472,6 +630,9 @@ type Next string
 // NotePrefix defines model for note-prefix.
 type NotePrefix string
 
+// PeerAddress defines model for peer-address.
+type PeerAddress string
+
 // Round defines model for round.
 type Round uint64
 
@@ -487,15 +648,167 @@ type TxId string
 // TxType defines model for tx-type.
 type TxType string
 
+// AccountApplicationResponse defines model for AccountApplicationResponse.
+type AccountApplicationResponse struct {
+
+	// Stores local state associated with an application.
+	AppLocalState *ApplicationLocalState `json:"app-local-state,omitempty"`
+
+	// Stores the global information associated with an application.
+	CreatedApp *ApplicationParams `json:"created-app,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountApplicationsResponse defines model for AccountApplicationsResponse.
+type AccountApplicationsResponse struct {
+
+	// The application local states in this page.
+	AppsLocalStates []ApplicationLocalState `json:"apps-local-states"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountAssetResponse defines model for AccountAssetResponse.
+type AccountAssetResponse struct {
+
+	// Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	AssetHolding *AssetHolding `json:"asset-holding,omitempty"`
+
+	// AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	CreatedAsset *AssetParams `json:"created-asset,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountAssetsResponse defines model for AccountAssetsResponse.
+type AccountAssetsResponse struct {
+
+	// The asset holdings in this page.
+	AssetHoldings []AssetHolding `json:"asset-holdings"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountCreatedApplicationsResponse defines model for AccountCreatedApplicationsResponse.
+type AccountCreatedApplicationsResponse struct {
+
+	// The created applications in this page.
+	CreatedApps []Application `json:"created-apps"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountCreatedAssetsResponse defines model for AccountCreatedAssetsResponse.
+type AccountCreatedAssetsResponse struct {
+
+	// The created assets in this page.
+	CreatedAssets []Asset `json:"created-assets"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse Account
 
+// AccountStateProofResponse defines model for AccountStateProofResponse.
+type AccountStateProofResponse struct {
+
+	// Account information at a given round.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AccountData
+	Account Account `json:"account"`
+
+	// The label of the catchpoint the proof was generated against.
+	Catchpoint string `json:"catchpoint"`
+
+	// The msgpack encoded account state proof, holding the account data, the components of the catchpoint label and the merkle proof of the account data in the catchpoint balances trie.
+	Proof []byte `json:"proof"`
+
+	// The catchpoint round.
+	Round uint64 `json:"round"`
+}
+
+// AgreementStateResponse defines model for AgreementStateResponse.
+type AgreementStateResponse struct {
+
+	// The time of the next timeout, relative to the beginning of the current period, in nanoseconds.
+	Deadline uint64 `json:"deadline"`
+
+	// The time of the next fast partition recovery timeout, relative to the beginning of the current period, in nanoseconds.
+	FastRecoveryDeadline uint64 `json:"fast-recovery-deadline"`
+
+	// The largest step reached in the previous period.
+	LastConcluding uint64 `json:"last-concluding"`
+
+	// Whether the next timeout is a random one, after which a next vote is sent.
+	Napping bool `json:"napping"`
+
+	// The current period.
+	Period uint64 `json:"period"`
+
+	// The periods of the current round in which votes were seen.
+	Periods []AgreementPeriod `json:"periods"`
+
+	// A proposal-value, identifying a proposed block.
+	Pinned *AgreementProposalValue `json:"pinned,omitempty"`
+
+	// The proposals tracked in the current round.
+	Proposals []AgreementProposal `json:"proposals"`
+
+	// The current round.
+	Round uint64 `json:"round"`
+
+	// The current step.
+	Step uint64 `json:"step"`
+
+	// The time elapsed since the beginning of the current period, in nanoseconds.
+	TimeInPeriod uint64 `json:"time-in-period"`
+}
+
 // ApplicationResponse defines model for ApplicationResponse.
 type ApplicationResponse Application
 
 // AssetResponse defines model for AssetResponse.
 type AssetResponse Asset
 
+// BlockHeaderProofResponse defines model for BlockHeaderProofResponse.
+type BlockHeaderProofResponse struct {
+
+	// The round of the block header certified by the compact cert.
+	CertRound uint64 `json:"cert-round"`
+
+	// The msgpack encoded header proof, holding the compact cert along with the block headers from the requested round up to the certified one.
+	HeaderProof []byte `json:"header-proof"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 
@@ -539,6 +852,11 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// EquivocationsResponse defines model for EquivocationsResponse.
+type EquivocationsResponse struct {
+	Equivocations []Equivocation `json:"equivocations"`
+}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -588,6 +906,18 @@ type NodeStatusResponse struct {
 	TimeSinceLastRound uint64 `json:"time-since-last-round"`
 }
 
+// PeerAdminResponse defines model for PeerAdminResponse.
+type PeerAdminResponse struct {
+
+	// Peer administration response string
+	Message string `json:"message"`
+}
+
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+	Peers []PeerStatus `json:"peers"`
+}
+
 // PendingTransactionsResponse defines model for PendingTransactionsResponse.
 type PendingTransactionsResponse struct {
 
@@ -644,6 +974,9 @@ type TransactionParametersResponse struct {
 	// at least MinTxnFee for the current network protocol.
 	Fee uint64 `json:"fee"`
 
+	// A summary of the fees offered by the transactions pending in the transaction pool. All the fees are effective fees per byte, computed over whole transaction groups, in micro-Algos.
+	FeeMarket *FeeMarket `json:"fee-market,omitempty"`
+
 	// GenesisHash is the hash of the genesis block.
 	GenesisHash []byte `json:"genesis-hash"`
 
@@ -666,6 +999,66 @@ type AccountInformationParams struct {
 
 	// Configures whether the response object is JSON or MessagePack encoded.
 	Format *string `json:"format,omitempty"`
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// When set to all, the asset holdings, created assets, application local states and created applications of the account are left out of the response. Defaults to none.
+	Exclude *string `json:"exclude,omitempty"`
+}
+
+// AccountApplicationsInformationParams defines parameters for AccountApplicationsInformation.
+type AccountApplicationsInformationParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+}
+
+// AccountApplicationInformationParams defines parameters for AccountApplicationInformation.
+type AccountApplicationInformationParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
+// AccountAssetsInformationParams defines parameters for AccountAssetsInformation.
+type AccountAssetsInformationParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+}
+
+// AccountAssetInformationParams defines parameters for AccountAssetInformation.
+type AccountAssetInformationParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
+// AccountCreatedApplicationsParams defines parameters for AccountCreatedApplications.
+type AccountCreatedApplicationsParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+}
+
+// AccountCreatedAssetsParams defines parameters for AccountCreatedAssets.
+type AccountCreatedAssetsParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
 }
 
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
@@ -678,6 +1071,20 @@ type GetPendingTransactionsByAddressParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetApplicationByIDParams defines parameters for GetApplicationByID.
+type GetApplicationByIDParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
+// GetAssetByIDParams defines parameters for GetAssetByID.
+type GetAssetByIDParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 
@@ -712,5 +1119,12 @@ type PendingTransactionInformationParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// ConfirmedTransactionInformationParams defines parameters for ConfirmedTransactionInformation.
+type ConfirmedTransactionInformationParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
 // TealDryrunRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody TealDryrunJSONBody
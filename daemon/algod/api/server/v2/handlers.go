@@ -18,6 +18,7 @@ package v2
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -28,17 +29,23 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/compactcert/lightclient"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -64,9 +71,17 @@ type NodeInterface interface {
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
+	FeeMarket() pools.FeeMarket
+	AgreementState(ctx context.Context) (agreement.State, error)
+	Equivocations(minRound basics.Round, max uint64) ([]agreement.EquivocationEvidence, error)
 	StartCatchup(catchpoint string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
+	PeersInfo() ([]network.PeerInfo, error)
+	DisconnectPeer(address string) error
+	PinPeer(address string) error
+	UnpinPeer(address string) error
+	ReloadPhonebook() (int, error)
 }
 
 // RegisterParticipationKeys registers participation keys.
@@ -91,16 +106,55 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
 
+	excludeResources := false
+	if params.Exclude != nil {
+		switch *params.Exclude {
+		case "all":
+			excludeResources = true
+		case "none", "":
+		default:
+			return badRequest(ctx, fmt.Errorf("unknown exclude option '%s'", *params.Exclude), errFailedParsingExcludeOption, v2.Log)
+		}
+	}
+
 	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	lastRound, ok, err := v2.requestedRound(ctx, params.Round)
+	if !ok {
+		return err
+	}
+
 	myLedger := v2.Node.Ledger()
-	lastRound := myLedger.Latest()
-	record, err := myLedger.Lookup(lastRound, addr)
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	var record, recordWithoutPendingRewards basics.AccountData
+	if params.Round == nil {
+		record, err = myLedger.Lookup(lastRound, addr)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		recordWithoutPendingRewards, _, err = myLedger.LookupWithoutRewards(lastRound, addr)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	} else {
+		recordWithoutPendingRewards, ok, err = v2.lookupHistorical(ctx, lastRound, addr)
+		if !ok {
+			return err
+		}
+		hdr, err := myLedger.BlockHdr(lastRound)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		record = recordWithoutPendingRewards.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel)
+	}
+
+	if excludeResources {
+		record.Assets = nil
+		record.AssetParams = nil
+		record.AppLocalStates = nil
+		record.AppParams = nil
 	}
 
 	if handle == protocol.CodecHandle {
@@ -111,38 +165,297 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return ctx.Blob(http.StatusOK, contentType, data)
 	}
 
-	recordWithoutPendingRewards, _, err := myLedger.LookupWithoutRewards(lastRound, addr)
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
-	}
 	amountWithoutPendingRewards := recordWithoutPendingRewards.MicroAlgos
 
 	assetsCreators := make(map[basics.AssetIndex]string, len(record.Assets))
-	if len(record.Assets) > 0 {
-		//assets = make(map[uint64]v1.AssetHolding)
-		for curid := range record.Assets {
-			var creator string
-			creatorAddr, ok, err := myLedger.GetCreator(basics.CreatableIndex(curid), basics.AssetCreatable)
-			if err == nil && ok {
-				creator = creatorAddr.String()
-			} else {
-				// Asset may have been deleted, so we can no
-				// longer fetch the creator
-				creator = ""
-			}
-			assetsCreators[curid] = creator
-		}
+	for curid := range record.Assets {
+		assetsCreators[curid] = v2.assetCreator(curid)
 	}
 
 	account, err := AccountDataToAccount(address, &record, assetsCreators, lastRound, amountWithoutPendingRewards)
 	if err != nil {
 		return internalError(ctx, err, errInternalFailure, v2.Log)
 	}
+	if excludeResources {
+		account.Assets = nil
+		account.CreatedAssets = nil
+		account.AppsLocalState = nil
+		account.CreatedApps = nil
+	}
 
 	response := generated.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// requestedRound returns the round an account, asset or application query is answered for: the
+// given round parameter if any, or the latest round otherwise. When ok is false, the error
+// response was already written and err is to be returned by the handler.
+func (v2 *Handlers) requestedRound(ctx echo.Context, round *uint64) (rnd basics.Round, ok bool, err error) {
+	lastRound := v2.Node.Ledger().Latest()
+	if round == nil {
+		return lastRound, true, nil
+	}
+	if basics.Round(*round) > lastRound {
+		return 0, false, badRequest(ctx, fmt.Errorf("round %d is after the latest round %d", *round, lastRound), errRoundAfterLatest, v2.Log)
+	}
+	return basics.Round(*round), true, nil
+}
+
+// lookupHistorical returns the account data of addr, without the pending rewards, at the
+// given round, which may precede the rounds kept in memory by the ledger. When ok is false,
+// the error response was already written and err is to be returned by the handler.
+func (v2 *Handlers) lookupHistorical(ctx echo.Context, rnd basics.Round, addr basics.Address) (record basics.AccountData, ok bool, err error) {
+	record, err = v2.Node.Ledger().LookupHistorical(rnd, addr)
+	if err == ledger.ErrAccountHistoryUnavailable {
+		return basics.AccountData{}, false, notFound(ctx, err, errAccountHistoryUnavailable, v2.Log)
+	}
+	if err != nil {
+		return basics.AccountData{}, false, internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	return record, true, nil
+}
+
+// lookupAccountResources parses the given address and returns its account data, without
+// the pending rewards, at the requested round. When ok is false, the error response was
+// already written and err is to be returned by the handler.
+func (v2 *Handlers) lookupAccountResources(ctx echo.Context, address string, round *uint64) (addr basics.Address, record basics.AccountData, rnd basics.Round, ok bool, err error) {
+	addr, err = basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return basics.Address{}, basics.AccountData{}, 0, false, badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	rnd, ok, err = v2.requestedRound(ctx, round)
+	if !ok {
+		return basics.Address{}, basics.AccountData{}, 0, false, err
+	}
+	record, ok, err = v2.lookupHistorical(ctx, rnd, addr)
+	if !ok {
+		return basics.Address{}, basics.AccountData{}, 0, false, err
+	}
+	return addr, record, rnd, true, nil
+}
+
+// assetCreator returns the creator of an asset, or an empty string if the asset was deleted
+// and the creator is not known to the ledger anymore.
+func (v2 *Handlers) assetCreator(idx basics.AssetIndex) string {
+	creatorAddr, ok, err := v2.Node.Ledger().LookupCreator(basics.CreatableIndex(idx), basics.AssetCreatable)
+	if err != nil || !ok {
+		return ""
+	}
+	return creatorAddr.String()
+}
+
+// AccountAssetInformation returns an account's holding and parameters of an asset.
+// (GET /v2/accounts/{address}/assets/{asset-id})
+func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params generated.AccountAssetInformationParams) error {
+	addr, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, params.Round)
+	if !ok {
+		return err
+	}
+
+	assetIdx := basics.AssetIndex(assetID)
+	response := generated.AccountAssetResponse{Round: uint64(lastRound)}
+	if holding, ok := record.Assets[assetIdx]; ok {
+		h := assetHoldingToGenerated(assetIdx, holding, v2.assetCreator(assetIdx))
+		response.AssetHolding = &h
+	}
+	if params, ok := record.AssetParams[assetIdx]; ok {
+		asset := AssetParamsToAsset(addr.String(), assetIdx, &params)
+		response.CreatedAsset = &asset.Params
+	}
+	if response.AssetHolding == nil && response.CreatedAsset == nil {
+		return notFound(ctx, errors.New(errAccountAssetDoesNotExist), errAccountAssetDoesNotExist, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountApplicationInformation returns an account's local state and parameters of an application.
+// (GET /v2/accounts/{address}/applications/{application-id})
+func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address string, applicationID uint64, params generated.AccountApplicationInformationParams) error {
+	addr, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, params.Round)
+	if !ok {
+		return err
+	}
+
+	appIdx := basics.AppIndex(applicationID)
+	response := generated.AccountApplicationResponse{Round: uint64(lastRound)}
+	if state, ok := record.AppLocalStates[appIdx]; ok {
+		localState := appLocalStateToGenerated(appIdx, &state)
+		response.AppLocalState = &localState
+	}
+	if params, ok := record.AppParams[appIdx]; ok {
+		app := AppParamsToApplication(addr.String(), appIdx, &params)
+		response.CreatedApp = &app.Params
+	}
+	if response.AppLocalState == nil && response.CreatedApp == nil {
+		return notFound(ctx, errors.New(errAccountAppDoesNotExist), errAccountAppDoesNotExist, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountAssetsInformation returns a page of an account's asset holdings.
+// (GET /v2/accounts/{address}/assets)
+func (v2 *Handlers) AccountAssetsInformation(ctx echo.Context, address string, params generated.AccountAssetsInformationParams) error {
+	_, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, nil)
+	if !ok {
+		return err
+	}
+
+	indices := make([]uint64, 0, len(record.Assets))
+	for idx := range record.Assets {
+		indices = append(indices, uint64(idx))
+	}
+	page, nextToken, err := paginateIndices(indices, params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingNextToken, v2.Log)
+	}
+
+	response := generated.AccountAssetsResponse{
+		Round:         uint64(lastRound),
+		AssetHoldings: make([]generated.AssetHolding, 0, len(page)),
+		NextToken:     nextToken,
+	}
+	for _, idx := range page {
+		assetIdx := basics.AssetIndex(idx)
+		response.AssetHoldings = append(response.AssetHoldings, assetHoldingToGenerated(assetIdx, record.Assets[assetIdx], v2.assetCreator(assetIdx)))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountCreatedAssets returns a page of the assets created by an account.
+// (GET /v2/accounts/{address}/created-assets)
+func (v2 *Handlers) AccountCreatedAssets(ctx echo.Context, address string, params generated.AccountCreatedAssetsParams) error {
+	addr, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, nil)
+	if !ok {
+		return err
+	}
+
+	indices := make([]uint64, 0, len(record.AssetParams))
+	for idx := range record.AssetParams {
+		indices = append(indices, uint64(idx))
+	}
+	page, nextToken, err := paginateIndices(indices, params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingNextToken, v2.Log)
+	}
+
+	response := generated.AccountCreatedAssetsResponse{
+		Round:         uint64(lastRound),
+		CreatedAssets: make([]generated.Asset, 0, len(page)),
+		NextToken:     nextToken,
+	}
+	for _, idx := range page {
+		assetIdx := basics.AssetIndex(idx)
+		assetParams := record.AssetParams[assetIdx]
+		response.CreatedAssets = append(response.CreatedAssets, AssetParamsToAsset(addr.String(), assetIdx, &assetParams))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountApplicationsInformation returns a page of an account's application local states.
+// (GET /v2/accounts/{address}/applications)
+func (v2 *Handlers) AccountApplicationsInformation(ctx echo.Context, address string, params generated.AccountApplicationsInformationParams) error {
+	_, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, nil)
+	if !ok {
+		return err
+	}
+
+	indices := make([]uint64, 0, len(record.AppLocalStates))
+	for idx := range record.AppLocalStates {
+		indices = append(indices, uint64(idx))
+	}
+	page, nextToken, err := paginateIndices(indices, params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingNextToken, v2.Log)
+	}
+
+	response := generated.AccountApplicationsResponse{
+		Round:           uint64(lastRound),
+		AppsLocalStates: make([]generated.ApplicationLocalState, 0, len(page)),
+		NextToken:       nextToken,
+	}
+	for _, idx := range page {
+		appIdx := basics.AppIndex(idx)
+		state := record.AppLocalStates[appIdx]
+		response.AppsLocalStates = append(response.AppsLocalStates, appLocalStateToGenerated(appIdx, &state))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountCreatedApplications returns a page of the applications created by an account.
+// (GET /v2/accounts/{address}/created-applications)
+func (v2 *Handlers) AccountCreatedApplications(ctx echo.Context, address string, params generated.AccountCreatedApplicationsParams) error {
+	addr, record, lastRound, ok, err := v2.lookupAccountResources(ctx, address, nil)
+	if !ok {
+		return err
+	}
+
+	indices := make([]uint64, 0, len(record.AppParams))
+	for idx := range record.AppParams {
+		indices = append(indices, uint64(idx))
+	}
+	page, nextToken, err := paginateIndices(indices, params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingNextToken, v2.Log)
+	}
+
+	response := generated.AccountCreatedApplicationsResponse{
+		Round:       uint64(lastRound),
+		CreatedApps: make([]generated.Application, 0, len(page)),
+		NextToken:   nextToken,
+	}
+	for _, idx := range page {
+		appIdx := basics.AppIndex(idx)
+		appParams := record.AppParams[appIdx]
+		response.CreatedApps = append(response.CreatedApps, AppParamsToApplication(addr.String(), appIdx, &appParams))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountStateProof returns an account's data at a catchpoint round, along with its proof against the catchpoint label.
+// (GET /v2/accounts/{address}/proof)
+func (v2 *Handlers) AccountStateProof(ctx echo.Context, address string, params private.AccountStateProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	round := basics.Round(params.Round)
+	label, proof, err := v2.Node.Ledger().AccountStateProof(round, addr)
+	if err != nil {
+		var noEntry ledgercore.ErrNoEntry
+		if errors.As(err, &noEntry) || err == ledger.ErrAccountNotInCatchpoint {
+			return notFound(ctx, err, errNoCatchpointAccountProof, v2.Log)
+		}
+		if err == ledger.ErrCatchpointProofBusy {
+			return serviceUnavailable(ctx, err, errCatchpointAccountProofBusy, v2.Log)
+		}
+		return internalError(ctx, err, errFailedGeneratingAccountProof, v2.Log)
+	}
+
+	var record basics.AccountData
+	err = protocol.Decode(proof.EncodedAccountData, &record)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	assetsCreators := make(map[basics.AssetIndex]string, len(record.Assets))
+	for idx := range record.Assets {
+		assetsCreators[idx] = v2.assetCreator(idx)
+	}
+	account, err := AccountDataToAccount(address, &record, assetsCreators, round, record.MicroAlgos)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+
+	response := private.AccountStateProofResponse{
+		Round:      uint64(round),
+		Catchpoint: label,
+		Account:    accountToPrivate(account),
+		Proof:      protocol.EncodeReflect(&proof),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetBlock gets the block for the given round.
 // (GET /v2/blocks/{round})
 func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params generated.GetBlockParams) error {
@@ -241,6 +554,98 @@ func (v2 *Handlers) GetProof(ctx echo.Context, round uint64, txid string, params
 	return notFound(ctx, err, err.Error(), v2.Log)
 }
 
+// GetBlockHeaderProof returns the compact cert covering the given round, along with the block headers leading to it.
+// (GET /v2/blocks/{round}/header-proof)
+func (v2 *Handlers) GetBlockHeaderProof(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.Ledger()
+	hdr, err := ledger.BlockHdr(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	proto := config.Consensus[hdr.CurrentProtocol]
+	if proto.CompactCertRounds == 0 {
+		err = errors.New(errCompactCertsNotSupported)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	interval := basics.Round(proto.CompactCertRounds)
+	certRound := (hdr.Round + interval - 1) / interval * interval
+	if certRound == 0 {
+		// there is no compact cert for the genesis block; it is covered by the first one.
+		certRound = interval
+	}
+
+	proof := lightclient.HeaderProof{CertRound: certRound}
+	found, err := findCompactCert(ledger, certRound, &proof.Cert)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if !found {
+		err = errors.New(errCompactCertNotAvailable)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	proof.Headers = make([]bookkeeping.BlockHeader, 0, certRound-hdr.Round+1)
+	for rnd := hdr.Round; rnd <= certRound; rnd++ {
+		h, err := ledger.BlockHdr(rnd)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		proof.Headers = append(proof.Headers, h)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.BlockHeaderProofResponse{
+		CertRound:   uint64(certRound),
+		HeaderProof: protocol.Encode(&proof),
+	})
+}
+
+// findCompactCert looks up the compact cert transaction certifying the given round. Since each block header tracks
+// the next round for which a compact cert is accepted, the block holding it is the first one whose next round moved
+// past certRound.
+func findCompactCert(ledger *data.Ledger, certRound basics.Round, cert *compactcert.Cert) (bool, error) {
+	lo, hi := certRound+1, ledger.Latest()
+	if hi < lo {
+		return false, nil
+	}
+	nextCertRound := func(rnd basics.Round) (basics.Round, error) {
+		hdr, err := ledger.BlockHdr(rnd)
+		return hdr.CompactCert[protocol.CompactCertBasic].CompactCertNextRound, err
+	}
+	next, err := nextCertRound(hi)
+	if err != nil || next <= certRound {
+		return false, err
+	}
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		next, err = nextCertRound(mid)
+		if err != nil {
+			return false, err
+		}
+		if next > certRound {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	block, err := ledger.Block(lo)
+	if err != nil {
+		return false, err
+	}
+	txns, err := block.DecodePaysetFlat()
+	if err != nil {
+		return false, err
+	}
+	for _, txn := range txns {
+		if txn.Txn.Type == protocol.CompactCertTx && txn.Txn.CertRound == certRound {
+			*cert = txn.Txn.Cert
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("block %d advanced the next compact cert round past %d without a compact cert transaction", lo, certRound)
+}
+
 // GetSupply gets the current supply reported by the ledger.
 // (GET /v2/ledger/supply)
 func (v2 *Handlers) GetSupply(ctx echo.Context) error {
@@ -467,6 +872,15 @@ func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
 		MinFee:           proto.MinTxnFee,
 	}
 
+	market := v2.Node.FeeMarket()
+	response.FeeMarket = &generated.FeeMarket{
+		PendingTransactions: uint64(market.PendingCount),
+		PoolCapacity:        uint64(market.Capacity),
+		MedianFeePerByte:    market.MedianFeePerByte,
+		NextBlockFeePerByte: market.NextBlockFeePerByte,
+		EvictionFeePerByte:  market.EvictionFeePerByte,
+	}
+
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -515,6 +929,68 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		return notFound(ctx, err, err.Error(), v2.Log)
 	}
 
+	response := v2.txnWithStatusToPreEncoded(txn)
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// ConfirmedTransactionInformation returns a transaction with the specified txID
+// that was confirmed within the rounds tracked by the ledger's transaction tail.
+// (GET /v2/transactions/{txid})
+func (v2 *Handlers) ConfirmedTransactionInformation(ctx echo.Context, txid string, params generated.ConfirmedTransactionInformationParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("ConfirmedTransactionInformation failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	txID := transactions.Txid{}
+	if err := txID.UnmarshalText([]byte(txid)); err != nil {
+		return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+	}
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	stxn, round, found, err := v2.Node.Ledger().LookupRecentTxid(txID)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if !found {
+		err := errors.New(errTransactionNotFound)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := v2.txnWithStatusToPreEncoded(node.TxnWithStatus{
+		Txn:            stxn.SignedTxn,
+		ConfirmedRound: round,
+		ApplyData:      stxn.ApplyData,
+	})
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// txnWithStatusToPreEncoded converts a transaction, along with its ApplyData if it was
+// confirmed, to the form in which it is returned by the transaction endpoints.
+func (v2 *Handlers) txnWithStatusToPreEncoded(txn node.TxnWithStatus) preEncodedTxInfo {
 	// Encoding wasn't working well without embedding "real" objects.
 	response := preEncodedTxInfo{
 		Txn: txn.Txn,
@@ -535,17 +1011,7 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		response.Logs = convertLogs(txn)
 		response.Inners = convertInners(&txn)
 	}
-
-	handle, contentType, err := getCodecHandle(params.Format)
-	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
-	}
-	data, err := encode(handle, response)
-	if err != nil {
-		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
-	}
-
-	return ctx.Blob(http.StatusOK, contentType, data)
+	return response
 }
 
 // getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
@@ -675,10 +1141,14 @@ func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params generated.Ge
 
 // GetApplicationByID returns application information by app idx.
 // (GET /v2/applications/{application-id})
-func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
+func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64, params generated.GetApplicationByIDParams) error {
 	appIdx := basics.AppIndex(applicationID)
-	ledger := v2.Node.Ledger()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	rnd, ok, err := v2.requestedRound(ctx, params.Round)
+	if !ok {
+		return err
+	}
+
+	creator, ok, err := v2.Node.Ledger().LookupCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
@@ -686,10 +1156,9 @@ func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) e
 		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
 	}
 
-	lastRound := ledger.Latest()
-	record, _, err := ledger.LookupWithoutRewards(lastRound, creator)
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	record, ok, err := v2.lookupHistorical(ctx, rnd, creator)
+	if !ok {
+		return err
 	}
 
 	appParams, ok := record.AppParams[appIdx]
@@ -703,10 +1172,14 @@ func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) e
 
 // GetAssetByID returns application information by app idx.
 // (GET /v2/assets/{asset-id})
-func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
+func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64, params generated.GetAssetByIDParams) error {
 	assetIdx := basics.AssetIndex(assetID)
-	ledger := v2.Node.Ledger()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	rnd, ok, err := v2.requestedRound(ctx, params.Round)
+	if !ok {
+		return err
+	}
+
+	creator, ok, err := v2.Node.Ledger().LookupCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
@@ -714,10 +1187,9 @@ func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
 		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
 	}
 
-	lastRound := ledger.Latest()
-	record, _, err := ledger.LookupWithoutRewards(lastRound, creator)
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	record, ok, err := v2.lookupHistorical(ctx, rnd, creator)
+	if !ok {
+		return err
 	}
 
 	assetParams, ok := record.AssetParams[assetIdx]
@@ -748,6 +1220,120 @@ func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.abortCatchup(ctx, catchpoint)
 }
 
+// GetPeers returns the list of the currently connected peers.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	peers, err := v2.Node.PeersInfo()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingPeers, v2.Log)
+	}
+	response := private.PeersResponse{
+		Peers: make([]private.PeerStatus, 0, len(peers)),
+	}
+	for _, peer := range peers {
+		response.Peers = append(response.Peers, peerInfoToPeerStatus(peer))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAgreementState returns a snapshot of the agreement service state.
+// (GET /v2/agreement)
+func (v2 *Handlers) GetAgreementState(ctx echo.Context) error {
+	state, err := v2.Node.AgreementState(ctx.Request().Context())
+	switch err {
+	case nil:
+	case agreement.ErrServiceNotRunning:
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, errFailedRetrievingAgreementState, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, agreementStateToResponse(state))
+}
+
+// GetEquivocations returns the evidence of the equivocations observed by the node.
+// (GET /v2/equivocations)
+func (v2 *Handlers) GetEquivocations(ctx echo.Context, params private.GetEquivocationsParams) error {
+	var minRound basics.Round
+	if params.MinRound != nil {
+		minRound = basics.Round(*params.MinRound)
+	}
+	var max uint64
+	if params.Limit != nil {
+		max = *params.Limit
+	}
+	evidence, err := v2.Node.Equivocations(minRound, max)
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingEquivocations, v2.Log)
+	}
+	response := private.EquivocationsResponse{
+		Equivocations: make([]private.Equivocation, len(evidence)),
+	}
+	for i, ev := range evidence {
+		response.Equivocations[i] = equivocationEvidenceToEquivocation(ev)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// DisconnectPeer disconnects the peer with the given address.
+// (DELETE /v2/peers)
+func (v2 *Handlers) DisconnectPeer(ctx echo.Context, params private.DisconnectPeerParams) error {
+	err := v2.Node.DisconnectPeer(params.Address)
+	switch err {
+	case nil:
+	case network.ErrPeerNotFound:
+		return notFound(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToDisconnectPeer, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.PeerAdminResponse{
+		Message: fmt.Sprintf("peer %s disconnected", params.Address),
+	})
+}
+
+// PinPeer asks the node to always maintain an outgoing connection to the given relay address.
+// (POST /v2/peers/pinned)
+func (v2 *Handlers) PinPeer(ctx echo.Context, params private.PinPeerParams) error {
+	err := v2.Node.PinPeer(params.Address)
+	switch err {
+	case nil:
+	case network.ErrInvalidPeerAddress:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPinPeer, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.PeerAdminResponse{
+		Message: fmt.Sprintf("peer %s pinned", params.Address),
+	})
+}
+
+// UnpinPeer removes the given relay address from the pinned peers.
+// (DELETE /v2/peers/pinned)
+func (v2 *Handlers) UnpinPeer(ctx echo.Context, params private.UnpinPeerParams) error {
+	err := v2.Node.UnpinPeer(params.Address)
+	switch err {
+	case nil:
+	case network.ErrPeerNotPinned:
+		return notFound(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToUnpinPeer, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.PeerAdminResponse{
+		Message: fmt.Sprintf("peer %s unpinned", params.Address),
+	})
+}
+
+// ReloadPhonebook reloads the static relay addresses from the phonebook file.
+// (POST /v2/peers/phonebook/reload)
+func (v2 *Handlers) ReloadPhonebook(ctx echo.Context) error {
+	count, err := v2.Node.ReloadPhonebook()
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedToReloadPhonebook, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.PeerAdminResponse{
+		Message: fmt.Sprintf("phonebook reloaded with %d addresses", count),
+	})
+}
+
 // TealCompile compiles TEAL code to binary, return both binary and hash
 // (POST /v2/teal/compile)
 func (v2 *Handlers) TealCompile(ctx echo.Context) error {
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
@@ -33,12 +34,14 @@ import (
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -97,6 +100,115 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func accountResourcesTest(t *testing.T, expectedCode int, call func(v2.Handlers, echo.Context) error) *httptest.ResponseRecorder {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := call(handler, c)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	return rec
+}
+
+func TestAccountInformationExclude(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	exclude := "all"
+	rec := accountResourcesTest(t, 200, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Exclude: &exclude})
+	})
+	actualResponse := generatedV2.AccountResponse{}
+	err := protocol.DecodeJSON(rec.Body.Bytes(), &actualResponse)
+	require.NoError(t, err)
+	require.Equal(t, poolAddrResponseGolden.Amount, actualResponse.Amount)
+	require.Nil(t, actualResponse.Assets)
+	require.Nil(t, actualResponse.CreatedAssets)
+	require.Nil(t, actualResponse.AppsLocalState)
+	require.Nil(t, actualResponse.CreatedApps)
+
+	unknown := "everything"
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Exclude: &unknown})
+	})
+}
+
+func TestAccountResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accountResourcesTest(t, 404, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountAssetInformation(c, poolAddr.String(), 1, generatedV2.AccountAssetInformationParams{})
+	})
+	accountResourcesTest(t, 404, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountApplicationInformation(c, poolAddr.String(), 1, generatedV2.AccountApplicationInformationParams{})
+	})
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountAssetsInformation(c, "bad account", generatedV2.AccountAssetsInformationParams{})
+	})
+
+	badNext := "not-an-index"
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountCreatedAssets(c, poolAddr.String(), generatedV2.AccountCreatedAssetsParams{Next: &badNext})
+	})
+
+	rec := accountResourcesTest(t, 200, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountApplicationsInformation(c, poolAddr.String(), generatedV2.AccountApplicationsInformationParams{})
+	})
+	appsResponse := generatedV2.AccountApplicationsResponse{}
+	err := protocol.DecodeJSON(rec.Body.Bytes(), &appsResponse)
+	require.NoError(t, err)
+	require.Empty(t, appsResponse.AppsLocalStates)
+	require.Nil(t, appsResponse.NextToken)
+
+	accountResourcesTest(t, 200, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountCreatedApplications(c, poolAddr.String(), generatedV2.AccountCreatedApplicationsParams{})
+	})
+}
+
+func TestAccountQueriesAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisRound := uint64(0)
+	rec := accountResourcesTest(t, 200, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Round: &genesisRound})
+	})
+	account := generatedV2.AccountResponse{}
+	err := protocol.DecodeJSON(rec.Body.Bytes(), &account)
+	require.NoError(t, err)
+	require.Equal(t, genesisRound, account.Round)
+
+	futureRound := uint64(1000)
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Round: &futureRound})
+	})
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountAssetInformation(c, poolAddr.String(), 1, generatedV2.AccountAssetInformationParams{Round: &futureRound})
+	})
+	accountResourcesTest(t, 404, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountApplicationInformation(c, poolAddr.String(), 1, generatedV2.AccountApplicationInformationParams{Round: &genesisRound})
+	})
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.GetAssetByID(c, 1, generatedV2.GetAssetByIDParams{Round: &futureRound})
+	})
+	accountResourcesTest(t, 404, func(handler v2.Handlers, c echo.Context) error {
+		return handler.GetApplicationByID(c, 1, generatedV2.GetApplicationByIDParams{Round: &genesisRound})
+	})
+}
+
+func TestAccountStateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accountResourcesTest(t, 400, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountStateProof(c, "bad account", private.AccountStateProofParams{Round: 1})
+	})
+	// the testing ledger doesn't generate catchpoints.
+	accountResourcesTest(t, 404, func(handler v2.Handlers, c echo.Context) error {
+		return handler.AccountStateProof(c, poolAddr.String(), private.AccountStateProofParams{Round: 1})
+	})
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -115,6 +227,29 @@ func TestGetBlock(t *testing.T) {
 	getBlockTest(t, 0, "bad format", 400)
 }
 
+func getBlockHeaderProofTest(t *testing.T, round uint64, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetBlockHeaderProof(c, round)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestGetBlockHeaderProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the test ledger's protocol doesn't support compact certs
+	getBlockHeaderProofTest(t, 0, 404)
+}
+
+func TestGetBlockHeaderProofMissingBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getBlockHeaderProofTest(t, 1, 404)
+}
+
 func TestGetBlockJsonEncoding(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -276,6 +411,11 @@ func TestGetTransactionParams(t *testing.T) {
 	err := handler.TransactionParams(c)
 	require.NoError(t, err)
 	require.Equal(t, 200, rec.Code)
+
+	var response generatedV2.TransactionParametersResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.NotNil(t, response.FeeMarket)
+	require.Equal(t, generatedV2.FeeMarket{PendingTransactions: 3, PoolCapacity: 10, MedianFeePerByte: 4, NextBlockFeePerByte: 5}, *response.FeeMarket)
 }
 
 func pendingTransactionInformationTest(t *testing.T, txidToUse int, format string, expectedCode int) {
@@ -301,6 +441,29 @@ func TestPendingTransactionInformation(t *testing.T) {
 	pendingTransactionInformationTest(t, 0, "bad format", 400)
 }
 
+func confirmedTransactionInformationTest(t *testing.T, txidToUse int, format string, expectedCode int) {
+	handler, c, rec, _, stxns, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	txid := "bad txid"
+	if txidToUse >= 0 {
+		txid = stxns[txidToUse].ID().String()
+	}
+	params := generatedV2.ConfirmedTransactionInformationParams{Format: &format}
+	err := handler.ConfirmedTransactionInformation(c, txid, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestConfirmedTransactionInformation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the testing transactions are never committed to the ledger.
+	confirmedTransactionInformationTest(t, 0, "json", 404)
+	confirmedTransactionInformationTest(t, -1, "json", 400)
+	confirmedTransactionInformationTest(t, 0, "bad format", 400)
+}
+
 func getPendingTransactionsTest(t *testing.T, format string, max uint64, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -512,6 +675,145 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func peerAdminTest(t *testing.T, nodeError error, expectedCode int, call func(v2.Handlers, echo.Context) error) *httptest.ResponseRecorder {
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nodeError)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := call(handler, c)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	return rec
+}
+
+func TestGetPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getPeers := func(handler v2.Handlers, c echo.Context) error {
+		return handler.GetPeers(c)
+	}
+	rec := peerAdminTest(t, nil, 200, getPeers)
+	var response private.PeersResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Peers, 1)
+	require.Equal(t, "r1.algorand.network:4160", response.Peers[0].Address)
+	require.True(t, response.Peers[0].Outgoing)
+	require.True(t, response.Peers[0].Pinned)
+	require.Equal(t, uint64(1600000000), response.Peers[0].ConnectedSince)
+	require.Equal(t, uint64(25*time.Millisecond), response.Peers[0].Latency)
+	require.Equal(t, uint64(10), response.Peers[0].MessagesReceived)
+	require.Equal(t, uint64(5), response.Peers[0].MessagesSent)
+	require.Nil(t, response.Peers[0].PriorityAddress)
+
+	peerAdminTest(t, errors.New("anything else is internal"), 500, getPeers)
+}
+
+func TestGetAgreementState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getAgreementState := func(handler v2.Handlers, c echo.Context) error {
+		return handler.GetAgreementState(c)
+	}
+	rec := peerAdminTest(t, nil, 200, getAgreementState)
+	var response private.AgreementStateResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(12), response.Round)
+	require.Equal(t, uint64(1), response.Period)
+	require.Equal(t, uint64(2), response.Step)
+	require.Equal(t, uint64(17*time.Second), response.Deadline)
+	require.Nil(t, response.Pinned)
+	require.Empty(t, response.Proposals)
+	require.Len(t, response.Periods, 1)
+	require.True(t, response.Periods[0].FrozenFinal)
+	require.NotNil(t, response.Periods[0].Staged)
+	digest := crypto.Digest{1}
+	require.Equal(t, digest[:], response.Periods[0].Staged.BlockDigest)
+	require.Len(t, response.Periods[0].Steps, 1)
+	require.Equal(t, uint64(2267), response.Periods[0].Steps[0].Threshold)
+	require.Len(t, response.Periods[0].Steps[0].Votes, 1)
+	require.Equal(t, uint64(2300), response.Periods[0].Steps[0].Votes[0].Weight)
+	require.Equal(t, uint64(7), response.Periods[0].Steps[0].Votes[0].Voters)
+
+	peerAdminTest(t, agreement.ErrServiceNotRunning, 503, getAgreementState)
+	peerAdminTest(t, errors.New("anything else is internal"), 500, getAgreementState)
+}
+
+func TestGetEquivocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getEquivocations := func(params private.GetEquivocationsParams) func(v2.Handlers, echo.Context) error {
+		return func(handler v2.Handlers, c echo.Context) error {
+			return handler.GetEquivocations(c, params)
+		}
+	}
+	rec := peerAdminTest(t, nil, 200, getEquivocations(private.GetEquivocationsParams{}))
+	var response private.EquivocationsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Equivocations, 2)
+	require.Equal(t, uint64(12), response.Equivocations[0].Round)
+	require.Equal(t, uint64(2), response.Equivocations[0].Step)
+	require.Len(t, response.Equivocations[0].Proposals, 2)
+	var ev agreement.EquivocationEvidence
+	require.NoError(t, protocol.Decode(response.Equivocations[0].Evidence, &ev))
+	require.Equal(t, uint64(10000), ev.KeyDilution)
+
+	minRound, limit := uint64(13), uint64(1)
+	rec = peerAdminTest(t, nil, 200, getEquivocations(private.GetEquivocationsParams{MinRound: &minRound, Limit: &limit}))
+	response = private.EquivocationsResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Equivocations, 1)
+	require.Equal(t, uint64(15), response.Equivocations[0].Round)
+
+	peerAdminTest(t, errors.New("failure"), 500, getEquivocations(private.GetEquivocationsParams{}))
+}
+
+func TestPeerAdministration(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	disconnect := func(handler v2.Handlers, c echo.Context) error {
+		return handler.DisconnectPeer(c, private.DisconnectPeerParams{Address: "r1.algorand.network:4160"})
+	}
+	peerAdminTest(t, nil, 200, disconnect)
+	peerAdminTest(t, network.ErrPeerNotFound, 404, disconnect)
+	peerAdminTest(t, errors.New("anything else is internal"), 500, disconnect)
+
+	pin := func(handler v2.Handlers, c echo.Context) error {
+		return handler.PinPeer(c, private.PinPeerParams{Address: "r1.algorand.network:4160"})
+	}
+	peerAdminTest(t, nil, 200, pin)
+	peerAdminTest(t, network.ErrInvalidPeerAddress, 400, pin)
+	peerAdminTest(t, errors.New("anything else is internal"), 500, pin)
+
+	unpin := func(handler v2.Handlers, c echo.Context) error {
+		return handler.UnpinPeer(c, private.UnpinPeerParams{Address: "r1.algorand.network:4160"})
+	}
+	peerAdminTest(t, nil, 200, unpin)
+	peerAdminTest(t, network.ErrPeerNotPinned, 404, unpin)
+	peerAdminTest(t, errors.New("anything else is internal"), 500, unpin)
+
+	reload := func(handler v2.Handlers, c echo.Context) error {
+		return handler.ReloadPhonebook(c)
+	}
+	peerAdminTest(t, nil, 200, reload)
+	peerAdminTest(t, errors.New("anything else is internal"), 500, reload)
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int, enableDeveloperAPI bool) {
 	numAccounts := 1
 	numTransactions := 1
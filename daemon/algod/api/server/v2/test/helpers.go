@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -31,10 +32,12 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
@@ -76,6 +79,18 @@ var poolAddrResponseGolden = generatedV2.AccountResponse{
 	CreatedApps:                 &appCreatedApps,
 }
 var txnPoolGolden = make([]transactions.SignedTxn, 2)
+var peersInfoGolden = []network.PeerInfo{
+	{
+		Address:          "r1.algorand.network:4160",
+		Outgoing:         true,
+		Pinned:           true,
+		Version:          "2.1",
+		ConnectedSince:   time.Unix(1600000000, 0),
+		Latency:          25 * time.Millisecond,
+		MessagesReceived: 10,
+		MessagesSent:     5,
+	},
+}
 
 // ordinarily mockNode would live in `components/mocks`
 // but doing this would create an import cycle, as mockNode needs
@@ -129,6 +144,54 @@ func (m mockNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: 1}
 }
 
+func (m mockNode) FeeMarket() pools.FeeMarket {
+	return pools.FeeMarket{PendingCount: 3, Capacity: 10, MedianFeePerByte: 4, NextBlockFeePerByte: 5}
+}
+
+var agreementStateGolden = agreement.State{
+	Round:    12,
+	Period:   1,
+	Step:     2,
+	Deadline: 17 * time.Second,
+	Periods: []agreement.PeriodState{
+		{
+			Period:      1,
+			Frozen:      &agreement.ProposalID{OriginalPeriod: 1, BlockDigest: crypto.Digest{1}},
+			FrozenFinal: true,
+			Staged:      &agreement.ProposalID{OriginalPeriod: 1, BlockDigest: crypto.Digest{1}},
+			Steps: []agreement.StepState{
+				{
+					Step:          1,
+					Threshold:     2267,
+					CommitteeSize: 2990,
+					Proposals: []agreement.ProposalWeight{
+						{ProposalID: agreement.ProposalID{OriginalPeriod: 1, BlockDigest: crypto.Digest{1}}, Weight: 2300, Voters: 7},
+					},
+				},
+			},
+		},
+	},
+}
+
+func (m mockNode) AgreementState(ctx context.Context) (agreement.State, error) {
+	return agreementStateGolden, m.err
+}
+
+var equivocationsGolden = []agreement.EquivocationEvidence{
+	{Round: 12, Period: 0, Step: 2, KeyDilution: 10000},
+	{Round: 15, Period: 1, Step: 1, KeyDilution: 10000},
+}
+
+func (m mockNode) Equivocations(minRound basics.Round, max uint64) ([]agreement.EquivocationEvidence, error) {
+	var evidence []agreement.EquivocationEvidence
+	for _, ev := range equivocationsGolden {
+		if ev.Round >= minRound && (max == 0 || uint64(len(evidence)) < max) {
+			evidence = append(evidence, ev)
+		}
+	}
+	return evidence, m.err
+}
+
 // unused by handlers:
 func (m mockNode) Config() config.Local {
 	return m.config
@@ -183,6 +246,26 @@ func (m mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m mockNode) PeersInfo() ([]network.PeerInfo, error) {
+	return peersInfoGolden, m.err
+}
+
+func (m mockNode) DisconnectPeer(address string) error {
+	return m.err
+}
+
+func (m mockNode) PinPeer(address string) error {
+	return m.err
+}
+
+func (m mockNode) UnpinPeer(address string) error {
+	return m.err
+}
+
+func (m mockNode) ReloadPhonebook() (int, error) {
+	return 0, m.err
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
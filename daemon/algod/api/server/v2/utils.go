@@ -27,11 +27,14 @@ import (
 	"github.com/algorand/go-codec/codec"
 	"github.com/labstack/echo/v4"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -344,3 +347,98 @@ func printableUTF8OrEmpty(in string) string {
 	}
 	return in
 }
+
+// peerInfoToPeerStatus converts a network peer snapshot into the private API representation.
+func peerInfoToPeerStatus(peer network.PeerInfo) private.PeerStatus {
+	return private.PeerStatus{
+		Address:          peer.Address,
+		Outgoing:         peer.Outgoing,
+		Pinned:           peer.Pinned,
+		TelemetryGuid:    strOrNil(peer.TelemetryGUID),
+		InstanceName:     strOrNil(peer.InstanceName),
+		Version:          peer.Version,
+		ConnectedSince:   uint64(peer.ConnectedSince.Unix()),
+		Latency:          uint64(peer.Latency),
+		MessagesReceived: peer.MessagesReceived,
+		MessagesSent:     peer.MessagesSent,
+		PriorityAddress:  addrOrNil(peer.PrioAddress),
+		PriorityWeight:   peer.PrioWeight,
+	}
+}
+
+// equivocationEvidenceToEquivocation converts an equivocation evidence into the private API representation.
+func equivocationEvidenceToEquivocation(ev agreement.EquivocationEvidence) private.Equivocation {
+	proposals := ev.Proposals()
+	return private.Equivocation{
+		Sender:    ev.Sender.String(),
+		Round:     uint64(ev.Round),
+		Period:    ev.Period,
+		Step:      ev.Step,
+		Proposals: []private.AgreementProposalValue{*proposalIDToProposalValue(&proposals[0]), *proposalIDToProposalValue(&proposals[1])},
+		Evidence:  protocol.Encode(&ev),
+	}
+}
+
+// agreementStateToResponse converts an agreement state snapshot into the private API representation.
+func agreementStateToResponse(state agreement.State) private.AgreementStateResponse {
+	response := private.AgreementStateResponse{
+		Round:                uint64(state.Round),
+		Period:               state.Period,
+		Step:                 state.Step,
+		LastConcluding:       state.LastConcluding,
+		TimeInPeriod:         uint64(state.TimeInPeriod),
+		Deadline:             uint64(state.Deadline),
+		Napping:              state.Napping,
+		FastRecoveryDeadline: uint64(state.FastRecoveryDeadline),
+		Pinned:               proposalIDToProposalValue(state.Pinned),
+		Proposals:            make([]private.AgreementProposal, 0, len(state.Proposals)),
+		Periods:              make([]private.AgreementPeriod, 0, len(state.Periods)),
+	}
+	for _, proposal := range state.Proposals {
+		response.Proposals = append(response.Proposals, private.AgreementProposal{
+			Value:           *proposalIDToProposalValue(&proposal.ProposalID),
+			PayloadReceived: proposal.PayloadReceived,
+		})
+	}
+	for _, period := range state.Periods {
+		p := private.AgreementPeriod{
+			Period:      period.Period,
+			Frozen:      proposalIDToProposalValue(period.Frozen),
+			FrozenFinal: period.FrozenFinal,
+			Staged:      proposalIDToProposalValue(period.Staged),
+			Steps:       make([]private.AgreementStep, 0, len(period.Steps)),
+		}
+		for _, step := range period.Steps {
+			st := private.AgreementStep{
+				Step:               step.Step,
+				Threshold:          step.Threshold,
+				CommitteeSize:      step.CommitteeSize,
+				EquivocatorsWeight: step.EquivocatorsWeight,
+				Votes:              make([]private.AgreementVoteTally, 0, len(step.Proposals)),
+			}
+			for _, tally := range step.Proposals {
+				st.Votes = append(st.Votes, private.AgreementVoteTally{
+					Value:  *proposalIDToProposalValue(&tally.ProposalID),
+					Weight: tally.Weight,
+					Voters: uint64(tally.Voters),
+				})
+			}
+			p.Steps = append(p.Steps, st)
+		}
+		response.Periods = append(response.Periods, p)
+	}
+	return response
+}
+
+// proposalIDToProposalValue converts an agreement proposal-value into the private API representation.
+func proposalIDToProposalValue(id *agreement.ProposalID) *private.AgreementProposalValue {
+	if id == nil {
+		return nil
+	}
+	return &private.AgreementProposalValue{
+		OriginalPeriod:   id.OriginalPeriod,
+		OriginalProposer: id.OriginalProposer.String(),
+		BlockDigest:      append([]byte(nil), id.BlockDigest[:]...),
+		Bottom:           id.Bottom,
+	}
+}
@@ -204,7 +204,7 @@ func (s *Server) Start() {
 
 	tcpListener := listener.(*net.TCPListener)
 
-	e := apiServer.NewRouter(s.log, s.node, s.stopping, apiToken, adminAPIToken, tcpListener)
+	e := apiServer.NewRouter(s.log, s.node, s.stopping, apiToken, adminAPIToken, s.RootPath, tcpListener)
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -23,6 +23,7 @@ import (
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
@@ -33,9 +34,10 @@ import (
 )
 
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
-// handlers to interact with kmd's session store
+// handlers to interact with kmd's session store and signing policies
 type reqContext struct {
-	sm *session.Manager
+	sm       *session.Manager
+	policies *policy.Engine
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -81,6 +83,25 @@ func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
 		DriverVersion:         metadata.DriverVersion,
 		SupportsMnemonicUX:    metadata.SupportsMnemonicUX,
 		SupportedTransactions: metadata.SupportedTransactions,
+		DerivationScheme:      metadata.DerivationScheme,
+	}
+}
+
+// apiAuditEntry is a helper to convert an audit log entry into its APIV1
+// representation
+func apiAuditEntry(entry policy.AuditEntry) kmdapi.APIV1AuditEntry {
+	return kmdapi.APIV1AuditEntry{
+		Seq:       entry.Seq,
+		Time:      entry.Time,
+		WalletID:  entry.WalletID,
+		Operation: entry.Operation,
+		TxID:      entry.TxID,
+		Sender:    entry.Sender,
+		Spent:     entry.Spent,
+		Allowed:   entry.Allowed,
+		Reason:    entry.Reason,
+		PrevHash:  entry.PrevHash,
+		Hash:      entry.Hash,
 	}
 }
 
@@ -172,7 +193,7 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey, req.DerivationScheme)
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -691,6 +712,128 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	successResponse(w, resp)
 }
 
+// postKeyDeriveHandler handles `POST /v1/key/derive`
+func postKeyDeriveHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/key/derive DeriveKey
+	//---
+	//    Summary: Derive a key by path
+	//    Produces:
+	//    - application/json
+	//    Description: >
+	//      Derives the key at the passed BIP-32 path, such as m/44'/283'/0'/0/0, from a wallet
+	//      created with the bip32-ed25519 derivation scheme and adds it to the wallet, returning
+	//      the public key. This recovers keys derived by other wallets from the same mnemonic.
+	//    Parameters:
+	//      - name: Derive Key Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeriveKeyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeriveKeyResponse"
+	var req kmdapi.APIV1POSTKeyDeriveRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Parse the derivation path
+	path, err := crypto.ParseHDPath(req.Path)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Derive the key
+	addr, err := wallet.DeriveKey(path)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTKeyDeriveResponse{
+		Address: encodeAddress(addr),
+		Path:    path.String(),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postExtendedPublicKeyExportHandler handles `POST /v1/extended-public-key/export`
+func postExtendedPublicKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/extended-public-key/export ExportExtendedPublicKey
+	//---
+	//    Summary: Export an extended public key
+	//    Produces:
+	//    - application/json
+	//    Description: >
+	//      Export the BIP32-Ed25519 extended public key at the passed path from a wallet created with
+	//      the bip32-ed25519 derivation scheme. The key is the public key followed by the chain code.
+	//      Watch-only wallets can derive the addresses of its non-hardened children without any secret.
+	//    Parameters:
+	//      - name: Export Extended Public Key Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ExportExtendedPublicKeyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ExportExtendedPublicKeyResponse"
+	var req kmdapi.APIV1POSTExtendedPublicKeyExportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Parse the derivation path
+	path, err := crypto.ParseHDPath(req.Path)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Export the extended public key
+	xpub, err := wallet.ExportHDPublicKey(path, []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTExtendedPublicKeyExportResponse{
+		ExtendedPublicKey: xpub.Bytes(),
+		Path:              path.String(),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // deleteKeyHandler handles `DELETE /v1/key`
 func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation DELETE /v1/key DeleteKey
@@ -1257,6 +1400,269 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	successResponse(w, resp)
 }
 
+// postPolicyHandler handles `POST /v1/policy`
+func postPolicyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/policy SetPolicy
+	//---
+	//    Summary: Set the signing policy of a wallet
+	//    Description: >
+	//      Sets the policy kmd enforces before signing with the keys of the wallet, such as
+	//      allowed receivers, amount limits, forbidden fields and required cosigners. The first
+	//      policy of a wallet sets its policy password, which is then needed, along with the
+	//      wallet password, to change or delete the policy. Keys can't be exported from a wallet
+	//      under a policy.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Set Policy Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SetPolicyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SetPolicyResponse"
+	var req kmdapi.APIV1POSTPolicyRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Check the wallet password
+	err = wallet.CheckPassword([]byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet metadata
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Set the policy
+	err = ctx.policies.SetPolicy(metadata.ID, policy.Policy(req.Policy), []byte(req.PolicyPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTPolicyResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postPolicyInfoHandler handles `POST /v1/policy/info`
+func postPolicyInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/policy/info GetPolicy
+	//---
+	//    Summary: Get the signing policy of a wallet
+	//    Description: >
+	//      Returns the signing policy of the wallet, and whether the wallet has one.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Get Policy Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/GetPolicyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/GetPolicyResponse"
+	var req kmdapi.APIV1POSTPolicyInfoRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Fetch the wallet metadata
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch the policy
+	p, ok, err := ctx.policies.Policy(metadata.ID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTPolicyInfoResponse{
+		Enforced: ok,
+		Policy:   kmdapi.APIV1Policy(p),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// deletePolicyHandler handles `DELETE /v1/policy`
+func deletePolicyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /v1/policy DeletePolicy
+	//---
+	//    Summary: Delete the signing policy of a wallet
+	//    Description: >
+	//      Lifts the signing policy of the wallet. Takes both the wallet password and the
+	//      policy password.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Delete Policy Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeletePolicyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeletePolicyResponse"
+	var req kmdapi.APIV1DELETEPolicyRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Check the wallet password
+	err = wallet.CheckPassword([]byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet metadata
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Delete the policy
+	err = ctx.policies.DeletePolicy(metadata.ID, []byte(req.PolicyPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1DELETEPolicyResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postAuditLogHandler handles `POST /v1/audit`
+func postAuditLogHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/audit ListAuditEntries
+	//---
+	//    Summary: List the audit log entries of a wallet
+	//    Description: >
+	//      Lists the signing policy decisions recorded about the wallet, oldest first, starting
+	//      after the entry with sequence number `after`. The entries of all wallets form a single
+	//      hash chain, which is verified on every request; the response carries its head so
+	//      that callers can later check that no entries were cut off the end.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Audit Entries Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListAuditEntriesRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListAuditEntriesResponse"
+	var req kmdapi.APIV1POSTAuditLogRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Fetch the wallet metadata
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch the entries
+	entries, err := ctx.policies.AuditLog(metadata.ID, req.After, req.Limit)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTAuditLogResponse{
+		Entries: make([]kmdapi.APIV1AuditEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		resp.Entries[i] = apiAuditEntry(entry)
+	}
+
+	// Verify the chain
+	head, err := ctx.policies.VerifyAuditLog(policy.AuditHead{Seq: req.TrustedHeadSeq, Hash: req.TrustedHeadHash})
+	resp.HeadSeq = head.Seq
+	resp.HeadHash = head.Hash
+	resp.Intact = err == nil
+	if err != nil {
+		resp.Problem = err.Error()
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // wrapCtx is used to pass common context to each request without using any
 // global variables.
 func wrapCtx(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
@@ -1280,7 +1686,7 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 }
 
 // RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
+func RegisterHandlers(router *mux.Router, sm *session.Manager, policies *policy.Engine, log logging.Logger, apiToken string, reqCB func()) {
 	// All /v1 requests require a valid auth token
 	router.Use(authMiddleware(log, apiToken))
 
@@ -1289,7 +1695,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	// ctx holds the global context passed to each of the handlers
 	ctx := reqContext{
-		sm: sm,
+		sm:       sm,
+		policies: policies,
 	}
 
 	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
@@ -1300,10 +1707,12 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/extended-public-key/export", wrapCtx(ctx, postExtendedPublicKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
 	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/key/derive", wrapCtx(ctx, postKeyDeriveHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, deleteKeyHandler)).Methods("DELETE")
 
@@ -1316,4 +1725,9 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+
+	router.HandleFunc("/policy", wrapCtx(ctx, postPolicyHandler)).Methods("POST")
+	router.HandleFunc("/policy/info", wrapCtx(ctx, postPolicyInfoHandler)).Methods("POST")
+	router.HandleFunc("/policy", wrapCtx(ctx, deletePolicyHandler)).Methods("DELETE")
+	router.HandleFunc("/audit", wrapCtx(ctx, postAuditLogHandler)).Methods("POST")
 }
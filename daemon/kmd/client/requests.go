@@ -111,6 +111,12 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTKeyRequest:
 		reqPath = "v1/key"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTKeyDeriveRequest:
+		reqPath = "v1/key/derive"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTExtendedPublicKeyExportRequest:
+		reqPath = "v1/extended-public-key/export"
+		reqMethod = "POST"
 	case kmdapi.APIV1DELETEKeyRequest:
 		reqPath = "v1/key"
 		reqMethod = "DELETE"
@@ -141,6 +147,18 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1DELETEMultisigRequest:
 		reqPath = "v1/multisig"
 		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTPolicyRequest:
+		reqPath = "v1/policy"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTPolicyInfoRequest:
+		reqPath = "v1/policy/info"
+		reqMethod = "POST"
+	case kmdapi.APIV1DELETEPolicyRequest:
+		reqPath = "v1/policy"
+		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTAuditLogRequest:
+		reqPath = "v1/audit"
+		reqMethod = "POST"
 	}
 	return
 }
@@ -49,11 +49,39 @@ func (kcl KMDClient) GenerateKey(walletHandle []byte) (resp kmdapi.APIV1POSTKeyR
 
 // CreateWallet wraps kmdapi.APIV1POSTWalletRequest
 func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, walletPassword []byte, walletMDK crypto.MasterDerivationKey) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	return kcl.CreateWalletWithDerivation(walletName, walletDriverName, walletPassword, walletMDK, "")
+}
+
+// CreateWalletWithDerivation wraps kmdapi.APIV1POSTWalletRequest, choosing
+// how the wallet derives its keys
+func (kcl KMDClient) CreateWalletWithDerivation(walletName []byte, walletDriverName string, walletPassword []byte, walletMDK crypto.MasterDerivationKey, derivationScheme string) (resp kmdapi.APIV1POSTWalletResponse, err error) {
 	req := kmdapi.APIV1POSTWalletRequest{
 		WalletName:          string(walletName),
 		WalletDriverName:    walletDriverName,
 		WalletPassword:      string(walletPassword),
 		MasterDerivationKey: walletMDK,
+		DerivationScheme:    derivationScheme,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// DeriveKey wraps kmdapi.APIV1POSTKeyDeriveRequest
+func (kcl KMDClient) DeriveKey(walletHandle []byte, path string) (resp kmdapi.APIV1POSTKeyDeriveResponse, err error) {
+	req := kmdapi.APIV1POSTKeyDeriveRequest{
+		WalletHandleToken: string(walletHandle),
+		Path:              path,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ExportExtendedPublicKey wraps kmdapi.APIV1POSTExtendedPublicKeyExportRequest
+func (kcl KMDClient) ExportExtendedPublicKey(walletHandle []byte, walletPassword []byte, path string) (resp kmdapi.APIV1POSTExtendedPublicKeyExportResponse, err error) {
+	req := kmdapi.APIV1POSTExtendedPublicKeyExportRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		Path:              path,
 	}
 	err = kcl.DoV1Request(req, &resp)
 	return
@@ -221,3 +249,48 @@ func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []by
 	err = kcl.DoV1Request(req, &resp)
 	return
 }
+
+// SetPolicy wraps kmdapi.APIV1POSTPolicyRequest
+func (kcl KMDClient) SetPolicy(walletHandle, pw, policyPassword []byte, policy kmdapi.APIV1Policy) (resp kmdapi.APIV1POSTPolicyResponse, err error) {
+	req := kmdapi.APIV1POSTPolicyRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		PolicyPassword:    string(policyPassword),
+		Policy:            policy,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// GetPolicy wraps kmdapi.APIV1POSTPolicyInfoRequest
+func (kcl KMDClient) GetPolicy(walletHandle []byte) (resp kmdapi.APIV1POSTPolicyInfoResponse, err error) {
+	req := kmdapi.APIV1POSTPolicyInfoRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// DeletePolicy wraps kmdapi.APIV1DELETEPolicyRequest
+func (kcl KMDClient) DeletePolicy(walletHandle, pw, policyPassword []byte) (resp kmdapi.APIV1DELETEPolicyResponse, err error) {
+	req := kmdapi.APIV1DELETEPolicyRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		PolicyPassword:    string(policyPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListAuditEntries wraps kmdapi.APIV1POSTAuditLogRequest
+func (kcl KMDClient) ListAuditEntries(walletHandle []byte, after, limit, trustedHeadSeq uint64, trustedHeadHash crypto.Digest) (resp kmdapi.APIV1POSTAuditLogResponse, err error) {
+	req := kmdapi.APIV1POSTAuditLogRequest{
+		WalletHandleToken: string(walletHandle),
+		After:             after,
+		Limit:             limit,
+		TrustedHeadSeq:    trustedHeadSeq,
+		TrustedHeadHash:   trustedHeadHash,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
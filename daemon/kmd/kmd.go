@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
@@ -63,13 +64,20 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Open the signing policies and their audit log
+	policies, err := policy.MakeEngine(startConfig.DataDir, kmdCfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams)
+	if err != nil {
+		return
+	}
+
 	// Configure the wallet API server
 	serverCfg := server.WalletServerConfig{
 		APIToken:       apiToken,
 		DataDir:        startConfig.DataDir,
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: session.MakeManager(kmdCfg, policies),
+		Policies:       policies,
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
 	}
@@ -45,6 +45,7 @@ type APIV1Wallet struct {
 	DriverVersion         uint32            `json:"driver_version"`
 	SupportsMnemonicUX    bool              `json:"mnemonic_ux"`
 	SupportedTransactions []protocol.TxType `json:"supported_txs"`
+	DerivationScheme      string            `json:"derivation_scheme"`
 }
 
 // APIV1WalletHandle includes the wallet the handle corresponds to
@@ -53,3 +54,35 @@ type APIV1WalletHandle struct {
 	Wallet         APIV1Wallet `json:"wallet"`
 	ExpiresSeconds int64       `json:"expires_seconds"`
 }
+
+// APIV1Policy is the API's representation of a wallet's signing policy. The
+// zero value of each field leaves the corresponding property unrestricted.
+type APIV1Policy struct {
+	AllowedReceivers    []string          `json:"allowed_receivers,omitempty"`
+	MaxAmount           uint64            `json:"max_amount,omitempty"`
+	MaxDailyAmount      uint64            `json:"max_daily_amount,omitempty"`
+	AllowedTxTypes      []protocol.TxType `json:"allowed_tx_types,omitempty"`
+	ForbidRekey         bool              `json:"forbid_rekey,omitempty"`
+	ForbidClose         bool              `json:"forbid_close,omitempty"`
+	AllowedAppIDs       []uint64          `json:"allowed_app_ids,omitempty"`
+	RequiredCosigners   []string          `json:"required_cosigners,omitempty"`
+	AllowProgramSigning bool              `json:"allow_program_signing,omitempty"`
+}
+
+// APIV1AuditEntry is the API's representation of a signing policy decision
+// recorded in kmd's audit log
+type APIV1AuditEntry struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Seq       uint64        `json:"seq"`
+	Time      int64         `json:"time"`
+	WalletID  string        `json:"wallet_id"`
+	Operation string        `json:"operation"`
+	TxID      string        `json:"txid"`
+	Sender    string        `json:"sender"`
+	Spent     uint64        `json:"spent"`
+	Allowed   bool          `json:"allowed"`
+	Reason    string        `json:"reason"`
+	PrevHash  crypto.Digest `json:"prev_hash"`
+	Hash      crypto.Digest `json:"hash"`
+}
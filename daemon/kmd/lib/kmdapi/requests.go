@@ -49,6 +49,7 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	DerivationScheme    string                   `json:"derivation_scheme"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -140,6 +141,27 @@ type APIV1POSTKeyRequest struct {
 	DisplayMnemonic   bool   `json:"display_mnemonic"`
 }
 
+// APIV1POSTKeyDeriveRequest is the request for `POST /v1/key/derive`
+//
+// swagger:model DeriveKeyRequest
+type APIV1POSTKeyDeriveRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Path              string `json:"path"`
+}
+
+// APIV1POSTExtendedPublicKeyExportRequest is the request for `POST /v1/extended-public-key/export`
+//
+// swagger:model ExportExtendedPublicKeyRequest
+type APIV1POSTExtendedPublicKeyExportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	Path              string `json:"path"`
+}
+
 // APIV1DELETEKeyRequest is the request for `DELETE /v1/key`
 //
 // swagger:model DeleteKeyRequest
@@ -262,3 +284,51 @@ type APIV1POSTMultisigProgramSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 }
+
+// APIV1POSTPolicyRequest is the request for `POST /v1/policy`
+//
+// swagger:model SetPolicyRequest
+type APIV1POSTPolicyRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string      `json:"wallet_handle_token"`
+	WalletPassword    string      `json:"wallet_password"`
+	PolicyPassword    string      `json:"policy_password"`
+	Policy            APIV1Policy `json:"policy"`
+}
+
+// APIV1POSTPolicyInfoRequest is the request for `POST /v1/policy/info`
+//
+// swagger:model GetPolicyRequest
+type APIV1POSTPolicyInfoRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
+// APIV1DELETEPolicyRequest is the request for `DELETE /v1/policy`
+//
+// swagger:model DeletePolicyRequest
+type APIV1DELETEPolicyRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	PolicyPassword    string `json:"policy_password"`
+}
+
+// APIV1POSTAuditLogRequest is the request for `POST /v1/audit`
+//
+// swagger:model ListAuditEntriesRequest
+type APIV1POSTAuditLogRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	After             uint64 `json:"after"`
+	Limit             uint64 `json:"limit"`
+	// TrustedHeadSeq and TrustedHeadHash are the head of the audit log
+	// returned by an earlier request and kept outside of kmd. The audit log
+	// is only vouched for up to this head.
+	TrustedHeadSeq  uint64        `json:"trusted_head_seq"`
+	TrustedHeadHash crypto.Digest `json:"trusted_head_hash"`
+}
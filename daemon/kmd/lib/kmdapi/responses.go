@@ -18,6 +18,8 @@ package kmdapi
 
 import (
 	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
 )
 
 // APIV1Response is the interface that all API V1 responses must satisfy
@@ -215,6 +217,37 @@ type generateKeyResponse struct {
 	Body *APIV1POSTKeyResponse
 }
 
+// APIV1POSTKeyDeriveResponse is the response to `POST /v1/key/derive`
+// friendly:DeriveKeyResponse
+type APIV1POSTKeyDeriveResponse struct {
+	APIV1ResponseEnvelope
+	Address string `json:"address"`
+	Path    string `json:"path"`
+}
+
+// Response to `POST /v1/key/derive`
+// swagger:response DeriveKeyResponse
+type deriveKeyResponse struct {
+	//	in:body
+	Body *APIV1POSTKeyDeriveResponse
+}
+
+// APIV1POSTExtendedPublicKeyExportResponse is the response to `POST /v1/extended-public-key/export`
+// friendly:ExportExtendedPublicKeyResponse
+type APIV1POSTExtendedPublicKeyExportResponse struct {
+	APIV1ResponseEnvelope
+	// ExtendedPublicKey is the public key followed by the chain code
+	ExtendedPublicKey []byte `json:"extended_public_key"`
+	Path              string `json:"path"`
+}
+
+// Response to `POST /v1/extended-public-key/export`
+// swagger:response ExportExtendedPublicKeyResponse
+type exportExtendedPublicKeyResponse struct {
+	//	in:body
+	Body *APIV1POSTExtendedPublicKeyExportResponse
+}
+
 // APIV1DELETEKeyResponse is the response to `DELETE /v1/key`
 // friendly:DeleteKeyResponse
 type APIV1DELETEKeyResponse struct {
@@ -362,3 +395,66 @@ type signProgramMultisigResponse struct {
 	//	in:body
 	Body *APIV1POSTMultisigProgramSignResponse
 }
+
+// APIV1POSTPolicyResponse is the response to `POST /v1/policy`
+// friendly:SetPolicyResponse
+type APIV1POSTPolicyResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `POST /v1/policy`
+// swagger:response SetPolicyResponse
+type setPolicyResponse struct {
+	//	in:body
+	Body *APIV1POSTPolicyResponse
+}
+
+// APIV1POSTPolicyInfoResponse is the response to `POST /v1/policy/info`
+// friendly:GetPolicyResponse
+type APIV1POSTPolicyInfoResponse struct {
+	APIV1ResponseEnvelope
+	Enforced bool        `json:"enforced"`
+	Policy   APIV1Policy `json:"policy"`
+}
+
+// Response to `POST /v1/policy/info`
+// swagger:response GetPolicyResponse
+type getPolicyResponse struct {
+	//	in:body
+	Body *APIV1POSTPolicyInfoResponse
+}
+
+// APIV1DELETEPolicyResponse is the response to `DELETE /v1/policy`
+// friendly:DeletePolicyResponse
+type APIV1DELETEPolicyResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `DELETE /v1/policy`
+// swagger:response DeletePolicyResponse
+type deletePolicyResponse struct {
+	//	in:body
+	Body *APIV1DELETEPolicyResponse
+}
+
+// APIV1POSTAuditLogResponse is the response to `POST /v1/audit`
+// friendly:ListAuditEntriesResponse
+type APIV1POSTAuditLogResponse struct {
+	APIV1ResponseEnvelope
+	Entries  []APIV1AuditEntry `json:"entries"`
+	HeadSeq  uint64            `json:"head_seq"`
+	HeadHash crypto.Digest     `json:"head_hash"`
+	// Intact tells whether the whole audit log checked out and still holds
+	// the trusted head of the request; if it didn't, Problem names the first
+	// entry that doesn't and the head is the last entry that does. The head
+	// is to be kept outside of kmd and passed as the trusted head next time.
+	Intact  bool   `json:"intact"`
+	Problem string `json:"problem,omitempty"`
+}
+
+// Response to `POST /v1/audit`
+// swagger:response ListAuditEntriesResponse
+type listAuditEntriesResponse struct {
+	//	in:body
+	Body *APIV1POSTAuditLogResponse
+}
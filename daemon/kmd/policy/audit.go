@@ -0,0 +1,248 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Operations recorded in the audit log
+const (
+	OpSignTransaction         = "sign_transaction"
+	OpMultisigSignTransaction = "multisig_sign_transaction"
+	OpSignProgram             = "sign_program"
+	OpMultisigSignProgram     = "multisig_sign_program"
+	OpExportKey               = "export_key"
+	OpExportMasterKey         = "export_master_key"
+	OpSetPolicy               = "set_policy"
+	OpDeletePolicy            = "delete_policy"
+)
+
+// auditEntryHashID separates the hashes of audit entries from the other
+// hashes of kmd
+const auditEntryHashID protocol.HashID = "KA"
+
+// AuditEntry records one decision of the engine. Each entry commits to the
+// one before it through PrevHash, so that editing, removing or reordering
+// entries breaks the chain from that point on.
+type AuditEntry struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Seq       uint64 `json:"seq"`
+	Time      int64  `json:"time"`
+	WalletID  string `json:"wallet_id"`
+	Operation string `json:"operation"`
+	TxID      string `json:"txid"`
+	Sender    string `json:"sender"`
+
+	// Spent is what the request spends in microAlgos, as counted against
+	// the policy's limits
+	Spent   uint64 `json:"spent"`
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+
+	PrevHash crypto.Digest `json:"prev_hash"`
+	Hash     crypto.Digest `json:"hash"`
+}
+
+// ToBeHashed implements the crypto.Hashable interface. The entry's own Hash
+// is left out.
+func (e AuditEntry) ToBeHashed() (protocol.HashID, []byte) {
+	e.Hash = crypto.Digest{}
+	return auditEntryHashID, protocol.EncodeReflect(&e)
+}
+
+// AuditHead identifies the latest entry of the audit log. The hashes of the
+// chain aren't keyed, so anyone able to write the policy database can
+// rewrite entries and recompute the chain from there on. A head kept outside
+// of kmd is what lets VerifyAuditLog vouch for the entries up to it.
+type AuditHead struct {
+	Seq  uint64        `json:"seq"`
+	Hash crypto.Digest `json:"hash"`
+}
+
+// auditRow is an entry's row in the audit table
+type auditRow struct {
+	Seq       uint64 `db:"seq"`
+	Time      int64  `db:"time"`
+	WalletID  string `db:"wallet_id"`
+	Operation string `db:"operation"`
+	TxID      string `db:"txid"`
+	Sender    string `db:"sender"`
+	Spent     int64  `db:"spent"`
+	Allowed   bool   `db:"allowed"`
+	Reason    string `db:"reason"`
+	PrevHash  []byte `db:"prev_hash"`
+	Hash      []byte `db:"hash"`
+}
+
+func (row auditRow) entry() (e AuditEntry) {
+	e = AuditEntry{
+		Seq:       row.Seq,
+		Time:      row.Time,
+		WalletID:  row.WalletID,
+		Operation: row.Operation,
+		TxID:      row.TxID,
+		Sender:    row.Sender,
+		Spent:     uint64(row.Spent),
+		Allowed:   row.Allowed,
+		Reason:    row.Reason,
+	}
+	copy(e.PrevHash[:], row.PrevHash)
+	copy(e.Hash[:], row.Hash)
+	return
+}
+
+const auditColumns = "seq, time, wallet_id, operation, txid, sender, spent, allowed, reason, prev_hash, hash"
+
+func fetchHead(q sqlx.Queryer) (head AuditHead, err error) {
+	var rows []auditRow
+	err = sqlx.Select(q, &rows, "SELECT "+auditColumns+" FROM audit ORDER BY seq DESC LIMIT 1")
+	if err != nil {
+		return head, errDatabase
+	}
+	if len(rows) == 0 {
+		return head, nil
+	}
+	return AuditHead{Seq: rows[0].Seq, Hash: rows[0].entry().Hash}, nil
+}
+
+// appendAuditLocked chains entry to the head of the audit log and stores it,
+// filling in its sequence number, hashes and, if unset, time
+func (e *Engine) appendAuditLocked(ext sqlx.Ext, entry *AuditEntry) error {
+	head, err := fetchHead(ext)
+	if err != nil {
+		return err
+	}
+
+	if entry.Time == 0 {
+		entry.Time = e.now().Unix()
+	}
+	// sqlite integers are signed
+	if entry.Spent > math.MaxInt64 {
+		entry.Spent = math.MaxInt64
+	}
+	entry.Seq = head.Seq + 1
+	entry.PrevHash = head.Hash
+	entry.Hash = crypto.HashObj(entry)
+
+	_, err = ext.Exec("INSERT INTO audit ("+auditColumns+") VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		entry.Seq, entry.Time, entry.WalletID, entry.Operation, entry.TxID, entry.Sender, int64(entry.Spent), entry.Allowed, entry.Reason, entry.PrevHash[:], entry.Hash[:])
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// spentSince sums what the wallet was allowed to spend from since on
+func spentSince(q sqlx.Queryer, walletID []byte, since time.Time) (uint64, error) {
+	var spent int64
+	err := sqlx.Get(q, &spent, "SELECT COALESCE(SUM(spent), 0) FROM audit WHERE wallet_id=? AND allowed=1 AND time>=?", string(walletID), since.Unix())
+	if err != nil {
+		return 0, errDatabase
+	}
+	return uint64(spent), nil
+}
+
+// AuditLog returns up to limit entries about the wallet with the passed ID,
+// oldest first, starting after the entry with sequence number after. A
+// limit of 0 picks a default.
+func (e *Engine) AuditLog(walletID []byte, after uint64, limit uint64) (entries []AuditEntry, err error) {
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+	if limit > maxAuditLimit {
+		limit = maxAuditLimit
+	}
+
+	db, err := e.connect()
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	var rows []auditRow
+	err = db.Select(&rows, "SELECT "+auditColumns+" FROM audit WHERE wallet_id=? AND seq>? ORDER BY seq LIMIT ?", string(walletID), int64(after), int64(limit))
+	if err != nil {
+		return nil, errDatabase
+	}
+
+	entries = make([]AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = row.entry()
+	}
+	return
+}
+
+// VerifyAuditLog walks the whole audit log and checks that every entry
+// hashes to its recorded hash and links to the one before it, and that the
+// log still holds the trusted head, which the caller kept outside of kmd
+// from an earlier call. Only the entries up to the trusted head are vouched
+// for: the ones after it could have been rewritten along with their hashes.
+// A zero trusted head vouches for nothing. It returns the head of the log,
+// to be kept for the next call, or, along with an error naming the first
+// entry that doesn't check out, the last entry that does.
+func (e *Engine) VerifyAuditLog(trusted AuditHead) (head AuditHead, err error) {
+	db, err := e.connect()
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	rows, err := db.Queryx("SELECT " + auditColumns + " FROM audit ORDER BY seq")
+	if err != nil {
+		return head, errDatabase
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row auditRow
+		err = rows.StructScan(&row)
+		if err != nil {
+			return head, errDatabase
+		}
+
+		entry := row.entry()
+		if entry.Seq != head.Seq+1 {
+			return head, fmt.Errorf("audit log is missing entries between %d and %d", head.Seq, entry.Seq)
+		}
+		if entry.PrevHash != head.Hash {
+			return head, fmt.Errorf("audit entry %d does not link to entry %d", entry.Seq, head.Seq)
+		}
+		if crypto.HashObj(entry) != entry.Hash {
+			return head, fmt.Errorf("audit entry %d does not match its hash", entry.Seq)
+		}
+		if entry.Seq == trusted.Seq && entry.Hash != trusted.Hash {
+			return head, fmt.Errorf("audit entry %d does not match the trusted head", entry.Seq)
+		}
+		head = AuditHead{Seq: entry.Seq, Hash: entry.Hash}
+	}
+	if rows.Err() != nil {
+		return head, errDatabase
+	}
+	if head.Seq < trusted.Seq {
+		return head, fmt.Errorf("audit log ends at entry %d, before the trusted head %d", head.Seq, trusted.Seq)
+	}
+	return head, nil
+}
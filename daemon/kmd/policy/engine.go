@@ -0,0 +1,410 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // register the sqlite3 driver
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+)
+
+const (
+	// PolicyDBFilename is the name of the database in the kmd data dir that
+	// holds the signing policies and the audit log
+	PolicyDBFilename = "kmd_policy.db"
+
+	policyDBOptions   = "_secure_delete=on&_txlock=exclusive"
+	passwordSaltLen   = 32
+	passwordHashLen   = 32
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+var policySchema = `
+CREATE TABLE IF NOT EXISTS policies (
+	wallet_id TEXT PRIMARY KEY,
+	policy BLOB NOT NULL,
+	password_salt BLOB NOT NULL,
+	password_hash BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS audit (
+	seq INTEGER PRIMARY KEY,
+	time INTEGER NOT NULL,
+	wallet_id TEXT NOT NULL,
+	operation TEXT NOT NULL,
+	txid TEXT NOT NULL,
+	sender TEXT NOT NULL,
+	spent INTEGER NOT NULL,
+	allowed INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	prev_hash BLOB NOT NULL,
+	hash BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS audit_wallet_time ON audit (wallet_id, time);
+`
+
+// Engine stores the signing policies of wallets, enforces them on the
+// wallets it wraps, and records its decisions in the audit log. Changing a
+// wallet's policy takes a policy password set along with the first policy,
+// so that holding the wallet password alone is not enough to lift it.
+type Engine struct {
+	dbPath string
+	scrypt config.ScryptParams
+
+	// now is replaced in tests to move the clock across days
+	now func() time.Time
+
+	// mu serializes decisions, so that concurrent requests can't both fit
+	// under the same daily limit
+	mu deadlock.Mutex
+}
+
+// MakeEngine opens the policy database in the kmd data dir, creating it if
+// needed. Policy passwords are stretched with the passed scrypt parameters.
+func MakeEngine(dataDir string, params config.ScryptParams) (*Engine, error) {
+	e := &Engine{
+		dbPath: filepath.Join(dataDir, PolicyDBFilename),
+		scrypt: params,
+		now:    time.Now,
+	}
+
+	db, err := e.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	_, err = db.Exec(policySchema)
+	if err != nil {
+		return nil, fmt.Errorf("could not create policy database: %v", err)
+	}
+	return e, nil
+}
+
+func (e *Engine) connect() (*sqlx.DB, error) {
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("file:%s?%s", e.dbPath, policyDBOptions))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	return db, nil
+}
+
+func (e *Engine) hashPassword(pw []byte, salt []byte) ([]byte, error) {
+	return scrypt.Key(pw, salt, e.scrypt.ScryptN, e.scrypt.ScryptR, e.scrypt.ScryptP, passwordHashLen)
+}
+
+// policyRow is a wallet's row in the policies table
+type policyRow struct {
+	Policy       []byte `db:"policy"`
+	PasswordSalt []byte `db:"password_salt"`
+	PasswordHash []byte `db:"password_hash"`
+}
+
+func fetchPolicyRow(q sqlx.Queryer, walletID []byte) (row policyRow, ok bool, err error) {
+	err = sqlx.Get(q, &row, "SELECT policy, password_salt, password_hash FROM policies WHERE wallet_id=?", string(walletID))
+	if err == sql.ErrNoRows {
+		return row, false, nil
+	}
+	if err != nil {
+		return row, false, errDatabase
+	}
+	return row, true, nil
+}
+
+func fetchPolicy(q sqlx.Queryer, walletID []byte) (p Policy, ok bool, err error) {
+	row, ok, err := fetchPolicyRow(q, walletID)
+	if err != nil || !ok {
+		return
+	}
+	err = json.Unmarshal(row.Policy, &p)
+	if err != nil {
+		return Policy{}, false, fmt.Errorf("could not decode stored policy: %v", err)
+	}
+	return p, true, nil
+}
+
+// Policy returns the signing policy of the wallet with the passed ID, and
+// whether it has one
+func (e *Engine) Policy(walletID []byte) (p Policy, ok bool, err error) {
+	db, err := e.connect()
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	return fetchPolicy(db, walletID)
+}
+
+// checkPolicyPasswordLocked compares pw with the policy password of an
+// existing policy, recording a denial in the audit log when they differ
+func (e *Engine) checkPolicyPasswordLocked(tx *sqlx.Tx, walletID []byte, op string, row policyRow, pw []byte) error {
+	hash, err := e.hashPassword(pw, row.PasswordSalt)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(hash, row.PasswordHash) == 1 {
+		return nil
+	}
+
+	// Commit the denial on its own, since the caller rolls back on error
+	err = e.appendAuditLocked(tx, &AuditEntry{
+		WalletID:  string(walletID),
+		Operation: op,
+		Reason:    errWrongPolicyPassword.Error(),
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return errWrongPolicyPassword
+}
+
+// SetPolicy sets the signing policy of the wallet with the passed ID. If the
+// wallet has no policy yet, pw becomes its policy password; otherwise pw
+// must match it.
+func (e *Engine) SetPolicy(walletID []byte, p Policy, pw []byte) error {
+	if len(pw) == 0 {
+		return errEmptyPolicyPassword
+	}
+	err := p.Validate()
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	db, err := e.connect()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	row, ok, err := fetchPolicyRow(tx, walletID)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = e.checkPolicyPasswordLocked(tx, walletID, OpSetPolicy, row, pw)
+		if err != nil {
+			return err
+		}
+	} else {
+		row.PasswordSalt = make([]byte, passwordSaltLen)
+		_, err = rand.Read(row.PasswordSalt)
+		if err != nil {
+			return err
+		}
+		row.PasswordHash, err = e.hashPassword(pw, row.PasswordSalt)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The audit entry carries the policy itself, so that the log shows
+	// every policy that was in force
+	policyJSON, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT OR REPLACE INTO policies (wallet_id, policy, password_salt, password_hash) VALUES(?, ?, ?, ?)", string(walletID), policyJSON, row.PasswordSalt, row.PasswordHash)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = e.appendAuditLocked(tx, &AuditEntry{
+		WalletID:  string(walletID),
+		Operation: OpSetPolicy,
+		Allowed:   true,
+		Reason:    string(policyJSON),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// DeletePolicy lifts the signing policy of the wallet with the passed ID. pw
+// must match the wallet's policy password.
+func (e *Engine) DeletePolicy(walletID []byte, pw []byte) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	db, err := e.connect()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	row, ok, err := fetchPolicyRow(tx, walletID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errNoPolicy
+	}
+
+	err = e.checkPolicyPasswordLocked(tx, walletID, OpDeletePolicy, row, pw)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM policies WHERE wallet_id=?", string(walletID))
+	if err != nil {
+		return errDatabase
+	}
+
+	err = e.appendAuditLocked(tx, &AuditEntry{
+		WalletID:  string(walletID),
+		Operation: OpDeletePolicy,
+		Allowed:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// decision is what the engine needs to know about a request to rule on it
+type decision struct {
+	walletID  []byte
+	operation string
+	txid      string
+	sender    string
+	spent     uint64
+
+	// check returns why the request breaks the policy, or "" if it doesn't.
+	// spentToday is what the wallet already spent this UTC day.
+	check func(r rules, spentToday uint64) string
+}
+
+// enforce rules on d under the wallet's policy. If the policy allows it,
+// enforce writes the decision to the audit log, calls perform, and only then
+// commits the entry, so that nothing is signed unless it is audited. If the
+// policy refuses it, enforce records the denial and returns a *DeniedError.
+// Wallets without a policy are not audited.
+func (e *Engine) enforce(d decision, perform func() error) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	db, err := e.connect()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	p, ok, err := fetchPolicy(db, d.walletID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return perform()
+	}
+
+	r, err := p.compile()
+	if err != nil {
+		return err
+	}
+
+	now := e.now()
+	var spentToday uint64
+	if r.MaxDailyAmount != 0 {
+		spentToday, err = spentSince(db, d.walletID, now.Truncate(24*time.Hour))
+		if err != nil {
+			return err
+		}
+	}
+
+	entry := AuditEntry{
+		Time:      now.Unix(),
+		WalletID:  string(d.walletID),
+		Operation: d.operation,
+		TxID:      d.txid,
+		Sender:    d.sender,
+		Spent:     d.spent,
+	}
+
+	entry.Reason = d.check(r, spentToday)
+	if entry.Reason != "" {
+		err = e.appendAuditLocked(db, &entry)
+		if err != nil {
+			return err
+		}
+		return &DeniedError{Reason: entry.Reason}
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	entry.Allowed = true
+	err = e.appendAuditLocked(tx, &entry)
+	if err != nil {
+		return err
+	}
+
+	err = perform()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
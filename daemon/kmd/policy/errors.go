@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+)
+
+var errDatabase = fmt.Errorf("database error")
+var errDatabaseConnect = fmt.Errorf("error connecting to policy database")
+var errNoPolicy = fmt.Errorf("wallet has no signing policy")
+var errEmptyPolicyPassword = fmt.Errorf("policy password must not be empty")
+var errWrongPolicyPassword = fmt.Errorf("wrong policy password")
+
+// DeniedError is returned when a wallet's signing policy refuses an operation
+type DeniedError struct {
+	Reason string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("denied by signing policy: %s", e.Reason)
+}
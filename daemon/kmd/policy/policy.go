@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Policy restricts what kmd will sign with the keys of a wallet. The zero
+// value of each field leaves the corresponding property unrestricted.
+// Amounts are in microAlgos.
+type Policy struct {
+	// AllowedReceivers are the only accounts payments and asset transfers
+	// may send to, including the close-to accounts. Transfers to the sender
+	// itself, such as asset opt-ins, are always allowed.
+	AllowedReceivers []string `json:"allowed_receivers,omitempty"`
+
+	// MaxAmount caps the microAlgos a single transaction spends, its fee
+	// included. Asset amounts can't be weighed against it, so transfers
+	// that move assets are refused whenever an amount limit is set; asset
+	// opt-ins are still allowed.
+	MaxAmount uint64 `json:"max_amount,omitempty"`
+
+	// MaxDailyAmount caps the microAlgos spent by all the transactions
+	// signed with the wallet during a UTC day.
+	MaxDailyAmount uint64 `json:"max_daily_amount,omitempty"`
+
+	// AllowedTxTypes are the only transaction types that may be signed.
+	AllowedTxTypes []protocol.TxType `json:"allowed_tx_types,omitempty"`
+
+	// ForbidRekey refuses transactions that set RekeyTo.
+	ForbidRekey bool `json:"forbid_rekey,omitempty"`
+
+	// ForbidClose refuses transactions that set CloseRemainderTo or
+	// AssetCloseTo. Closing out moves a whole balance, so both are also
+	// refused whenever an amount limit is set.
+	ForbidClose bool `json:"forbid_close,omitempty"`
+
+	// AllowedAppIDs are the only applications that may be called. Listing
+	// 0 allows creating applications.
+	AllowedAppIDs []uint64 `json:"allowed_app_ids,omitempty"`
+
+	// RequiredCosigners are accounts that must take part in every
+	// signature: single signatures are refused, and a multisig signature
+	// is only added if the multisig account cannot reach its threshold
+	// without each of them.
+	RequiredCosigners []string `json:"required_cosigners,omitempty"`
+
+	// AllowProgramSigning allows delegating the wallet's keys to programs.
+	// A delegated program can approve transactions kmd never sees, so
+	// program signing is refused unless this is set.
+	AllowProgramSigning bool `json:"allow_program_signing,omitempty"`
+}
+
+// rules is a Policy parsed into a form cheap to check transactions against
+type rules struct {
+	Policy
+	receivers map[basics.Address]bool
+	txTypes   map[protocol.TxType]bool
+	appIDs    map[basics.AppIndex]bool
+	cosigners []crypto.PublicKey
+}
+
+// Validate returns an error if the policy refers to malformed addresses or
+// unknown transaction types
+func (p Policy) Validate() error {
+	_, err := p.compile()
+	return err
+}
+
+func (p Policy) compile() (r rules, err error) {
+	r.Policy = p
+
+	if len(p.AllowedReceivers) > 0 {
+		r.receivers = make(map[basics.Address]bool, len(p.AllowedReceivers))
+		for _, s := range p.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(s)
+			if err != nil {
+				return rules{}, fmt.Errorf("bad allowed receiver %s: %v", s, err)
+			}
+			r.receivers[addr] = true
+		}
+	}
+
+	if len(p.AllowedTxTypes) > 0 {
+		r.txTypes = make(map[protocol.TxType]bool, len(p.AllowedTxTypes))
+		for _, txType := range p.AllowedTxTypes {
+			if !knownTxType(txType) {
+				return rules{}, fmt.Errorf("unknown transaction type %s", txType)
+			}
+			r.txTypes[txType] = true
+		}
+	}
+
+	if len(p.AllowedAppIDs) > 0 {
+		r.appIDs = make(map[basics.AppIndex]bool, len(p.AllowedAppIDs))
+		for _, id := range p.AllowedAppIDs {
+			r.appIDs[basics.AppIndex(id)] = true
+		}
+	}
+
+	for _, s := range p.RequiredCosigners {
+		addr, err := basics.UnmarshalChecksumAddress(s)
+		if err != nil {
+			return rules{}, fmt.Errorf("bad required cosigner %s: %v", s, err)
+		}
+		r.cosigners = append(r.cosigners, crypto.PublicKey(addr))
+	}
+
+	return r, nil
+}
+
+func knownTxType(txType protocol.TxType) bool {
+	for _, known := range protocol.TxnTypes {
+		if known == txType && known != protocol.UnknownTx {
+			return true
+		}
+	}
+	return false
+}
+
+// spend returns the microAlgos tx takes out of its sender, not counting
+// what a close-to moves
+func spend(tx transactions.Transaction) uint64 {
+	spent := tx.Fee.Raw
+	if tx.Type == protocol.PaymentTx {
+		spent = basics.AddSaturate(spent, tx.Amount.Raw)
+	}
+	return spent
+}
+
+// limited tells whether the rules cap the amounts the wallet spends
+func (r rules) limited() bool {
+	return r.MaxAmount != 0 || r.MaxDailyAmount != 0
+}
+
+// checkReceiver returns why sending to addr breaks the rules, or "" if it
+// doesn't
+func (r rules) checkReceiver(tx transactions.Transaction, addr basics.Address) string {
+	if r.receivers == nil || addr.IsZero() || addr == tx.Sender || r.receivers[addr] {
+		return ""
+	}
+	return fmt.Sprintf("receiver %s is not allowed", addr)
+}
+
+// checkTransaction returns why signing tx breaks the rules, or "" if it
+// doesn't. spentToday is what the wallet already spent this UTC day.
+func (r rules) checkTransaction(tx transactions.Transaction, spentToday uint64) string {
+	if r.txTypes != nil && !r.txTypes[tx.Type] {
+		return fmt.Sprintf("transaction type %s is not allowed", tx.Type)
+	}
+
+	if r.ForbidRekey && !tx.RekeyTo.IsZero() {
+		return "rekeying is not allowed"
+	}
+
+	switch tx.Type {
+	case protocol.PaymentTx:
+		if !tx.CloseRemainderTo.IsZero() && (r.ForbidClose || r.limited()) {
+			return "closing the account is not allowed"
+		}
+		if reason := r.checkReceiver(tx, tx.Receiver); reason != "" {
+			return reason
+		}
+		if reason := r.checkReceiver(tx, tx.CloseRemainderTo); reason != "" {
+			return reason
+		}
+	case protocol.AssetTransferTx:
+		if !tx.AssetCloseTo.IsZero() && (r.ForbidClose || r.limited()) {
+			return "closing out the asset is not allowed"
+		}
+		if tx.AssetAmount != 0 && r.limited() {
+			return "asset transfers are not allowed under amount limits"
+		}
+		if reason := r.checkReceiver(tx, tx.AssetReceiver); reason != "" {
+			return reason
+		}
+		if reason := r.checkReceiver(tx, tx.AssetCloseTo); reason != "" {
+			return reason
+		}
+	case protocol.ApplicationCallTx:
+		if r.appIDs != nil && !r.appIDs[tx.ApplicationID] {
+			return fmt.Sprintf("application %d is not allowed", tx.ApplicationID)
+		}
+	}
+
+	spent := spend(tx)
+	if r.MaxAmount != 0 && spent > r.MaxAmount {
+		return fmt.Sprintf("spends %d microAlgos, over the limit of %d per transaction", spent, r.MaxAmount)
+	}
+	if r.MaxDailyAmount != 0 && basics.AddSaturate(spentToday, spent) > r.MaxDailyAmount {
+		return fmt.Sprintf("spends %d microAlgos, over the daily limit of %d with %d already spent today", spent, r.MaxDailyAmount, spentToday)
+	}
+
+	return ""
+}
+
+// checkSingleSig returns why a single signature breaks the rules, or "" if
+// it doesn't
+func (r rules) checkSingleSig() string {
+	if len(r.cosigners) > 0 {
+		return "required cosigners cannot take part in a single signature"
+	}
+	return ""
+}
+
+// checkMultisig returns why signing for the multisig account with the
+// passed preimage breaks the rules, or "" if it doesn't
+func (r rules) checkMultisig(threshold uint8, pks []crypto.PublicKey) string {
+	for _, cosigner := range r.cosigners {
+		others := 0
+		for _, pk := range pks {
+			if pk != cosigner {
+				others++
+			}
+		}
+		if others >= int(threshold) {
+			return fmt.Sprintf("multisig account can sign without required cosigner %s", basics.Address(cosigner))
+		}
+	}
+	return ""
+}
+
+// checkProgram returns why delegating a key to a program breaks the rules,
+// or "" if it doesn't
+func (r rules) checkProgram() string {
+	if !r.AllowProgramSigning {
+		return "program signing is not allowed"
+	}
+	return ""
+}
@@ -0,0 +1,373 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var testScrypt = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+func randAddr() basics.Address {
+	var addr basics.Address
+	crypto.RandBytes(addr[:])
+	return addr
+}
+
+func payment(sender, receiver basics.Address, amount, fee uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender: sender,
+			Fee:    basics.MicroAlgos{Raw: fee},
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func TestPolicyTransactionChecks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sender, friend, stranger := randAddr(), randAddr(), randAddr()
+
+	r, err := Policy{
+		AllowedReceivers: []string{friend.String()},
+		AllowedTxTypes:   []protocol.TxType{protocol.PaymentTx, protocol.AssetTransferTx, protocol.ApplicationCallTx},
+		AllowedAppIDs:    []uint64{7},
+		ForbidRekey:      true,
+		MaxAmount:        1000,
+		MaxDailyAmount:   2500,
+	}.compile()
+	require.NoError(t, err)
+
+	require.Empty(t, r.checkTransaction(payment(sender, friend, 900, 100), 0))
+	require.Contains(t, r.checkTransaction(payment(sender, friend, 901, 100), 0), "per transaction")
+	require.Contains(t, r.checkTransaction(payment(sender, friend, 900, 100), 1501), "daily limit")
+	require.Contains(t, r.checkTransaction(payment(sender, stranger, 1, 1), 0), "receiver")
+
+	rekey := payment(sender, friend, 1, 1)
+	rekey.RekeyTo = stranger
+	require.Contains(t, r.checkTransaction(rekey, 0), "rekeying")
+
+	// Closing moves the whole balance, which the amount limits can't bound
+	closeOut := payment(sender, friend, 1, 1)
+	closeOut.CloseRemainderTo = friend
+	require.Contains(t, r.checkTransaction(closeOut, 0), "closing")
+
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx, Header: transactions.Header{Sender: sender}}
+	require.Contains(t, r.checkTransaction(keyreg, 0), "type keyreg")
+
+	optIn := transactions.Transaction{Type: protocol.AssetTransferTx, Header: transactions.Header{Sender: sender}}
+	optIn.AssetReceiver = sender
+	require.Empty(t, r.checkTransaction(optIn, 0))
+
+	// Asset amounts can't be weighed against the limits, so assets can't
+	// move or be closed out while they are set
+	xfer := optIn
+	xfer.AssetReceiver = friend
+	xfer.AssetAmount = 1
+	require.Contains(t, r.checkTransaction(xfer, 0), "asset transfers")
+	assetClose := optIn
+	assetClose.AssetCloseTo = friend
+	require.Contains(t, r.checkTransaction(assetClose, 0), "closing out")
+
+	call := transactions.Transaction{Type: protocol.ApplicationCallTx, Header: transactions.Header{Sender: sender}}
+	call.ApplicationID = 7
+	require.Empty(t, r.checkTransaction(call, 0))
+	call.ApplicationID = 8
+	require.Contains(t, r.checkTransaction(call, 0), "application 8")
+	call.ApplicationID = 0
+	require.Contains(t, r.checkTransaction(call, 0), "application 0")
+
+	// Without limits, only ForbidClose refuses closing
+	r, err = Policy{}.compile()
+	require.NoError(t, err)
+	require.Empty(t, r.checkTransaction(closeOut, 0))
+	r, err = Policy{ForbidClose: true}.compile()
+	require.NoError(t, err)
+	require.Contains(t, r.checkTransaction(closeOut, 0), "closing")
+	require.Contains(t, r.checkTransaction(assetClose, 0), "closing out")
+
+	// Without limits, assets move to allowed receivers only
+	r, err = Policy{AllowedReceivers: []string{friend.String()}}.compile()
+	require.NoError(t, err)
+	require.Empty(t, r.checkTransaction(xfer, 0))
+	require.Empty(t, r.checkTransaction(assetClose, 0))
+	assetClose.AssetCloseTo = stranger
+	require.Contains(t, r.checkTransaction(assetClose, 0), "receiver")
+
+	require.Error(t, Policy{AllowedReceivers: []string{"nope"}}.Validate())
+	require.Error(t, Policy{AllowedTxTypes: []protocol.TxType{protocol.UnknownTx}}.Validate())
+	require.Error(t, Policy{RequiredCosigners: []string{"nope"}}.Validate())
+}
+
+func TestPolicyCosigners(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ours, guard, other := randAddr(), randAddr(), randAddr()
+	pks := func(addrs ...basics.Address) (out []crypto.PublicKey) {
+		for _, addr := range addrs {
+			out = append(out, crypto.PublicKey(addr))
+		}
+		return
+	}
+
+	r, err := Policy{RequiredCosigners: []string{guard.String()}}.compile()
+	require.NoError(t, err)
+
+	require.NotEmpty(t, r.checkSingleSig())
+	// 2-of-2 with the guard can't sign without it
+	require.Empty(t, r.checkMultisig(2, pks(ours, guard)))
+	// 2-of-3 can sign with ours and other alone
+	require.NotEmpty(t, r.checkMultisig(2, pks(ours, guard, other)))
+	// 1-of-2 can sign with ours alone
+	require.NotEmpty(t, r.checkMultisig(1, pks(ours, guard)))
+	// the guard must be in the preimage at all
+	require.NotEmpty(t, r.checkMultisig(2, pks(ours, other)))
+
+	r, err = Policy{}.compile()
+	require.NoError(t, err)
+	require.Empty(t, r.checkSingleSig())
+	require.Empty(t, r.checkMultisig(1, pks(ours, other)))
+	require.NotEmpty(t, r.checkProgram())
+}
+
+var errTestPassword = fmt.Errorf("wrong password")
+
+// testWallet is a wallet.Wallet that signs anything, for the engine to guard
+type testWallet struct {
+	wallet.Wallet
+	id     []byte
+	signed int
+}
+
+func (w *testWallet) CheckPassword(pw []byte) error {
+	if string(pw) != "pw" {
+		return errTestPassword
+	}
+	return nil
+}
+
+func (w *testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: w.id}, nil
+}
+
+func (w *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	w.signed++
+	return []byte("signed"), nil
+}
+
+func (w *testWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, nil
+}
+
+func TestEngineEnforce(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", "kmdpolicy")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	e, err := MakeEngine(dir, testScrypt)
+	require.NoError(t, err)
+	now := time.Date(2021, 6, 1, 23, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+
+	tw := &testWallet{id: []byte("treasury")}
+	w := e.Wrap(tw)
+	sender, friend := randAddr(), randAddr()
+
+	// Without a policy, everything goes and nothing is audited
+	_, err = w.SignTransaction(payment(sender, randAddr(), 1e9, 1000), crypto.PublicKey{}, []byte("pw"))
+	require.NoError(t, err)
+	_, err = w.ExportKey(crypto.Digest{}, []byte("pw"))
+	require.NoError(t, err)
+	entries, err := e.AuditLog(tw.id, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	p := Policy{AllowedReceivers: []string{friend.String()}, MaxDailyAmount: 2000}
+	require.Equal(t, errEmptyPolicyPassword, e.SetPolicy(tw.id, p, nil))
+	require.NoError(t, e.SetPolicy(tw.id, p, []byte("admin")))
+	stored, ok, err := e.Policy(tw.id)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, p, stored)
+
+	// Wrong wallet passwords are turned away before the policy
+	_, err = w.SignTransaction(payment(sender, friend, 1, 1), crypto.PublicKey{}, []byte("bad"))
+	require.Equal(t, errTestPassword, err)
+
+	signed := tw.signed
+	_, err = w.SignTransaction(payment(sender, friend, 1000, 500), crypto.PublicKey{}, []byte("pw"))
+	require.NoError(t, err)
+	_, err = w.SignTransaction(payment(sender, friend, 1000, 500), crypto.PublicKey{}, []byte("pw"))
+	require.IsType(t, &DeniedError{}, err)
+	_, err = w.SignTransaction(payment(sender, randAddr(), 1, 1), crypto.PublicKey{}, []byte("pw"))
+	require.IsType(t, &DeniedError{}, err)
+	_, err = w.ExportKey(crypto.Digest{}, []byte("pw"))
+	require.IsType(t, &DeniedError{}, err)
+	require.Equal(t, signed+1, tw.signed)
+
+	// The daily limit starts over at midnight UTC
+	now = now.Add(2 * time.Hour)
+	_, err = w.SignTransaction(payment(sender, friend, 1000, 500), crypto.PublicKey{}, []byte("pw"))
+	require.NoError(t, err)
+
+	// The policy password guards changes
+	require.Equal(t, errWrongPolicyPassword, e.SetPolicy(tw.id, Policy{}, []byte("pw")))
+	require.Equal(t, errWrongPolicyPassword, e.DeletePolicy(tw.id, []byte("pw")))
+	require.NoError(t, e.DeletePolicy(tw.id, []byte("admin")))
+	require.Equal(t, errNoPolicy, e.DeletePolicy(tw.id, []byte("admin")))
+	_, err = w.ExportKey(crypto.Digest{}, []byte("pw"))
+	require.NoError(t, err)
+
+	entries, err = e.AuditLog(tw.id, 0, 0)
+	require.NoError(t, err)
+	var ops []string
+	var allowed []bool
+	for _, entry := range entries {
+		ops = append(ops, entry.Operation)
+		allowed = append(allowed, entry.Allowed)
+	}
+	require.Equal(t, []string{OpSetPolicy, OpSignTransaction, OpSignTransaction, OpSignTransaction, OpExportKey, OpSignTransaction, OpSetPolicy, OpDeletePolicy, OpDeletePolicy}, ops)
+	require.Equal(t, []bool{true, true, false, false, false, true, false, false, true}, allowed)
+	require.Equal(t, uint64(1500), entries[1].Spent)
+	require.Equal(t, sender.String(), entries[1].Sender)
+
+	entries, err = e.AuditLog(tw.id, entries[6].Seq, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, OpDeletePolicy, entries[0].Operation)
+
+	head, err := e.VerifyAuditLog(AuditHead{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), head.Seq)
+	head, err = e.VerifyAuditLog(AuditHead{Seq: entries[0].Seq, Hash: entries[0].Hash})
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), head.Seq)
+}
+
+func TestAuditLogTamperEvident(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// tampered builds a fresh audit log of five entries, keeps its head,
+	// runs tamper on its database and verifies the result against the head
+	tampered := func(tamper func(db *sqlx.DB)) (AuditHead, error) {
+		dir, err := ioutil.TempDir("", "kmdpolicy")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		e, err := MakeEngine(dir, testScrypt)
+		require.NoError(t, err)
+		w := e.Wrap(&testWallet{id: []byte("treasury")})
+
+		require.NoError(t, e.SetPolicy([]byte("treasury"), Policy{MaxAmount: 10}, []byte("admin")))
+		sender := randAddr()
+		for i := uint64(0); i < 4; i++ {
+			w.SignTransaction(payment(sender, sender, i*5, 1), crypto.PublicKey{}, []byte("pw"))
+		}
+		trusted, err := e.VerifyAuditLog(AuditHead{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), trusted.Seq)
+
+		db, err := e.connect()
+		require.NoError(t, err)
+		defer db.Close()
+		tamper(db)
+
+		return e.VerifyAuditLog(trusted)
+	}
+	exec := func(query string) func(db *sqlx.DB) {
+		return func(db *sqlx.DB) {
+			_, err := db.Exec(query)
+			require.NoError(t, err)
+		}
+	}
+
+	head, err := tampered(exec("UPDATE audit SET allowed=1 WHERE seq=4"))
+	require.EqualError(t, err, "audit entry 4 does not match its hash")
+	require.Equal(t, uint64(3), head.Seq)
+
+	_, err = tampered(exec("DELETE FROM audit WHERE seq=3"))
+	require.EqualError(t, err, "audit log is missing entries between 2 and 4")
+
+	_, err = tampered(exec("DELETE FROM audit WHERE seq=3; UPDATE audit SET seq=seq-1 WHERE seq>3"))
+	require.EqualError(t, err, "audit entry 3 does not link to entry 2")
+
+	// Cutting entries off the end leaves a valid chain with an older head
+	head, err = tampered(exec("DELETE FROM audit WHERE seq=5"))
+	require.EqualError(t, err, "audit log ends at entry 4, before the trusted head 5")
+	require.Equal(t, uint64(4), head.Seq)
+
+	// Rewriting an entry along with its hash leaves a valid chain too
+	_, err = tampered(func(db *sqlx.DB) {
+		var row auditRow
+		require.NoError(t, db.Get(&row, "SELECT "+auditColumns+" FROM audit WHERE seq=5"))
+		entry := row.entry()
+		entry.Allowed = !entry.Allowed
+		hash := crypto.HashObj(entry)
+		_, err := db.Exec("UPDATE audit SET allowed=?, hash=? WHERE seq=5", entry.Allowed, hash[:])
+		require.NoError(t, err)
+	})
+	require.EqualError(t, err, "audit entry 5 does not match the trusted head")
+}
+
+func TestEngineFailsClosed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", "kmdpolicy")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	e, err := MakeEngine(dir, testScrypt)
+	require.NoError(t, err)
+	tw := &testWallet{id: []byte("treasury")}
+	w := e.Wrap(tw)
+	require.NoError(t, e.SetPolicy(tw.id, Policy{}, []byte("admin")))
+
+	// Nothing gets signed if the decision can't be audited
+	db, err := e.connect()
+	require.NoError(t, err)
+	_, err = db.Exec("DROP TABLE audit")
+	require.NoError(t, err)
+	db.Close()
+
+	sender := randAddr()
+	stx, err := w.SignTransaction(payment(sender, sender, 1, 1), crypto.PublicKey{}, []byte("pw"))
+	require.Error(t, err)
+	require.Nil(t, stx)
+	require.Zero(t, tw.signed)
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// enforcedWallet is a wallet.Wallet whose signatures and key exports are
+// ruled on by the engine first. Results are only handed out once the engine
+// has committed its decision to the audit log.
+type enforcedWallet struct {
+	wallet.Wallet
+	e *Engine
+}
+
+// Wrap returns w with its signing policy enforced. Keys can't be exported
+// from a wallet under a policy, since they could then sign anything. A nil
+// engine returns w as is.
+func (e *Engine) Wrap(w wallet.Wallet) wallet.Wallet {
+	if e == nil {
+		return w
+	}
+	return &enforcedWallet{Wallet: w, e: e}
+}
+
+func (w *enforcedWallet) walletID() ([]byte, error) {
+	metadata, err := w.Metadata()
+	return metadata.ID, err
+}
+
+// decide builds the decision about an operation of the wallet. The wallet
+// password is checked first, so that only authenticated requests are
+// ruled on and audited.
+func (w *enforcedWallet) decide(op string, pw []byte) (d decision, err error) {
+	err = w.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+	d.walletID, err = w.walletID()
+	d.operation = op
+	return
+}
+
+// multisigPreimage returns the threshold and keys of the multisig account a
+// signature is added to, taken from the partial signature or, if there is
+// none yet, from the wallet
+func (w *enforcedWallet) multisigPreimage(addr crypto.Digest, partial crypto.MultisigSig) (threshold uint8, pks []crypto.PublicKey, err error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		_, threshold, pks, err = w.LookupMultisigPreimage(addr)
+		return
+	}
+	_, threshold, pks = partial.Preimage()
+	return
+}
+
+func deniedWhileEnforced(reason string) func(rules, uint64) string {
+	return func(rules, uint64) string {
+		return reason
+	}
+}
+
+func (w *enforcedWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterDerivationKey, err error) {
+	d, err := w.decide(OpExportMasterKey, pw)
+	if err != nil {
+		return
+	}
+	d.check = deniedWhileEnforced("master key export is not allowed")
+	var exported crypto.MasterDerivationKey
+	err = w.e.enforce(d, func() (err error) {
+		exported, err = w.Wallet.ExportMasterDerivationKey(pw)
+		return
+	})
+	if err != nil {
+		return
+	}
+	return exported, nil
+}
+
+func (w *enforcedWallet) ExportKey(pk crypto.Digest, pw []byte) (sk crypto.PrivateKey, err error) {
+	d, err := w.decide(OpExportKey, pw)
+	if err != nil {
+		return
+	}
+	d.sender = basics.Address(pk).String()
+	d.check = deniedWhileEnforced("key export is not allowed")
+	var exported crypto.PrivateKey
+	err = w.e.enforce(d, func() (err error) {
+		exported, err = w.Wallet.ExportKey(pk, pw)
+		return
+	})
+	if err != nil {
+		return
+	}
+	return exported, nil
+}
+
+func (w *enforcedWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) (stx []byte, err error) {
+	d, err := w.decide(OpSignTransaction, pw)
+	if err != nil {
+		return
+	}
+	d.txid = tx.ID().String()
+	d.sender = tx.Sender.String()
+	d.spent = spend(tx)
+	d.check = func(r rules, spentToday uint64) string {
+		if reason := r.checkSingleSig(); reason != "" {
+			return reason
+		}
+		return r.checkTransaction(tx, spentToday)
+	}
+	var signed []byte
+	err = w.e.enforce(d, func() (err error) {
+		signed, err = w.Wallet.SignTransaction(tx, pk, pw)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func (w *enforcedWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (msig crypto.MultisigSig, err error) {
+	d, err := w.decide(OpMultisigSignTransaction, pw)
+	if err != nil {
+		return
+	}
+	threshold, pks, err := w.multisigPreimage(crypto.Digest(tx.Src()), partial)
+	if err != nil {
+		return
+	}
+	d.txid = tx.ID().String()
+	d.sender = tx.Sender.String()
+	d.spent = spend(tx)
+	d.check = func(r rules, spentToday uint64) string {
+		if reason := r.checkMultisig(threshold, pks); reason != "" {
+			return reason
+		}
+		return r.checkTransaction(tx, spentToday)
+	}
+	var signed crypto.MultisigSig
+	err = w.e.enforce(d, func() (err error) {
+		signed, err = w.Wallet.MultisigSignTransaction(tx, pk, partial, pw, signer)
+		return
+	})
+	if err != nil {
+		return
+	}
+	return signed, nil
+}
+
+func (w *enforcedWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) (sig []byte, err error) {
+	d, err := w.decide(OpSignProgram, pw)
+	if err != nil {
+		return
+	}
+	d.sender = basics.Address(src).String()
+	d.check = func(r rules, spentToday uint64) string {
+		if reason := r.checkProgram(); reason != "" {
+			return reason
+		}
+		return r.checkSingleSig()
+	}
+	var signed []byte
+	err = w.e.enforce(d, func() (err error) {
+		signed, err = w.Wallet.SignProgram(program, src, pw)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func (w *enforcedWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (msig crypto.MultisigSig, err error) {
+	d, err := w.decide(OpMultisigSignProgram, pw)
+	if err != nil {
+		return
+	}
+	threshold, pks, err := w.multisigPreimage(src, partial)
+	if err != nil {
+		return
+	}
+	d.sender = basics.Address(src).String()
+	d.check = func(r rules, spentToday uint64) string {
+		if reason := r.checkProgram(); reason != "" {
+			return reason
+		}
+		return r.checkMultisig(threshold, pks)
+	}
+	var signed crypto.MultisigSig
+	err = w.e.enforce(d, func() (err error) {
+		signed, err = w.Wallet.MultisigSignProgram(program, src, pk, partial, pw)
+		return
+	})
+	if err != nil {
+		return
+	}
+	return signed, nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/gofrs/flock"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
@@ -56,6 +57,7 @@ type WalletServerConfig struct {
 	Address        string
 	AllowedOrigins []string
 	SessionManager *session.Manager
+	Policies       *policy.Engine
 	Log            logging.Logger
 	Timeout        *time.Duration
 }
@@ -212,7 +214,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.Policies, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -145,7 +145,7 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 	handle := walletHandle{
 		secret:  handleSecret,
 		expires: time.Now().Add(sm.sessionLifetime),
-		wallet:  w,
+		wallet:  sm.policies.Wrap(w),
 	}
 
 	// Insert the handle into the walletHandles map
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-deadlock"
 )
@@ -38,19 +39,22 @@ type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
 	sessionLifetime time.Duration
+	policies        *policy.Engine
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
-// configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+// configuration. Wallets initialized through the Manager have their signing
+// policies enforced by the passed engine, if any.
+func MakeManager(cfg config.KMDConfig, policies *policy.Engine) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
+		policies:        policies,
 		Kill:            cancel,
 		ctx:             ctx,
 	}
@@ -33,11 +33,11 @@ var walletDrivers = map[string]Driver{
 // Driver is the interface that all wallet drivers must expose in order to be
 // compatible with kmd. In particular, wallet drivers must be able to
 // initialize themselves from a Config, create a wallet with a name, ID,
-// and password, and fetch a wallet by ID.
+// password and key derivation scheme, and fetch a wallet by ID.
 type Driver interface {
 	InitWithConfig(cfg config.KMDConfig, log logging.Logger) error
 	ListWalletMetadatas() ([]wallet.Metadata, error)
-	CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error
+	CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, derivation string) error
 	RenameWallet(newName []byte, id []byte, pw []byte) error
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
@@ -78,7 +78,7 @@ type LedgerWallet struct {
 // key in a hardware wallet, derived from the device master
 // secret.  We could, in principle, derive multiple keys.
 // This is not supported at the moment.
-func (lwd *LedgerWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+func (lwd *LedgerWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, derivation string) error {
 	return errNotSupported
 }
 
@@ -300,6 +300,16 @@ func (lw *LedgerWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
 	return errNotSupported
 }
 
+// DeriveKey implements the Wallet interface.
+func (lw *LedgerWallet) DeriveKey(path crypto.HDPath) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportHDPublicKey implements the Wallet interface.
+func (lw *LedgerWallet) ExportHDPublicKey(path crypto.HDPath, pw []byte) (crypto.HDPublicKey, error) {
+	return crypto.HDPublicKey{}, errNotSupported
+}
+
 // ImportMultisigAddr implements the Wallet interface.
 func (lw *LedgerWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
 	return crypto.Digest{}, errNotSupported
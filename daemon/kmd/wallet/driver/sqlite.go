@@ -79,6 +79,15 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 	threshold INT NOT NULL,
 	pks BLOB NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS derivation (
+	scheme TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS hd_keys (
+	address BLOB PRIMARY KEY,
+	path TEXT NOT NULL
+);
 `
 
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
@@ -198,6 +207,11 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		return
 	}
 
+	derivation, err := walletDerivationScheme(db)
+	if err != nil {
+		return
+	}
+
 	// Build the Metadata
 	metadata = wallet.Metadata{
 		ID:                    walletID,
@@ -207,6 +221,7 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		SupportsMnemonicUX:    sqliteWalletHasMnemonicUX,
 		SupportsMasterKey:     sqliteWalletHasMasterKey,
 		SupportedTransactions: sqliteWalletSupportedTxs,
+		DerivationScheme:      derivation,
 	}
 
 	return
@@ -412,7 +427,7 @@ func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath
 
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
-func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, derivation string) error {
 	if len(name) > sqliteMaxWalletNameLen {
 		return errNameTooLong
 	}
@@ -421,6 +436,15 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 		return errIDTooLong
 	}
 
+	// Wallets derive their keys sequentially unless asked otherwise
+	switch derivation {
+	case "":
+		derivation = wallet.DerivationSequential
+	case wallet.DerivationSequential, wallet.DerivationBIP32Ed25519:
+	default:
+		return errUnknownDerivation
+	}
+
 	dbPath, err := swd.claimWalletNameID(name, id)
 	if err != nil {
 		return err
@@ -486,6 +510,12 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 		return errDatabase
 	}
 
+	// Record how the wallet derives its keys
+	_, err = db.Exec("INSERT INTO derivation (scheme) VALUES(?)", derivation)
+	if err != nil {
+		return errDatabase
+	}
+
 	return nil
 }
 
@@ -657,6 +687,12 @@ func (sw *SQLiteWallet) Init(pw []byte) error {
 		return err
 	}
 
+	// Add the tables introduced since wallets created by older versions
+	err = sw.upgradeSchema()
+	if err != nil {
+		return err
+	}
+
 	// Decrypt the master derivation key
 	masterDerivationKey, err := sw.decryptAndGetMasterDerivationKey(masterEncryptionKey)
 	if err != nil {
@@ -779,6 +815,16 @@ func (sw *SQLiteWallet) ExportKey(addr crypto.Digest, pw []byte) (sk crypto.Priv
 		return
 	}
 
+	// Keys derived with BIP32-Ed25519 have no ed25519 seed to export
+	_, isHD, err := sw.lookupHDPath(addr)
+	if err != nil {
+		return
+	}
+	if isHD {
+		err = errHDKeyExport
+		return
+	}
+
 	// Export the key
 	return sw.fetchSecretKey(addr)
 }
@@ -907,8 +953,24 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 	// nextIndex is the index of the next key we should generate
 	nextIndex := highestIndex + 1
 
+	// Hierarchical deterministic wallets generate the addresses of their
+	// first account, m/44'/283'/0'/0/(nextIndex-1)
+	derivation, err := walletDerivationScheme(tx)
+	if err != nil {
+		return
+	}
+	var hdMaster crypto.HDPrivateKey
+	if derivation == wallet.DerivationBIP32Ed25519 {
+		hdMaster, err = sw.hdMasterKey()
+		if err != nil {
+			return
+		}
+	}
+
 	var genPK crypto.PublicKey
 	var genSK crypto.PrivateKey
+	var genHDKey crypto.HDPrivateKey
+	var genPath crypto.HDPath
 	var genAddr crypto.Digest
 
 	// We may have to bump nextIndex if the user has manually imported the next
@@ -922,9 +984,22 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 		}
 
 		// Compute the secret key and public key for nextIndex
-		genPK, genSK, err = extractKeyWithIndex(sw.masterDerivationKey, nextIndex)
-		if err != nil {
-			return
+		if derivation == wallet.DerivationBIP32Ed25519 {
+			if nextIndex > uint64(crypto.HDHardened) {
+				err = errTooManyKeys
+				return
+			}
+			genPath = crypto.AlgorandHDPath(0, uint32(nextIndex-1))
+			genHDKey, err = hdMaster.Derive(genPath)
+			if err != nil {
+				return
+			}
+			genPK = genHDKey.PublicKey()
+		} else {
+			genPK, genSK, err = extractKeyWithIndex(sw.masterDerivationKey, nextIndex)
+			if err != nil {
+				return
+			}
 		}
 
 		// Convert the public key into an address
@@ -947,16 +1022,24 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 		nextIndex++
 	}
 
-	// Encrypt the encoded secret key
-	skEncrypted, err := encryptBlobWithKey(msgpackEncode(genSK), PTSecretKey, sw.masterEncryptionKey)
-	if err != nil {
-		return
-	}
+	if derivation == wallet.DerivationBIP32Ed25519 {
+		err = sw.insertHDKeyTxLocked(tx, genHDKey, genPath, nextIndex)
+		if err != nil {
+			return
+		}
+	} else {
+		// Encrypt the encoded secret key
+		var skEncrypted []byte
+		skEncrypted, err = encryptBlobWithKey(msgpackEncode(genSK), PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return
+		}
 
-	// Insert the key into the database
-	_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", genAddr[:], skEncrypted, nextIndex)
-	if err != nil {
-		return
+		// Insert the key into the database
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", genAddr[:], skEncrypted, nextIndex)
+		if err != nil {
+			return
+		}
 	}
 
 	// Encrypt the new max key index
@@ -990,8 +1073,30 @@ func (sw *SQLiteWallet) DeleteKey(addr crypto.Digest, pw []byte) (err error) {
 	}
 	defer db.Close()
 
-	// Delete the key
-	_, err = db.Exec("DELETE FROM keys WHERE address=?", addr[:])
+	// Begin an exclusive database transaction, so that the key and its
+	// derivation path go away together
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	// Delete the key, along with its derivation path if it has one
+	_, err = tx.Exec("DELETE FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		tx.Rollback()
+		err = errDatabase
+		return
+	}
+	_, err = tx.Exec("DELETE FROM hd_keys WHERE address=?", addr[:])
+	if err != nil {
+		tx.Rollback()
+		err = errDatabase
+		return
+	}
+
+	// Commit the transaction
+	err = tx.Commit()
 	if err != nil {
 		err = errDatabase
 	}
@@ -1119,25 +1224,18 @@ func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	}
 
 	// Fetch the required key
-	var sk crypto.PrivateKey
+	var key signingKey
 	if (pk == crypto.PublicKey{}) {
-		sk, err = sw.fetchSecretKey(crypto.Digest(tx.Src()))
+		key, err = sw.fetchSigningKey(crypto.Digest(tx.Src()))
 	} else {
-		sk, err = sw.fetchSecretKey(crypto.Digest(pk))
-	}
-	if err != nil {
-		return
+		key, err = sw.fetchSigningKey(crypto.Digest(pk))
 	}
-
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
 	if err != nil {
-		err = errSKToPK
 		return
 	}
 
 	// Sign the transaction
-	stxn := tx.Sign(secrets)
+	stxn := key.signTransaction(tx)
 	stx = protocol.Encode(&stxn)
 	return
 }
@@ -1151,21 +1249,14 @@ func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	}
 
 	// Fetch the required key
-	sk, err := sw.fetchSecretKey(crypto.Digest(src))
-	if err != nil {
-		return
-	}
-
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	key, err := sw.fetchSigningKey(crypto.Digest(src))
 	if err != nil {
-		err = errSKToPK
 		return
 	}
 
 	progb := logic.Program(data)
 	// Sign the transaction
-	sig := secrets.Sign(&progb)
+	sig := key.sign(&progb)
 	stx = sig[:]
 	return
 }
@@ -1192,23 +1283,15 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var key signingKey
+		key, err = sw.fetchSigningKey(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
 		from := crypto.Digest(tx.Src())
-		sig, err = crypto.MultisigSign(tx, from, version, threshold, pks, *secrets)
+		sig, err = key.multisigSign(tx, from, version, threshold, pks)
 		return
 	}
 
@@ -1240,20 +1323,14 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	key, err := sw.fetchSigningKey(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
 
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
-	msig2, err := crypto.MultisigSign(tx, addr, version, threshold, pks, *secrets)
+	msig2, err := key.multisigSign(tx, addr, version, threshold, pks)
 	if err != nil {
 		return
 	}
@@ -1283,24 +1360,16 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var key signingKey
+		key, err = sw.fetchSigningKey(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
 		from := src
 		progb := logic.Program(data)
-		sig, err = crypto.MultisigSign(&progb, from, version, threshold, pks, *secrets)
+		sig, err = key.multisigSign(&progb, from, version, threshold, pks)
 		return
 	}
 
@@ -1330,13 +1399,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	key, err := sw.fetchSigningKey(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
@@ -1344,7 +1407,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
 	progb := logic.Program(data)
-	msig2, err := crypto.MultisigSign(&progb, addr, version, threshold, pks, *secrets)
+	msig2, err := key.multisigSign(&progb, addr, version, threshold, pks)
 	if err != nil {
 		return
 	}
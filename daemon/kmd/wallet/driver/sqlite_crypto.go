@@ -51,6 +51,8 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTHDSecretKey is the plaintext type for a BIP32-Ed25519 extended secret key
+	PTHDSecretKey plaintextType = "hd_secret_key"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -45,3 +45,6 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errUnknownDerivation = fmt.Errorf("unknown key derivation scheme")
+var errNotHDWallet = fmt.Errorf("wallet does not derive its keys with BIP32-Ed25519")
+var errHDKeyExport = fmt.Errorf("keys derived with BIP32-Ed25519 cannot be exported, back up the wallet mnemonic instead")
@@ -0,0 +1,324 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// walletDerivationScheme reads how the wallet derives its keys. Wallets
+// created before the derivation table existed derive them sequentially
+func walletDerivationScheme(q sqlx.Queryer) (scheme string, err error) {
+	var cnt int
+	err = sqlx.Get(q, &cnt, "SELECT COUNT(1) FROM sqlite_master WHERE type='table' AND name='derivation'")
+	if err != nil {
+		return "", errDatabase
+	}
+	if cnt == 0 {
+		return wallet.DerivationSequential, nil
+	}
+
+	err = sqlx.Get(q, &scheme, "SELECT scheme FROM derivation LIMIT 1")
+	if err == sql.ErrNoRows {
+		return wallet.DerivationSequential, nil
+	}
+	if err != nil {
+		return "", errDatabase
+	}
+	return scheme, nil
+}
+
+// upgradeSchema creates the tables missing from wallets created by older
+// versions of kmd. Every statement of the schema is idempotent
+func (sw *SQLiteWallet) upgradeSchema() error {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec(walletSchema)
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// hdMasterKey computes the BIP32-Ed25519 master key of the wallet. The master
+// derivation key is used as the entropy of a BIP-39 mnemonic without
+// passphrase, so that the wallet backup phrase restores the same keys in any
+// BIP-44 wallet
+func (sw *SQLiteWallet) hdMasterKey() (crypto.HDPrivateKey, error) {
+	mnemonic, err := passphrase.KeyToBIP39Mnemonic(sw.masterDerivationKey)
+	if err != nil {
+		return crypto.HDPrivateKey{}, err
+	}
+	return crypto.HDMasterKey(passphrase.BIP39Seed(mnemonic, "")), nil
+}
+
+// checkHDWallet fails unless the wallet derives its keys with BIP32-Ed25519
+func (sw *SQLiteWallet) checkHDWallet(q sqlx.Queryer) error {
+	derivation, err := walletDerivationScheme(q)
+	if err != nil {
+		return err
+	}
+	if derivation != wallet.DerivationBIP32Ed25519 {
+		return errNotHDWallet
+	}
+	return nil
+}
+
+// lookupHDPath returns the derivation path of the key of addr, if the key
+// was derived with BIP32-Ed25519
+func (sw *SQLiteWallet) lookupHDPath(addr crypto.Digest) (path crypto.HDPath, isHD bool, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var pathStr string
+	err = db.Get(&pathStr, "SELECT path FROM hd_keys WHERE address=?", addr[:])
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	path, err = crypto.ParseHDPath(pathStr)
+	if err != nil {
+		err = errTampering
+		return
+	}
+	return path, true, nil
+}
+
+// insertHDKeyTxLocked encrypts a derived key and stores it along with its
+// path. keyIdx is the index of generated keys, and nil for keys derived
+// from an explicit path
+func (sw *SQLiteWallet) insertHDKeyTxLocked(tx *sqlx.Tx, key crypto.HDPrivateKey, path crypto.HDPath, keyIdx interface{}) error {
+	addr := publicKeyToAddress(key.PublicKey())
+
+	skEncrypted, err := encryptBlobWithKey(msgpackEncode(key), PTHDSecretKey, sw.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+	err = checkDBError(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO hd_keys (address, path) VALUES(?, ?)", addr[:], path.String())
+	return checkDBError(err)
+}
+
+// fetchHDSecretKey retrieves the extended secret key for a given address
+func (sw *SQLiteWallet) fetchHDSecretKey(addr crypto.Digest) (key crypto.HDPrivateKey, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var blob []byte
+	err = db.Get(&blob, "SELECT secret_key_encrypted FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		err = errKeyNotFound
+		return
+	}
+
+	keyEncoded, err := decryptBlobWithPassword(blob, PTHDSecretKey, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	var candidate crypto.HDPrivateKey
+	err = msgpackDecode(keyEncoded, &candidate)
+	if err != nil {
+		return
+	}
+
+	// Ensure the key matches the address we used to look it up
+	if publicKeyToAddress(candidate.PublicKey()) != addr {
+		err = errTampering
+		return
+	}
+	return candidate, nil
+}
+
+// DeriveKey derives the key at path from the wallet's BIP32-Ed25519 master
+// key and adds it to the wallet. This recovers keys that were derived by
+// another wallet restored from the same mnemonic
+func (sw *SQLiteWallet) DeriveKey(path crypto.HDPath) (addr crypto.Digest, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	err = sw.checkHDWallet(db)
+	if err != nil {
+		return
+	}
+
+	master, err := sw.hdMasterKey()
+	if err != nil {
+		return
+	}
+	key, err := master.Derive(path)
+	if err != nil {
+		return
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	err = sw.insertHDKeyTxLocked(tx, key, path, nil)
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	return publicKeyToAddress(key.PublicKey()), nil
+}
+
+// ExportHDPublicKey derives the extended public key at path, from which
+// watch-only wallets can generate the addresses of the non-hardened children
+func (sw *SQLiteWallet) ExportHDPublicKey(path crypto.HDPath, pw []byte) (xpub crypto.HDPublicKey, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	err = sw.checkHDWallet(db)
+	if err != nil {
+		return
+	}
+
+	master, err := sw.hdMasterKey()
+	if err != nil {
+		return
+	}
+	key, err := master.Derive(path)
+	if err != nil {
+		return
+	}
+	return key.Public(), nil
+}
+
+// signingKey signs on behalf of an address of the wallet, with either an
+// imported or sequentially derived ed25519 key, or a BIP32-Ed25519 key
+type signingKey struct {
+	secrets *crypto.SignatureSecrets
+	hd      *crypto.HDPrivateKey
+}
+
+// fetchSigningKey retrieves the key of the given address, whichever way it
+// was derived
+func (sw *SQLiteWallet) fetchSigningKey(addr crypto.Digest) (key signingKey, err error) {
+	_, isHD, err := sw.lookupHDPath(addr)
+	if err != nil {
+		return
+	}
+
+	if isHD {
+		var hdKey crypto.HDPrivateKey
+		hdKey, err = sw.fetchHDSecretKey(addr)
+		if err != nil {
+			return
+		}
+		key.hd = &hdKey
+		return
+	}
+
+	sk, err := sw.fetchSecretKey(addr)
+	if err != nil {
+		return
+	}
+	key.secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+	if err != nil {
+		err = errSKToPK
+	}
+	return
+}
+
+func (k signingKey) publicKey() crypto.PublicKey {
+	if k.hd != nil {
+		return k.hd.PublicKey()
+	}
+	return k.secrets.SignatureVerifier
+}
+
+func (k signingKey) sign(msg crypto.Hashable) crypto.Signature {
+	if k.hd != nil {
+		return k.hd.Sign(msg)
+	}
+	return k.secrets.Sign(msg)
+}
+
+func (k signingKey) signTransaction(tx transactions.Transaction) transactions.SignedTxn {
+	if k.hd == nil {
+		return tx.Sign(k.secrets)
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: k.hd.Sign(tx),
+	}
+	// Set the AuthAddr if the signing key doesn't match the transaction sender
+	if basics.Address(k.publicKey()) != tx.Sender {
+		stxn.AuthAddr = basics.Address(k.publicKey())
+	}
+	return stxn
+}
+
+func (k signingKey) multisigSign(msg crypto.Hashable, addr crypto.Digest, version, threshold uint8, pks []crypto.PublicKey) (crypto.MultisigSig, error) {
+	if k.hd != nil {
+		return crypto.MultisigSignHD(msg, addr, version, threshold, pks, k.hd)
+	}
+	return crypto.MultisigSign(msg, addr, version, threshold, pks, *k.secrets)
+}
@@ -29,6 +29,16 @@ const (
 	walletIDBytes = 16
 )
 
+const (
+	// DerivationSequential derives the keys of a wallet from its master
+	// derivation key one index after the other, with kmd's own scheme
+	DerivationSequential = "sequential"
+	// DerivationBIP32Ed25519 derives the keys of a wallet along BIP-44 paths
+	// with BIP32-Ed25519, from the BIP-39 seed whose entropy is the master
+	// derivation key, like hardware and mobile wallets do
+	DerivationBIP32Ed25519 = "bip32-ed25519"
+)
+
 // Wallet represents the interface that any wallet technology must satisfy in
 // order to be used with KMD. Wallets start in a locked state until they are
 // initialized with Init.
@@ -46,6 +56,9 @@ type Wallet interface {
 	GenerateKey(displayMnemonic bool) (crypto.Digest, error)
 	DeleteKey(pk crypto.Digest, pw []byte) error
 
+	DeriveKey(path crypto.HDPath) (crypto.Digest, error)
+	ExportHDPublicKey(path crypto.HDPath, pw []byte) (crypto.HDPublicKey, error)
+
 	ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error)
 	LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error)
 	ListMultisigAddrs() (addrs []crypto.Digest, err error)
@@ -69,6 +82,7 @@ type Metadata struct {
 	SupportsMnemonicUX    bool
 	SupportsMasterKey     bool
 	SupportedTransactions []protocol.TxType
+	DerivationScheme      string
 }
 
 // GenerateWalletID generates a random hex wallet ID
@@ -54,6 +54,10 @@ type Participation struct {
 	LastValid  basics.Round
 
 	KeyDilution uint64
+
+	// RemoteSigner, when set, performs the participation operations on behalf of this account. In that case,
+	// VRF and Voting hold only the public keys, as the secrets are kept by the remote signing host.
+	RemoteSigner ParticipationSigner
 }
 
 // PersistedParticipation encapsulates the static state of the participation
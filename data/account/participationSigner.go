@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// errVRFProofFailed is returned when a VRF proof could not be constructed, which indicates a corrupt selection key.
+var errVRFProofFailed = errors.New("failed to construct a VRF proof -- participation key may be corrupt")
+
+// A ParticipationSigner performs the operations that require the secrets of a participation key:
+// it produces VRF proofs using the selection key, and one-time signatures using the voting key.
+//
+// The secrets might be held locally, or by a remote signing host, in which case none of the
+// secrets ever reach the node.
+type ParticipationSigner interface {
+	// ProveVRF produces a VRF proof over the given message using the selection key.
+	ProveVRF(message crypto.Hashable) (crypto.VrfProof, error)
+
+	// SignOneTime produces a one-time signature over the given message using the
+	// ephemeral voting key of the given identifier.
+	SignOneTime(id crypto.OneTimeSignatureIdentifier, message crypto.Hashable) (crypto.OneTimeSignature, error)
+
+	// KeyDilution returns the key dilution of the voting key.
+	KeyDilution(params config.ConsensusParams) uint64
+}
+
+// localParticipationSigner is a ParticipationSigner using secrets held in memory.
+type localParticipationSigner struct {
+	voting    crypto.OneTimeSigner
+	selection *crypto.VRFSecrets
+}
+
+// MakeLocalSigner creates a ParticipationSigner that uses the given voting and selection secrets.
+func MakeLocalSigner(voting crypto.OneTimeSigner, selection *crypto.VRFSecrets) ParticipationSigner {
+	return localParticipationSigner{
+		voting:    voting,
+		selection: selection,
+	}
+}
+
+// ProveVRF implements the ParticipationSigner interface.
+func (s localParticipationSigner) ProveVRF(message crypto.Hashable) (crypto.VrfProof, error) {
+	proof, ok := s.selection.SK.Prove(message)
+	if !ok {
+		return crypto.VrfProof{}, errVRFProofFailed
+	}
+	return proof, nil
+}
+
+// SignOneTime implements the ParticipationSigner interface.
+func (s localParticipationSigner) SignOneTime(id crypto.OneTimeSignatureIdentifier, message crypto.Hashable) (crypto.OneTimeSignature, error) {
+	return s.voting.Sign(id, message), nil
+}
+
+// KeyDilution implements the ParticipationSigner interface.
+func (s localParticipationSigner) KeyDilution(params config.ConsensusParams) uint64 {
+	return s.voting.KeyDilution(params)
+}
+
+// Signer returns the ParticipationSigner performing the operations of this Participation account.
+func (part Participation) Signer() ParticipationSigner {
+	if part.RemoteSigner != nil {
+		return part.RemoteSigner
+	}
+	return MakeLocalSigner(part.VotingSigner(), part.VRF)
+}
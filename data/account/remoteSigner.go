@@ -0,0 +1,430 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The paths served by the remote participation signer.
+const (
+	RemoteSignerKeysPath = "/v1/participation/keys"
+	RemoteSignerVRFPath  = "/v1/participation/vrf"
+	RemoteSignerOTSPath  = "/v1/participation/ots"
+)
+
+// RemoteSignerTokenHeader is the HTTP header carrying the token authenticating the node against the remote signer.
+const RemoteSignerTokenHeader = "X-Algo-API-Token"
+
+// remoteSignerRequestTimeout bounds each of the requests made to the remote signer. Votes are useless once the
+// agreement moves on, so there is no point in waiting for much longer.
+const remoteSignerRequestTimeout = 2 * time.Second
+
+// remoteSignerMaxRequestSize is the maximal size of a request body accepted by the remote signer.
+const remoteSignerMaxRequestSize = 64 * 1024
+
+// remoteSignerVRFHashIDs are the kinds of messages the remote signer would produce VRF proofs for:
+// the agreement selectors ( credentials ) and the block seeds.
+var remoteSignerVRFHashIDs = map[protocol.HashID]bool{
+	protocol.AgreementSelector: true,
+	protocol.Seed:              true,
+}
+
+// RemoteParticipationKey describes a participation key held by a remote signer.
+type RemoteParticipationKey struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Parent      basics.Address                  `codec:"parent"`
+	SelectionID crypto.VRFVerifier              `codec:"selkey"`
+	VoteID      crypto.OneTimeSignatureVerifier `codec:"votekey"`
+	FirstValid  basics.Round                    `codec:"first"`
+	LastValid   basics.Round                    `codec:"last"`
+	KeyDilution uint64                          `codec:"kd"`
+}
+
+// remoteVRFRequest asks the remote signer for a VRF proof over a message.
+type remoteVRFRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	VoteID  crypto.OneTimeSignatureVerifier `codec:"votekey"`
+	HashID  protocol.HashID                 `codec:"hid"`
+	Message []byte                          `codec:"msg"`
+}
+
+// remoteVRFResponse is the response to a remoteVRFRequest.
+type remoteVRFResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Proof crypto.VrfProof `codec:"proof"`
+}
+
+// remoteOTSRequest asks the remote signer for a one-time signature over a message.
+type remoteOTSRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	VoteID  crypto.OneTimeSignatureVerifier   `codec:"votekey"`
+	ID      crypto.OneTimeSignatureIdentifier `codec:"id"`
+	HashID  protocol.HashID                   `codec:"hid"`
+	Message []byte                            `codec:"msg"`
+}
+
+// remoteOTSResponse is the response to a remoteOTSRequest.
+type remoteOTSResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sig crypto.OneTimeSignature `codec:"sig"`
+}
+
+// rawHashable is a message that was already split into its hash id and encoded data.
+type rawHashable struct {
+	hashID protocol.HashID
+	data   []byte
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (r rawHashable) ToBeHashed() (protocol.HashID, []byte) {
+	return r.hashID, r.data
+}
+
+// remoteSignerClient sends requests to a remote participation signer.
+type remoteSignerClient struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+// RemoteSigner is a ParticipationSigner that delegates the participation operations of a single participation
+// key to a remote signing host.
+type RemoteSigner struct {
+	client      *remoteSignerClient
+	voteID      crypto.OneTimeSignatureVerifier
+	keyDilution uint64
+}
+
+// FetchRemoteParticipations retrieves the participation keys held by the remote signer at the given endpoint, and
+// returns the corresponding Participation accounts. These hold only the public keys, and delegate the participation
+// operations to the remote signer.
+func FetchRemoteParticipations(endpoint, token string) ([]Participation, error) {
+	client := &remoteSignerClient{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+		client:   &http.Client{Timeout: remoteSignerRequestTimeout},
+	}
+	var keys []RemoteParticipationKey
+	err := client.do(http.MethodGet, RemoteSignerKeysPath, nil, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("FetchRemoteParticipations: %w", err)
+	}
+
+	parts := make([]Participation, 0, len(keys))
+	for _, key := range keys {
+		voting := &crypto.OneTimeSignatureSecrets{}
+		voting.OneTimeSignatureVerifier = key.VoteID
+		parts = append(parts, Participation{
+			Parent:      key.Parent,
+			VRF:         &crypto.VRFSecrets{PK: key.SelectionID},
+			Voting:      voting,
+			FirstValid:  key.FirstValid,
+			LastValid:   key.LastValid,
+			KeyDilution: key.KeyDilution,
+			RemoteSigner: &RemoteSigner{
+				client:      client,
+				voteID:      key.VoteID,
+				keyDilution: key.KeyDilution,
+			},
+		})
+	}
+	return parts, nil
+}
+
+// ProveVRF implements the ParticipationSigner interface.
+func (s *RemoteSigner) ProveVRF(message crypto.Hashable) (crypto.VrfProof, error) {
+	hashID, data := message.ToBeHashed()
+	req := remoteVRFRequest{
+		VoteID:  s.voteID,
+		HashID:  hashID,
+		Message: data,
+	}
+	var resp remoteVRFResponse
+	err := s.client.do(http.MethodPost, RemoteSignerVRFPath, &req, &resp)
+	if err != nil {
+		return crypto.VrfProof{}, fmt.Errorf("RemoteSigner.ProveVRF: %w", err)
+	}
+	return resp.Proof, nil
+}
+
+// SignOneTime implements the ParticipationSigner interface.
+func (s *RemoteSigner) SignOneTime(id crypto.OneTimeSignatureIdentifier, message crypto.Hashable) (crypto.OneTimeSignature, error) {
+	hashID, data := message.ToBeHashed()
+	req := remoteOTSRequest{
+		VoteID:  s.voteID,
+		ID:      id,
+		HashID:  hashID,
+		Message: data,
+	}
+	var resp remoteOTSResponse
+	err := s.client.do(http.MethodPost, RemoteSignerOTSPath, &req, &resp)
+	if err != nil {
+		return crypto.OneTimeSignature{}, fmt.Errorf("RemoteSigner.SignOneTime: %w", err)
+	}
+	return resp.Sig, nil
+}
+
+// KeyDilution implements the ParticipationSigner interface.
+func (s *RemoteSigner) KeyDilution(params config.ConsensusParams) uint64 {
+	if s.keyDilution != 0 {
+		return s.keyDilution
+	}
+	return params.DefaultKeyDilution
+}
+
+// do sends a request to the remote signer, and decodes its response.
+func (c *remoteSignerClient) do(method, path string, request interface{}, response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		body = bytes.NewReader(protocol.EncodeReflect(request))
+	}
+	req, err := http.NewRequest(method, c.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/msgpack")
+	if c.token != "" {
+		req.Header.Set(RemoteSignerTokenHeader, c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer responded with %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return protocol.DecodeReflect(respBody, response)
+}
+
+// RemoteSignerServer serves the participation operations of a set of participation keys to remote nodes. Votes are
+// signed only after they were checked against, and recorded into, the persistent anti-equivocation state, and the
+// ephemeral voting keys are deleted as the signed votes advance.
+type RemoteSignerServer struct {
+	mu    deadlock.Mutex
+	keys  map[crypto.OneTimeSignatureVerifier]PersistedParticipation
+	guard *voteGuard
+	token string
+	log   logging.Logger
+
+	// deletedBefore tracks the round before which the ephemeral keys of each participation key were deleted.
+	deletedBefore map[crypto.OneTimeSignatureVerifier]basics.Round
+}
+
+// MakeRemoteSignerServer creates a RemoteSignerServer for the given participation keys. The anti-equivocation state
+// is kept in stateDB. Requests are required to carry the given token. The keys must have their own key dilution, since
+// the server has no way of knowing the protocol of the rounds it signs for.
+func MakeRemoteSignerServer(keys []PersistedParticipation, stateDB db.Accessor, token string, log logging.Logger) (*RemoteSignerServer, error) {
+	if token == "" {
+		return nil, fmt.Errorf("MakeRemoteSignerServer: a token is required")
+	}
+	for _, key := range keys {
+		if key.KeyDilution == 0 {
+			return nil, fmt.Errorf("MakeRemoteSignerServer: participation key %v of %v has no key dilution", key.Voting.OneTimeSignatureVerifier, key.Parent)
+		}
+	}
+	guard, err := makeVoteGuard(stateDB)
+	if err != nil {
+		return nil, err
+	}
+	s := &RemoteSignerServer{
+		keys:          make(map[crypto.OneTimeSignatureVerifier]PersistedParticipation, len(keys)),
+		guard:         guard,
+		token:         token,
+		log:           log,
+		deletedBefore: make(map[crypto.OneTimeSignatureVerifier]basics.Round),
+	}
+	for _, key := range keys {
+		s.keys[key.Voting.OneTimeSignatureVerifier] = key
+	}
+	return s, nil
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *RemoteSignerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(RemoteSignerTokenHeader)), []byte(s.token)) != 1 {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	var response interface{}
+	var err error
+	status := http.StatusBadRequest
+	switch {
+	case r.URL.Path == RemoteSignerKeysPath && r.Method == http.MethodGet:
+		response = s.listKeys()
+	case r.URL.Path == RemoteSignerVRFPath && r.Method == http.MethodPost:
+		var req remoteVRFRequest
+		if err = s.decodeRequest(r, &req); err == nil {
+			response, status, err = s.proveVRF(req)
+		}
+	case r.URL.Path == RemoteSignerOTSPath && r.Method == http.MethodPost:
+		var req remoteOTSRequest
+		if err = s.decodeRequest(r, &req); err == nil {
+			response, status, err = s.signOneTime(req)
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		s.log.Infof("RemoteSignerServer: %s %s from %s failed: %v", r.Method, r.URL.Path, r.RemoteAddr, err)
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/msgpack")
+	w.WriteHeader(http.StatusOK)
+	w.Write(protocol.EncodeReflect(response))
+}
+
+// decodeRequest decodes the body of the given request.
+func (s *RemoteSignerServer) decodeRequest(r *http.Request, request interface{}) error {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, remoteSignerMaxRequestSize))
+	if err != nil {
+		return err
+	}
+	return protocol.DecodeReflect(body, request)
+}
+
+// listKeys returns the public description of the served participation keys.
+func (s *RemoteSignerServer) listKeys() []RemoteParticipationKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]RemoteParticipationKey, 0, len(s.keys))
+	for _, part := range s.keys {
+		keys = append(keys, RemoteParticipationKey{
+			Parent:      part.Parent,
+			SelectionID: part.VRF.PK,
+			VoteID:      part.Voting.OneTimeSignatureVerifier,
+			FirstValid:  part.FirstValid,
+			LastValid:   part.LastValid,
+			KeyDilution: part.KeyDilution,
+		})
+	}
+	return keys
+}
+
+// lookup returns the served participation key with the given voting key.
+func (s *RemoteSignerServer) lookup(voteID crypto.OneTimeSignatureVerifier) (PersistedParticipation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	part, ok := s.keys[voteID]
+	return part, ok
+}
+
+// proveVRF produces a VRF proof for a remoteVRFRequest.
+func (s *RemoteSignerServer) proveVRF(req remoteVRFRequest) (interface{}, int, error) {
+	part, ok := s.lookup(req.VoteID)
+	if !ok {
+		return nil, http.StatusNotFound, fmt.Errorf("unknown participation key %v", req.VoteID)
+	}
+	if !remoteSignerVRFHashIDs[req.HashID] {
+		return nil, http.StatusForbidden, fmt.Errorf("refusing to produce a VRF proof for message of type %s", req.HashID)
+	}
+	proof, err := MakeLocalSigner(part.VotingSigner(), part.VRF).ProveVRF(rawHashable{hashID: req.HashID, data: req.Message})
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return remoteVRFResponse{Proof: proof}, http.StatusOK, nil
+}
+
+// signOneTime produces a one-time signature for a remoteOTSRequest. Only agreement votes are signed, and only after
+// they were recorded by the vote guard.
+func (s *RemoteSignerServer) signOneTime(req remoteOTSRequest) (interface{}, int, error) {
+	part, ok := s.lookup(req.VoteID)
+	if !ok {
+		return nil, http.StatusNotFound, fmt.Errorf("unknown participation key %v", req.VoteID)
+	}
+	if req.HashID != protocol.Vote {
+		return nil, http.StatusForbidden, fmt.Errorf("refusing to sign message of type %s", req.HashID)
+	}
+	vote, err := decodeVote(req.Message)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if vote.Sender != part.Parent {
+		return nil, http.StatusForbidden, fmt.Errorf("vote sender %v doesn't match participation key parent %v", vote.Sender, part.Parent)
+	}
+	if vote.Round < part.FirstValid || vote.Round > part.LastValid {
+		return nil, http.StatusForbidden, fmt.Errorf("vote round %d is outside of the participation key validity range [%d, %d]", vote.Round, part.FirstValid, part.LastValid)
+	}
+	if req.ID != basics.OneTimeIDForRound(vote.Round, part.KeyDilution) {
+		return nil, http.StatusForbidden, fmt.Errorf("one-time signature identifier %v doesn't match vote round %d", req.ID, vote.Round)
+	}
+
+	err = s.guard.record(vote, req.Message)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == ErrConflictingVote {
+			status = http.StatusConflict
+		}
+		return nil, status, err
+	}
+
+	sig := part.VotingSigner().Sign(req.ID, rawHashable{hashID: req.HashID, data: req.Message})
+	if (sig == crypto.OneTimeSignature{}) {
+		return nil, http.StatusInternalServerError, fmt.Errorf("no ephemeral key is available for round %d", vote.Round)
+	}
+	s.deleteOldKeys(part, vote.Round)
+	return remoteOTSResponse{Sig: sig}, http.StatusOK, nil
+}
+
+// deleteOldKeys deletes the ephemeral keys of the given participation key for rounds strictly older than the given
+// round, as votes for these rounds would never be signed again.
+func (s *RemoteSignerServer) deleteOldKeys(part PersistedParticipation, round basics.Round) {
+	s.mu.Lock()
+	if s.deletedBefore[part.Voting.OneTimeSignatureVerifier] >= round {
+		s.mu.Unlock()
+		return
+	}
+	s.deletedBefore[part.Voting.OneTimeSignatureVerifier] = round
+	s.mu.Unlock()
+
+	// the consensus parameters are only used for the default key dilution, which doesn't apply here.
+	errCh := part.DeleteOldKeys(round, config.ConsensusParams{})
+	go func() {
+		if err := <-errCh; err != nil {
+			s.log.Warnf("RemoteSignerServer: failed to delete old keys of %v: %v", part.Parent, err)
+		}
+	}()
+}
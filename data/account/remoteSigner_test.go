@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const testRemoteSignerToken = "remote-signer-test-token"
+
+// testRemoteSignerKeyDilution differs from the default key dilution, which the remote signer must not rely on.
+const testRemoteSignerKeyDilution = 100
+
+func makeTestRemoteSignerKey(t *testing.T) PersistedParticipation {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	address := basics.Address(crypto.GenerateSignatureSecrets(seed).SignatureVerifier)
+
+	partDB, err := db.MakeAccessor(t.Name()+"_part", false, true)
+	require.NoError(t, err)
+	part, err := FillDBWithParticipationKeys(partDB, address, 0, 1000, testRemoteSignerKeyDilution)
+	require.NoError(t, err)
+	return part
+}
+
+func makeTestRemoteSigner(t *testing.T, part PersistedParticipation, statePath string) (*httptest.Server, Participation) {
+	stateDB, err := db.MakeAccessor(statePath, false, false)
+	require.NoError(t, err)
+	server, err := MakeRemoteSignerServer([]PersistedParticipation{part}, stateDB, testRemoteSignerToken, logging.TestingLog(t))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		stateDB.Close()
+	})
+
+	remote, err := FetchRemoteParticipations(httpServer.URL, testRemoteSignerToken)
+	require.NoError(t, err)
+	require.Len(t, remote, 1)
+	return httpServer, remote[0]
+}
+
+func testRemoteVote(sender basics.Address, round basics.Round, step uint64, digest crypto.Digest) rawHashable {
+	vote := signedVoteMessage{Sender: sender, Round: round, Step: step}
+	vote.Proposal.BlockDigest = digest
+	return rawHashable{hashID: protocol.Vote, data: protocol.EncodeReflect(&vote)}
+}
+
+func TestRemoteSignerRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestRemoteSignerKey(t)
+	defer part.Close()
+	dir, err := ioutil.TempDir("", "remotesigner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	_, remote := makeTestRemoteSigner(t, part, filepath.Join(dir, "state.sqlite"))
+
+	require.Equal(t, part.Parent, remote.Parent)
+	require.Equal(t, part.VRF.PK, remote.VRF.PK)
+	require.Equal(t, part.Voting.OneTimeSignatureVerifier, remote.Voting.OneTimeSignatureVerifier)
+	require.Equal(t, part.FirstValid, remote.FirstValid)
+	require.Equal(t, part.LastValid, remote.LastValid)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	signer := remote.Signer()
+	require.Equal(t, uint64(testRemoteSignerKeyDilution), signer.KeyDilution(proto))
+
+	seed := rawHashable{hashID: protocol.Seed, data: []byte("previous seed")}
+	proof, err := signer.ProveVRF(seed)
+	require.NoError(t, err)
+	ok, _ := remote.VRF.PK.Verify(proof, seed)
+	require.True(t, ok)
+
+	// the remote signer refuses producing proofs over arbitrary messages.
+	_, err = signer.ProveVRF(rawHashable{hashID: protocol.Message, data: []byte("arbitrary")})
+	require.Error(t, err)
+
+	round := basics.Round(10)
+	id := basics.OneTimeIDForRound(round, signer.KeyDilution(proto))
+	vote := testRemoteVote(remote.Parent, round, 1, crypto.Hash([]byte("block")))
+	sig, err := signer.SignOneTime(id, vote)
+	require.NoError(t, err)
+	require.True(t, remote.Voting.OneTimeSignatureVerifier.Verify(id, vote, sig))
+
+	// signing the very same vote again is allowed.
+	sig2, err := signer.SignOneTime(id, vote)
+	require.NoError(t, err)
+	require.True(t, remote.Voting.OneTimeSignatureVerifier.Verify(id, vote, sig2))
+
+	// the remote signer only signs votes.
+	_, err = signer.SignOneTime(id, rawHashable{hashID: protocol.Message, data: []byte("arbitrary")})
+	require.Error(t, err)
+
+	// votes with mismatching identifiers, senders or rounds are refused.
+	_, err = signer.SignOneTime(basics.OneTimeIDForRound(round+1, signer.KeyDilution(proto)), testRemoteVote(remote.Parent, round, 2, crypto.Digest{}))
+	require.Error(t, err)
+	_, err = signer.SignOneTime(id, testRemoteVote(basics.Address{}, round, 2, crypto.Digest{}))
+	require.Error(t, err)
+	_, err = signer.SignOneTime(basics.OneTimeIDForRound(2000, signer.KeyDilution(proto)), testRemoteVote(remote.Parent, 2000, 2, crypto.Digest{}))
+	require.Error(t, err)
+}
+
+func TestRemoteSignerConflictingVotes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestRemoteSignerKey(t)
+	defer part.Close()
+	dir, err := ioutil.TempDir("", "remotesigner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	statePath := filepath.Join(dir, "state.sqlite")
+	httpServer, remote := makeTestRemoteSigner(t, part, statePath)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	signer := remote.Signer()
+	round := basics.Round(20)
+	id := basics.OneTimeIDForRound(round, signer.KeyDilution(proto))
+
+	_, err = signer.SignOneTime(id, testRemoteVote(remote.Parent, round, 2, crypto.Hash([]byte("first"))))
+	require.NoError(t, err)
+
+	_, err = signer.SignOneTime(id, testRemoteVote(remote.Parent, round, 2, crypto.Hash([]byte("second"))))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "409")
+
+	// a different step isn't a conflict.
+	_, err = signer.SignOneTime(id, testRemoteVote(remote.Parent, round, 3, crypto.Hash([]byte("second"))))
+	require.NoError(t, err)
+
+	// the signed votes are remembered across restarts.
+	httpServer.Close()
+	_, remote = makeTestRemoteSigner(t, part, statePath)
+	signer = remote.Signer()
+	_, err = signer.SignOneTime(id, testRemoteVote(remote.Parent, round, 2, crypto.Hash([]byte("second"))))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "409")
+	_, err = signer.SignOneTime(id, testRemoteVote(remote.Parent, round, 2, crypto.Hash([]byte("first"))))
+	require.NoError(t, err)
+}
+
+func TestRemoteSignerToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestRemoteSignerKey(t)
+	defer part.Close()
+	dir, err := ioutil.TempDir("", "remotesigner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	httpServer, _ := makeTestRemoteSigner(t, part, filepath.Join(dir, "state.sqlite"))
+
+	_, err = FetchRemoteParticipations(httpServer.URL, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "401")
+
+	_, err = FetchRemoteParticipations(httpServer.URL, "wrong-token")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "401")
+
+	// the token is mandatory, as is the key dilution of the served keys.
+	stateDB, err := db.MakeAccessor(filepath.Join(dir, "other.sqlite"), false, false)
+	require.NoError(t, err)
+	defer stateDB.Close()
+	_, err = MakeRemoteSignerServer([]PersistedParticipation{part}, stateDB, "", logging.TestingLog(t))
+	require.Error(t, err)
+	undiluted := part
+	undiluted.KeyDilution = 0
+	_, err = MakeRemoteSignerServer([]PersistedParticipation{undiluted}, stateDB, testRemoteSignerToken, logging.TestingLog(t))
+	require.Error(t, err)
+}
+
+func TestVoteGuardPruning(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store, err := db.MakeAccessor(t.Name(), false, true)
+	require.NoError(t, err)
+	defer store.Close()
+	guard, err := makeVoteGuard(store)
+	require.NoError(t, err)
+
+	var sender basics.Address
+	sender[0] = 1
+	encode := func(v signedVoteMessage) []byte { return protocol.EncodeReflect(&v) }
+
+	old := signedVoteMessage{Sender: sender, Round: 1}
+	require.NoError(t, guard.record(old, encode(old)))
+	conflicting := old
+	conflicting.Proposal.BlockDigest[0] = 1
+	require.Equal(t, ErrConflictingVote, guard.record(conflicting, encode(conflicting)))
+
+	recent := signedVoteMessage{Sender: sender, Round: 2 + voteGuardRetainedRounds}
+	require.NoError(t, guard.record(recent, encode(recent)))
+
+	// the votes of the old round were pruned.
+	require.NoError(t, guard.record(conflicting, encode(conflicting)))
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrConflictingVote is returned when a participation signer is asked to sign a vote that conflicts with a vote
+// it has already signed for the same round, period and step.
+var ErrConflictingVote = errors.New("refusing to sign a vote conflicting with a previously signed vote")
+
+// voteGuardRetainedRounds is the number of rounds, behind the latest signed vote, for which the signed votes are retained.
+// Votes for older rounds are of no use to the agreement, and the ephemeral keys that would be needed for signing them
+// get deleted anyway.
+const voteGuardRetainedRounds = 1000
+
+// signedVoteMessage mirrors the encoding of the votes signed by the agreement ( agreement.rawVote ), allowing
+// the signer to figure which round, period and step a vote belongs to.
+type signedVoteMessage struct {
+	_struct  struct{}             `codec:",omitempty,omitemptyarray"`
+	Sender   basics.Address       `codec:"snd"`
+	Round    basics.Round         `codec:"rnd"`
+	Period   uint64               `codec:"per"`
+	Step     uint64               `codec:"step"`
+	Proposal signedVoteProposalID `codec:"prop"`
+}
+
+// signedVoteProposalID mirrors the encoding of the proposal value a vote is cast for ( agreement.proposalValue ).
+type signedVoteProposalID struct {
+	_struct          struct{}       `codec:",omitempty,omitemptyarray"`
+	OriginalPeriod   uint64         `codec:"oper"`
+	OriginalProposer basics.Address `codec:"oprop"`
+	BlockDigest      crypto.Digest  `codec:"dig"`
+	EncodingDigest   crypto.Digest  `codec:"encdig"`
+}
+
+// voteGuard keeps track of the votes signed by a participation signer, so that it would never sign two different
+// votes for the same round, period and step. The signed votes are persisted before the signature is released,
+// so that the guarantee holds across restarts.
+type voteGuard struct {
+	store db.Accessor
+}
+
+// makeVoteGuard creates a voteGuard persisting its state into the given database.
+func makeVoteGuard(store db.Accessor) (*voteGuard, error) {
+	err := store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS signedvotes (
+			sender BLOB NOT NULL,
+			round INTEGER NOT NULL,
+			period INTEGER NOT NULL,
+			step INTEGER NOT NULL,
+			digest BLOB NOT NULL, --*  hash of the encoded vote
+			PRIMARY KEY (sender, round, period, step)
+		)`)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("makeVoteGuard: unable to create the signed votes table: %w", err)
+	}
+	return &voteGuard{store: store}, nil
+}
+
+// decodeVote decodes the given encoded vote.
+func decodeVote(encodedVote []byte) (vote signedVoteMessage, err error) {
+	err = protocol.DecodeReflect(encodedVote, &vote)
+	if err != nil {
+		err = fmt.Errorf("unable to decode vote: %w", err)
+	}
+	return
+}
+
+// record checks that the given encoded vote doesn't conflict with any previously signed vote, and records it.
+// Recording the very same vote again is allowed, as the agreement might need to re-sign it after a restart.
+func (g *voteGuard) record(vote signedVoteMessage, encodedVote []byte) error {
+	digest := crypto.Hash(encodedVote)
+	return g.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var existing []byte
+		err := tx.QueryRow("SELECT digest FROM signedvotes WHERE sender=? AND round=? AND period=? AND step=?",
+			vote.Sender[:], vote.Round, vote.Period, vote.Step).Scan(&existing)
+		switch err {
+		case nil:
+			if digest != digestFromBytes(existing) {
+				return ErrConflictingVote
+			}
+			return nil
+		case sql.ErrNoRows:
+		default:
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO signedvotes (sender, round, period, step, digest) VALUES (?, ?, ?, ?, ?)",
+			vote.Sender[:], vote.Round, vote.Period, vote.Step, digest[:])
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("DELETE FROM signedvotes WHERE sender=? AND round<?",
+			vote.Sender[:], vote.Round.SubSaturate(voteGuardRetainedRounds))
+		return err
+	})
+}
+
+// digestFromBytes converts the given byte slice into a digest.
+func digestFromBytes(b []byte) (d crypto.Digest) {
+	copy(d[:], b)
+	return
+}
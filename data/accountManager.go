@@ -49,6 +49,10 @@ type AccountManager struct {
 
 	partKeys map[ParticipationKeyIdentity]account.PersistedParticipation
 
+	// remoteKeys are the participation keys held by a remote participation signer. These can only be used
+	// for votes and proposals, and their ephemeral keys are deleted by the remote signer.
+	remoteKeys []account.Participation
+
 	// Map to keep track of accounts for which we've sent
 	// AccountRegistered telemetry events
 	registeredAccounts map[string]bool
@@ -79,6 +83,19 @@ func (manager *AccountManager) Keys(rnd basics.Round) (out []account.Participati
 	return out
 }
 
+// RemoteKeys returns a list of the Participation accounts held by a remote participation signer.
+func (manager *AccountManager) RemoteKeys(rnd basics.Round) (out []account.Participation) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	for _, part := range manager.remoteKeys {
+		if part.OverlapsInterval(rnd, rnd) {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
 // HasLiveKeys returns true if we have any Participation
 // keys valid for the specified round range (inclusive)
 func (manager *AccountManager) HasLiveKeys(from, to basics.Round) bool {
@@ -90,9 +107,33 @@ func (manager *AccountManager) HasLiveKeys(from, to basics.Round) bool {
 			return true
 		}
 	}
+	for _, part := range manager.remoteKeys {
+		if part.OverlapsInterval(from, to) {
+			return true
+		}
+	}
 	return false
 }
 
+// SetRemoteParticipations replaces the set of Participation accounts held by a remote participation signer.
+// The return value is the number of accounts that were not known before.
+func (manager *AccountManager) SetRemoteParticipations(parts []account.Participation) (added int) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	known := make(map[crypto.OneTimeSignatureVerifier]bool, len(manager.remoteKeys))
+	for _, part := range manager.remoteKeys {
+		known[part.Voting.OneTimeSignatureVerifier] = true
+	}
+	for _, part := range parts {
+		if !known[part.Voting.OneTimeSignatureVerifier] {
+			added++
+		}
+	}
+	manager.remoteKeys = parts
+	return added
+}
+
 // AddParticipation adds a new account.Participation to be managed.
 // The return value indicates if the key has been added (true) or
 // if this is a duplicate key (false).
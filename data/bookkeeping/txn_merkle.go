@@ -86,13 +86,17 @@ func (tme *txnMerkleElem) ToBeHashed() (protocol.HashID, []byte) {
 
 // Hash implements an optimized version of crypto.HashObj(tme).
 func (tme *txnMerkleElem) Hash() crypto.Digest {
-	txid := tme.txn.ID()
-	stib := tme.stib.Hash()
+	return TxnMerkleLeafHash(tme.txn.ID(), tme.stib.Hash())
+}
 
+// TxnMerkleLeafHash returns the hash of the leaf of the transactions Merkle tree
+// for the transaction with the given ID, whose SignedTxnInBlock hashes to stibHash.
+// It allows verifying the proofs of membership generated out of TxnMerkleTree.
+func TxnMerkleLeafHash(txid transactions.Txid, stibHash crypto.Digest) crypto.Digest {
 	var buf [len(protocol.TxnMerkleLeaf) + 2*crypto.DigestSize]byte
 	s := buf[:0]
 	s = append(s, protocol.TxnMerkleLeaf...)
 	s = append(s, txid[:]...)
-	s = append(s, stib[:]...)
+	s = append(s, stibHash[:]...)
 	return crypto.Hash(s)
 }
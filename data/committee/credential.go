@@ -75,7 +75,20 @@ type (
 func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Membership) (res Credential, err error) {
 	selectionKey := m.Record.SelectionID
 	ok, vrfOut := selectionKey.Verify(cred.Proof, m.Selector)
+	if !ok {
+		err = fmt.Errorf("UnauthenticatedCredential.Verify: could not verify VRF Proof with %v (parameters = %+v, proof = %#v)", selectionKey, m, cred.Proof)
+		return
+	}
+
+	return cred.VerifyWithOutput(proto, m, vrfOut)
+}
 
+// VerifyWithOutput is like Verify, but takes the VRF output of the
+// credential's proof instead of computing it.
+//
+// The caller must have already checked the proof against the membership's
+// selection key and selector (e.g., with a crypto.VrfBatchVerifier).
+func (cred UnauthenticatedCredential) VerifyWithOutput(proto config.ConsensusParams, m Membership, vrfOut crypto.VrfOutput) (res Credential, err error) {
 	hashable := hashableCredential{
 		RawOut: vrfOut,
 		Member: m.Record.Addr,
@@ -89,11 +102,6 @@ func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Mem
 		h = crypto.Hash(append(vrfOut[:], m.Record.Addr[:]...))
 	}
 
-	if !ok {
-		err = fmt.Errorf("UnauthenticatedCredential.Verify: could not verify VRF Proof with %v (parameters = %+v, proof = %#v)", selectionKey, m, cred.Proof)
-		return
-	}
-
 	var weight uint64
 	userMoney := m.Record.VotingStake()
 	expectedSelection := float64(m.Selector.CommitteeSize(proto))
@@ -0,0 +1,279 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/pooldata"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// evictedTxnError is the status reported for transactions that were evicted from a full pool by better paying ones.
+const evictedTxnError = "transaction was evicted from the transaction pool by higher fee transactions"
+
+var transactionPoolEvictedGroupsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_transaction_pool_evicted_groups_total", Description: "Number of pending transaction groups evicted from a full transaction pool by higher fee groups"})
+
+// groupFee is the fee summary of a transaction group, used for ordering the groups by their effective fee per byte.
+// The effective fee per byte of a group is the total fee paid by all of its transactions, divided by the total
+// encoded length of these transactions; a group is prioritized as a whole, regardless of how the fee is split
+// between its transactions.
+type groupFee struct {
+	// counter is the GroupCounter of the transaction group.
+	counter uint64
+	// txCount is the number of transactions in the group.
+	txCount int
+	// fee is the total fee paid by the group, in microalgos.
+	fee uint64
+	// length is the total encoded length of the group, in bytes.
+	length uint64
+}
+
+// makeGroupFee summarizes the fee of the given transaction group.
+func makeGroupFee(txgroup pooldata.SignedTxGroup) groupFee {
+	gf := groupFee{
+		counter: txgroup.GroupCounter,
+		txCount: len(txgroup.Transactions),
+	}
+
+	// A compact cert transaction issued by the special compact-cert-sender address pays no fee, yet it
+	// should never be pushed aside by any other transaction.
+	if len(txgroup.Transactions) == 1 {
+		t := txgroup.Transactions[0].Txn
+		if t.Type == protocol.CompactCertTx && t.Sender == transactions.CompactCertSender && t.Fee.IsZero() {
+			gf.fee = math.MaxUint64
+			gf.length = 1
+			return gf
+		}
+	}
+
+	for _, t := range txgroup.Transactions {
+		gf.fee = basics.AddSaturate(gf.fee, t.Txn.Fee.Raw)
+	}
+	if txgroup.EncodedLength > 0 {
+		gf.length = uint64(txgroup.EncodedLength)
+	} else {
+		for _, t := range txgroup.Transactions {
+			gf.length += uint64(t.GetEncodedLength())
+		}
+	}
+	if gf.length == 0 {
+		gf.length = 1
+	}
+	return gf
+}
+
+// less returns true if gf pays a lower effective fee per byte than other. The comparison is done on the exact
+// ratios, so that groups paying almost the same fee per byte are still told apart.
+func (gf groupFee) less(other groupFee) bool {
+	lhsHi, lhsLo := bits.Mul64(gf.fee, other.length)
+	rhsHi, rhsLo := bits.Mul64(other.fee, gf.length)
+	if lhsHi != rhsHi {
+		return lhsHi < rhsHi
+	}
+	return lhsLo < rhsLo
+}
+
+// feePerByte returns the effective fee per byte of the group, rounded down.
+func (gf groupFee) feePerByte() uint64 {
+	return gf.fee / gf.length
+}
+
+// evictsBefore returns true if gf should be evicted from a full pool before other. The lower paying groups are
+// evicted first, and among equally paying groups, the newer ones are evicted first.
+func (gf groupFee) evictsBefore(other groupFee) bool {
+	if gf.less(other) {
+		return true
+	}
+	if other.less(gf) {
+		return false
+	}
+	return gf.counter > other.counter
+}
+
+// sortByFeePriority returns a copy of the given transaction groups, sorted by their effective fee per byte, in
+// decreasing order. Equally paying groups retain their original order.
+func sortByFeePriority(txgroups []pooldata.SignedTxGroup) []pooldata.SignedTxGroup {
+	fees := make([]groupFee, len(txgroups))
+	order := make([]int, len(txgroups))
+	for i, txgroup := range txgroups {
+		fees[i] = makeGroupFee(txgroup)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return fees[order[j]].less(fees[order[i]])
+	})
+	sorted := make([]pooldata.SignedTxGroup, len(txgroups))
+	for i, idx := range order {
+		sorted[i] = txgroups[idx]
+	}
+	return sorted
+}
+
+// FeeMarket summarizes the fees offered by the transaction groups that are pending in the transaction pool.
+// All the fees are effective fees per byte, in microalgos.
+type FeeMarket struct {
+	// PendingCount is the number of transactions pending in the pool.
+	PendingCount int
+	// Capacity is the number of transactions the pool could hold before it starts evicting the lowest paying groups.
+	Capacity int
+	// MedianFeePerByte is the median effective fee per byte among the pending transaction groups.
+	MedianFeePerByte uint64
+	// NextBlockFeePerByte is the lowest effective fee per byte among the pending transaction groups that would fit
+	// into the next block. It is zero if all the pending transaction groups would fit into the next block.
+	NextBlockFeePerByte uint64
+	// EvictionFeePerByte is the effective fee per byte a new transaction group needs to pay in order to evict the
+	// lowest paying pending group. It is zero if the pool isn't full.
+	EvictionFeePerByte uint64
+}
+
+// rebuildFeeOrder recreates the eviction order out of the pending transaction groups. The caller is assumed to
+// be holding pool.mu.
+func (pool *TransactionPool) rebuildFeeOrder() {
+	pool.pendingFeeOrder = make([]groupFee, len(pool.pendingTxGroups))
+	for i, txgroup := range pool.pendingTxGroups {
+		pool.pendingFeeOrder[i] = makeGroupFee(txgroup)
+	}
+	sort.Slice(pool.pendingFeeOrder, func(i, j int) bool {
+		return pool.pendingFeeOrder[i].evictsBefore(pool.pendingFeeOrder[j])
+	})
+}
+
+// insertFeeOrder adds the given transaction group to the eviction order. The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) insertFeeOrder(txgroup pooldata.SignedTxGroup) {
+	gf := makeGroupFee(txgroup)
+	i := sort.Search(len(pool.pendingFeeOrder), func(i int) bool {
+		return gf.evictsBefore(pool.pendingFeeOrder[i])
+	})
+	pool.pendingFeeOrder = append(pool.pendingFeeOrder, groupFee{})
+	copy(pool.pendingFeeOrder[i+1:], pool.pendingFeeOrder[i:])
+	pool.pendingFeeOrder[i] = gf
+}
+
+// checkPendingQueueSize tests to see if we can grow the pending group transaction list by adding the given
+// transaction groups. The limits comes from the total number of transactions and not from the total number
+// of transaction groups. When the pool is full, room could be made by evicting the lowest paying pending groups,
+// as long as each of these pays a lower effective fee per byte than all of the given groups. The returned value
+// is the number of pending groups that need to be evicted, in the order of pool.pendingFeeOrder.
+// The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) checkPendingQueueSize(txgroups []pooldata.SignedTxGroup) (int, error) {
+	txCount := 0
+	for _, txgroup := range txgroups {
+		txCount += len(txgroup.Transactions)
+	}
+	excess := pool.pendingTxIDsCount() + txCount - pool.txPoolMaxSize
+	if excess <= 0 {
+		return 0, nil
+	}
+
+	// find the lowest paying group among the ones we'd like to add; the evicted groups need to pay less than it.
+	var threshold groupFee
+	for i, txgroup := range txgroups {
+		gf := makeGroupFee(txgroup)
+		if i == 0 || gf.less(threshold) {
+			threshold = gf
+		}
+	}
+
+	evict := 0
+	for excess > 0 {
+		if evict >= len(pool.pendingFeeOrder) || !pool.pendingFeeOrder[evict].less(threshold) {
+			return 0, fmt.Errorf("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
+		}
+		excess -= pool.pendingFeeOrder[evict].txCount
+		evict++
+	}
+	return evict, nil
+}
+
+// evictLowestPaying removes the given number of the lowest paying transaction groups from the pool.
+// The caller is assumed to be holding pool.mu.
+//
+// The evicted groups were already fed into the pending block evaluator. Rather than re-evaluating the whole pool
+// on every eviction, the evaluator is left as is until it is recomputed out of the remaining groups on the next
+// block; until then, it might reject new groups which conflict with the evicted ones. Since blocks are only
+// assembled while recomputing the evaluator, the evicted transactions won't make it into an assembled block.
+func (pool *TransactionPool) evictLowestPaying(count int) {
+	if count == 0 {
+		return
+	}
+	evicted := make(map[uint64]bool, count)
+	for _, gf := range pool.pendingFeeOrder[:count] {
+		evicted[gf.counter] = true
+	}
+	pool.pendingFeeOrder = pool.pendingFeeOrder[count:]
+
+	pool.pendingMu.Lock()
+	defer pool.pendingMu.Unlock()
+	// PendingTxGroups callers might be holding the current array, so we need to allocate a new one rather than
+	// modifying it in place.
+	remaining := make([]pooldata.SignedTxGroup, 0, len(pool.pendingTxGroups)-count)
+	for _, txgroup := range pool.pendingTxGroups {
+		if !evicted[txgroup.GroupCounter] {
+			remaining = append(remaining, txgroup)
+			continue
+		}
+		for _, tx := range txgroup.Transactions {
+			delete(pool.pendingTxids, tx.ID())
+			pool.statusCache.put(tx, evictedTxnError)
+		}
+	}
+	pool.pendingTxGroups = remaining
+	pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
+	transactionPoolEvictedGroupsTotal.AddUint64(uint64(count), nil)
+}
+
+// FeeMarket returns a summary of the fees offered by the transaction groups pending in the pool.
+func (pool *TransactionPool) FeeMarket() FeeMarket {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	market := FeeMarket{
+		PendingCount: pool.pendingTxIDsCount(),
+		Capacity:     pool.txPoolMaxSize,
+	}
+	if len(pool.pendingFeeOrder) == 0 {
+		return market
+	}
+
+	// pendingFeeOrder is sorted from the lowest paying group to the highest paying one.
+	market.MedianFeePerByte = pool.pendingFeeOrder[len(pool.pendingFeeOrder)/2].feePerByte()
+
+	blockBytes := uint64(0)
+	for i := len(pool.pendingFeeOrder) - 1; i >= 0; i-- {
+		blockBytes += pool.pendingFeeOrder[i].length
+		if blockBytes > uint64(pool.pendingMaxTxnBytes) {
+			if i+1 < len(pool.pendingFeeOrder) {
+				market.NextBlockFeePerByte = pool.pendingFeeOrder[i+1].feePerByte()
+			} else {
+				market.NextBlockFeePerByte = pool.pendingFeeOrder[i].feePerByte()
+			}
+			break
+		}
+	}
+
+	if market.PendingCount >= market.Capacity {
+		market.EvictionFeePerByte = pool.pendingFeeOrder[0].feePerByte() + 1
+	}
+	return market
+}
@@ -19,6 +19,7 @@ package pools
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -47,6 +48,13 @@ import (
 // only if its fees are sufficiently high and its state changes are
 // consistent with the prior transactions in the queue.
 //
+// The pending transaction groups are fed into the block evaluator in
+// decreasing order of their effective fee per byte, so that the block
+// constructed by TransactionPool.AssembleBlock contains the best paying
+// groups. Once the pool reaches its capacity, a new transaction group
+// replaces the lowest paying pending groups, provided it pays more
+// than these.
+//
 // TransactionPool.AssembleBlock constructs a valid block for
 // proposal given a deadline.
 type TransactionPool struct {
@@ -73,6 +81,11 @@ type TransactionPool struct {
 	numPendingWholeBlocks  basics.Round
 	feeThresholdMultiplier uint64
 	statusCache            *statusCache
+	// pendingFeeOrder holds the fee summary of every pending transaction group, ordered by the order in which the groups
+	// would be evicted from a full pool ( i.e. lowest effective fee per byte first ).
+	pendingFeeOrder []groupFee
+	// pendingMaxTxnBytes is the maximal size of the transactions in the block being evaluated by pendingBlockEvaluator.
+	pendingMaxTxnBytes int
 
 	assemblyMu       deadlock.Mutex
 	assemblyCond     sync.Cond
@@ -138,7 +151,7 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 	}
 	pool.cond.L = &pool.mu
 	pool.assemblyCond.L = &pool.assemblyMu
-	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	pool.recomputeBlockEvaluator(make(map[transactions.Txid]ledgercore.IncludedTransactions), 0)
 	return &pool
 }
 
@@ -196,11 +209,12 @@ func (pool *TransactionPool) Reset() {
 	pool.pendingLatestLocal = pooldata.InvalidSignedTxGroupCounter
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
+	pool.pendingFeeOrder = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
 	pool.statusCache.reset()
-	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	pool.recomputeBlockEvaluator(make(map[transactions.Txid]ledgercore.IncludedTransactions), 0)
 }
 
 // NumExpired returns the number of transactions that expired at the
@@ -261,6 +275,7 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 		pool.pendingTxids = pool.rememberedTxids
 		pool.pendingLatestLocal = pool.rememberedLatestLocal
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
+		pool.rebuildFeeOrder()
 	} else {
 		// update the GroupCounter on all the transaction groups we're going to add.
 		// this would ensure that each transaction group has a unique monotonic GroupCounter
@@ -277,6 +292,7 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 			if txGroup.LocallyOriginated {
 				pool.pendingLatestLocal = txGroup.GroupCounter
 			}
+			pool.insertFeeOrder(txGroup)
 		}
 		protocol.PutEncodingBuf(encodingBuf)
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
@@ -314,18 +330,6 @@ func (pool *TransactionPool) pendingCountNoLock() int {
 	return count
 }
 
-// checkPendingQueueSize tests to see if we can grow the pending group transaction list
-// by adding txCount more transactions. The limits comes from the total number of transactions
-// and not from the total number of transaction groups.
-// As long as we haven't surpassed the size limit, we should be good to go.
-func (pool *TransactionPool) checkPendingQueueSize(txCount int) error {
-	pendingSize := pool.pendingTxIDsCount()
-	if pendingSize+txCount > pool.txPoolMaxSize {
-		return fmt.Errorf("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
-	}
-	return nil
-}
-
 // FeePerByte returns the current minimum microalgos per byte a transaction
 // needs to pay in order to get into the pool.
 func (pool *TransactionPool) FeePerByte() uint64 {
@@ -401,13 +405,13 @@ func (pool *TransactionPool) checkSufficientFee(txgroup pooldata.SignedTxGroup)
 // Test performs basic duplicate detection and well-formedness checks
 // on a transaction group without storing the group.
 func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
-	if err := pool.checkPendingQueueSize(len(txgroup)); err != nil {
-		return err
-	}
-
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
+	if _, err := pool.checkPendingQueueSize([]pooldata.SignedTxGroup{{Transactions: txgroup}}); err != nil {
+		return err
+	}
+
 	if pool.pendingBlockEvaluator == nil {
 		return fmt.Errorf("Test: pendingBlockEvaluator is nil")
 	}
@@ -489,19 +493,21 @@ func (pool *TransactionPool) ingest(txgroup pooldata.SignedTxGroup, params poolI
 // The function is called by the transaction handler ( i.e. txsync or gossip ) or by the node when
 // transaction is coming from a REST API call.
 func (pool *TransactionPool) Remember(txgroup pooldata.SignedTxGroup) error {
-	if err := pool.checkPendingQueueSize(len(txgroup.Transactions)); err != nil {
-		return err
-	}
-
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
-	err := pool.remember(txgroup)
+	evict, err := pool.checkPendingQueueSize([]pooldata.SignedTxGroup{txgroup})
+	if err != nil {
+		return err
+	}
+
+	err = pool.remember(txgroup)
 	if err != nil {
 		return fmt.Errorf("TransactionPool.Remember: %v", err)
 	}
 
 	pool.rememberCommit(false)
+	pool.evictLowestPaying(evict)
 	return nil
 }
 
@@ -509,19 +515,16 @@ func (pool *TransactionPool) Remember(txgroup pooldata.SignedTxGroup) error {
 // Precondition: Only RememberArray() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 // The function is called by the transaction handler ( i.e. txsync )
 func (pool *TransactionPool) RememberArray(txgroups []pooldata.SignedTxGroup) error {
-	totalSize := 0
-	for _, txGroup := range txgroups {
-		totalSize += len(txGroup.Transactions)
-	}
-	if err := pool.checkPendingQueueSize(totalSize); err != nil {
-		return err
-	}
-
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
+	evict, err := pool.checkPendingQueueSize(txgroups)
+	if err != nil {
+		return err
+	}
+
 	for _, txGroup := range txgroups {
-		err := pool.remember(txGroup)
+		err = pool.remember(txGroup)
 		if err != nil {
 			// we need to explicitly clear the remembered transaction groups here, since we might have added the first one successfully and then failing on the second one.
 			pool.resetRememberedTransactionGroups()
@@ -530,6 +533,7 @@ func (pool *TransactionPool) RememberArray(txgroups []pooldata.SignedTxGroup) er
 	}
 
 	pool.rememberCommit(false)
+	pool.evictLowestPaying(evict)
 	return nil
 }
 
@@ -714,7 +718,7 @@ func (pool *TransactionPool) addToPendingBlockEvaluator(txgroup pooldata.SignedT
 // recomputeBlockEvaluator constructs a new BlockEvaluator and feeds all
 // in-pool transactions to it (removing any transactions that are rejected
 // by the BlockEvaluator). Expects that the pool.mu mutex would be already taken.
-func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transactions.Txid]basics.Round, knownCommitted uint) (stats telemetryspec.ProcessBlockMetrics) {
+func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transactions.Txid]ledgercore.IncludedTransactions, knownCommitted uint) (stats telemetryspec.ProcessBlockMetrics) {
 	pool.pendingBlockEvaluator = nil
 
 	latest := pool.ledger.Latest()
@@ -758,7 +762,11 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	if hint < 0 || int(knownCommitted) < 0 {
 		hint = 0
 	}
-	pool.pendingBlockEvaluator, err = pool.ledger.StartEvaluator(next.BlockHeader, hint, pool.calculateMaxTxnBytesPerBlock(next.BlockHeader.CurrentProtocol))
+	pool.pendingMaxTxnBytes = pool.calculateMaxTxnBytesPerBlock(next.BlockHeader.CurrentProtocol)
+	pool.pendingBlockEvaluator, err = pool.ledger.StartEvaluator(next.BlockHeader, hint, pool.pendingMaxTxnBytes)
+	if pool.pendingMaxTxnBytes == 0 {
+		pool.pendingMaxTxnBytes = config.Consensus[next.BlockHeader.CurrentProtocol].MaxTxnBytesPerBlock
+	}
 	if err != nil {
 		// The pendingBlockEvaluator is an interface, and in case of an evaluator error
 		// we want to remove the interface itself rather then keeping an interface
@@ -781,8 +789,11 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 
 	firstTxnGrpTime := time.Now()
 
-	// Feed the transactions in order
-	for _, txgroup := range txgroups {
+	// Feed the transactions by their effective fee per byte, so that the best paying ones would make it into
+	// the assembled block. A group might fail since it depends on a lower paying group that wasn't fed yet
+	// ( i.e. a payment that funds its sender ), so the failing groups are given another chance at the end.
+	var deferred []pooldata.SignedTxGroup
+	for _, txgroup := range sortByFeePriority(txgroups) {
 		if len(txgroup.Transactions) == 0 {
 			asmStats.InvalidCount++
 			continue
@@ -791,33 +802,31 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 			asmStats.EarlyCommittedCount++
 			continue
 		}
+		err := pool.add(txgroup, &asmStats)
+		switch err.(type) {
+		case nil:
+			pool.recomputedGroup(txgroup)
+		case *ledgercore.TransactionInLedgerError, transactions.TxnDeadError, transactions.MinFeeError:
+			pool.recomputeFailedGroup(txgroup, err, &stats, &asmStats)
+		default:
+			deferred = append(deferred, txgroup)
+		}
+	}
+	for _, txgroup := range deferred {
 		err := pool.add(txgroup, &asmStats)
 		if err != nil {
-			for _, tx := range txgroup.Transactions {
-				pool.statusCache.put(tx, err.Error())
-			}
-
-			switch err.(type) {
-			case *ledgercore.TransactionInLedgerError:
-				asmStats.CommittedCount++
-				stats.RemovedInvalidCount++
-			case transactions.TxnDeadError:
-				asmStats.InvalidCount++
-				stats.ExpiredCount++
-			case transactions.MinFeeError:
-				asmStats.InvalidCount++
-				stats.RemovedInvalidCount++
-				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
-			default:
-				asmStats.InvalidCount++
-				stats.RemovedInvalidCount++
-				pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
-			}
-		} else if txgroup.LocallyOriginated {
-			pool.rememberedLatestLocal = txgroup.GroupCounter
+			pool.recomputeFailedGroup(txgroup, err, &stats, &asmStats)
+		} else {
+			pool.recomputedGroup(txgroup)
 		}
 	}
 
+	// the pending transaction groups are kept in the order in which they've arrived, as the transaction sync
+	// scans them by their group counter.
+	sort.Slice(pool.rememberedTxGroups, func(i, j int) bool {
+		return pool.rememberedTxGroups[i].GroupCounter < pool.rememberedTxGroups[j].GroupCounter
+	})
+
 	pool.assemblyMu.Lock()
 	if !pool.assemblyDeadline.IsZero() {
 		// The deadline was generated by the agreement, allocating ProposalAssemblyTime milliseconds for completing proposal
@@ -847,8 +856,45 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	return
 }
 
+// recomputedGroup updates the latest locally originated group counter after the given group was
+// re-added to the pool by recomputeBlockEvaluator.
+func (pool *TransactionPool) recomputedGroup(txgroup pooldata.SignedTxGroup) {
+	if !txgroup.LocallyOriginated {
+		return
+	}
+	if pool.rememberedLatestLocal == pooldata.InvalidSignedTxGroupCounter || txgroup.GroupCounter > pool.rememberedLatestLocal {
+		pool.rememberedLatestLocal = txgroup.GroupCounter
+	}
+}
+
+// recomputeFailedGroup records the reason the given group could not be re-added to the pool by recomputeBlockEvaluator.
+func (pool *TransactionPool) recomputeFailedGroup(txgroup pooldata.SignedTxGroup, err error, stats *telemetryspec.ProcessBlockMetrics, asmStats *telemetryspec.AssembleBlockMetrics) {
+	for _, tx := range txgroup.Transactions {
+		pool.statusCache.put(tx, err.Error())
+	}
+
+	switch err.(type) {
+	case *ledgercore.TransactionInLedgerError:
+		asmStats.CommittedCount++
+		stats.RemovedInvalidCount++
+	case transactions.TxnDeadError:
+		asmStats.InvalidCount++
+		stats.ExpiredCount++
+	case transactions.MinFeeError:
+		asmStats.InvalidCount++
+		stats.RemovedInvalidCount++
+		pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+	default:
+		asmStats.InvalidCount++
+		stats.RemovedInvalidCount++
+		pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
+	}
+}
+
 // AssembleBlock assembles a block for a given round, trying not to
-// take longer than deadline to finish.
+// take longer than deadline to finish. The block contains the pending
+// transaction groups in decreasing order of their effective fee per byte,
+// as fed into the pending block evaluator by recomputeBlockEvaluator.
 func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Time) (assembled *ledgercore.ValidatedBlock, err error) {
 	var stats telemetryspec.AssembleBlockMetrics
 
@@ -1060,7 +1106,7 @@ func (pool *TransactionPool) AssembleDevModeBlock() (assembled *ledgercore.Valid
 	defer pool.mu.Unlock()
 
 	// drop the current block evaluator and start with a new one.
-	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	pool.recomputeBlockEvaluator(make(map[transactions.Txid]ledgercore.IncludedTransactions), 0)
 
 	// The above was already pregenerating the entire block,
 	// so there won't be any waiting on this call.
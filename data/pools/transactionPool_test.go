@@ -21,6 +21,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -1289,3 +1290,290 @@ func TestTxPoolSizeLimits(t *testing.T) {
 		}
 	}
 }
+
+func TestTransactionPoolFeePriorityAssembly(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 6
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	// the last account is not funded; it would be funded by the lowest paying transaction.
+	unfunded := addresses[numOfAccounts-1]
+	l := makeMockLedger(t, initAccFixed(addresses[:numOfAccounts-1], 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	makeTxn := func(sender int, receiver basics.Address, fee uint64, amount uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        make([]byte, 2),
+				GenesisHash: l.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		return tx.Sign(secrets[sender])
+	}
+
+	// add the transactions in increasing fee order.
+	for i := 0; i < numOfAccounts-1; i++ {
+		require.NoError(t, transactionPool.RememberOne(makeTxn(i, addresses[0], proto.MinTxnFee*uint64(i+1), 0)))
+	}
+	funding := makeTxn(0, unfunded, proto.MinTxnFee, 2*proto.MinBalance)
+	require.NoError(t, transactionPool.RememberOne(funding))
+	dependent := makeTxn(numOfAccounts-1, addresses[1], 10*proto.MinTxnFee, proto.MinBalance/2)
+	require.NoError(t, transactionPool.RememberOne(dependent))
+
+	// the pending transaction groups retain their arrival order.
+	pending, _ := transactionPool.PendingTxGroups()
+	require.Equal(t, numOfAccounts+1, len(pending))
+	for i := 1; i < len(pending); i++ {
+		require.Less(t, pending[i-1].GroupCounter, pending[i].GroupCounter)
+	}
+
+	blk, err := transactionPool.AssembleDevModeBlock()
+	require.NoError(t, err)
+	payset := blk.Block().Payset
+	require.Equal(t, numOfAccounts+1, len(payset))
+
+	// the dependent transaction pays the highest fee, but can only be evaluated after its sender was funded.
+	require.Equal(t, unfunded, payset[len(payset)-1].Txn.Sender)
+	var paidFees []uint64
+	for _, txib := range payset[:len(payset)-1] {
+		paidFees = append(paidFees, txib.Txn.Fee.Raw)
+	}
+	require.Equal(t, []uint64{5000, 4000, 3000, 2000, 1000, 1000}, paidFees)
+
+	pending, _ = transactionPool.PendingTxGroups()
+	for i := 1; i < len(pending); i++ {
+		require.Less(t, pending[i-1].GroupCounter, pending[i].GroupCounter)
+	}
+}
+
+func TestTransactionPoolEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secret := keypair()
+	sender := basics.Address(secret.SignatureVerifier)
+	var receiver basics.Address
+	crypto.RandBytes(receiver[:])
+
+	l := makeMockLedger(t, initAccFixed([]basics.Address{sender}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 10
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	uniqueTxID := 0
+	makeGroup := func(fee uint64, size int) (txgroup pooldata.SignedTxGroup) {
+		for i := 0; i < size; i++ {
+			tx := transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      sender,
+					Fee:         basics.MicroAlgos{Raw: fee},
+					FirstValid:  0,
+					LastValid:   basics.Round(proto.MaxTxnLife),
+					Note:        []byte{byte(uniqueTxID), byte(uniqueTxID >> 8)},
+					GenesisHash: l.GenesisHash(),
+				},
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: receiver,
+					Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+				},
+			}
+			txgroup.Transactions = append(txgroup.Transactions, tx.Sign(secret))
+			uniqueTxID++
+		}
+		if size > 1 {
+			var group transactions.TxGroup
+			for _, stxn := range txgroup.Transactions {
+				group.TxGroupHashes = append(group.TxGroupHashes, crypto.HashObj(stxn.Txn))
+			}
+			gid := crypto.HashObj(group)
+			for i := range txgroup.Transactions {
+				txgroup.Transactions[i].Txn.Group = gid
+				txgroup.Transactions[i].Sig = txgroup.Transactions[i].Txn.Sign(secret).Sig
+			}
+		}
+		return
+	}
+
+	// fill the pool; the first transaction pays a little more than the others.
+	var filled []pooldata.SignedTxGroup
+	for i := 0; i < cfg.TxPoolSize; i++ {
+		fee := proto.MinTxnFee
+		if i == 0 {
+			fee = proto.MinTxnFee + 100
+		}
+		txgroup := makeGroup(fee, 1)
+		require.NoError(t, transactionPool.Remember(txgroup))
+		filled = append(filled, txgroup)
+	}
+	market := transactionPool.FeeMarket()
+	require.Equal(t, cfg.TxPoolSize, market.PendingCount)
+	require.NotZero(t, market.EvictionFeePerByte)
+
+	// a transaction paying the same fee can't get in.
+	require.Error(t, transactionPool.Remember(makeGroup(proto.MinTxnFee, 1)))
+	require.Error(t, transactionPool.Test(makeGroup(proto.MinTxnFee, 1).Transactions))
+
+	// a better paying group evicts the newest of the lowest paying transactions.
+	better := makeGroup(proto.MinTxnFee*2, 2)
+	require.NoError(t, transactionPool.Test(better.Transactions))
+	require.NoError(t, transactionPool.Remember(better))
+	require.Equal(t, cfg.TxPoolSize, transactionPool.PendingCount())
+	for i, txgroup := range filled {
+		_, txErr, found := transactionPool.Lookup(txgroup.Transactions[0].ID())
+		require.True(t, found)
+		if i < cfg.TxPoolSize-2 {
+			require.Empty(t, txErr)
+		} else {
+			require.Equal(t, evictedTxnError, txErr)
+		}
+	}
+	pending, _ := transactionPool.PendingTxGroups()
+	require.Equal(t, better.Transactions, pending[len(pending)-1].Transactions)
+
+	// the evicted transactions don't make it into the block assembled once the pending block evaluator
+	// is recomputed on the next block.
+	transactionPool.mu.Lock()
+	transactionPool.recomputeBlockEvaluator(nil, 0)
+	transactionPool.mu.Unlock()
+	blk, err := transactionPool.AssembleBlock(l.Latest()+1, time.Now().Add(config.ProposalAssemblyTime))
+	require.NoError(t, err)
+	payset, err := blk.Block().DecodePaysetFlat()
+	require.NoError(t, err)
+	require.Equal(t, cfg.TxPoolSize, len(payset))
+	included := make(map[transactions.Txid]bool, len(payset))
+	for _, stxn := range payset {
+		included[stxn.ID()] = true
+	}
+	for i, txgroup := range filled {
+		require.Equal(t, i < cfg.TxPoolSize-2, included[txgroup.Transactions[0].ID()])
+	}
+	for _, stxn := range better.Transactions {
+		require.True(t, included[stxn.ID()])
+	}
+
+	// a group can't evict a transaction paying more than its lowest paying member.
+	require.Error(t, transactionPool.RememberArray([]pooldata.SignedTxGroup{
+		makeGroup(proto.MinTxnFee+50, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+		makeGroup(proto.MinTxnFee*3, 1),
+	}))
+	require.Equal(t, cfg.TxPoolSize, transactionPool.PendingCount())
+	_, txErr, _ := transactionPool.Lookup(filled[0].Transactions[0].ID())
+	require.Empty(t, txErr)
+}
+
+func TestTransactionPoolFeeMarket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secret := keypair()
+	sender := basics.Address(secret.SignatureVerifier)
+
+	l := makeMockLedger(t, initAccFixed([]basics.Address{sender}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	market := transactionPool.FeeMarket()
+	require.Equal(t, FeeMarket{Capacity: testPoolSize}, market)
+
+	var lengths []int
+	for i := 0; i < 5; i++ {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee * uint64(i+1)},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(i)},
+				GenesisHash: l.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: sender,
+			},
+		}
+		signedTx := tx.Sign(secret)
+		lengths = append(lengths, signedTx.GetEncodedLength())
+		require.NoError(t, transactionPool.RememberOne(signedTx))
+	}
+
+	market = transactionPool.FeeMarket()
+	require.Equal(t, 5, market.PendingCount)
+	require.Equal(t, proto.MinTxnFee*3/uint64(lengths[2]), market.MedianFeePerByte)
+	// everything fits into the next block, and the pool isn't full.
+	require.Zero(t, market.NextBlockFeePerByte)
+	require.Zero(t, market.EvictionFeePerByte)
+
+	// shrink the block, so that only the two best paying transactions would fit.
+	transactionPool.mu.Lock()
+	transactionPool.pendingMaxTxnBytes = lengths[4] + lengths[3]
+	transactionPool.mu.Unlock()
+	market = transactionPool.FeeMarket()
+	require.Equal(t, proto.MinTxnFee*4/uint64(lengths[3]), market.NextBlockFeePerByte)
+}
+
+func TestGroupFeeOrdering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cheap := groupFee{counter: 1, fee: 1000, length: 200}
+	pricey := groupFee{counter: 2, fee: 1001, length: 200}
+	bigGroup := groupFee{counter: 3, fee: 2000, length: 400}
+	require.True(t, cheap.less(pricey))
+	require.False(t, pricey.less(cheap))
+	require.False(t, cheap.less(bigGroup))
+	require.False(t, bigGroup.less(cheap))
+
+	// equally paying groups are evicted newest first.
+	require.True(t, bigGroup.evictsBefore(cheap))
+	require.True(t, cheap.evictsBefore(pricey))
+
+	// the comparison doesn't overflow.
+	huge := groupFee{fee: 1 << 63, length: 1 << 40}
+	require.True(t, cheap.less(huge))
+
+	// the fee of a group is the sum of the fees of its transactions.
+	var txgroup pooldata.SignedTxGroup
+	for _, fee := range []uint64{0, 5000} {
+		txgroup.Transactions = append(txgroup.Transactions, transactions.SignedTxn{Txn: transactions.Transaction{Header: transactions.Header{Fee: basics.MicroAlgos{Raw: fee}}}})
+	}
+	txgroup.EncodedLength = 250
+	require.Equal(t, uint64(20), makeGroupFee(txgroup).feePerByte())
+
+	// the compact cert transaction precedes any other transaction.
+	compactCert := pooldata.SignedTxGroup{Transactions: []transactions.SignedTxn{{Txn: transactions.Transaction{
+		Type:   protocol.CompactCertTx,
+		Header: transactions.Header{Sender: transactions.CompactCertSender},
+	}}}}
+	sorted := sortByFeePriority([]pooldata.SignedTxGroup{txgroup, compactCert})
+	require.Equal(t, protocol.CompactCertTx, sorted[0].Transactions[0].Txn.Type)
+}
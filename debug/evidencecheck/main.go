@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// evidencecheck verifies equivocation evidence recorded by Algorand nodes
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var filename = flag.String("file", "", "Name of the input evidence file, either msgpack or base64 encoded (otherwise, use stdin)")
+
+func main() {
+	flag.Parse()
+
+	var data []byte
+	var err error
+	if *filename == "" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(*filename)
+	}
+	if err != nil {
+		log.Fatalf("evidencecheck: failed to read evidence: %v", err)
+	}
+
+	// the evidence is reported base64 encoded by both the REST API and telemetry; accept the raw msgpack encoding as well.
+	if decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data))); err == nil {
+		data = decoded
+	}
+
+	var ev agreement.EquivocationEvidence
+	err = protocol.Decode(data, &ev)
+	if err != nil {
+		log.Fatalf("evidencecheck: failed to decode evidence: %v", err)
+	}
+
+	proposals := ev.Proposals()
+	fmt.Printf("sender: %v\nround: %d\tperiod: %d\tstep: %d\n", ev.Sender, ev.Round, ev.Period, ev.Step)
+	fmt.Printf("voting key: %v\tkey dilution: %d\n", ev.VoteID, ev.KeyDilution)
+	fmt.Printf("proposals: %v, %v\n", proposals[0].BlockDigest, proposals[1].BlockDigest)
+
+	err = ev.Verify()
+	if err != nil {
+		fmt.Printf("evidence is INVALID: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("evidence is valid; check that the voting key matches the account registration at the round's balance round")
+}
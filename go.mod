@@ -19,6 +19,7 @@ require (
 	github.com/fortytw2/leaktest v1.3.0 // indirect
 	github.com/gen2brain/beeep v0.0.0-20180718162406-4e430518395f
 	github.com/getkin/kin-openapi v0.22.0
+	github.com/ghodss/yaml v1.0.0
 	github.com/godbus/dbus v0.0.0-20181101234600-2ff6f7ffd60f // indirect
 	github.com/gofrs/flock v0.7.0
 	github.com/google/go-querystring v1.0.0
@@ -32,6 +33,7 @@ require (
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/karalabe/hid v1.0.0
 	github.com/labstack/echo/v4 v4.1.17
+	github.com/lucas-clemente/quic-go v0.19.3
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/mattn/go-sqlite3 v1.10.0
 	github.com/miekg/dns v1.1.27
@@ -0,0 +1,263 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrAccountHistoryUnavailable is returned when the account history store is disabled, or does not cover the requested round.
+var ErrAccountHistoryUnavailable = errors.New("account history is not available for the requested round")
+
+// The account history store keeps, for every account, a row per round in which the account was modified holding the
+// account data as of the end of that round. The first time an account is modified after the history was started, an
+// additional row holding its data as of the history start round is written as well. This way, the state of an account
+// at a covered round is either found in its latest row at or before that round, or, when the account has no rows
+// at all, it hasn't changed since the history was started and is found in the accountbase table.
+var accountHistorySchema = []string{
+	`CREATE TABLE IF NOT EXISTS accounthistory (
+		address blob,
+		rnd integer,
+		data blob,
+		PRIMARY KEY (address, rnd))`,
+	`CREATE TABLE IF NOT EXISTS creatablehistory (
+		asset integer,
+		ctype integer,
+		creator blob,
+		PRIMARY KEY (asset, ctype))`,
+}
+
+var accountHistoryResetExprs = []string{
+	`DROP TABLE IF EXISTS accounthistory`,
+	`DROP TABLE IF EXISTS creatablehistory`,
+	`DELETE FROM acctrounds WHERE id='histstart' OR id='histbase'`,
+}
+
+// accountHistory is a ledger tracker recording the versioned state of the accounts as rounds are committed
+// to the tracker database.
+type accountHistory struct {
+	// enabled is set when the node was configured to keep the account history.
+	enabled bool
+
+	dbs db.Pair
+	log logging.Logger
+}
+
+// accountHistoryRounds returns the first and the last rounds covered by the account history store.
+func accountHistoryRounds(tx *sql.Tx) (start basics.Round, base basics.Round, err error) {
+	err = tx.QueryRow("SELECT rnd FROM acctrounds WHERE id='histstart'").Scan(&start)
+	if err != nil {
+		return
+	}
+	err = tx.QueryRow("SELECT rnd FROM acctrounds WHERE id='histbase'").Scan(&base)
+	return
+}
+
+// accountHistoryInitialize creates the account history tables, and restarts the history at dbRound unless the
+// recorded history already extends up to dbRound.
+func accountHistoryInitialize(tx *sql.Tx, dbRound basics.Round, log logging.Logger) error {
+	for _, stmt := range accountHistorySchema {
+		_, err := tx.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	start, base, err := accountHistoryRounds(tx)
+	switch err {
+	case nil:
+		if base == dbRound {
+			return nil
+		}
+		log.Warnf("accountHistoryInitialize: account history covering rounds %d-%d does not reach the accounts round %d; restarting it", start, base, dbRound)
+	case sql.ErrNoRows:
+	default:
+		return err
+	}
+
+	for _, stmt := range []string{`DELETE FROM accounthistory`, `DELETE FROM creatablehistory`} {
+		_, err = tx.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
+	for _, id := range []string{"histstart", "histbase"} {
+		_, err = tx.Exec("INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES(?, ?)", id, dbRound)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ah *accountHistory) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	ah.dbs = l.trackerDB()
+	ah.log = l.trackerLog()
+
+	return ah.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		if !ah.enabled {
+			for _, stmt := range accountHistoryResetExprs {
+				_, err := tx.Exec(stmt)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return accountHistoryInitialize(tx, dbRound, ah.log)
+	})
+}
+
+func (ah *accountHistory) close() {
+}
+
+func (ah *accountHistory) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+}
+
+func (ah *accountHistory) committedUpTo(rnd basics.Round) basics.Round {
+	return rnd
+}
+
+func (ah *accountHistory) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+// commitRound records the account data of the accounts modified by the committed rounds. It relies on the accounts
+// tracker committing first, which loads the data the modified accounts had before these rounds.
+func (ah *accountHistory) commitRound(ctx context.Context, tx *sql.Tx, dcc *deferredCommitContext) (err error) {
+	if !ah.enabled {
+		return nil
+	}
+
+	start, _, err := accountHistoryRounds(tx)
+	if err != nil {
+		return err
+	}
+
+	insertStartStmt, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO accounthistory(address, rnd, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertStartStmt.Close()
+
+	for i := 0; i < dcc.compactAccountDeltas.len(); i++ {
+		addr, delta := dcc.compactAccountDeltas.getByIdx(i)
+		// an account without rows hasn't changed since the history was started, so its data before these
+		// rounds is its data as of the start round.
+		_, err = insertStartStmt.ExecContext(ctx, addr[:], start, protocol.Encode(&delta.old.accountData))
+		if err != nil {
+			return err
+		}
+	}
+
+	insertStmt, err := tx.PrepareContext(ctx, "INSERT OR REPLACE INTO accounthistory(address, rnd, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertStmt.Close()
+
+	for i, deltas := range dcc.deltas {
+		rnd := dcc.oldBase + basics.Round(i) + 1
+		for j := 0; j < deltas.Len(); j++ {
+			addr, data := deltas.GetByIdx(j)
+			_, err = insertStmt.ExecContext(ctx, addr[:], rnd, protocol.Encode(&data))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for cidx, mc := range dcc.compactCreatableDeltas {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO creatablehistory(asset, ctype, creator) VALUES(?, ?, ?)", cidx, mc.Ctype, mc.Creator[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE acctrounds SET rnd=? WHERE id='histbase'", dcc.newBase)
+	return err
+}
+
+func (ah *accountHistory) postCommit(deferredCommitContext) {
+}
+
+func (ah *accountHistory) handleUnorderedCommit(uint64, basics.Round, basics.Round) {
+}
+
+// lookup returns the account data of addr, without pending rewards, as of the end of round rnd.
+func (ah *accountHistory) lookup(rnd basics.Round, addr basics.Address) (data basics.AccountData, err error) {
+	if !ah.enabled {
+		return basics.AccountData{}, ErrAccountHistoryUnavailable
+	}
+
+	err = ah.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		start, base, err := accountHistoryRounds(tx)
+		if err != nil {
+			return err
+		}
+		if rnd < start || rnd > base {
+			return ErrAccountHistoryUnavailable
+		}
+
+		var buf []byte
+		err = tx.QueryRow("SELECT data FROM accounthistory WHERE address=? AND rnd<=? ORDER BY rnd DESC LIMIT 1", addr[:], rnd).Scan(&buf)
+		if err == sql.ErrNoRows {
+			err = tx.QueryRow("SELECT data FROM accountbase WHERE address=?", addr[:]).Scan(&buf)
+		}
+		if err == sql.ErrNoRows {
+			data = basics.AccountData{}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return protocol.Decode(buf, &data)
+	})
+	return
+}
+
+// lookupCreator returns the creator of a creatable created after the history was started, including ones which
+// were deleted since.
+func (ah *accountHistory) lookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
+	if !ah.enabled {
+		return
+	}
+
+	err = ah.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var buf []byte
+		err := tx.QueryRow("SELECT creator FROM creatablehistory WHERE asset=? AND ctype=?", cidx, ctype).Scan(&buf)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ok = true
+		copy(creator[:], buf)
+		return nil
+	})
+	return
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func newTestLedgerWithAccountHistory(t *testing.T, balances bookkeeping.GenesisBalances, enabled bool) *Ledger {
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusFuture, balances, "test", genHash)
+	require.NoError(t, err)
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableAccountHistory = enabled
+	l, err := OpenLedger(logging.Base(), dbName, true, ledgercore.InitState{
+		Block:       genBlock,
+		Accounts:    balances.Balances,
+		GenesisHash: genHash,
+	}, cfg)
+	require.NoError(t, err)
+	return l
+}
+
+func TestAccountHistoryLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedgerWithAccountHistory(t, genBalances, true)
+	defer l.Close()
+
+	// a fresh account, which doesn't exist before its first payment.
+	fresh := ledgertesting.RandomAddress()
+
+	expected := make(map[basics.Round]map[basics.Address]basics.AccountData)
+	record := func() {
+		rnd := l.Latest()
+		expected[rnd] = make(map[basics.Address]basics.AccountData)
+		for _, addr := range []basics.Address{addrs[0], addrs[1], addrs[2], fresh} {
+			data, _, err := l.LookupWithoutRewards(rnd, addr)
+			require.NoError(t, err)
+			expected[rnd][addr] = data
+		}
+	}
+	record()
+
+	var assetIdx basics.AssetIndex
+	proto := config.Consensus[protocol.ConsensusFuture]
+	lastRound := basics.Round(proto.MaxBalLookback + 100)
+	for rnd := basics.Round(1); rnd <= lastRound; rnd++ {
+		eval := testingEvaluator{l.nextBlock(t), l}
+		switch {
+		case rnd == 5:
+			eval.txn(t, &txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: fresh, Amount: 1000000})
+		case rnd == 10:
+			eval.txn(t, &txntest.Txn{Type: "acfg", Sender: addrs[2], AssetParams: basics.AssetParams{Total: 10, UnitName: "hist", Manager: addrs[2]}})
+		case rnd == 20:
+			eval.txn(t, &txntest.Txn{Type: "acfg", Sender: addrs[2], ConfigAsset: assetIdx})
+		case rnd%7 == 0:
+			eval.txn(t, &txntest.Txn{Type: "pay", Sender: addrs[1], Receiver: addrs[0], Amount: uint64(rnd)})
+		}
+		l.endBlock(t, eval)
+		if rnd == 10 {
+			for idx := range l.lookup(t, addrs[2]).AssetParams {
+				assetIdx = idx
+			}
+			require.NotZero(t, assetIdx)
+		}
+		record()
+	}
+	l.WaitForCommit(lastRound)
+	l.waitAccountsWriting()
+
+	// the early rounds are no longer available from the accounts tracker.
+	_, _, err := l.LookupWithoutRewards(1, addrs[0])
+	var roundOffsetErr *RoundOffsetError
+	require.True(t, errors.As(err, &roundOffsetErr))
+
+	for rnd, accounts := range expected {
+		for addr, data := range accounts {
+			actual, err := l.LookupHistorical(rnd, addr)
+			require.NoError(t, err)
+			// compare the encodings, since decoding doesn't tell empty maps from nil ones.
+			require.Equal(t, protocol.Encode(&data), protocol.Encode(&actual), "round %d address %s", rnd, addr)
+		}
+	}
+
+	// the destroyed asset is no longer known to the accounts tracker, but its creator is kept in the history.
+	_, ok, err := l.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	require.NoError(t, err)
+	require.False(t, ok)
+	creator, ok, err := l.LookupCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, addrs[2], creator)
+	require.Contains(t, expected[15][addrs[2]].AssetParams, assetIdx)
+}
+
+func TestAccountHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedgerWithAccountHistory(t, genBalances, false)
+	defer l.Close()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	lastRound := basics.Round(proto.MaxBalLookback + 10)
+	for rnd := basics.Round(1); rnd <= lastRound; rnd++ {
+		l.endBlock(t, testingEvaluator{l.nextBlock(t), l})
+	}
+	l.WaitForCommit(lastRound)
+	l.waitAccountsWriting()
+
+	_, err := l.LookupHistorical(1, addrs[0])
+	require.Equal(t, ErrAccountHistoryUnavailable, err)
+
+	// rounds kept by the accounts tracker are still answered.
+	_, err = l.LookupHistorical(lastRound, addrs[0])
+	require.NoError(t, err)
+}
@@ -1157,20 +1157,6 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 	return
 }
 
-// accountHashBuilder calculates the hash key used for the trie by combining the account address and the account data
-func accountHashBuilder(addr basics.Address, accountData basics.AccountData, encodedAccountData []byte) []byte {
-	hash := make([]byte, 4+crypto.DigestSize)
-	// write out the lowest 32 bits of the reward base. This should improve the caching of the trie by allowing
-	// recent updated to be in-cache, and "older" nodes will be left alone.
-	for i, rewards := 3, accountData.RewardsBase; i >= 0; i, rewards = i-1, rewards>>8 {
-		// the following takes the rewards & 255 -> hash[i]
-		hash[i] = byte(rewards)
-	}
-	entryHash := crypto.Hash(append(addr[:], encodedAccountData[:]...))
-	copy(hash[4:], entryHash[:])
-	return hash[:]
-}
-
 // accountsInitializeHashes initializes account hashes.
 // as part of the initialization, it tests if a hash table matches to account base and updates the former.
 func (au *accountUpdates) accountsInitializeHashes(ctx context.Context, tx *sql.Tx, rnd basics.Round) error {
@@ -1308,6 +1294,10 @@ func deleteStoredCatchpoints(ctx context.Context, dbQueries *accountsDbQueries,
 				// we can't delete the file, abort -
 				return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", absCatchpointFileName, err)
 			}
+			err = removeCatchpointProofDB(absCatchpointFileName)
+			if err != nil {
+				return fmt.Errorf("unable to delete the proof database of old catchpoint file '%s' : %v", absCatchpointFileName, err)
+			}
 			// clear the entry from the database
 			err = dbQueries.storeCatchpoint(ctx, round, "", "", 0)
 			if err != nil {
@@ -1329,7 +1319,7 @@ func (au *accountUpdates) accountsUpdateBalances(accountsDeltas compactAccountDe
 	for i := 0; i < accountsDeltas.len(); i++ {
 		addr, delta := accountsDeltas.getByIdx(i)
 		if !delta.old.accountData.IsZero() {
-			deleteHash := accountHashBuilder(addr, delta.old.accountData, protocol.Encode(&delta.old.accountData))
+			deleteHash := ledgercore.AccountHashBuilder(addr, delta.old.accountData, protocol.Encode(&delta.old.accountData))
 			deleted, err = au.balancesTrie.Delete(deleteHash)
 			if err != nil {
 				return fmt.Errorf("failed to delete hash '%s' from merkle trie for account %v: %w", hex.EncodeToString(deleteHash), addr, err)
@@ -1342,7 +1332,7 @@ func (au *accountUpdates) accountsUpdateBalances(accountsDeltas compactAccountDe
 		}
 
 		if !delta.new.IsZero() {
-			addHash := accountHashBuilder(addr, delta.new, protocol.Encode(&delta.new))
+			addHash := ledgercore.AccountHashBuilder(addr, delta.new, protocol.Encode(&delta.new))
 			added, err = au.balancesTrie.Add(addHash)
 			if err != nil {
 				return fmt.Errorf("attempted to add duplicate hash '%s' to merkle trie for account %v: %w", hex.EncodeToString(addHash), addr, err)
@@ -2198,6 +2188,10 @@ func (au *accountUpdates) saveCatchpointFile(round basics.Round, fileName string
 			// we can't delete the file, abort -
 			return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", absCatchpointFileName, err)
 		}
+		err = removeCatchpointProofDB(absCatchpointFileName)
+		if err != nil {
+			return fmt.Errorf("unable to delete the proof database of old catchpoint file '%s' : %v", absCatchpointFileName, err)
+		}
 		err = au.accountsq.storeCatchpoint(context.Background(), round, "", "", 0)
 		if err != nil {
 			return fmt.Errorf("unable to delete old catchpoint entry '%s' : %v", fileToDelete, err)
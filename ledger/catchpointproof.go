@@ -0,0 +1,363 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrAccountNotInCatchpoint is returned when a proof is requested for an account which doesn't exist in the catchpoint.
+// Proofs of non-membership aren't supported: the balances trie elements are hashes of the account data, and
+// therefore the trie can't be used to prove that no element exists for a given address.
+var ErrAccountNotInCatchpoint = errors.New("account does not exist in the catchpoint")
+
+// ErrCatchpointProofBusy is returned when a proof requires building the proof database of a catchpoint while
+// the proof database of another catchpoint is being built.
+var ErrCatchpointProofBusy = errors.New("another catchpoint is being prepared for generating account state proofs")
+
+// catchpointProofDBSuffix is appended to the name of a catchpoint file to get the name of the database which
+// the account state proofs for that catchpoint are generated from.
+const catchpointProofDBSuffix = ".proofs.sqlite"
+
+var catchpointProofDBSchema = []string{
+	`CREATE TABLE IF NOT EXISTS catchpointheader (
+		id integer primary key,
+		data blob)`,
+	`CREATE TABLE IF NOT EXISTS accountbase (
+		address blob primary key,
+		data blob)`,
+	`CREATE TABLE IF NOT EXISTS accounthashes (
+		id integer primary key,
+		data blob)`,
+}
+
+// catchpointProofDBPath returns the path of the proof database of the given catchpoint file.
+func catchpointProofDBPath(catchpointFileName string) string {
+	return catchpointFileName + catchpointProofDBSuffix
+}
+
+// removeCatchpointProofDB removes the proof database of the given catchpoint file, if there is one.
+func removeCatchpointProofDB(catchpointFileName string) error {
+	return removeCatchpointProofDBFiles(catchpointProofDBPath(catchpointFileName))
+}
+
+// removeCatchpointProofDBFiles removes the database at dbPath, along with its journal files.
+func removeCatchpointProofDBFiles(dbPath string) error {
+	for _, fileName := range []string{dbPath, dbPath + "-shm", dbPath + "-wal"} {
+		err := os.Remove(fileName)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildCatchpointProofDB reads the given catchpoint file stream, and writes the accounts it contains along with
+// their merkle trie into a database at dbPath. The trie is stored on disk as it is being built, so that the memory
+// used doesn't depend on the number of accounts. The resulting trie root is validated against the catchpoint label
+// before the database is moved into place.
+func buildCatchpointProofDB(ctx context.Context, reader io.Reader, dbPath string) (err error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tmpPath := dbPath + ".tmp"
+	err = removeCatchpointProofDBFiles(tmpPath)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(dbPath), 0700)
+	if err != nil {
+		return err
+	}
+	proofDB, err := db.MakeAccessor(tmpPath, false, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		proofDB.Close()
+		if err != nil {
+			removeCatchpointProofDBFiles(tmpPath)
+			return
+		}
+		err = os.Rename(tmpPath, dbPath)
+	}()
+
+	var trie *merkletrie.Trie
+	err = proofDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range catchpointProofDBSchema {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		mc, err := MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return err
+		}
+		trie, err = merkletrie.MakeTrie(mc, TrieMemoryConfig)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var fileHeader CatchpointFileHeader
+	seenHeader := false
+	uncommitedHashesCount := 0
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		sectionBytes, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return err
+		}
+
+		if header.Name == "content.msgpack" {
+			err = protocol.Decode(sectionBytes, &fileHeader)
+			if err != nil {
+				return err
+			}
+			if fileHeader.Version != catchpointFileVersion {
+				return fmt.Errorf("buildCatchpointProofDB: catchpoint file version %d is not supported", fileHeader.Version)
+			}
+			seenHeader = true
+			continue
+		}
+		if !strings.HasPrefix(header.Name, "balances.") || !strings.HasSuffix(header.Name, ".msgpack") {
+			// skip unknown sections, the same way the catchup does.
+			continue
+		}
+
+		var balances catchpointFileBalancesChunk
+		err = protocol.Decode(sectionBytes, &balances)
+		if err != nil {
+			return err
+		}
+		err = proofDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			// set a long 30-second window for the evict before warning is generated.
+			db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(30*time.Second))
+			mc, err := MakeMerkleCommitter(tx, false)
+			if err != nil {
+				return err
+			}
+			trie.SetCommitter(mc)
+			insertStmt, err := tx.Prepare("INSERT INTO accountbase (address, data) VALUES (?, ?)")
+			if err != nil {
+				return err
+			}
+			defer insertStmt.Close()
+			for _, balance := range balances.Balances {
+				var accountData basics.AccountData
+				err = protocol.Decode(balance.AccountData, &accountData)
+				if err != nil {
+					return err
+				}
+				added, err := trie.Add(ledgercore.AccountHashBuilder(balance.Address, accountData, balance.AccountData))
+				if err != nil {
+					return err
+				}
+				if !added {
+					return fmt.Errorf("buildCatchpointProofDB: the catchpoint file contained account %v more than once", balance.Address)
+				}
+				_, err = insertStmt.Exec(balance.Address[:], balance.AccountData)
+				if err != nil {
+					return err
+				}
+			}
+			uncommitedHashesCount += len(balances.Balances)
+			if uncommitedHashesCount >= trieRebuildCommitFrequency {
+				_, err = trie.Evict(true)
+				uncommitedHashesCount = 0
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !seenHeader {
+		return fmt.Errorf("buildCatchpointProofDB: catchpoint file is missing its content section")
+	}
+
+	return proofDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(30*time.Second))
+		mc, err := MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return err
+		}
+		trie.SetCommitter(mc)
+		root, err := trie.RootHash()
+		if err != nil {
+			return err
+		}
+		_, labelHash, err := ledgercore.ParseCatchpointLabel(fileHeader.Catchpoint)
+		if err != nil {
+			return err
+		}
+		if ledgercore.MakeCatchpointLabel(fileHeader.BlocksRound, fileHeader.BlockHeaderDigest, root, fileHeader.Totals).Hash() != labelHash {
+			return fmt.Errorf("buildCatchpointProofDB: catchpoint file balances do not match the catchpoint label %s", fileHeader.Catchpoint)
+		}
+		_, err = trie.Evict(true)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO catchpointheader (id, data) VALUES (0, ?)", protocol.Encode(&fileHeader))
+		return err
+	})
+}
+
+// catchpointProofDBAccountStateProof generates a proof of the given account's data against the catchpoint label,
+// out of the proof database at dbPath. It returns the catchpoint label along with the proof.
+func catchpointProofDBAccountStateProof(dbPath string, addr basics.Address) (label string, proof ledgercore.AccountStateProof, err error) {
+	proofDB, err := db.MakeAccessor(dbPath, true, false)
+	if err != nil {
+		return "", ledgercore.AccountStateProof{}, err
+	}
+	defer proofDB.Close()
+
+	err = proofDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var encodedHeader []byte
+		err := tx.QueryRow("SELECT data FROM catchpointheader WHERE id = 0").Scan(&encodedHeader)
+		if err != nil {
+			return err
+		}
+		var fileHeader CatchpointFileHeader
+		err = protocol.Decode(encodedHeader, &fileHeader)
+		if err != nil {
+			return err
+		}
+
+		var encodedAccountData []byte
+		err = tx.QueryRow("SELECT data FROM accountbase WHERE address = ?", addr[:]).Scan(&encodedAccountData)
+		if err == sql.ErrNoRows {
+			return ErrAccountNotInCatchpoint
+		} else if err != nil {
+			return err
+		}
+		var accountData basics.AccountData
+		err = protocol.Decode(encodedAccountData, &accountData)
+		if err != nil {
+			return err
+		}
+
+		mc, err := MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(mc, TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		root, err := trie.RootHash()
+		if err != nil {
+			return err
+		}
+		trieProof, err := trie.Prove(ledgercore.AccountHashBuilder(addr, accountData, encodedAccountData))
+		if err != nil {
+			return err
+		}
+		label = fileHeader.Catchpoint
+		proof = ledgercore.AccountStateProof{
+			Address:            addr,
+			EncodedAccountData: encodedAccountData,
+			BlockHeaderDigest:  fileHeader.BlockHeaderDigest,
+			BalancesMerkleRoot: root,
+			Totals:             fileHeader.Totals,
+			Proof:              *trieProof,
+		}
+		return nil
+	})
+	if err != nil {
+		return "", ledgercore.AccountStateProof{}, err
+	}
+	return label, proof, nil
+}
+
+// AccountStateProof returns the label of the catchpoint generated for the given round, along with a proof
+// of the account's data at that catchpoint. The catchpoint file has to be available on this node. The first
+// proof requested for a catchpoint builds a proof database next to its catchpoint file, which is removed along
+// with the catchpoint file; only one such database is built at a time, and requests which need another one
+// fail with ErrCatchpointProofBusy rather than queue behind it.
+func (l *Ledger) AccountStateProof(round basics.Round, addr basics.Address) (label string, proof ledgercore.AccountStateProof, err error) {
+	catchpointFileName := filepath.Join(l.accts.dbDirectory, "catchpoints", catchpointRoundToPath(round))
+	dbPath := catchpointProofDBPath(catchpointFileName)
+
+	l.catchpointProofMu.Lock()
+	if _, err = os.Stat(dbPath); os.IsNotExist(err) {
+		if l.catchpointProofBuilding {
+			l.catchpointProofMu.Unlock()
+			return "", ledgercore.AccountStateProof{}, ErrCatchpointProofBusy
+		}
+		l.catchpointProofBuilding = true
+		l.catchpointProofMu.Unlock()
+		err = l.buildCatchpointProofDB(round, catchpointFileName)
+		l.catchpointProofMu.Lock()
+		l.catchpointProofBuilding = false
+	}
+	l.catchpointProofMu.Unlock()
+	if err != nil {
+		return "", ledgercore.AccountStateProof{}, err
+	}
+	return catchpointProofDBAccountStateProof(dbPath, addr)
+}
+
+// buildCatchpointProofDB builds the proof database of the catchpoint generated for the given round out of its catchpoint file.
+func (l *Ledger) buildCatchpointProofDB(round basics.Round, catchpointFileName string) error {
+	stream, err := l.GetCatchpointStream(round)
+	if err != nil {
+		return err
+	}
+	err = buildCatchpointProofDB(context.Background(), stream, catchpointProofDBPath(catchpointFileName))
+	stream.Close()
+	if err != nil {
+		return err
+	}
+	// the catchpoint file might have been deleted while its proof database was being built.
+	if _, err = os.Stat(catchpointFileName); os.IsNotExist(err) {
+		removeCatchpointProofDB(catchpointFileName)
+		return ledgercore.ErrNoEntry{}
+	}
+	return err
+}
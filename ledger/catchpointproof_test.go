@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCatchpointAccountStateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	temporaryDirectroy, _ := ioutil.TempDir(os.TempDir(), "catchpoints")
+	defer os.RemoveAll(temporaryDirectroy)
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk+10, false)
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au := newAcctUpdates(t, ml, conf, ".")
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	balancesRoot, err := au.balancesTrie.RootHash()
+	require.NoError(t, err)
+	au.close()
+
+	fileName := filepath.Join(temporaryDirectroy, "12345.catchpoint")
+	badFileName := filepath.Join(temporaryDirectroy, "bad.catchpoint")
+	blocksRound := basics.Round(12345)
+	blockHeaderDigest := crypto.Hash([]byte{1, 2, 3})
+	var label string
+	readDb := ml.trackerDB().Rdb
+	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		totals, err := accountsTotals(tx, false)
+		require.NoError(t, err)
+		label = ledgercore.MakeCatchpointLabel(blocksRound, blockHeaderDigest, balancesRoot, totals).String()
+		// the second catchpoint file carries a label which commits to a different balances root.
+		badLabel := ledgercore.MakeCatchpointLabel(blocksRound, blockHeaderDigest, crypto.Hash([]byte{4, 5, 6}), totals).String()
+		for fileName, label := range map[string]string{fileName: label, badFileName: badLabel} {
+			writer := makeCatchpointWriter(context.Background(), fileName, tx, blocksRound, blockHeaderDigest, label)
+			for {
+				more, err := writer.WriteStep(context.Background())
+				require.NoError(t, err)
+				if !more {
+					break
+				}
+			}
+		}
+		return
+	})
+	require.NoError(t, err)
+
+	// a proof database isn't built out of a catchpoint file which doesn't match its label,
+	// and neither the database nor its temporary file are left behind.
+	file, err := os.Open(badFileName)
+	require.NoError(t, err)
+	badDBPath := catchpointProofDBPath(badFileName)
+	err = buildCatchpointProofDB(context.Background(), file, badDBPath)
+	file.Close()
+	require.Error(t, err)
+	_, err = os.Stat(badDBPath)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(badDBPath + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	file, err = os.Open(fileName)
+	require.NoError(t, err)
+	dbPath := catchpointProofDBPath(fileName)
+	err = buildCatchpointProofDB(context.Background(), file, dbPath)
+	file.Close()
+	require.NoError(t, err)
+	_, err = os.Stat(dbPath + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	for addr, acct := range accts {
+		proofLabel, proof, err := catchpointProofDBAccountStateProof(dbPath, addr)
+		require.NoError(t, err)
+		require.Equal(t, label, proofLabel)
+
+		var decoded ledgercore.AccountStateProof
+		err = protocol.DecodeReflect(protocol.EncodeReflect(&proof), &decoded)
+		require.NoError(t, err)
+
+		accountData, err := ledgercore.VerifyAccountStateProof(label, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, acct, accountData)
+
+		// a proof has to be rejected against any other catchpoint label.
+		otherLabel := ledgercore.MakeCatchpointLabel(blocksRound, crypto.Hash([]byte{4, 5, 6}), balancesRoot, proof.Totals).String()
+		_, err = ledgercore.VerifyAccountStateProof(otherLabel, &decoded)
+		require.Equal(t, ledgercore.ErrAccountStateProofLabelMismatch, err)
+
+		// as well as when it is used to prove another account data.
+		modified := accountData
+		modified.MicroAlgos.Raw++
+		decoded.EncodedAccountData = protocol.Encode(&modified)
+		_, err = ledgercore.VerifyAccountStateProof(label, &decoded)
+		require.Error(t, err)
+	}
+
+	_, _, err = catchpointProofDBAccountStateProof(dbPath, ledgertesting.RandomAddress())
+	require.Equal(t, ErrAccountNotInCatchpoint, err)
+
+	// the ledger serves the proofs out of the proof database next to the catchpoint file, and doesn't build
+	// a proof database while another one is being built.
+	l := &Ledger{}
+	l.accts.dbDirectory = temporaryDirectroy
+	catchpointFileName := filepath.Join(temporaryDirectroy, "catchpoints", catchpointRoundToPath(blocksRound))
+	require.NoError(t, os.MkdirAll(filepath.Dir(catchpointFileName), 0700))
+	require.NoError(t, os.Rename(fileName, catchpointFileName))
+	require.NoError(t, os.Rename(dbPath, catchpointProofDBPath(catchpointFileName)))
+	for addr := range accts {
+		proofLabel, _, err := l.AccountStateProof(blocksRound, addr)
+		require.NoError(t, err)
+		require.Equal(t, label, proofLabel)
+		break
+	}
+	l.catchpointProofBuilding = true
+	_, _, err = l.AccountStateProof(blocksRound+1, ledgertesting.RandomAddress())
+	require.Equal(t, ErrCatchpointProofBusy, err)
+
+	require.NoError(t, removeCatchpointProofDB(catchpointFileName))
+	_, err = os.Stat(catchpointProofDBPath(catchpointFileName))
+	require.True(t, os.IsNotExist(err))
+}
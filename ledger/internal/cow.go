@@ -213,7 +213,7 @@ func (cb *roundCowState) incTxnCount() {
 }
 
 func (cb *roundCowState) addTx(txn transactions.Transaction, txid transactions.Txid) {
-	cb.mods.Txids[txid] = txn.LastValid
+	cb.mods.Txids[txid] = ledgercore.IncludedTransactions{LastValid: txn.LastValid, Intra: uint64(len(cb.mods.Txids))}
 	cb.incTxnCount()
 	if txn.Lease != [32]byte{} {
 		cb.mods.Txleases[ledgercore.Txlease{Sender: txn.Sender, Lease: txn.Lease}] = txn.LastValid
@@ -254,8 +254,10 @@ func (cb *roundCowState) setGroupIdx(txnIdx int) {
 func (cb *roundCowState) commitToParent() {
 	cb.commitParent.mods.Accts.MergeAccounts(cb.mods.Accts)
 
-	for txid, lv := range cb.mods.Txids {
-		cb.commitParent.mods.Txids[txid] = lv
+	// the transactions of a child are offset by the ones its parent already included.
+	commitParentBaseIdx := uint64(len(cb.commitParent.mods.Txids))
+	for txid, incTxn := range cb.mods.Txids {
+		cb.commitParent.mods.Txids[txid] = ledgercore.IncludedTransactions{LastValid: incTxn.LastValid, Intra: commitParentBaseIdx + incTxn.Intra}
 	}
 	cb.commitParent.txnCount += cb.txnCount
 
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sync"
 
+	ccverify "github.com/algorand/go-algorand/compactcert/verify"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/compactcert"
@@ -373,7 +374,7 @@ func (cs *roundCowState) compactCert(certRnd basics.Round, certType protocol.Com
 			return err
 		}
 
-		err = validateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
+		err = ccverify.ValidateCompactCert(certHdr, cert, votersHdr, nextCertRnd, atRound)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -28,7 +29,6 @@ import (
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
-	"github.com/algorand/go-algorand/crypto/compactcert"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -75,11 +75,12 @@ type Ledger struct {
 	genesisProto config.ConsensusParams
 
 	// State-machine trackers
-	accts    accountUpdates
-	txTail   txTail
-	bulletin bulletin
-	notifier blockNotifier
-	metrics  metricsTracker
+	accts        accountUpdates
+	acctsHistory accountHistory
+	txTail       txTail
+	bulletin     bulletin
+	notifier     blockNotifier
+	metrics      metricsTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -89,6 +90,11 @@ type Ledger struct {
 	// verifiedTxnCache holds all the verified transactions state
 	verifiedTxnCache verify.VerifiedTransactionCache
 
+	// catchpointProofBuilding is set while the proof database of a catchpoint is being built
+	// for generating account state proofs.
+	catchpointProofBuilding bool
+	catchpointProofMu       deadlock.Mutex
+
 	cfg config.Local
 }
 
@@ -154,6 +160,7 @@ func OpenLedger(
 	}
 
 	l.accts.initialize(cfg, dbPathPrefix)
+	l.acctsHistory.enabled = cfg.EnableAccountHistory
 
 	err = l.reloadLedger()
 	if err != nil {
@@ -195,11 +202,12 @@ func (l *Ledger) reloadLedger() error {
 
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
-		&l.accts,    // update the balances
-		&l.txTail,   // update the transaction tail, tracking the recent 1000 txn
-		&l.bulletin, // provide closed channel signaling support for completed rounds
-		&l.notifier, // send OnNewBlocks to subscribers
-		&l.metrics,  // provides metrics reporting support
+		&l.accts,        // update the balances
+		&l.acctsHistory, // record the versioned account states, relying on the balances being updated first
+		&l.txTail,       // update the transaction tail, tracking the recent 1000 txn
+		&l.bulletin,     // provide closed channel signaling support for completed rounds
+		&l.notifier,     // send OnNewBlocks to subscribers
+		&l.metrics,      // provides metrics reporting support
 	}
 
 	err = l.trackers.initialize(&l.accts, l, trackers)
@@ -508,6 +516,33 @@ func (l *Ledger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ba
 	return data, validThrough, nil
 }
 
+// LookupHistorical is like LookupWithoutRewards, but also answers for rounds older than the
+// ones kept by the accounts tracker using the account history store, when it is enabled.
+func (l *Ledger) LookupHistorical(rnd basics.Round, addr basics.Address) (basics.AccountData, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	data, _, err := l.accts.LookupWithoutRewards(rnd, addr)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) {
+		return l.acctsHistory.lookup(rnd, addr)
+	}
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	return data, nil
+}
+
+// LookupCreator is like GetCreator, but also finds the creators of creatables which were
+// deleted since, using the account history store, when it is enabled.
+func (l *Ledger) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	creator, ok, err := l.GetCreator(cidx, ctype)
+	if err != nil || ok {
+		return creator, ok, err
+	}
+	return l.acctsHistory.lookupCreator(cidx, ctype)
+}
+
 // LatestTotals returns the totals of all accounts for the most recent round, as well as the round number.
 func (l *Ledger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
 	l.trackerMu.RLock()
@@ -533,6 +568,34 @@ func (l *Ledger) CheckDup(currentProto config.ConsensusParams, current basics.Ro
 	return l.txTail.checkDup(currentProto, current, firstValid, lastValid, txid, txl)
 }
 
+// LookupRecentTxid returns a transaction which was confirmed within the rounds tracked by the transaction tail,
+// along with its ApplyData and the round it was confirmed in. Unlike LookupTxid, it doesn't need to be told which
+// round to look in.
+func (l *Ledger) LookupRecentTxid(txid transactions.Txid) (stxn transactions.SignedTxnWithAD, round basics.Round, found bool, err error) {
+	l.trackerMu.RLock()
+	location, found := l.txTail.lookupTxid(txid)
+	l.trackerMu.RUnlock()
+	if !found {
+		return transactions.SignedTxnWithAD{}, 0, false, nil
+	}
+
+	blk, err := l.Block(location.round)
+	if err != nil {
+		return transactions.SignedTxnWithAD{}, 0, false, err
+	}
+	if location.intra >= uint64(len(blk.Payset)) {
+		return transactions.SignedTxnWithAD{}, 0, false, fmt.Errorf("LookupRecentTxid: transaction %v index %d exceeds round %d payset size %d", txid, location.intra, location.round, len(blk.Payset))
+	}
+	stxn.SignedTxn, stxn.ApplyData, err = blk.DecodeSignedTxn(blk.Payset[location.intra])
+	if err != nil {
+		return transactions.SignedTxnWithAD{}, 0, false, err
+	}
+	if stxn.ID() != txid {
+		return transactions.SignedTxnWithAD{}, 0, false, fmt.Errorf("LookupRecentTxid: transaction %d of round %d is %v rather than %v", location.intra, location.round, stxn.ID(), txid)
+	}
+	return stxn, location.round, true, nil
+}
+
 // Latest returns the latest known block round added to the ledger.
 func (l *Ledger) Latest() basics.Round {
 	return l.blockQ.latest()
@@ -727,24 +790,6 @@ func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionP
 	return &vb, nil
 }
 
-// CompactCertParams computes the parameters for building or verifying
-// a compact cert for block hdr, using voters from block votersHdr.
-func CompactCertParams(votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockHeader) (res compactcert.Params, err error) {
-	return internal.CompactCertParams(votersHdr, hdr)
-}
-
-// AcceptableCompactCertWeight computes the acceptable signed weight
-// of a compact cert if it were to appear in a transaction with a
-// particular firstValid round.  Earlier rounds require a smaller cert.
-// votersHdr specifies the block that contains the Merkle commitment of
-// the voters for this compact cert (and thus the compact cert is for
-// votersHdr.Round() + CompactCertRounds).
-//
-// logger must not be nil; use at least logging.Base()
-func AcceptableCompactCertWeight(votersHdr bookkeeping.BlockHeader, firstValid basics.Round, logger logging.Logger) uint64 {
-	return internal.AcceptableCompactCertWeight(votersHdr, firstValid, logger)
-}
-
 // DebuggerLedger defines the minimal set of method required for creating a debug balances.
 type DebuggerLedger = internal.LedgerForCowBase
 
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+//msgp:ignore AccountStateProof
+
+// ErrAccountStateProofLabelMismatch is returned when an account state proof doesn't match the catchpoint label it is verified against.
+var ErrAccountStateProofLabelMismatch = errors.New("account state proof does not match the catchpoint label")
+
+// ErrAccountStateProofNotIncluded is returned when an account state proof shows that the account data is not part of the catchpoint.
+var ErrAccountStateProofNotIncluded = errors.New("account state proof shows the account data is not included in the catchpoint")
+
+// AccountHashBuilder calculates the hash key used for the trie by combining the account address and the account data
+func AccountHashBuilder(addr basics.Address, accountData basics.AccountData, encodedAccountData []byte) []byte {
+	hash := make([]byte, 4+crypto.DigestSize)
+	// write out the lowest 32 bits of the reward base. This should improve the caching of the trie by allowing
+	// recent updated to be in-cache, and "older" nodes will be left alone.
+	for i, rewards := 3, accountData.RewardsBase; i >= 0; i, rewards = i-1, rewards>>8 {
+		// the following takes the rewards & 255 -> hash[i]
+		hash[i] = byte(rewards)
+	}
+	entryHash := crypto.Hash(append(addr[:], encodedAccountData[:]...))
+	copy(hash[4:], entryHash[:])
+	return hash[:]
+}
+
+// AccountStateProof ties the data of a single account to a catchpoint label. It carries the components
+// of the label alongside a merkle proof of the account's entry in the catchpoint balances trie, so that
+// a client can check the account data against a label it trusts without trusting the node serving it.
+type AccountStateProof struct {
+	Address            basics.Address   `codec:"addr"`
+	EncodedAccountData []byte           `codec:"ad"`
+	BlockHeaderDigest  crypto.Digest    `codec:"bhd"`
+	BalancesMerkleRoot crypto.Digest    `codec:"root"`
+	Totals             AccountTotals    `codec:"totals"`
+	Proof              merkletrie.Proof `codec:"proof"`
+}
+
+// VerifyAccountStateProof verifies the given proof against the catchpoint label, and returns the
+// account data it proves.
+func VerifyAccountStateProof(label string, proof *AccountStateProof) (accountData basics.AccountData, err error) {
+	round, labelHash, err := ParseCatchpointLabel(label)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	if MakeCatchpointLabel(round, proof.BlockHeaderDigest, proof.BalancesMerkleRoot, proof.Totals).Hash() != labelHash {
+		return basics.AccountData{}, ErrAccountStateProofLabelMismatch
+	}
+
+	err = protocol.Decode(proof.EncodedAccountData, &accountData)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	included, err := merkletrie.VerifyProof(proof.BalancesMerkleRoot, AccountHashBuilder(proof.Address, accountData, proof.EncodedAccountData), &proof.Proof)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	if !included {
+		return basics.AccountData{}, ErrAccountStateProofNotIncluded
+	}
+	return accountData, nil
+}
@@ -66,13 +66,19 @@ type Txlease struct {
 	Lease  [32]byte
 }
 
+// IncludedTransactions defines the transactions included in a block, their index and last valid round.
+type IncludedTransactions struct {
+	LastValid basics.Round
+	Intra     uint64 // the index of the transaction in the block
+}
+
 // StateDelta describes the delta between a given round to the previous round
 type StateDelta struct {
 	// modified accounts
 	Accts AccountDeltas
 
-	// new Txids for the txtail and TxnCounter, mapped to txn.LastValid
-	Txids map[transactions.Txid]basics.Round
+	// new Txids for the txtail and TxnCounter, mapped to txn.LastValid and the index of the txn in the block
+	Txids map[transactions.Txid]IncludedTransactions
 
 	// new txleases for the txtail mapped to expiration
 	Txleases map[Txlease]basics.Round
@@ -120,7 +126,7 @@ func MakeStateDelta(hdr *bookkeeping.BlockHeader, prevTimestamp int64, hint int,
 			accts:      make([]basics.BalanceRecord, 0, hint*2),
 			acctsCache: make(map[basics.Address]int, hint*2),
 		},
-		Txids:    make(map[transactions.Txid]basics.Round, hint),
+		Txids:    make(map[transactions.Txid]IncludedTransactions, hint),
 		Txleases: make(map[Txlease]basics.Round, hint),
 		// asset or application creation are considered as rare events so do not pre-allocate space for them
 		Creatables:               make(map[basics.CreatableIndex]ModifiedCreatable),
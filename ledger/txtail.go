@@ -30,15 +30,27 @@ import (
 
 const initialLastValidArrayLen = 256
 
+// txTailEntry is used while loading the txTail from disk to collect the transactions sharing the same LastValid
+type txTailEntry struct {
+	txid     transactions.Txid
+	location txTailLocation
+}
+
 type roundTxMembers struct {
 	txleases map[ledgercore.Txlease]basics.Round // map of transaction lease to when it expires
 	proto    config.ConsensusParams
 }
 
+// txTailLocation identifies where a transaction tracked by the txTail was confirmed
+type txTailLocation struct {
+	round basics.Round // the round of the block which included the transaction
+	intra uint64       // the index of the transaction in the block's payset
+}
+
 type txTail struct {
 	recent map[basics.Round]roundTxMembers
 
-	lastValid map[basics.Round]map[transactions.Txid]struct{} // map tx.LastValid -> tx confirmed set
+	lastValid map[basics.Round]map[transactions.Txid]txTailLocation // map tx.LastValid -> tx confirmed set
 
 	// duplicate detection queries with LastValid before
 	// lowWaterMark are not guaranteed to succeed
@@ -60,7 +72,7 @@ func (t *txTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
 	old := (latest + 1).SubSaturate(basics.Round(proto.MaxTxnLife))
 
 	t.lowWaterMark = latest
-	t.lastValid = make(map[basics.Round]map[transactions.Txid]struct{})
+	t.lastValid = make(map[basics.Round]map[transactions.Txid]txTailLocation)
 
 	t.recent = make(map[basics.Round]roundTxMembers)
 
@@ -68,7 +80,7 @@ func (t *txTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
 	// loadFromDisk, allowing us to construct the lastValid maps in their
 	// optimal size. This would ensure that upon startup, we don't preallocate
 	// more memory than we truly need.
-	roundsLastValids := make(map[basics.Round][]transactions.Txid)
+	roundsLastValids := make(map[basics.Round][]txTailEntry)
 
 	for ; old <= latest; old++ {
 		blk, err := l.Block(old)
@@ -87,7 +99,7 @@ func (t *txTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
 			proto:    consensusParams,
 		}
 
-		for _, txad := range payset {
+		for intra, txad := range payset {
 			tx := txad.SignedTxn
 			if consensusParams.SupportTransactionLeases && (tx.Txn.Lease != [32]byte{}) {
 				t.recent[old].txleases[ledgercore.Txlease{Sender: tx.Txn.Sender, Lease: tx.Txn.Lease}] = tx.Txn.LastValid
@@ -96,16 +108,16 @@ func (t *txTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
 				list := roundsLastValids[tx.Txn.LastValid]
 				// if the list reached capacity, resize.
 				if len(list) == cap(list) {
-					var newList []transactions.Txid
+					var newList []txTailEntry
 					if cap(list) == 0 {
-						newList = make([]transactions.Txid, 0, initialLastValidArrayLen)
+						newList = make([]txTailEntry, 0, initialLastValidArrayLen)
 					} else {
-						newList = make([]transactions.Txid, len(list), len(list)*2)
+						newList = make([]txTailEntry, len(list), len(list)*2)
 					}
 					copy(newList[:], list[:])
 					list = newList
 				}
-				list = append(list, tx.ID())
+				list = append(list, txTailEntry{txid: tx.ID(), location: txTailLocation{round: old, intra: uint64(intra)}})
 				roundsLastValids[tx.Txn.LastValid] = list
 			}
 		}
@@ -113,9 +125,9 @@ func (t *txTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
 
 	// add all the entries in roundsLastValids to their corresponding map entry in t.lastValid
 	for lastValid, list := range roundsLastValids {
-		lastValueMap := make(map[transactions.Txid]struct{}, len(list))
-		for _, id := range list {
-			lastValueMap[id] = struct{}{}
+		lastValueMap := make(map[transactions.Txid]txTailLocation, len(list))
+		for _, entry := range list {
+			lastValueMap[entry.txid] = entry.location
 		}
 		t.lastValid[lastValid] = lastValueMap
 	}
@@ -138,8 +150,8 @@ func (t *txTail) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
 		proto:    config.Consensus[blk.CurrentProtocol],
 	}
 
-	for txid, lv := range delta.Txids {
-		t.putLV(lv, txid)
+	for txid, txnInc := range delta.Txids {
+		t.putLV(txnInc.LastValid, txid, txTailLocation{round: rnd, intra: txnInc.Intra})
 	}
 }
 
@@ -210,9 +222,20 @@ func (t *txTail) checkDup(proto config.ConsensusParams, current basics.Round, fi
 	return nil
 }
 
-func (t *txTail) putLV(lastValid basics.Round, id transactions.Txid) {
+// lookupTxid returns the location of a confirmed transaction, if it is still tracked by the txTail.
+// The transaction's LastValid isn't known to the caller, so all the LastValid sets are searched.
+func (t *txTail) lookupTxid(txid transactions.Txid) (txTailLocation, bool) {
+	for _, confirmed := range t.lastValid {
+		if location, ok := confirmed[txid]; ok {
+			return location, true
+		}
+	}
+	return txTailLocation{}, false
+}
+
+func (t *txTail) putLV(lastValid basics.Round, id transactions.Txid, location txTailLocation) {
 	if _, ok := t.lastValid[lastValid]; !ok {
-		t.lastValid[lastValid] = make(map[transactions.Txid]struct{})
+		t.lastValid[lastValid] = make(map[transactions.Txid]txTailLocation)
 	}
-	t.lastValid[lastValid][id] = struct{}{}
+	t.lastValid[lastValid][id] = location
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/protocol"
@@ -58,8 +59,11 @@ func TestTxTailCheckdup(t *testing.T) {
 			},
 		}
 
-		txids := make(map[transactions.Txid]basics.Round, 1)
-		txids[transactions.Txid(crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(1)}))] = rnd + txvalidity
+		txids := make(map[transactions.Txid]ledgercore.IncludedTransactions, 1)
+		txids[transactions.Txid(crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(1)}))] = ledgercore.IncludedTransactions{
+			LastValid: rnd + txvalidity,
+			Intra:     0,
+		}
 		txleases := make(map[ledgercore.Txlease]basics.Round, 1)
 		txleases[ledgercore.Txlease{Sender: basics.Address(crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(2)})), Lease: crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(3)})}] = rnd + leasevalidity
 
@@ -84,6 +88,18 @@ func TestTxTailCheckdup(t *testing.T) {
 		}
 	}
 
+	// test txid lookup
+	for rnd := basics.Round(1); rnd < lastRound; rnd++ {
+		txid := transactions.Txid(crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(1)}))
+		location, found := tail.lookupTxid(txid)
+		if rnd+txvalidity < lastRound-lookback-1 {
+			require.Falsef(t, found, "round %d", rnd)
+		} else {
+			require.Truef(t, found, "round %d", rnd)
+			require.Equal(t, txTailLocation{round: rnd, intra: 0}, location)
+		}
+	}
+
 	// test lease detection
 	for rnd := basics.Round(1); rnd < lastRound; rnd++ {
 		lease := ledgercore.Txlease{Sender: basics.Address(crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(2)})), Lease: crypto.Hash([]byte{byte(rnd % 256), byte(rnd / 256), byte(3)})}
@@ -194,3 +210,76 @@ func TestTxTailLoadFromDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestTxTailLookupTxid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	var ledger txTailTestLedger
+	txtail := txTail{}
+
+	err := txtail.loadFromDisk(&ledger, 0)
+	require.NoError(t, err)
+
+	for r := ledger.Latest() - testTxTailValidityRange + 1; r <= ledger.Latest(); r++ {
+		blk, err := ledger.Block(r)
+		require.NoError(t, err)
+		for i := 0; i < testTxTailTxnPerRound; i += 13 {
+			txid := blk.Payset[i].Txn.ID()
+			location, found := txtail.lookupTxid(txid)
+			require.True(t, found)
+			// identical transactions might appear more than once, so compare the located transaction rather than its index.
+			locatedBlk, err := ledger.Block(location.round)
+			require.NoError(t, err)
+			require.Equal(t, txid, locatedBlk.Payset[location.intra].Txn.ID())
+		}
+	}
+
+	// transactions whose LastValid already passed aren't tracked.
+	blk, err := ledger.Block(ledger.Latest() - testTxTailValidityRange - 1)
+	require.NoError(t, err)
+	_, found := txtail.lookupTxid(blk.Payset[0].Txn.ID())
+	require.False(t, found)
+}
+
+// TestLedgerLookupRecentTxid ensures that transactions evaluated as part of groups are located at their payset index
+func TestLedgerLookupRecentTxid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	pay := func(from, to int, amount uint64) *txntest.Txn {
+		return &txntest.Txn{
+			Type:     "pay",
+			Sender:   addrs[from],
+			Receiver: addrs[to],
+			Amount:   amount,
+		}
+	}
+
+	single := pay(0, 1, 1000)
+	grouped := []*txntest.Txn{pay(1, 2, 2000), pay(2, 3, 3000)}
+	last := pay(3, 4, 4000)
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txn(t, single)
+	require.NoError(t, eval.txgroup(t, grouped...))
+	eval.txn(t, last)
+	vb := l.endBlock(t, eval)
+	require.Len(t, vb.Block().Payset, 4)
+
+	for _, stib := range vb.Block().Payset {
+		expected, expectedAD, err := vb.Block().DecodeSignedTxn(stib)
+		require.NoError(t, err)
+		stxn, round, found, err := l.LookupRecentTxid(expected.ID())
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, vb.Block().Round(), round)
+		require.Equal(t, expected, stxn.SignedTxn)
+		require.Equal(t, expectedAD, stxn.ApplyData)
+	}
+
+	_, _, found, err := l.LookupRecentTxid(transactions.Txid{})
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -25,6 +25,7 @@ import (
 	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
 	"github.com/algorand/go-algorand/rpcs"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/nodecontrol"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
@@ -662,6 +664,105 @@ func (c *Client) AccountInformationV2(account string) (resp generatedV2.Account,
 	return
 }
 
+// AccountInformationV2AtRound takes an address and a round and returns the account information as of that round
+func (c *Client) AccountInformationV2AtRound(account string, round uint64) (resp generatedV2.Account, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountInformationV2AtRound(account, round)
+	}
+	return
+}
+
+// AccountInformationV2WithExclude takes an address and an exclude option and returns its information without the excluded resources
+func (c *Client) AccountInformationV2WithExclude(account string, exclude string) (resp generatedV2.Account, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountInformationV2WithExclude(account, exclude)
+	}
+	return
+}
+
+// AccountAssetInformation takes an address and an asset ID and returns the account's holding and created parameters of the asset
+func (c *Client) AccountAssetInformation(account string, assetID uint64) (resp generatedV2.AccountAssetResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountAssetInformation(account, assetID)
+	}
+	return
+}
+
+// AccountAssetsInformation takes an address and returns a page of its asset holdings
+func (c *Client) AccountAssetsInformation(account string, limit uint64, next string) (resp generatedV2.AccountAssetsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountAssetsInformation(account, limit, next)
+	}
+	return
+}
+
+// AccountCreatedAssets takes an address and returns a page of the assets it created
+func (c *Client) AccountCreatedAssets(account string, limit uint64, next string) (resp generatedV2.AccountCreatedAssetsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountCreatedAssets(account, limit, next)
+	}
+	return
+}
+
+// AccountApplicationInformation takes an address and an application ID and returns the account's local state and created parameters of the application
+func (c *Client) AccountApplicationInformation(account string, applicationID uint64) (resp generatedV2.AccountApplicationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountApplicationInformation(account, applicationID)
+	}
+	return
+}
+
+// AccountApplicationsInformation takes an address and returns a page of its application local states
+func (c *Client) AccountApplicationsInformation(account string, limit uint64, next string) (resp generatedV2.AccountApplicationsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountApplicationsInformation(account, limit, next)
+	}
+	return
+}
+
+// AccountCreatedApplications takes an address and returns a page of the applications it created
+func (c *Client) AccountCreatedApplications(account string, limit uint64, next string) (resp generatedV2.AccountCreatedApplicationsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountCreatedApplications(account, limit, next)
+	}
+	return
+}
+
+// AccountStateProof takes an address and a catchpoint label, and returns the account data at that catchpoint
+// after verifying the proof served by the node against the given catchpoint label
+func (c *Client) AccountStateProof(account string, catchpointLabel string) (accountData basics.AccountData, err error) {
+	round, _, err := ledgercore.ParseCatchpointLabel(catchpointLabel)
+	if err != nil {
+		return
+	}
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	resp, err := algod.AccountStateProof(account, uint64(round))
+	if err != nil {
+		return
+	}
+	var proof ledgercore.AccountStateProof
+	err = protocol.DecodeReflect(resp.Proof, &proof)
+	if err != nil {
+		return
+	}
+	if proof.Address.String() != account {
+		err = fmt.Errorf("account state proof was generated for %s rather than %s", proof.Address.String(), account)
+		return
+	}
+	return ledgercore.VerifyAccountStateProof(catchpointLabel, &proof)
+}
+
 // AccountData takes an address and returns its basics.AccountData
 func (c *Client) AccountData(account string) (accountData basics.AccountData, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -756,6 +857,15 @@ func (c *Client) PendingTransactionInformationV2(txid string) (resp generatedV2.
 	return
 }
 
+// ConfirmedTransactionInformation returns information about a transaction confirmed within the node's transaction tail
+func (c *Client) ConfirmedTransactionInformation(txid string) (resp generatedV2.PendingTransactionResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.ConfirmedTransactionInformation(txid)
+	}
+	return
+}
+
 // Block takes a round and returns its block
 func (c *Client) Block(round uint64) (resp v1.Block, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -967,6 +1077,69 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// AgreementState returns a snapshot of the agreement service state
+func (c *Client) AgreementState() (resp privateV2.AgreementStateResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AgreementState()
+	}
+	return
+}
+
+// Equivocations returns up to limit of the equivocations observed by the node, starting at minRound
+func (c *Client) Equivocations(minRound, limit uint64) (resp privateV2.EquivocationsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.Equivocations(minRound, limit)
+	}
+	return
+}
+
+// Peers returns the list of the peers the node is currently connected to
+func (c *Client) Peers() (resp privateV2.PeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.Peers()
+	}
+	return
+}
+
+// DisconnectPeer disconnects the peer with the given address
+func (c *Client) DisconnectPeer(address string) (resp privateV2.PeerAdminResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.DisconnectPeer(address)
+	}
+	return
+}
+
+// PinPeer asks the node to always maintain a connection to the given relay address
+func (c *Client) PinPeer(address string) (resp privateV2.PeerAdminResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.PinPeer(address)
+	}
+	return
+}
+
+// UnpinPeer removes the given relay address from the pinned peers
+func (c *Client) UnpinPeer(address string) (resp privateV2.PeerAdminResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.UnpinPeer(address)
+	}
+	return
+}
+
+// ReloadPhonebook asks the node to reload its phonebook
+func (c *Client) ReloadPhonebook() (resp privateV2.PeerAdminResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.ReloadPhonebook()
+	}
+	return
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1104,3 +1277,12 @@ func (c *Client) TxnProof(txid string, round uint64) (resp generatedV2.ProofResp
 	}
 	return
 }
+
+// BlockHeaderProof returns the compact cert covering the given round, along with the block headers leading to it.
+func (c *Client) BlockHeaderProof(round uint64) (resp generatedV2.BlockHeaderProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.BlockHeaderProof(round)
+	}
+	return
+}
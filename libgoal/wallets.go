@@ -30,6 +30,12 @@ const (
 
 // CreateWallet creates a kmd wallet with the specified parameters
 func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDerivationKey) ([]byte, error) {
+	return c.CreateWalletWithDerivation(name, password, mdk, "")
+}
+
+// CreateWalletWithDerivation creates a kmd wallet that derives its keys with
+// the specified scheme, or the kmd default if it is empty
+func (c *Client) CreateWalletWithDerivation(name []byte, password []byte, mdk crypto.MasterDerivationKey, derivationScheme string) ([]byte, error) {
 	// Pull the list of all wallets from kmd
 	kmd, err := c.ensureKmdClient()
 	if err != nil {
@@ -37,7 +43,7 @@ func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDer
 	}
 
 	// Create the wallet
-	resp, err := kmd.CreateWallet(name, defaultWalletDriver, password, mdk)
+	resp, err := kmd.CreateWalletWithDerivation(name, defaultWalletDriver, password, mdk, derivationScheme)
 	if err != nil {
 		return nil, err
 	}
@@ -220,3 +226,33 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// DeriveAddress derives the key at a BIP-32 path in a hierarchical
+// deterministic wallet, and returns its address
+func (c *Client) DeriveAddress(wh []byte, path string) (string, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := kmd.DeriveKey(wh, path)
+	if err != nil {
+		return "", err
+	}
+	return resp.Address, nil
+}
+
+// ExportExtendedPublicKey exports the extended public key at a BIP-32 path
+// from a hierarchical deterministic wallet
+func (c *Client) ExportExtendedPublicKey(wh []byte, pw []byte, path string) (xpub crypto.HDPublicKey, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	resp, err := kmd.ExportExtendedPublicKey(wh, pw, path)
+	if err != nil {
+		return
+	}
+	return crypto.HDPublicKeyFromBytes(resp.ExtendedPublicKey)
+}
@@ -175,6 +175,21 @@ type EquivocatedVoteEventDetails struct {
 	PreviousProposalHash2 string
 }
 
+// EquivocationEvidenceEvent event
+const EquivocationEvidenceEvent Event = "EquivocationEvidence"
+
+// EquivocationEvidenceEventDetails contains details for the EquivocationEvidenceEvent
+type EquivocationEvidenceEventDetails struct {
+	VoterAddress  string
+	Round         uint64
+	Period        uint64
+	Step          uint64
+	ProposalHash1 string
+	ProposalHash2 string
+	// Evidence is the base64 encoding of the msgpack encoded agreement.EquivocationEvidence
+	Evidence string
+}
+
 // ConnectPeerEvent event
 const ConnectPeerEvent Event = "ConnectPeer"
 
@@ -328,7 +328,7 @@ func (pm *connectionPerformanceMonitor) pruneOldMessages(now int64) {
 }
 
 func (pm *connectionPerformanceMonitor) accumulateMessage(msg *IncomingMessage, newMessages bool) {
-	msgDigest := generateMessageDigest(msg.Tag, msg.Data)
+	msgDigest := msg.messageDigest()
 
 	var msgBucket *pmPendingMessageBucket
 	var pendingMsg *pmMessage
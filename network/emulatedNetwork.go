@@ -0,0 +1,893 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// emulatedAddressScheme is the scheme of the addresses assigned to the emulated nodes. The host part of the
+// address is the node name.
+const emulatedAddressScheme = "http://"
+
+var errEmulatedNodeUnknown = errors.New("unknown emulated node")
+var errEmulatedNodeUnreachable = errors.New("emulated node is unreachable")
+var errEmulatedPeerClosed = errors.New("emulated peer connection is closed")
+
+// LinkProfile describes the conditions of a directional link between two emulated nodes.
+type LinkProfile struct {
+	// Latency is the delay applied to every message sent over the link.
+	Latency time.Duration
+	// Jitter is the upper bound of a random delay added on top of the latency. Messages sent over the same
+	// link are never reordered.
+	Jitter time.Duration
+	// LossRate is the probability at which a message sent over the link would be dropped.
+	LossRate float64
+}
+
+// EmulatedNetwork is an in-memory network connecting a set of emulated nodes, each implementing GossipNode.
+// Messages are delivered according to a virtual time, subject to the configured link profiles and partitions.
+// All the random decisions are taken out of a seeded source, so that a test driving the virtual time would
+// observe the same network conditions on every run.
+type EmulatedNetwork struct {
+	log  logging.Logger
+	time *timers.VirtualTime
+
+	mu           deadlock.Mutex
+	rand         *rand.Rand
+	nodes        map[string]*EmulatedNode
+	defaultLink  LinkProfile
+	links        map[emulatedLink]LinkProfile
+	lastDelivery map[emulatedLink]time.Duration
+	// partitions maps each node name to its partition group. A nil map means that the network isn't partitioned.
+	partitions map[string]int
+}
+
+// emulatedLink identifies the directional link between two emulated nodes.
+type emulatedLink struct {
+	from string
+	to   string
+}
+
+// MakeEmulatedNetwork creates an empty emulated network, driven by the given virtual time.
+func MakeEmulatedNetwork(log logging.Logger, vt *timers.VirtualTime, seed int64) *EmulatedNetwork {
+	return &EmulatedNetwork{
+		log:          log,
+		time:         vt,
+		rand:         rand.New(rand.NewSource(seed)),
+		nodes:        make(map[string]*EmulatedNode),
+		links:        make(map[emulatedLink]LinkProfile),
+		lastDelivery: make(map[emulatedLink]time.Duration),
+	}
+}
+
+// AddNode creates a new emulated node. As with the websocket network, the node relays messages if it has
+// a NetAddress configured, or if ForceRelayMessages is set.
+func (en *EmulatedNetwork) AddNode(name string, cfg config.Local, genesisID string) (*EmulatedNode, error) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	if _, has := en.nodes[name]; has {
+		return nil, fmt.Errorf("emulated node %s already exists", name)
+	}
+	node := &EmulatedNode{
+		net:       en,
+		name:      name,
+		log:       en.log.With("name", name),
+		config:    cfg,
+		genesisID: genesisID,
+		relay:     cfg.NetAddress != "" || cfg.ForceRelayMessages,
+		router:    mux.NewRouter(),
+		peers:     make(map[string]*emulatedPeer),
+		outgoing:  make(map[string]bool),
+		wake:      make(chan struct{}, 1),
+	}
+	node.handlers.log = node.log
+	node.handlers.ClearHandlers([]Tag{})
+	en.nodes[name] = node
+	return node, nil
+}
+
+// Node returns the emulated node with the given name, or nil if there is no such node.
+func (en *EmulatedNetwork) Node(name string) *EmulatedNode {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	return en.nodes[name]
+}
+
+// SetDefaultLink sets the profile of all the links which don't have a specific profile.
+func (en *EmulatedNetwork) SetDefaultLink(profile LinkProfile) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	en.defaultLink = profile
+}
+
+// SetLink sets the profile of the link carrying the messages sent from one node to another.
+func (en *EmulatedNetwork) SetLink(from, to string, profile LinkProfile) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	en.links[emulatedLink{from: from, to: to}] = profile
+}
+
+// Partition splits the network into the given groups of nodes; messages and http requests between nodes of
+// different groups are dropped, including the ones that are already in flight. Nodes that aren't listed in
+// any of the groups are placed together in an additional group.
+func (en *EmulatedNetwork) Partition(groups ...[]string) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	en.partitions = make(map[string]int)
+	for i, group := range groups {
+		for _, name := range group {
+			en.partitions[name] = i + 1
+		}
+	}
+}
+
+// Heal removes all the partitions.
+func (en *EmulatedNetwork) Heal() {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	en.partitions = nil
+}
+
+// Connect establishes an outgoing connection from one node to another. The connection is remembered, and
+// would be re-established by RequestConnectOutgoing if it gets disconnected.
+func (en *EmulatedNetwork) Connect(from, to string) error {
+	en.mu.Lock()
+	src, dst := en.nodes[from], en.nodes[to]
+	en.mu.Unlock()
+	if src == nil || dst == nil || src == dst {
+		return errEmulatedNodeUnknown
+	}
+	src.mu.Lock()
+	src.outgoing[to] = true
+	src.mu.Unlock()
+	return en.connect(src, dst)
+}
+
+// Idle returns true if all the messages which were delivered so far were handled by their recipients.
+// Messages that are still in flight, waiting for the virtual time to advance, are not taken into account.
+func (en *EmulatedNetwork) Idle() bool {
+	en.mu.Lock()
+	nodes := make([]*EmulatedNode, 0, len(en.nodes))
+	for _, node := range en.nodes {
+		nodes = append(nodes, node)
+	}
+	en.mu.Unlock()
+	for _, node := range nodes {
+		node.mu.Lock()
+		pending := node.pending
+		node.mu.Unlock()
+		if pending > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// reachable returns true if the two nodes are in the same partition group. Should be called with mu held.
+func (en *EmulatedNetwork) reachable(from, to string) bool {
+	if en.partitions == nil {
+		return true
+	}
+	return en.partitions[from] == en.partitions[to]
+}
+
+// link returns the profile of the link between the two nodes. Should be called with mu held.
+func (en *EmulatedNetwork) link(from, to string) LinkProfile {
+	if profile, has := en.links[emulatedLink{from: from, to: to}]; has {
+		return profile
+	}
+	return en.defaultLink
+}
+
+// connect creates a pair of peers for a connection between the two nodes, provided that both are running and
+// can reach each other.
+func (en *EmulatedNetwork) connect(src, dst *EmulatedNode) error {
+	en.mu.Lock()
+	reachable := en.reachable(src.name, dst.name)
+	en.mu.Unlock()
+	if !reachable || !src.isRunning() || !dst.isRunning() {
+		return errEmulatedNodeUnreachable
+	}
+
+	out := &emulatedPeer{node: src, remote: dst, outgoing: true}
+	in := &emulatedPeer{node: dst, remote: src, outgoing: false}
+	out.counterpart, in.counterpart = in, out
+	if !src.addPeer(out) {
+		// already connected.
+		return nil
+	}
+	if !dst.addPeer(in) {
+		src.removePeer(out)
+	}
+	return nil
+}
+
+// disconnect closes both ends of the connection represented by the given peer.
+func (en *EmulatedNetwork) disconnect(peer *emulatedPeer) {
+	en.log.Debugf("emulated network: disconnecting %s from %s", peer.node.name, peer.remote.name)
+	peer.node.removePeer(peer)
+	peer.remote.removePeer(peer.counterpart)
+}
+
+// send schedules the delivery of a message over the connection represented by the given peer. The message
+// is silently dropped if the link loses it, or if the nodes get partitioned before it is delivered.
+func (en *EmulatedNetwork) send(peer *emulatedPeer, tag protocol.Tag, data []byte) {
+	from, to := peer.node.name, peer.remote.name
+	en.mu.Lock()
+	profile := en.link(from, to)
+	if !en.reachable(from, to) || (profile.LossRate > 0 && en.rand.Float64() < profile.LossRate) {
+		en.mu.Unlock()
+		return
+	}
+	delay := profile.Latency
+	if profile.Jitter > 0 {
+		delay += time.Duration(en.rand.Int63n(int64(profile.Jitter)))
+	}
+	// messages over the same link are delivered in order.
+	now := en.time.Now()
+	l := emulatedLink{from: from, to: to}
+	deliverAt := now + delay
+	if deliverAt < en.lastDelivery[l] {
+		deliverAt = en.lastDelivery[l]
+	}
+	en.lastDelivery[l] = deliverAt
+	en.mu.Unlock()
+
+	en.time.AfterFunc(deliverAt-now, func() {
+		en.mu.Lock()
+		reachable := en.reachable(from, to)
+		en.mu.Unlock()
+		if reachable {
+			peer.remote.deliver(peer.counterpart, tag, data)
+		}
+	})
+}
+
+// roundTripDelay returns the virtual delay of a request-response exchange between the two nodes. Should be
+// called with mu held.
+func (en *EmulatedNetwork) roundTripDelay(from, to string) time.Duration {
+	return en.link(from, to).Latency + en.link(to, from).Latency
+}
+
+var _ GossipNode = &EmulatedNode{}
+var _ UnicastPeer = &emulatedPeer{}
+var _ HTTPPeer = &emulatedPeer{}
+var _ HTTPPeer = &emulatedHTTPPeer{}
+
+// EmulatedNode is a GossipNode connected to an EmulatedNetwork.
+type EmulatedNode struct {
+	net       *EmulatedNetwork
+	name      string
+	log       logging.Logger
+	config    config.Local
+	genesisID string
+	relay     bool
+	handlers  Multiplexer
+	router    *mux.Router
+
+	mu       deadlock.Mutex
+	running  bool
+	ready    chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	peers    map[string]*emulatedPeer
+	outgoing map[string]bool
+	// messagesOfInterest is the set of tags this node wants to receive; nil means all the tags.
+	messagesOfInterest map[protocol.Tag]bool
+	// incoming holds the delivered messages waiting to be handled, and pending counts these along with the one
+	// being handled.
+	incoming []IncomingMessage
+	pending  int
+	wake     chan struct{}
+	done     chan struct{}
+}
+
+// Name returns the name of the node within the emulated network.
+func (node *EmulatedNode) Name() string {
+	return node.name
+}
+
+// Address implements GossipNode
+func (node *EmulatedNode) Address() (string, bool) {
+	return emulatedAddressScheme + node.name, true
+}
+
+// Broadcast implements GossipNode
+func (node *EmulatedNode) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return node.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
+}
+
+// BroadcastArray implements GossipNode
+func (node *EmulatedNode) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if len(tags) != len(data) {
+		return errBcastInvalidArray
+	}
+	for _, peer := range node.connectedPeers() {
+		if Peer(peer) == except {
+			continue
+		}
+		for i, tag := range tags {
+			if !peer.remote.interestedIn(tag) {
+				continue
+			}
+			node.net.send(peer, tag, append([]byte(nil), data[i]...))
+		}
+	}
+	return nil
+}
+
+// Relay implements GossipNode
+func (node *EmulatedNode) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if node.relay {
+		return node.Broadcast(ctx, tag, data, wait, except)
+	}
+	return nil
+}
+
+// RelayArray implements GossipNode
+func (node *EmulatedNode) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if node.relay {
+		return node.BroadcastArray(ctx, tags, data, wait, except)
+	}
+	return nil
+}
+
+// Disconnect implements GossipNode
+func (node *EmulatedNode) Disconnect(badnode Peer) {
+	if peer, ok := badnode.(*emulatedPeer); ok && peer.node == node {
+		node.net.disconnect(peer)
+	}
+}
+
+// DisconnectPeers implements GossipNode
+func (node *EmulatedNode) DisconnectPeers() {
+	for _, peer := range node.connectedPeers() {
+		node.net.disconnect(peer)
+	}
+}
+
+// Ready implements GossipNode
+func (node *EmulatedNode) Ready() chan struct{} {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.ready == nil {
+		node.ready = make(chan struct{})
+	}
+	return node.ready
+}
+
+// RegisterHTTPHandler implements GossipNode
+func (node *EmulatedNode) RegisterHTTPHandler(path string, handler http.Handler) {
+	node.router.Handle(path, handler)
+}
+
+// RequestConnectOutgoing re-establishes the outgoing connections that were made by EmulatedNetwork.Connect
+// and got disconnected since, as long as the remote nodes are reachable.
+func (node *EmulatedNode) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	if replace {
+		for _, peer := range node.connectedPeers() {
+			if peer.outgoing {
+				node.net.disconnect(peer)
+			}
+		}
+	}
+	node.mu.Lock()
+	targets := make([]string, 0, len(node.outgoing))
+	for name := range node.outgoing {
+		if _, connected := node.peers[name]; !connected {
+			targets = append(targets, name)
+		}
+	}
+	node.mu.Unlock()
+	for _, name := range targets {
+		if remote := node.net.Node(name); remote != nil {
+			node.net.connect(node, remote)
+		}
+	}
+}
+
+// GetPeers implements GossipNode. The phonebook options return all the other nodes of the emulated network
+// which have the matching role, regardless of whether they are reachable.
+func (node *EmulatedNode) GetPeers(options ...PeerOption) []Peer {
+	outPeers := make([]Peer, 0)
+	for _, option := range options {
+		switch option {
+		case PeersConnectedOut, PeersConnectedIn:
+			for _, peer := range node.connectedPeers() {
+				if peer.outgoing == (option == PeersConnectedOut) {
+					outPeers = append(outPeers, peer)
+				}
+			}
+		case PeersPhonebookRelays, PeersPhonebookArchivers:
+			for _, other := range node.net.sortedNodes() {
+				if other == node {
+					continue
+				}
+				if (option == PeersPhonebookRelays && other.relay) || (option == PeersPhonebookArchivers && other.config.Archival) {
+					outPeers = append(outPeers, &emulatedHTTPPeer{node: node, address: emulatedAddressScheme + other.name})
+				}
+			}
+		}
+	}
+	return outPeers
+}
+
+// Start implements GossipNode
+func (node *EmulatedNode) Start() {
+	node.mu.Lock()
+	if node.running {
+		node.mu.Unlock()
+		return
+	}
+	node.running = true
+	node.ctx, node.cancel = context.WithCancel(context.Background())
+	node.done = make(chan struct{})
+	if node.ready == nil {
+		node.ready = make(chan struct{})
+	}
+	close(node.ready)
+	node.mu.Unlock()
+
+	go node.messageHandlerThread(node.ctx, node.done)
+	node.RequestConnectOutgoing(false, nil)
+}
+
+// Stop implements GossipNode
+func (node *EmulatedNode) Stop() {
+	node.mu.Lock()
+	if !node.running {
+		node.mu.Unlock()
+		return
+	}
+	node.running = false
+	node.cancel()
+	done := node.done
+	node.ready = nil
+	node.mu.Unlock()
+
+	<-done
+	node.DisconnectPeers()
+
+	node.mu.Lock()
+	node.incoming = nil
+	node.pending = 0
+	node.mu.Unlock()
+	node.handlers.ClearHandlers([]Tag{})
+}
+
+// RegisterHandlers implements GossipNode
+func (node *EmulatedNode) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	node.handlers.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers implements GossipNode
+func (node *EmulatedNode) ClearHandlers() {
+	node.handlers.ClearHandlers([]Tag{})
+}
+
+// GetRoundTripper implements GossipNode. The returned transport serves the requests using the http handlers
+// registered by the target node, after a virtual round trip delay.
+func (node *EmulatedNode) GetRoundTripper() http.RoundTripper {
+	return &emulatedTransport{node: node}
+}
+
+// OnNetworkAdvance implements GossipNode
+func (node *EmulatedNode) OnNetworkAdvance() {}
+
+// GetHTTPRequestConnection implements GossipNode. There are no underlying connections in the emulated network.
+func (node *EmulatedNode) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return nil
+}
+
+// RegisterMessageInterest implements GossipNode
+func (node *EmulatedNode) RegisterMessageInterest(tag protocol.Tag) error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.messagesOfInterest == nil {
+		node.messagesOfInterest = make(map[protocol.Tag]bool)
+		for tag, flag := range defaultSendMessageTags {
+			node.messagesOfInterest[tag] = flag
+		}
+	}
+	node.messagesOfInterest[tag] = true
+	return nil
+}
+
+// SubstituteGenesisID implements GossipNode
+func (node *EmulatedNode) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", node.genesisID, -1)
+}
+
+// GetPeerData implements GossipNode
+func (node *EmulatedNode) GetPeerData(peer Peer, key string) interface{} {
+	p, ok := peer.(*emulatedPeer)
+	if !ok {
+		return nil
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return p.data[key]
+}
+
+// SetPeerData implements GossipNode
+func (node *EmulatedNode) SetPeerData(peer Peer, key string, value interface{}) {
+	p, ok := peer.(*emulatedPeer)
+	if !ok {
+		return
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if p.data == nil {
+		p.data = make(map[string]interface{})
+	}
+	p.data[key] = value
+}
+
+func (node *EmulatedNode) isRunning() bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.running
+}
+
+// interestedIn returns true if the node wants to receive messages with the given tag.
+func (node *EmulatedNode) interestedIn(tag protocol.Tag) bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.messagesOfInterest == nil || node.messagesOfInterest[tag]
+}
+
+// connectedPeers returns the connected peers, sorted by their remote node name.
+func (node *EmulatedNode) connectedPeers() []*emulatedPeer {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	peers := make([]*emulatedPeer, 0, len(node.peers))
+	for _, peer := range node.peers {
+		peers = append(peers, peer)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].remote.name < peers[j].remote.name
+	})
+	return peers
+}
+
+// addPeer adds the given peer, unless the node is already connected to the same remote node.
+func (node *EmulatedNode) addPeer(peer *emulatedPeer) bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if _, has := node.peers[peer.remote.name]; has {
+		return false
+	}
+	peer.closing = make(chan struct{})
+	node.peers[peer.remote.name] = peer
+	return true
+}
+
+func (node *EmulatedNode) removePeer(peer *emulatedPeer) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.peers[peer.remote.name] != peer {
+		return
+	}
+	delete(node.peers, peer.remote.name)
+	close(peer.closing)
+}
+
+// deliver queues an incoming message received from the given peer. Responses to requests are dispatched
+// right away, as these don't go through the message handlers.
+func (node *EmulatedNode) deliver(sender *emulatedPeer, tag protocol.Tag, data []byte) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if !node.running || node.peers[sender.remote.name] != sender {
+		// the connection was closed while the message was in flight.
+		return
+	}
+	if tag == protocol.TopicMsgRespTag {
+		sender.dispatchResponse(data)
+		return
+	}
+	if sender.incomingSequence == nil {
+		sender.incomingSequence = make(map[protocol.Tag]uint64)
+	}
+	msg := IncomingMessage{
+		Sender:   sender,
+		Tag:      tag,
+		Data:     data,
+		Net:      node,
+		Sequence: sender.incomingSequence[tag],
+		Received: time.Now().UnixNano(),
+	}
+	sender.incomingSequence[tag]++
+	node.incoming = append(node.incoming, msg)
+	node.pending++
+	select {
+	case node.wake <- struct{}{}:
+	default:
+	}
+}
+
+// messageHandlerThread passes the incoming messages to the registered handlers, one at a time, in the order
+// these were delivered.
+func (node *EmulatedNode) messageHandlerThread(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	for {
+		node.mu.Lock()
+		if len(node.incoming) == 0 {
+			node.mu.Unlock()
+			select {
+			case <-node.wake:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		msg := node.incoming[0]
+		node.incoming = node.incoming[1:]
+		node.mu.Unlock()
+
+		outmsg := node.handlers.Handle(msg)
+		switch outmsg.Action {
+		case Disconnect:
+			node.Disconnect(msg.Sender)
+		case Broadcast:
+			node.Broadcast(ctx, msg.Tag, msg.Data, false, msg.Sender)
+		case Respond:
+			err := msg.Sender.(*emulatedPeer).Respond(ctx, msg, outmsg.Topics)
+			if err != nil && err != ctx.Err() {
+				node.log.Warnf("EmulatedNode.messageHandlerThread: Respond returned unexpected error %v", err)
+			}
+		default:
+		}
+
+		node.mu.Lock()
+		if node.pending > 0 {
+			node.pending--
+		}
+		node.mu.Unlock()
+	}
+}
+
+// sortedNodes returns all the nodes of the network, sorted by name.
+func (en *EmulatedNetwork) sortedNodes() []*EmulatedNode {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	nodes := make([]*EmulatedNode, 0, len(en.nodes))
+	for _, node := range en.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].name < nodes[j].name
+	})
+	return nodes
+}
+
+// emulatedPeer is one end of a connection between two emulated nodes, as seen by the local node.
+type emulatedPeer struct {
+	node        *EmulatedNode
+	remote      *EmulatedNode
+	counterpart *emulatedPeer
+	outgoing    bool
+	closing     chan struct{}
+
+	// the following are guarded by the mutex of the local node.
+	data             map[string]interface{}
+	incomingSequence map[protocol.Tag]uint64
+	outgoingSequence map[protocol.Tag]uint64
+	requestNonce     uint64
+	responseChannels map[uint64]chan *Response
+}
+
+// GetAddress returns the address of the remote node.
+func (peer *emulatedPeer) GetAddress() string {
+	return emulatedAddressScheme + peer.remote.name
+}
+
+// GetHTTPClient returns a client which sends its requests to the remote node over the emulated network.
+func (peer *emulatedPeer) GetHTTPClient() *http.Client {
+	return &http.Client{Transport: peer.node.GetRoundTripper()}
+}
+
+// Unicast sends the given bytes to the remote node.
+func (peer *emulatedPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag, callback UnicastWebsocketMessageStateCallback) error {
+	select {
+	case <-peer.closing:
+		return fmt.Errorf("emulated peer failed to unicast: %v", peer.GetAddress())
+	default:
+	}
+	if callback != nil {
+		peer.node.mu.Lock()
+		if peer.outgoingSequence == nil {
+			peer.outgoingSequence = make(map[protocol.Tag]uint64)
+		}
+		seq := peer.outgoingSequence[tag]
+		peer.outgoingSequence[tag] = seq + 1
+		peer.node.mu.Unlock()
+		if callback(true, seq) != nil {
+			peer.node.net.disconnect(peer)
+			return nil
+		}
+	}
+	if peer.remote.interestedIn(tag) {
+		peer.node.net.send(peer, tag, append([]byte(nil), data...))
+	}
+	return nil
+}
+
+// Version returns the protocol version of the connection.
+func (peer *emulatedPeer) Version() string {
+	return ProtocolVersion
+}
+
+// Request sends the request to the remote node, and waits for its response.
+func (peer *emulatedPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Response, e error) {
+	peer.node.mu.Lock()
+	peer.requestNonce++
+	nonce := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(nonce, peer.requestNonce)
+	peer.node.mu.Unlock()
+
+	topics = append(topics, Topic{key: "nonce", data: nonce})
+	serializedMsg := topics.MarshallTopics()
+	hash := hashTopics(serializedMsg)
+
+	responseChannel := make(chan *Response, 1)
+	peer.node.mu.Lock()
+	if peer.responseChannels == nil {
+		peer.responseChannels = make(map[uint64]chan *Response)
+	}
+	peer.responseChannels[hash] = responseChannel
+	peer.node.mu.Unlock()
+	defer func() {
+		peer.node.mu.Lock()
+		delete(peer.responseChannels, hash)
+		peer.node.mu.Unlock()
+	}()
+
+	peer.node.net.send(peer, tag, serializedMsg)
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-peer.closing:
+		return nil, errEmulatedPeerClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response of a request message to the remote node.
+func (peer *emulatedPeer) Respond(ctx context.Context, reqMsg IncomingMessage, responseTopics Topics) (e error) {
+	requestHash := hashTopics(reqMsg.Data)
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, requestHash)
+	responseTopics = append(responseTopics, Topic{key: requestHashKey, data: requestHashData})
+
+	select {
+	case <-peer.closing:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	peer.node.net.send(peer, protocol.TopicMsgRespTag, responseTopics.MarshallTopics())
+	return nil
+}
+
+// IsOutgoing returns true if the local node initiated the connection.
+func (peer *emulatedPeer) IsOutgoing() bool {
+	return peer.outgoing
+}
+
+// GetConnectionLatency returns the configured latency of the link to the remote node.
+func (peer *emulatedPeer) GetConnectionLatency() time.Duration {
+	peer.node.net.mu.Lock()
+	defer peer.node.net.mu.Unlock()
+	return peer.node.net.link(peer.node.name, peer.remote.name).Latency
+}
+
+// dispatchResponse passes a response to the pending request it answers. Should be called with the mutex of
+// the local node held.
+func (peer *emulatedPeer) dispatchResponse(data []byte) {
+	topics, err := UnmarshallTopics(data)
+	if err != nil {
+		peer.node.log.Warnf("emulated peer: could not read the response from %s : %v", peer.remote.name, err)
+		return
+	}
+	requestHash, found := topics.GetValue(requestHashKey)
+	if !found {
+		peer.node.log.Warnf("emulated peer: response from %s is missing the %s", peer.remote.name, requestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	channel, found := peer.responseChannels[hashKey]
+	if !found {
+		peer.node.log.Warnf("emulated peer: received a response from %s for a stale request", peer.remote.name)
+		return
+	}
+	delete(peer.responseChannels, hashKey)
+	channel <- &Response{Topics: topics}
+}
+
+// emulatedHTTPPeer is a phonebook entry of the emulated network, which can only be reached over http.
+type emulatedHTTPPeer struct {
+	node    *EmulatedNode
+	address string
+}
+
+// GetAddress returns the address of the remote node.
+func (peer *emulatedHTTPPeer) GetAddress() string {
+	return peer.address
+}
+
+// GetHTTPClient returns a client which sends its requests to the remote node over the emulated network.
+func (peer *emulatedHTTPPeer) GetHTTPClient() *http.Client {
+	return &http.Client{Transport: peer.node.GetRoundTripper()}
+}
+
+// emulatedTransport is an http.RoundTripper which serves the requests using the handlers registered by
+// the target emulated node.
+type emulatedTransport struct {
+	node *EmulatedNode
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *emulatedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	en := t.node.net
+	target := en.Node(request.URL.Host)
+	if target == nil {
+		return nil, errEmulatedNodeUnknown
+	}
+	en.mu.Lock()
+	reachable := en.reachable(t.node.name, target.name)
+	delay := en.roundTripDelay(t.node.name, target.name)
+	en.mu.Unlock()
+	if !reachable || !target.isRunning() {
+		return nil, errEmulatedNodeUnreachable
+	}
+
+	select {
+	case <-en.time.After(delay):
+	case <-request.Context().Done():
+		return nil, request.Context().Err()
+	}
+
+	served := request.Clone(request.Context())
+	served.RemoteAddr = t.node.name
+	served.RequestURI = request.URL.RequestURI()
+	recorder := httptest.NewRecorder()
+	target.router.ServeHTTP(recorder, served)
+	response := recorder.Result()
+	response.Request = request
+	return response, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+type emulatedReceiver struct {
+	received chan string
+	action   ForwardingPolicy
+}
+
+func (r *emulatedReceiver) Handle(message IncomingMessage) OutgoingMessage {
+	r.received <- string(message.Data)
+	return OutgoingMessage{Action: r.action}
+}
+
+func makeTestEmulatedNetwork(t *testing.T, names ...string) (*EmulatedNetwork, *timers.VirtualTime, map[string]*emulatedReceiver) {
+	vt := timers.MakeVirtualTime()
+	en := MakeEmulatedNetwork(logging.TestingLog(t), vt, 1)
+	receivers := make(map[string]*emulatedReceiver)
+	for _, name := range names {
+		cfg := config.GetDefaultLocal()
+		if name == "relay" {
+			cfg.NetAddress = ":4160"
+		}
+		node, err := en.AddNode(name, cfg, "test-genesis")
+		require.NoError(t, err)
+		receivers[name] = &emulatedReceiver{received: make(chan string, 100)}
+		if name == "relay" {
+			receivers[name].action = Broadcast
+		}
+		node.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: receivers[name]}})
+		node.Start()
+	}
+	return en, vt, receivers
+}
+
+// advance moves the virtual time forward in small steps, letting the nodes handle the delivered messages.
+func advance(en *EmulatedNetwork, vt *timers.VirtualTime, d time.Duration) {
+	const step = time.Millisecond
+	for elapsed := time.Duration(0); elapsed < d; elapsed += step {
+		vt.Advance(step)
+		for !en.Idle() {
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func received(r *emulatedReceiver) []string {
+	var out []string
+	for {
+		select {
+		case msg := <-r.received:
+			out = append(out, msg)
+		default:
+			return out
+		}
+	}
+}
+
+func TestEmulatedNetworkLatencyAndRelay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	en, vt, receivers := makeTestEmulatedNetwork(t, "a", "b", "relay")
+	require.NoError(t, en.Connect("a", "relay"))
+	require.NoError(t, en.Connect("b", "relay"))
+	en.SetDefaultLink(LinkProfile{Latency: 50 * time.Millisecond})
+
+	a := en.Node("a")
+	require.Len(t, a.GetPeers(PeersConnectedOut), 1)
+	require.Len(t, en.Node("relay").GetPeers(PeersConnectedIn), 2)
+	require.Len(t, a.GetPeers(PeersPhonebookRelays), 1)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, a.Broadcast(context.Background(), protocol.TxnTag, []byte(fmt.Sprintf("msg%d", i)), false, nil))
+	}
+	advance(en, vt, 49*time.Millisecond)
+	require.Empty(t, received(receivers["relay"]))
+
+	advance(en, vt, time.Millisecond)
+	require.Equal(t, []string{"msg0", "msg1", "msg2"}, received(receivers["relay"]))
+	require.Empty(t, received(receivers["b"]))
+
+	// the relay forwards the messages to b, but not back to a.
+	advance(en, vt, 50*time.Millisecond)
+	require.Equal(t, []string{"msg0", "msg1", "msg2"}, received(receivers["b"]))
+	require.Empty(t, received(receivers["a"]))
+}
+
+func TestEmulatedNetworkPartitionAndLoss(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	en, vt, receivers := makeTestEmulatedNetwork(t, "a", "b", "c")
+	require.NoError(t, en.Connect("a", "b"))
+	require.NoError(t, en.Connect("a", "c"))
+	en.SetDefaultLink(LinkProfile{Latency: 10 * time.Millisecond})
+	a := en.Node("a")
+
+	// messages in flight are dropped once the nodes get partitioned.
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("before"), false, nil)
+	en.Partition([]string{"a", "b"})
+	advance(en, vt, 10*time.Millisecond)
+	require.Equal(t, []string{"before"}, received(receivers["b"]))
+	require.Empty(t, received(receivers["c"]))
+
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("during"), false, nil)
+	advance(en, vt, 10*time.Millisecond)
+	require.Equal(t, []string{"during"}, received(receivers["b"]))
+	require.Empty(t, received(receivers["c"]))
+
+	en.Heal()
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("after"), false, nil)
+	advance(en, vt, 10*time.Millisecond)
+	require.Equal(t, []string{"after"}, received(receivers["b"]))
+	require.Equal(t, []string{"after"}, received(receivers["c"]))
+
+	// a lossy link drops roughly the configured fraction of the messages.
+	en.SetLink("a", "c", LinkProfile{Latency: 10 * time.Millisecond, LossRate: 0.5})
+	for i := 0; i < 100; i++ {
+		a.Broadcast(context.Background(), protocol.TxnTag, []byte("lossy"), false, nil)
+	}
+	advance(en, vt, 10*time.Millisecond)
+	require.Len(t, received(receivers["b"]), 100)
+	lost := 100 - len(received(receivers["c"]))
+	require.True(t, lost > 25 && lost < 75, "lost %d", lost)
+}
+
+func TestEmulatedNetworkRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	en, vt, _ := makeTestEmulatedNetwork(t, "a", "b")
+	require.NoError(t, en.Connect("a", "b"))
+	en.SetDefaultLink(LinkProfile{Latency: 10 * time.Millisecond})
+	a, b := en.Node("a"), en.Node("b")
+
+	// topic requests are answered by the handlers of the remote node.
+	b.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		return OutgoingMessage{Action: Respond, Topics: Topics{MakeTopic("answer", []byte("42"))}}
+	})}})
+	b.RegisterHTTPHandler("/v1/{genesisID}/hello", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello " + r.RemoteAddr))
+	}))
+
+	peer := a.GetPeers(PeersConnectedOut)[0].(UnicastPeer)
+	responses := make(chan *Response, 1)
+	go func() {
+		resp, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, Topics{})
+		if err != nil {
+			resp = &Response{}
+		}
+		responses <- resp
+	}()
+	var resp *Response
+	for resp == nil {
+		advance(en, vt, time.Millisecond)
+		select {
+		case resp = <-responses:
+		default:
+		}
+	}
+	answer, found := resp.Topics.GetValue("answer")
+	require.True(t, found)
+	require.Equal(t, "42", string(answer))
+
+	type httpResult struct {
+		body string
+		err  error
+	}
+	results := make(chan httpResult, 1)
+	get := func() {
+		httpPeer := peer.(HTTPPeer)
+		response, err := httpPeer.GetHTTPClient().Get(a.SubstituteGenesisID(httpPeer.GetAddress() + "/v1/{genesisID}/hello"))
+		if err != nil {
+			results <- httpResult{err: err}
+			return
+		}
+		defer response.Body.Close()
+		body, err := ioutil.ReadAll(response.Body)
+		results <- httpResult{body: string(body), err: err}
+	}
+	go get()
+	var result *httpResult
+	for result == nil {
+		advance(en, vt, time.Millisecond)
+		select {
+		case r := <-results:
+			result = &r
+		default:
+		}
+	}
+	require.NoError(t, result.err)
+	require.Equal(t, "hello a", result.body)
+
+	// http requests across a partition fail.
+	en.Partition([]string{"a"}, []string{"b"})
+	go get()
+	require.Error(t, (<-results).err)
+}
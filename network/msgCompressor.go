@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/compress"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// CompressionHeader HTTP header by which a peer advertises the per-message compression formats it supports.
+const CompressionHeader = "X-Algorand-Compression"
+
+// compressionFormatDeflateName is the name of the deflate ( gzip ) compression format, as advertised in the CompressionHeader.
+const compressionFormatDeflateName = "deflate"
+
+// The compression format byte follows the tag of every framed message.
+const (
+	compressionFormatNone    byte = 0
+	compressionFormatDeflate byte = 1
+)
+
+// gzipMinLength is the size of the gzip header and trailer; a valid gzip stream can't be shorter than that.
+const gzipMinLength = 18
+
+// compressionLevel is the libdeflate compression level used for outgoing messages. The lowest level provides most of
+// the size reduction of the msgpack encoded messages, at a fraction of the cpu cost of the higher levels.
+const compressionLevel = 1
+
+// compressibleTags are the message tags that are framed with a compression format byte when exchanged with a peer
+// that has negotiated compression. The set is part of the wire protocol, and is not affected by the local compression
+// policy, which only determines which of these would actually get compressed.
+var compressibleTags = map[protocol.Tag]bool{
+	protocol.ProposalPayloadTag: true,
+	protocol.TxnTag:             true,
+}
+
+var networkCompressedMessagesSentTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_compressed_messages_sent_total", Description: "Number of compressed messages sent to peers"})
+var networkCompressionSavedBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_compression_saved_bytes_total", Description: "Number of bytes saved by compressing outgoing messages"})
+var networkCompressionSavedBytesByTag = metrics.NewTagCounter("algod_network_compression_saved_bytes_{TAG}", "Number of bytes saved by compressing outgoing messages per message tag")
+var networkDecompressedMessagesReceivedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_decompressed_messages_received_total", Description: "Number of compressed messages received from peers"})
+
+var errCompressedMessageTooShort = errors.New("compressed message is too short")
+var errCompressedMessageTooLong = errors.New("compressed message would decompress beyond the maximum message length")
+
+// msgCompressor applies the local compression policy to outgoing messages, and decodes the framed incoming messages.
+type msgCompressor struct {
+	// enabled is true if compression is advertised and used.
+	enabled bool
+	// threshold is the minimal message size to be considered for compression.
+	threshold int
+	// tags are the message tags the local policy wants to compress.
+	tags map[protocol.Tag]bool
+}
+
+// makeMsgCompressor creates a msgCompressor out of the given configuration. Tags that aren't compressible are ignored.
+func makeMsgCompressor(cfg config.Local, log logging.Logger) msgCompressor {
+	mc := msgCompressor{
+		enabled:   cfg.EnableGossipCompression,
+		threshold: int(cfg.GossipCompressionThreshold),
+		tags:      make(map[protocol.Tag]bool),
+	}
+	for _, tag := range strings.Split(cfg.GossipCompressionTags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if !compressibleTags[protocol.Tag(tag)] {
+			log.Warnf("GossipCompressionTags contains tag '%s' which isn't compressible; ignoring", tag)
+			continue
+		}
+		mc.tags[protocol.Tag(tag)] = true
+	}
+	return mc
+}
+
+// setHeader advertises the supported compression format, if compression is enabled.
+func (mc *msgCompressor) setHeader(header http.Header) {
+	if mc.enabled {
+		header.Set(CompressionHeader, compressionFormatDeflateName)
+	}
+}
+
+// negotiate returns true if compression is enabled locally, and the other side has advertised the supported format.
+func (mc *msgCompressor) negotiate(otherHeader http.Header) bool {
+	if !mc.enabled {
+		return false
+	}
+	for _, format := range strings.Split(otherHeader.Get(CompressionHeader), ",") {
+		if strings.TrimSpace(format) == compressionFormatDeflateName {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldCompress returns true if the local policy calls for compressing a message with the given tag and payload length.
+func (mc *msgCompressor) shouldCompress(tag protocol.Tag, length int) bool {
+	return mc.enabled && mc.tags[tag] && length >= mc.threshold
+}
+
+// frame converts the given message ( tag followed by the payload ) into the framed representation sent to peers that
+// have negotiated compression. The payload would be compressed if the local policy calls for it, and if the compression
+// actually reduces its size.
+func (mc *msgCompressor) frame(mbytes []byte) []byte {
+	tag := protocol.Tag(mbytes[:2])
+	payload := mbytes[2:]
+	if mc.shouldCompress(tag, len(payload)) && len(payload) > 0 {
+		out := make([]byte, 3, 3+len(payload))
+		copy(out, mbytes[:2])
+		out[2] = compressionFormatDeflate
+		_, compressed, err := compress.Compress(payload, out[3:3:cap(out)], compressionLevel)
+		if err == nil && len(compressed) < len(payload) {
+			return out[:3+len(compressed)]
+		}
+		// compression wasn't effective; fall back to sending the payload as is.
+	}
+	out := make([]byte, 3+len(payload))
+	copy(out, mbytes[:2])
+	out[2] = compressionFormatNone
+	copy(out[3:], payload)
+	return out
+}
+
+// unframe decodes the framed payload of an incoming message, decompressing it if needed.
+func unframe(data []byte) ([]byte, bool, error) {
+	if len(data) < 1 {
+		return nil, false, errCompressedMessageTooShort
+	}
+	switch data[0] {
+	case compressionFormatNone:
+		return data[1:], false, nil
+	case compressionFormatDeflate:
+		payload := data[1:]
+		if len(payload) < gzipMinLength {
+			return nil, true, errCompressedMessageTooShort
+		}
+		// the gzip trailer ends with the size of the uncompressed data.
+		uncompressedLength := binary.LittleEndian.Uint32(payload[len(payload)-4:])
+		if uncompressedLength == 0 || uncompressedLength > maxMessageLength {
+			return nil, true, errCompressedMessageTooLong
+		}
+		decompressed, err := compress.Decompress(payload, make([]byte, 0, uncompressedLength))
+		if err != nil {
+			return nil, true, err
+		}
+		if len(decompressed) != int(uncompressedLength) {
+			return nil, true, fmt.Errorf("decompressed message length mismatch: expected %d, actual %d", uncompressedLength, len(decompressed))
+		}
+		return decompressed, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported compression format %d", data[0])
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeCompressibleMessage(tag protocol.Tag, length int) []byte {
+	msg := make([]byte, 2+length)
+	copy(msg, tag)
+	for i := 2; i < len(msg); i++ {
+		msg[i] = byte(i % 7)
+	}
+	return msg
+}
+
+func TestMsgCompressorFrameUnframe(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableGossipCompression = true
+	cfg.GossipCompressionThreshold = 1024
+	cfg.GossipCompressionTags = "PP"
+	mc := makeMsgCompressor(cfg, logging.TestingLog(t))
+
+	// a large proposal payload gets compressed.
+	msg := makeCompressibleMessage(protocol.ProposalPayloadTag, 64*1024)
+	framed := mc.frame(msg)
+	require.Equal(t, msg[:2], framed[:2])
+	require.Equal(t, compressionFormatDeflate, framed[2])
+	require.Less(t, len(framed), len(msg))
+	payload, compressed, err := unframe(framed[2:])
+	require.NoError(t, err)
+	require.True(t, compressed)
+	require.Equal(t, msg[2:], payload)
+
+	// messages below the threshold, or with a tag excluded by the policy, are framed as is.
+	for _, msg := range [][]byte{
+		makeCompressibleMessage(protocol.ProposalPayloadTag, 100),
+		makeCompressibleMessage(protocol.TxnTag, 64*1024),
+	} {
+		framed := mc.frame(msg)
+		require.Equal(t, compressionFormatNone, framed[2])
+		require.Equal(t, len(msg)+1, len(framed))
+		payload, compressed, err := unframe(framed[2:])
+		require.NoError(t, err)
+		require.False(t, compressed)
+		require.Equal(t, msg[2:], payload)
+	}
+
+	// incompressible payloads are sent as is.
+	random := make([]byte, 2+4096)
+	copy(random, protocol.ProposalPayloadTag)
+	crypto.RandBytes(random[2:])
+	framed = mc.frame(random)
+	require.Equal(t, compressionFormatNone, framed[2])
+}
+
+func TestMsgCompressorPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableGossipCompression = true
+	cfg.GossipCompressionTags = " TX, AV ,"
+	mc := makeMsgCompressor(cfg, logging.TestingLog(t))
+	require.True(t, mc.shouldCompress(protocol.TxnTag, int(cfg.GossipCompressionThreshold)))
+	require.False(t, mc.shouldCompress(protocol.TxnTag, int(cfg.GossipCompressionThreshold)-1))
+	require.False(t, mc.shouldCompress(protocol.ProposalPayloadTag, maxMessageLength))
+	require.False(t, mc.shouldCompress(protocol.AgreementVoteTag, maxMessageLength))
+
+	header := http.Header{}
+	mc.setHeader(header)
+	require.Equal(t, compressionFormatDeflateName, header.Get(CompressionHeader))
+	require.True(t, mc.negotiate(header))
+	require.False(t, mc.negotiate(http.Header{}))
+
+	cfg.EnableGossipCompression = false
+	mc = makeMsgCompressor(cfg, logging.TestingLog(t))
+	require.False(t, mc.shouldCompress(protocol.TxnTag, maxMessageLength))
+	header = http.Header{}
+	mc.setHeader(header)
+	require.Empty(t, header.Get(CompressionHeader))
+	require.False(t, mc.negotiate(http.Header{CompressionHeader: []string{compressionFormatDeflateName}}))
+}
+
+func TestMsgCompressorUnframeErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, _, err := unframe(nil)
+	require.Equal(t, errCompressedMessageTooShort, err)
+
+	_, _, err = unframe([]byte{compressionFormatDeflate, 1, 2, 3})
+	require.Equal(t, errCompressedMessageTooShort, err)
+
+	_, _, err = unframe([]byte{7, 1, 2, 3})
+	require.Error(t, err)
+
+	// a gzip trailer claiming a size beyond the maximum message length is rejected before decompressing.
+	bogus := make([]byte, 1+gzipMinLength)
+	bogus[0] = compressionFormatDeflate
+	bogus[len(bogus)-1] = 0xff
+	_, _, err = unframe(bogus)
+	require.Equal(t, errCompressedMessageTooLong, err)
+
+	// corrupted compressed data fails to decompress.
+	cfg := config.GetDefaultLocal()
+	cfg.EnableGossipCompression = true
+	mc := makeMsgCompressor(cfg, logging.TestingLog(t))
+	framed := mc.frame(makeCompressibleMessage(protocol.TxnTag, 8192))
+	require.Equal(t, compressionFormatDeflate, framed[2])
+	for i := 15; i < len(framed)-8; i++ {
+		framed[i] ^= 0x5a
+	}
+	_, _, err = unframe(framed[2:])
+	require.Error(t, err)
+}
+
+type payloadCollector struct {
+	payloads chan []byte
+}
+
+func (pc *payloadCollector) Handle(message IncomingMessage) OutgoingMessage {
+	pc.payloads <- message.Data
+	return OutgoingMessage{Action: Ignore}
+}
+
+// TestWebsocketNetworkCompression tests that large messages are exchanged intact between peers, whether or not both
+// of them have compression enabled.
+func TestWebsocketNetworkCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, enabledB := range []bool{true, false} {
+		cfgA := defaultConfig
+		cfgA.GossipFanout = 1
+		cfgA.EnableGossipCompression = true
+		netA := makeTestWebsocketNodeWithConfig(t, cfgA)
+		netA.Start()
+
+		cfgB := defaultConfig
+		cfgB.GossipFanout = 1
+		cfgB.EnableGossipCompression = enabledB
+		netB := makeTestWebsocketNodeWithConfig(t, cfgB)
+		addrA, postListen := netA.Address()
+		require.True(t, postListen)
+		netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+		netB.Start()
+
+		collector := &payloadCollector{payloads: make(chan []byte, 10)}
+		netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.ProposalPayloadTag, MessageHandler: collector}})
+
+		readyTimeout := time.NewTimer(2 * time.Second)
+		waitReady(t, netA, readyTimeout.C)
+		waitReady(t, netB, readyTimeout.C)
+
+		peers := netA.GetPeers(PeersConnectedIn)
+		require.Len(t, peers, 1)
+		require.Equal(t, enabledB, peers[0].(*wsPeer).compression)
+
+		msg := makeCompressibleMessage(protocol.ProposalPayloadTag, 256*1024)
+		small := []byte("small")
+		netA.Broadcast(context.Background(), protocol.ProposalPayloadTag, msg[2:], true, nil)
+		require.NoError(t, peers[0].(UnicastPeer).Unicast(context.Background(), small, protocol.ProposalPayloadTag, nil))
+
+		// the broadcast and the unicast messages could arrive in any order.
+		for i := 0; i < 2; i++ {
+			select {
+			case payload := <-collector.payloads:
+				require.True(t, bytes.Equal(msg[2:], payload) || bytes.Equal(small, payload))
+			case <-time.After(2 * time.Second):
+				require.Fail(t, "timeout waiting for message")
+			}
+		}
+
+		netB.Stop()
+		netA.Stop()
+	}
+}
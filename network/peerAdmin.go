@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// pinnedPeersNetworkName is the phonebook network name used for the addresses that were pinned
+// by the node operator. Keeping these under their own network name allows the DNS bootstrap
+// refresh to replace its own entries without dropping the pinned ones.
+const pinnedPeersNetworkName = "pinned"
+
+// ErrPeerNotFound is returned when the requested peer address does not match any connected peer.
+var ErrPeerNotFound = errors.New("peer not found")
+
+// ErrPeerNotPinned is returned when attempting to unpin an address that was never pinned.
+var ErrPeerNotPinned = errors.New("peer address is not pinned")
+
+// ErrInvalidPeerAddress is returned when the provided peer address cannot be parsed.
+var ErrInvalidPeerAddress = errors.New("invalid peer address")
+
+// PeerInfo is a snapshot of a single connected peer, as exposed to the node operator.
+type PeerInfo struct {
+	// Address identifies the peer. For outgoing connections this is the address we've dialed,
+	// and for incoming connections it is the remote host:port of the connection.
+	Address string
+	// Outgoing is true if we initiated the connection.
+	Outgoing bool
+	// Pinned is true if the address was pinned by the node operator.
+	Pinned bool
+	// TelemetryGUID and InstanceName are the identity headers the peer provided during the handshake.
+	TelemetryGUID string
+	InstanceName  string
+	// Version is the negotiated network protocol version.
+	Version string
+	// ConnectedSince is the time at which the connection was established.
+	ConnectedSince time.Time
+	// Latency is the round trip time measured by the ping-pong latency tracker.
+	Latency time.Duration
+	// MessagesReceived and MessagesSent count the messages exchanged over this connection.
+	MessagesReceived uint64
+	MessagesSent     uint64
+	// PrioAddress and PrioWeight are the participation priority address and weight associated with this peer.
+	PrioAddress basics.Address
+	PrioWeight  uint64
+}
+
+// peerAddress returns the address used to identify the peer in the administration interface.
+func (wp *wsPeer) peerAddress() string {
+	if wp.outgoing {
+		return wp.rootURL
+	}
+	return wp.conn.RemoteAddr().String()
+}
+
+// PeersInfo returns a snapshot of all the currently connected peers, sorted by address.
+func (wn *WebsocketNetwork) PeersInfo() []PeerInfo {
+	var peers []*wsPeer
+	peers, _ = wn.peerSnapshot(peers)
+	infos := make([]PeerInfo, 0, len(peers))
+	for _, peer := range peers {
+		info := PeerInfo{
+			Address:          peer.peerAddress(),
+			Outgoing:         peer.outgoing,
+			TelemetryGUID:    peer.TelemetryGUID,
+			InstanceName:     peer.InstanceName,
+			Version:          peer.version,
+			ConnectedSince:   peer.createTime,
+			Latency:          peer.GetConnectionLatency(),
+			MessagesReceived: atomic.LoadUint64(&peer.messagesReceived),
+			MessagesSent:     atomic.LoadUint64(&peer.messagesSent),
+		}
+		if peer.outgoing {
+			info.Pinned = wn.isPinned(peer.rootURL)
+		}
+		wn.peersLock.RLock()
+		info.PrioAddress = peer.prioAddress
+		info.PrioWeight = peer.prioWeight
+		wn.peersLock.RUnlock()
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Address < infos[j].Address
+	})
+	return infos
+}
+
+// DisconnectPeer disconnects the connected peer identified by the given address, as reported by PeersInfo.
+// Note that a pinned peer would be reconnected by the mesh thread; it needs to be unpinned first.
+func (wn *WebsocketNetwork) DisconnectPeer(address string) error {
+	var peers []*wsPeer
+	peers, _ = wn.peerSnapshot(peers)
+	for _, peer := range peers {
+		if peer.peerAddress() == address {
+			wn.disconnect(peer, disconnectAdminRequest)
+			return nil
+		}
+	}
+	return ErrPeerNotFound
+}
+
+// PinPeer adds the given relay address to the set of outgoing connections that the node would
+// always attempt to maintain. Pinned connections are not subject to the performance-based
+// and clique-resolving disconnections.
+func (wn *WebsocketNetwork) PinPeer(address string) error {
+	if _, err := wn.addrToGossipAddr(address); err != nil {
+		return ErrInvalidPeerAddress
+	}
+	wn.pinnedPeersMu.Lock()
+	wn.pinnedPeers[address] = true
+	pinned := wn.pinnedAddresses()
+	wn.pinnedPeersMu.Unlock()
+
+	wn.phonebook.ReplacePeerList(pinned, pinnedPeersNetworkName, PhoneBookEntryRelayRole)
+	wn.requestMeshUpdate()
+	return nil
+}
+
+// UnpinPeer removes the given address from the set of pinned outgoing connections. An existing
+// connection to that address is kept, but it would be treated as any other outgoing connection.
+func (wn *WebsocketNetwork) UnpinPeer(address string) error {
+	wn.pinnedPeersMu.Lock()
+	if !wn.pinnedPeers[address] {
+		wn.pinnedPeersMu.Unlock()
+		return ErrPeerNotPinned
+	}
+	delete(wn.pinnedPeers, address)
+	pinned := wn.pinnedAddresses()
+	wn.pinnedPeersMu.Unlock()
+
+	wn.phonebook.ReplacePeerList(pinned, pinnedPeersNetworkName, PhoneBookEntryRelayRole)
+	return nil
+}
+
+// PinnedPeers returns the list of pinned peer addresses.
+func (wn *WebsocketNetwork) PinnedPeers() []string {
+	wn.pinnedPeersMu.Lock()
+	defer wn.pinnedPeersMu.Unlock()
+	return wn.pinnedAddresses()
+}
+
+// ReloadPhonebook replaces the statically configured relay addresses with the given ones, and
+// asks the mesh thread to refresh the DNS bootstrap entries and update the outgoing connections.
+func (wn *WebsocketNetwork) ReloadPhonebook(addresses []string) {
+	wn.phonebook.ReplacePeerList(addresses, wn.config.DNSBootstrapID, PhoneBookEntryRelayRole)
+	wn.requestMeshUpdate()
+}
+
+// pinnedAddresses returns a sorted list of the pinned addresses. Should be called with pinnedPeersMu held.
+func (wn *WebsocketNetwork) pinnedAddresses() []string {
+	addresses := make([]string, 0, len(wn.pinnedPeers))
+	for addr := range wn.pinnedPeers {
+		addresses = append(addresses, addr)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// isPinned returns true if the given address was pinned by the node operator.
+func (wn *WebsocketNetwork) isPinned(address string) bool {
+	wn.pinnedPeersMu.Lock()
+	defer wn.pinnedPeersMu.Unlock()
+	return wn.pinnedPeers[address]
+}
+
+// requestMeshUpdate asks the mesh thread to re-evaluate the outgoing connections, without waiting for it.
+func (wn *WebsocketNetwork) requestMeshUpdate() {
+	select {
+	case wn.meshUpdateRequests <- meshRequest{disconnect: false}:
+	default:
+		// there is already a pending request; it would pick up the change.
+	}
+}
+
+// connectPinnedPeers starts a connection attempt for every pinned address that isn't connected yet.
+// it returns true if any connection attempt was started.
+func (wn *WebsocketNetwork) connectPinnedPeers() bool {
+	started := false
+	for _, addr := range wn.PinnedPeers() {
+		gossipAddr, ok := wn.tryConnectReserveAddr(addr)
+		if ok {
+			wn.wg.Add(1)
+			go wn.tryConnect(addr, gossipAddr)
+			started = true
+		}
+	}
+	return started
+}
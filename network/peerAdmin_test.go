@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func waitPeersInfo(t *testing.T, wn *WebsocketNetwork, cond func([]PeerInfo) bool) []PeerInfo {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		peers := wn.PeersInfo()
+		if cond(peers) {
+			return peers
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "timeout waiting for peers condition")
+	return nil
+}
+
+func TestPeerAdminPinDisconnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	// netB doesn't look for any outgoing connections on its own, so the only connection it would make
+	// is the pinned one.
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 0
+	netB.Start()
+	defer func() { t.Log("stopping B"); netB.Stop(); t.Log("B done") }()
+
+	require.Equal(t, ErrInvalidPeerAddress, netB.PinPeer("http://[::1"))
+	require.Equal(t, ErrPeerNotPinned, netB.UnpinPeer(addrA))
+	require.NoError(t, netB.PinPeer(addrA))
+	require.Equal(t, []string{addrA}, netB.PinnedPeers())
+
+	peers := waitPeersInfo(t, netB, func(peers []PeerInfo) bool { return len(peers) == 1 })
+	require.Equal(t, addrA, peers[0].Address)
+	require.True(t, peers[0].Outgoing)
+	require.True(t, peers[0].Pinned)
+	require.False(t, peers[0].ConnectedSince.IsZero())
+
+	// the incoming side should see the connection as well.
+	incoming := waitPeersInfo(t, netA, func(peers []PeerInfo) bool { return len(peers) == 1 })
+	require.False(t, incoming[0].Outgoing)
+	require.False(t, incoming[0].Pinned)
+
+	netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte("foo"), true, nil)
+	waitPeersInfo(t, netB, func(peers []PeerInfo) bool { return len(peers) == 1 && peers[0].MessagesSent > 0 })
+	waitPeersInfo(t, netA, func(peers []PeerInfo) bool { return len(peers) == 1 && peers[0].MessagesReceived > 0 })
+
+	require.Equal(t, ErrPeerNotFound, netB.DisconnectPeer("http://127.0.0.1:1"))
+	require.NoError(t, netB.UnpinPeer(addrA))
+	require.Empty(t, netB.PinnedPeers())
+	require.NoError(t, netB.DisconnectPeer(addrA))
+	require.Empty(t, netB.PeersInfo())
+}
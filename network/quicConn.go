@@ -0,0 +1,466 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+//
+//go:build quic
+// +build quic
+
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/lucas-clemente/quic-go"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// quicStreamClass identifies the QUIC stream over which a message is sent. Each class has its own stream, so that
+// a large message on one stream wouldn't delay the messages on the other streams.
+type quicStreamClass byte
+
+const (
+	// quicStreamControl is the bidirectional stream carrying the websocket handshake, the websocket control
+	// frames and all the messages that don't belong to any other class.
+	quicStreamControl quicStreamClass = iota
+	quicStreamVotes
+	quicStreamProposals
+	quicStreamTransactions
+	quicStreamCatchup
+	quicStreamClassesCount
+)
+
+// quicStreamClassByTag maps the message tags to the stream they are sent on. Tags that aren't listed are sent on
+// the control stream.
+var quicStreamClassByTag = map[protocol.Tag]quicStreamClass{
+	protocol.AgreementVoteTag:   quicStreamVotes,
+	protocol.VoteBundleTag:      quicStreamVotes,
+	protocol.ProposalPayloadTag: quicStreamProposals,
+	protocol.TxnTag:             quicStreamTransactions,
+	protocol.Txn2Tag:            quicStreamTransactions,
+	protocol.MsgDigestSkipTag:   quicStreamTransactions,
+	protocol.UniCatchupReqTag:   quicStreamCatchup,
+	protocol.UniEnsBlockReqTag:  quicStreamCatchup,
+	protocol.TopicMsgRespTag:    quicStreamCatchup,
+}
+
+// quicStreamReadPriority is the order in which the pending incoming messages are handed out to the reader.
+var quicStreamReadPriority = []quicStreamClass{quicStreamVotes, quicStreamProposals, quicStreamControl, quicStreamCatchup, quicStreamTransactions}
+
+const (
+	// quicStreamWriteQueueLength is the number of outgoing messages that could be queued on each of the streams.
+	quicStreamWriteQueueLength = 64
+	// quicStreamReadQueueLength is the number of incoming messages that could be queued on each of the streams
+	// before we stop reading from the stream, letting the QUIC flow control slow down the sender.
+	quicStreamReadQueueLength = 16
+	// quicStreamWriteTimeout bounds the time it takes to write a single message to a stream.
+	quicStreamWriteTimeout = 30 * time.Second
+)
+
+var errQuicConnectionClosed = errors.New("quic connection closed")
+var errQuicMessageTooLong = errors.New("quic stream message exceeds the read limit")
+
+// quicIncomingMessage is a single message read from any of the streams. Control frames arriving on the control
+// stream are passed as a handler, which is invoked by NextReader.
+type quicIncomingMessage struct {
+	messageType int
+	data        []byte
+	handler     func() error
+}
+
+// quicOutgoingMessage is a single message queued for writing on one of the unidirectional streams.
+type quicOutgoingMessage struct {
+	data   []byte
+	queued int64
+}
+
+// quicPeerConn implements the wsPeerWebsocketConn interface over a QUIC connection. The websocket connection
+// established over the control stream is used for the handshake and the control frames, while the messages of
+// each class are sent over a dedicated unidirectional stream, framed with their length.
+type quicPeerConn struct {
+	control *websocket.Conn
+	conn    quic.Session
+
+	// readLimit is the maximal length of an incoming message, accessed atomically.
+	readLimit int64
+
+	incoming [quicStreamClassesCount]chan quicIncomingMessage
+	outgoing [quicStreamClassesCount]chan quicOutgoingMessage
+
+	// writing holds, for each stream, the UnixNano time at which the message currently being written to it was
+	// passed to WriteMessage, or zero if no message is being written. Accessed atomically.
+	writing [quicStreamClassesCount]int64
+
+	// acceptedStreams tracks the classes for which the other side has already opened a stream.
+	acceptedStreams   [quicStreamClassesCount]bool
+	acceptedStreamsMu sync.Mutex
+
+	// startReading starts the reading goroutines on the first call to NextReader, once the control frame
+	// handlers have been set.
+	startReading sync.Once
+
+	closed    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// makeQuicPeerConn creates a quicPeerConn out of the websocket connection established over the control stream of
+// the given QUIC connection, and starts its writing goroutines.
+func makeQuicPeerConn(control *websocket.Conn, conn quic.Session) *quicPeerConn {
+	qc := &quicPeerConn{
+		control:   control,
+		conn:      conn,
+		readLimit: maxMessageLength,
+		closed:    make(chan struct{}),
+	}
+	for class := range qc.incoming {
+		qc.incoming[class] = make(chan quicIncomingMessage, quicStreamReadQueueLength)
+		qc.outgoing[class] = make(chan quicOutgoingMessage, quicStreamWriteQueueLength)
+	}
+	for class := quicStreamControl + 1; class < quicStreamClassesCount; class++ {
+		go qc.streamWriteLoop(class)
+	}
+	return qc
+}
+
+// quicStreamClassOf returns the class of the stream on which a message with the given tag is sent.
+func quicStreamClassOf(tag protocol.Tag) quicStreamClass {
+	if class, has := quicStreamClassByTag[tag]; has {
+		return class
+	}
+	return quicStreamControl
+}
+
+// RemoteAddr returns the address of the other side of the QUIC connection.
+func (qc *quicPeerConn) RemoteAddr() net.Addr {
+	return qc.conn.RemoteAddr()
+}
+
+// NextReader returns the next incoming message. Pending votes and proposals are returned before any other message.
+// As with the websocket connection, the control frame handlers are invoked by NextReader.
+func (qc *quicPeerConn) NextReader() (int, io.Reader, error) {
+	qc.startReading.Do(func() {
+		go qc.controlReadLoop()
+		go qc.acceptStreamsLoop()
+	})
+	for {
+		msg, err := qc.nextMessage()
+		if err != nil {
+			return 0, nil, err
+		}
+		if msg.handler != nil {
+			if err = msg.handler(); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		return msg.messageType, bytes.NewReader(msg.data), nil
+	}
+}
+
+// nextMessage waits for the next incoming message, according to the streams priority.
+func (qc *quicPeerConn) nextMessage() (msg quicIncomingMessage, err error) {
+	for _, class := range quicStreamReadPriority {
+		select {
+		case msg = <-qc.incoming[class]:
+			return msg, nil
+		default:
+		}
+	}
+	select {
+	case msg = <-qc.incoming[quicStreamVotes]:
+	case msg = <-qc.incoming[quicStreamProposals]:
+	case msg = <-qc.incoming[quicStreamControl]:
+	case msg = <-qc.incoming[quicStreamCatchup]:
+	case msg = <-qc.incoming[quicStreamTransactions]:
+	case <-qc.closed:
+		return msg, qc.closeErr
+	}
+	return msg, nil
+}
+
+// WriteMessage queues the given message on the stream matching its tag. The data must not be modified once passed
+// to WriteMessage, as it might be written asynchronously. A failure to write a queued message closes the connection,
+// and the error is returned by any subsequent call; the messages which are slow to be written are reported by
+// oldestPendingWrite.
+func (qc *quicPeerConn) WriteMessage(messageType int, data []byte) error {
+	class := quicStreamControl
+	if messageType == websocket.BinaryMessage && len(data) >= 2 {
+		class = quicStreamClassOf(protocol.Tag(data[:2]))
+	}
+	if class == quicStreamControl {
+		return qc.control.WriteMessage(messageType, data)
+	}
+	select {
+	case <-qc.closed:
+		return qc.closeErr
+	default:
+	}
+	select {
+	case qc.outgoing[class] <- quicOutgoingMessage{data: data, queued: time.Now().UnixNano()}:
+		return nil
+	case <-qc.closed:
+		return qc.closeErr
+	}
+}
+
+// oldestPendingWrite returns the UnixNano time at which the oldest message which is still being written to any of
+// the unidirectional streams was passed to WriteMessage, or zero if there is no such message. As each stream is
+// written in order, the queued messages are all newer than the one being written to their stream.
+func (qc *quicPeerConn) oldestPendingWrite() int64 {
+	oldest := int64(0)
+	for class := range qc.writing {
+		queued := atomic.LoadInt64(&qc.writing[class])
+		if queued != 0 && (oldest == 0 || queued < oldest) {
+			oldest = queued
+		}
+	}
+	return oldest
+}
+
+// WriteControl writes a websocket control frame on the control stream.
+func (qc *quicPeerConn) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	return qc.control.WriteControl(messageType, data, deadline)
+}
+
+// SetReadLimit sets the maximal length of an incoming message, on all the streams.
+func (qc *quicPeerConn) SetReadLimit(limit int64) {
+	atomic.StoreInt64(&qc.readLimit, limit)
+	qc.control.SetReadLimit(limit)
+}
+
+// CloseWithoutFlush closes the QUIC connection, abandoning any queued outgoing messages.
+func (qc *quicPeerConn) CloseWithoutFlush() error {
+	qc.close(errQuicConnectionClosed)
+	return nil
+}
+
+// SetPingHandler sets the handler for the websocket ping frames arriving on the control stream. It should be called
+// before the first call to NextReader.
+func (qc *quicPeerConn) SetPingHandler(h func(appData string) error) {
+	qc.control.SetPingHandler(qc.deferredHandler(h))
+}
+
+// SetPongHandler sets the handler for the websocket pong frames arriving on the control stream. It should be called
+// before the first call to NextReader.
+func (qc *quicPeerConn) SetPongHandler(h func(appData string) error) {
+	qc.control.SetPongHandler(qc.deferredHandler(h))
+}
+
+// deferredHandler wraps a control frame handler, so that it would be invoked by NextReader rather than by the
+// goroutine reading the control stream.
+func (qc *quicPeerConn) deferredHandler(h func(appData string) error) func(appData string) error {
+	if h == nil {
+		return nil
+	}
+	return func(appData string) error {
+		qc.deliver(quicStreamControl, quicIncomingMessage{handler: func() error { return h(appData) }})
+		return nil
+	}
+}
+
+// close tears down the connection; the given error would be returned by any subsequent read or write.
+func (qc *quicPeerConn) close(err error) {
+	qc.closeOnce.Do(func() {
+		qc.closeErr = err
+		close(qc.closed)
+		qc.control.CloseWithoutFlush()
+		qc.conn.CloseWithError(0, "")
+	})
+}
+
+// deliver hands out an incoming message to the reader. It returns false if the connection was closed.
+func (qc *quicPeerConn) deliver(class quicStreamClass, msg quicIncomingMessage) bool {
+	select {
+	case qc.incoming[class] <- msg:
+		return true
+	case <-qc.closed:
+		return false
+	}
+}
+
+// controlReadLoop reads the websocket messages arriving on the control stream. The websocket library invokes the
+// ping and pong handlers while reading.
+func (qc *quicPeerConn) controlReadLoop() {
+	for {
+		messageType, reader, err := qc.control.NextReader()
+		if err != nil {
+			qc.close(err)
+			return
+		}
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			qc.close(err)
+			return
+		}
+		if !qc.deliver(quicStreamControl, quicIncomingMessage{messageType: messageType, data: data}) {
+			return
+		}
+	}
+}
+
+// acceptStreamsLoop accepts the unidirectional streams opened by the other side, and starts reading from them.
+func (qc *quicPeerConn) acceptStreamsLoop() {
+	for {
+		stream, err := qc.conn.AcceptUniStream(qc.conn.Context())
+		if err != nil {
+			qc.close(err)
+			return
+		}
+		go qc.streamReadLoop(stream)
+	}
+}
+
+// streamReadLoop reads the length framed messages from a unidirectional stream. The first byte of the stream
+// identifies its class; each class could have a single stream.
+func (qc *quicPeerConn) streamReadLoop(stream quic.ReceiveStream) {
+	var header [4]byte
+	if _, err := io.ReadFull(stream, header[:1]); err != nil {
+		qc.close(err)
+		return
+	}
+	class := quicStreamClass(header[0])
+	if class == quicStreamControl || class >= quicStreamClassesCount {
+		qc.close(fmt.Errorf("invalid quic stream class %d", class))
+		return
+	}
+	qc.acceptedStreamsMu.Lock()
+	duplicate := qc.acceptedStreams[class]
+	qc.acceptedStreams[class] = true
+	qc.acceptedStreamsMu.Unlock()
+	if duplicate {
+		qc.close(fmt.Errorf("duplicate quic stream of class %d", class))
+		return
+	}
+
+	for {
+		if _, err := io.ReadFull(stream, header[:]); err != nil {
+			qc.close(err)
+			return
+		}
+		length := binary.BigEndian.Uint32(header[:])
+		if int64(length) > atomic.LoadInt64(&qc.readLimit) {
+			qc.close(errQuicMessageTooLong)
+			return
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(stream, data); err != nil {
+			qc.close(err)
+			return
+		}
+		if !qc.deliver(class, quicIncomingMessage{messageType: websocket.BinaryMessage, data: data}) {
+			return
+		}
+	}
+}
+
+// streamWriteLoop writes the queued messages of a single class to its unidirectional stream, which is opened
+// once the first message is queued.
+func (qc *quicPeerConn) streamWriteLoop(class quicStreamClass) {
+	var stream quic.SendStream
+	for {
+		var msg quicOutgoingMessage
+		select {
+		case msg = <-qc.outgoing[class]:
+		case <-qc.closed:
+			return
+		}
+		atomic.StoreInt64(&qc.writing[class], msg.queued)
+		var err error
+		stream, err = qc.writeStreamMessage(stream, class, msg.data)
+		atomic.StoreInt64(&qc.writing[class], 0)
+		if err != nil {
+			qc.close(fmt.Errorf("quic stream of class %d write failed: %w", class, err))
+			return
+		}
+	}
+}
+
+// writeStreamMessage writes a single length framed message to the unidirectional stream of the given class,
+// opening it first if it wasn't opened yet. It returns the stream.
+func (qc *quicPeerConn) writeStreamMessage(stream quic.SendStream, class quicStreamClass, data []byte) (quic.SendStream, error) {
+	var header [4]byte
+	if stream == nil {
+		var err error
+		stream, err = qc.conn.OpenUniStreamSync(qc.conn.Context())
+		if err != nil {
+			return nil, err
+		}
+		header[0] = byte(class)
+		if _, err = stream.Write(header[:1]); err != nil {
+			return nil, err
+		}
+	}
+	binary.BigEndian.PutUint32(header[:], uint32(len(data)))
+	stream.SetWriteDeadline(time.Now().Add(quicStreamWriteTimeout))
+	if _, err := stream.Write(header[:]); err != nil {
+		return nil, err
+	}
+	if _, err := stream.Write(data); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// quicLocalAddr wraps the local address of a QUIC connection. All the connections accepted by a listener share
+// the same local address, while the request tracker identifies the http connections by their local address
+// interface; a distinct wrapper object is used for each connection. It also carries the QUIC connection itself,
+// so that it could be found once the control stream is wrapped by the listeners.
+type quicLocalAddr struct {
+	net.Addr
+	conn quic.Session
+}
+
+// quicStreamConn exposes the control stream of a QUIC connection as a net.Conn, so that the gossip handshake
+// could be carried out by the http and websocket libraries.
+type quicStreamConn struct {
+	quic.Stream
+	conn      quic.Session
+	localAddr net.Addr
+}
+
+func makeQuicStreamConn(conn quic.Session, stream quic.Stream) *quicStreamConn {
+	return &quicStreamConn{
+		Stream:    stream,
+		conn:      conn,
+		localAddr: &quicLocalAddr{Addr: conn.LocalAddr(), conn: conn},
+	}
+}
+
+// LocalAddr returns the local address of the QUIC connection.
+func (sc *quicStreamConn) LocalAddr() net.Addr {
+	return sc.localAddr
+}
+
+// RemoteAddr returns the address of the other side of the QUIC connection.
+func (sc *quicStreamConn) RemoteAddr() net.Addr {
+	return sc.conn.RemoteAddr()
+}
+
+// Close closes the entire QUIC connection, as the control stream is required for its operation.
+func (sc *quicStreamConn) Close() error {
+	sc.Stream.Close()
+	return sc.conn.CloseWithError(0, "")
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/algorand/websocket"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// quicHandshakeTimeout bounds the time it takes to establish a QUIC connection, and to open its control stream.
+	quicHandshakeTimeout = 3 * time.Second
+	// quicFallbackPeriod is the duration for which we would connect to a relay over websockets, after failing to
+	// connect to it over QUIC.
+	quicFallbackPeriod = 30 * time.Minute
+)
+
+var networkQuicConnectionsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_quic_connections_total", Description: "Number of peer connections established over QUIC"})
+var networkQuicFallbacksTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_quic_fallbacks_total", Description: "Number of outgoing connections that fell back from QUIC to websockets"})
+
+// dialGossip establishes the gossip connection to the given address. When the QUIC transport is enabled, the
+// connection is first attempted over QUIC; if that fails, the relay is reached over websockets for the following
+// quicFallbackPeriod.
+func (wn *WebsocketNetwork) dialGossip(addr, gossipAddr string, requestHeader http.Header) (wsPeerWebsocketConn, *http.Response, error) {
+	if quicTransportSupported && wn.config.EnableQUICTransport && wn.quicAllowed(addr) {
+		conn, response, err := wn.dialQuic(gossipAddr, requestHeader)
+		if err == nil {
+			networkQuicConnectionsTotal.Inc(nil)
+			return conn, response, nil
+		}
+		if err == websocket.ErrBadHandshake {
+			// the relay was reached, and has declined the connection.
+			return nil, response, err
+		}
+		wn.log.Infof("quic connect(%s) failed, falling back to websockets : %v", gossipAddr, err)
+		networkQuicFallbacksTotal.Inc(nil)
+		wn.quicFallbackMu.Lock()
+		wn.quicFallbacks[addr] = time.Now().Add(quicFallbackPeriod)
+		wn.quicFallbackMu.Unlock()
+	}
+
+	var websocketDialer = websocket.Dialer{
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+		NetDialContext:    wn.dialer.DialContext,
+		NetDial:           wn.dialer.Dial,
+	}
+	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
+	if err != nil {
+		return nil, response, err
+	}
+	return conn, response, nil
+}
+
+// quicAllowed returns false if connecting to the given address over QUIC has recently failed.
+func (wn *WebsocketNetwork) quicAllowed(addr string) bool {
+	wn.quicFallbackMu.Lock()
+	defer wn.quicFallbackMu.Unlock()
+	retryAfter, has := wn.quicFallbacks[addr]
+	if !has {
+		return true
+	}
+	if time.Now().Before(retryAfter) {
+		return false
+	}
+	delete(wn.quicFallbacks, addr)
+	return true
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+//
+//go:build !quic
+// +build !quic
+
+package network
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/algorand/websocket"
+)
+
+// quicTransportSupported is set when the QUIC transport is included in the build. The QUIC transport is only
+// included in the builds made with the quic build tag.
+const quicTransportSupported = false
+
+var errQuicTransportUnsupported = errors.New("the QUIC transport isn't included in this build")
+
+// quicListener is only implemented by the builds which include the QUIC transport.
+type quicListener struct{}
+
+// startQuicListener reports that the QUIC transport was enabled on a build which doesn't include it; the relay
+// keeps accepting the websocket connections only.
+func (wn *WebsocketNetwork) startQuicListener() {
+	wn.log.Warnf("network could not listen for quic connections: %v", errQuicTransportUnsupported)
+}
+
+// quicPeerConnOf returns nil, as the gossip handshakes only arrive over TCP when the QUIC transport isn't included
+// in the build.
+func quicPeerConnOf(request *http.Request, control *websocket.Conn) wsPeerWebsocketConn {
+	return nil
+}
+
+// dialQuic fails, as the QUIC transport isn't included in the build.
+func (wn *WebsocketNetwork) dialQuic(gossipAddr string, requestHeader http.Header) (wsPeerWebsocketConn, *http.Response, error) {
+	return nil, nil, errQuicTransportUnsupported
+}
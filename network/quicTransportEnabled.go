@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+//
+//go:build quic
+// +build quic
+
+package network
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/lucas-clemente/quic-go"
+	"golang.org/x/net/netutil"
+)
+
+// quicTransportSupported is set when the QUIC transport is included in the build.
+const quicTransportSupported = true
+
+// quicALPN is the application protocol negotiated during the QUIC handshake.
+const quicALPN = "algorand-gossip"
+
+// quicMaxIdleTimeout is the duration after which an idle QUIC connection is closed. Keep-alive packets are sent
+// on an idle connection at half of this duration.
+const quicMaxIdleTimeout = 60 * time.Second
+
+var errQuicListenerClosed = errors.New("quic listener closed")
+
+// quicConnectionContextKey is the http request context key under which the QUIC connection carrying the
+// request is stored.
+type quicConnectionContextKey struct{}
+
+// quicConfig returns the QUIC configuration. Only the connecting side opens the control stream, while both sides
+// open a single unidirectional stream per message class.
+func quicConfig(listening bool) *quic.Config {
+	cfg := &quic.Config{
+		HandshakeTimeout:      quicHandshakeTimeout,
+		MaxIdleTimeout:        quicMaxIdleTimeout,
+		KeepAlive:             true,
+		MaxIncomingStreams:    -1,
+		MaxIncomingUniStreams: int64(quicStreamClassesCount - 1),
+	}
+	if listening {
+		cfg.MaxIncomingStreams = 1
+	}
+	return cfg
+}
+
+// quicServerTLSConfig returns the TLS configuration of the QUIC listener. The configured TLS certificate is used if
+// there is one; otherwise, a self-signed certificate is generated, as the peers are authenticated by the gossip
+// handshake rather than by the transport.
+func (wn *WebsocketNetwork) quicServerTLSConfig() (*tls.Config, error) {
+	var cert tls.Certificate
+	var err error
+	if wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "" {
+		cert, err = tls.LoadX509KeyPair(wn.config.TLSCertFile, wn.config.TLSKeyFile)
+	} else {
+		cert, err = makeSelfSignedCertificate()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{quicALPN},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// quicClientTLSConfig returns the TLS configuration used for outgoing QUIC connections. Like the websocket
+// connections made over plain http, the relay identity isn't verified by the transport.
+func quicClientTLSConfig() *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{quicALPN},
+		MinVersion:         tls.VersionTLS13,
+	}
+}
+
+// makeSelfSignedCertificate generates an ephemeral self-signed certificate.
+func makeSelfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: quicALPN},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// quicListener accepts QUIC connections, and exposes the control stream of each of them as a net.Conn, so that
+// the gossip handshake would be served by an http.Server, exactly as it's done for the websocket connections.
+type quicListener struct {
+	listener quic.Listener
+	conns    chan net.Conn
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func makeQuicListener(address string, tlsConfig *tls.Config) (*quicListener, error) {
+	listener, err := quic.ListenAddr(address, tlsConfig, quicConfig(true))
+	if err != nil {
+		return nil, err
+	}
+	ql := &quicListener{
+		listener: listener,
+		conns:    make(chan net.Conn),
+	}
+	ql.ctx, ql.cancel = context.WithCancel(context.Background())
+	go ql.acceptLoop()
+	return ql, nil
+}
+
+func (ql *quicListener) acceptLoop() {
+	for {
+		conn, err := ql.listener.Accept(ql.ctx)
+		if err != nil {
+			return
+		}
+		go ql.acceptControlStream(conn)
+	}
+}
+
+// acceptControlStream waits for the connecting side to open the control stream, and hands it to Accept.
+func (ql *quicListener) acceptControlStream(conn quic.Session) {
+	ctx, cancel := context.WithTimeout(ql.ctx, quicHandshakeTimeout)
+	defer cancel()
+	stream, err := conn.AcceptStream(ctx)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		return
+	}
+	// a connection which isn't picked up in time, e.g. since the incoming connections limit was reached, is dropped
+	// rather than held open.
+	select {
+	case ql.conns <- makeQuicStreamConn(conn, stream):
+	case <-ctx.Done():
+		conn.CloseWithError(0, "")
+	}
+}
+
+// Accept returns the control stream of the next accepted QUIC connection.
+func (ql *quicListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-ql.conns:
+		return conn, nil
+	case <-ql.ctx.Done():
+		return nil, errQuicListenerClosed
+	}
+}
+
+// Close stops accepting QUIC connections. The connections that were already accepted are not affected.
+func (ql *quicListener) Close() error {
+	ql.cancel()
+	return ql.listener.Close()
+}
+
+// Addr returns the UDP address the listener is bound to.
+func (ql *quicListener) Addr() net.Addr {
+	return ql.listener.Addr()
+}
+
+// startQuicListener starts accepting QUIC connections on the UDP port matching the port of the websocket listener.
+// The QUIC connections go through the same admission checks as the websocket ones: the incoming connections limit
+// and the per-IP connection rate limiting are applied as the connections are accepted, and the gossip handshake is
+// served by the same handler, which checks the connected peers limits before registering the peer.
+func (wn *WebsocketNetwork) startQuicListener() {
+	tlsConfig, err := wn.quicServerTLSConfig()
+	if err != nil {
+		wn.log.Errorf("network could not create the quic tls configuration: %v", err)
+		return
+	}
+	listener, err := makeQuicListener(wn.listener.Addr().String(), tlsConfig)
+	if err != nil {
+		wn.log.Errorf("network could not listen for quic connections on %v: %v", wn.listener.Addr(), err)
+		return
+	}
+	wn.quicListener = listener
+	wn.quicServer = &http.Server{
+		Handler:           wn.server.Handler,
+		ReadHeaderTimeout: httpServerReadHeaderTimeout,
+		WriteTimeout:      httpServerWriteTimeout,
+		IdleTimeout:       httpServerIdleTimeout,
+		MaxHeaderBytes:    httpServerMaxHeaderBytes,
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			// the control stream is wrapped by the limiting and tracking listeners; its local address is not.
+			if addr, ok := c.LocalAddr().(*quicLocalAddr); ok {
+				return context.WithValue(ctx, quicConnectionContextKey{}, addr.conn)
+			}
+			return ctx
+		},
+	}
+	wn.log.Debugf("listening for quic connections on %s", listener.Addr().String())
+	// wrap the quic listener with a limited connection listener, and then with the requests tracker.
+	limitedListener := netutil.LimitListener(listener, wn.config.IncomingConnectionsLimit)
+	wn.wg.Add(1)
+	go wn.quicHttpdThread(wn.requestsTracker.TrackListener(limitedListener))
+}
+
+func (wn *WebsocketNetwork) quicHttpdThread(listener net.Listener) {
+	defer wn.wg.Done()
+	err := wn.quicServer.Serve(listener)
+	if err != nil && err != http.ErrServerClosed && err != errQuicListenerClosed {
+		wn.log.Info("quic net http server exited ", err)
+	}
+}
+
+// quicPeerConnOf returns the connection of a peer whose gossip handshake has arrived over the control stream of
+// a QUIC connection, or nil if the handshake has arrived over TCP.
+func quicPeerConnOf(request *http.Request, control *websocket.Conn) wsPeerWebsocketConn {
+	conn, _ := request.Context().Value(quicConnectionContextKey{}).(quic.Session)
+	if conn == nil {
+		return nil
+	}
+	return makeQuicPeerConn(control, conn)
+}
+
+// dialQuic connects to the given gossip address over QUIC, and performs the websocket handshake over the
+// control stream.
+func (wn *WebsocketNetwork) dialQuic(gossipAddr string, requestHeader http.Header) (wsPeerWebsocketConn, *http.Response, error) {
+	gossipURL, err := url.Parse(gossipAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(wn.ctx, quicHandshakeTimeout)
+	defer cancel()
+	conn, err := quic.DialAddrContext(ctx, gossipURL.Host, quicClientTLSConfig(), quicConfig(false))
+	if err != nil {
+		return nil, nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		return nil, nil, err
+	}
+	streamConn := makeQuicStreamConn(conn, stream)
+
+	// the QUIC connection is already encrypted; the handshake is carried out in plain text over the control stream.
+	gossipURL.Scheme = "ws"
+	websocketDialer := websocket.Dialer{
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+		NetDialContext: func(context.Context, string, string) (net.Conn, error) {
+			return streamConn, nil
+		},
+	}
+	control, response, err := websocketDialer.DialContext(wn.ctx, gossipURL.String(), requestHeader)
+	if err != nil {
+		streamConn.Close()
+		return nil, response, err
+	}
+	return makeQuicPeerConn(control, conn), response, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+//
+//go:build quic
+// +build quic
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeQuicTestNetworks starts a relay and a node connecting to it, with the given QUIC transport settings.
+func makeQuicTestNetworks(t *testing.T, relayQuic, nodeQuic bool, tags []protocol.Tag) (relay, node *WebsocketNetwork, collector *payloadCollector) {
+	cfgA := defaultConfig
+	cfgA.GossipFanout = 1
+	cfgA.EnableQUICTransport = relayQuic
+	relay = makeTestWebsocketNodeWithConfig(t, cfgA)
+	relay.Start()
+
+	cfgB := defaultConfig
+	cfgB.GossipFanout = 1
+	cfgB.EnableQUICTransport = nodeQuic
+	node = makeTestWebsocketNodeWithConfig(t, cfgB)
+	addrA, postListen := relay.Address()
+	require.True(t, postListen)
+	node.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+
+	collector = &payloadCollector{payloads: make(chan []byte, 100)}
+	handlers := make([]TaggedMessageHandler, 0, len(tags))
+	for _, tag := range tags {
+		handlers = append(handlers, TaggedMessageHandler{Tag: tag, MessageHandler: collector})
+	}
+	node.RegisterHandlers(handlers)
+	node.Start()
+
+	// falling back to websockets takes up to quicHandshakeTimeout.
+	readyTimeout := time.NewTimer(quicHandshakeTimeout + 5*time.Second)
+	waitReady(t, relay, readyTimeout.C)
+	waitReady(t, node, readyTimeout.C)
+	return
+}
+
+// TestQuicTransportMessageClasses tests that messages of all the classes are exchanged over a QUIC connection,
+// in both directions.
+func TestQuicTransportMessageClasses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tags := []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.Txn2Tag, protocol.UniEnsBlockReqTag, protocol.NetPrioResponseTag}
+	relay, node, collector := makeQuicTestNetworks(t, true, true, tags)
+	defer relay.Stop()
+	defer node.Stop()
+	relayCollector := &payloadCollector{payloads: make(chan []byte, 100)}
+	relay.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: relayCollector}})
+
+	incoming := relay.GetPeers(PeersConnectedIn)
+	require.Len(t, incoming, 1)
+	require.IsType(t, &quicPeerConn{}, incoming[0].(*wsPeer).conn)
+	outgoing := node.GetPeers(PeersConnectedOut)
+	require.Len(t, outgoing, 1)
+	require.IsType(t, &quicPeerConn{}, outgoing[0].(*wsPeer).conn)
+
+	// a large proposal is sent ahead of the other messages, all of which should still arrive intact.
+	proposal := make([]byte, 2*1024*1024)
+	crypto.RandBytes(proposal)
+	expected := [][]byte{proposal}
+	relay.Broadcast(context.Background(), protocol.ProposalPayloadTag, proposal, true, nil)
+	for i, tag := range tags {
+		data := []byte{byte(i), 'q', 'u', 'i', 'c'}
+		expected = append(expected, data)
+		relay.Broadcast(context.Background(), tag, data, true, nil)
+	}
+	for range expected {
+		select {
+		case payload := <-collector.payloads:
+			found := false
+			for i, data := range expected {
+				if bytes.Equal(data, payload) {
+					expected = append(expected[:i], expected[i+1:]...)
+					found = true
+					break
+				}
+			}
+			require.True(t, found)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting for message", "%d left: %v", len(expected), expected[0][:5])
+		}
+	}
+
+	require.NoError(t, outgoing[0].(UnicastPeer).Unicast(context.Background(), []byte("vote"), protocol.AgreementVoteTag, nil))
+	select {
+	case payload := <-relayCollector.payloads:
+		require.Equal(t, []byte("vote"), payload)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for message")
+	}
+}
+
+// TestQuicTransportFallback tests that a node falls back to websockets when connecting to a relay which doesn't
+// accept QUIC connections, and that a relay accepting QUIC connections still accepts websocket connections.
+func TestQuicTransportFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, relayQuic := range []bool{false, true} {
+		relay, node, _ := makeQuicTestNetworks(t, relayQuic, !relayQuic, nil)
+		outgoing := node.GetPeers(PeersConnectedOut)
+		require.Len(t, outgoing, 1)
+		require.IsType(t, &websocket.Conn{}, outgoing[0].(*wsPeer).conn)
+		incoming := relay.GetPeers(PeersConnectedIn)
+		require.Len(t, incoming, 1)
+		require.IsType(t, &websocket.Conn{}, incoming[0].(*wsPeer).conn)
+
+		addrA, _ := relay.Address()
+		require.Equal(t, relayQuic, node.quicAllowed(addrA))
+		node.Stop()
+		relay.Stop()
+	}
+}
+
+// TestQuicTransportAdmission tests that the incoming QUIC connections are tracked and rate limited by the requests
+// tracker, the same way the websocket connections are.
+func TestQuicTransportAdmission(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfgA := defaultConfig
+	cfgA.GossipFanout = 1
+	cfgA.EnableQUICTransport = true
+	cfgA.ConnectionsRateLimitingCount = 1
+	cfgA.ConnectionsRateLimitingWindowSeconds = 60
+	cfgA.DisableLocalhostConnectionRateLimit = false
+	relay := makeTestWebsocketNodeWithConfig(t, cfgA)
+	relay.Start()
+	defer relay.Stop()
+
+	cfgB := defaultConfig
+	cfgB.GossipFanout = 1
+	cfgB.EnableQUICTransport = true
+	node := makeTestWebsocketNodeWithConfig(t, cfgB)
+	addrA, postListen := relay.Address()
+	require.True(t, postListen)
+	node.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	node.Start()
+	defer node.Stop()
+
+	readyTimeout := time.NewTimer(quicHandshakeTimeout + 5*time.Second)
+	waitReady(t, relay, readyTimeout.C)
+	waitReady(t, node, readyTimeout.C)
+	incoming := relay.GetPeers(PeersConnectedIn)
+	require.Len(t, incoming, 1)
+	require.IsType(t, &quicPeerConn{}, incoming[0].(*wsPeer).conn)
+
+	relay.requestsTracker.hostRequestsMu.Lock()
+	trackedQuic := 0
+	for localAddr := range relay.requestsTracker.acceptedConnections {
+		if _, ok := localAddr.(*quicLocalAddr); ok {
+			trackedQuic++
+		}
+	}
+	relay.requestsTracker.hostRequestsMu.Unlock()
+	require.Equal(t, 1, trackedQuic)
+
+	// a second connection from the same host exceeds the connection rate limit.
+	gossipAddr, err := node.addrToGossipAddr(addrA)
+	require.NoError(t, err)
+	requestHeader := make(http.Header)
+	node.setHeaders(requestHeader)
+	_, _, err = node.dialQuic(gossipAddr, requestHeader)
+	require.Error(t, err)
+	require.Len(t, relay.GetPeers(PeersConnectedIn), 1)
+}
+
+// TestQuicPeerConnPendingWrites tests that the messages queued on a QUIC connection are accounted for by the slow
+// writing peers detection, and that a closed connection doesn't accept any further messages.
+func TestQuicPeerConnPendingWrites(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	qc := &quicPeerConn{closed: make(chan struct{})}
+	for class := range qc.outgoing {
+		qc.outgoing[class] = make(chan quicOutgoingMessage, quicStreamWriteQueueLength)
+	}
+	peer := &wsPeer{conn: qc}
+	now := time.Now()
+	require.Zero(t, qc.oldestPendingWrite())
+	require.False(t, peer.CheckSlowWritingPeer(now))
+
+	qc.writing[quicStreamTransactions] = now.Add(-time.Second).UnixNano()
+	qc.writing[quicStreamProposals] = now.Add(-2 * maxMessageQueueDuration).UnixNano()
+	require.Equal(t, qc.writing[quicStreamProposals], qc.oldestPendingWrite())
+	require.True(t, peer.CheckSlowWritingPeer(now))
+	qc.writing[quicStreamProposals] = 0
+	require.False(t, peer.CheckSlowWritingPeer(now))
+
+	writeErr := errors.New("write failed")
+	qc.closeErr = writeErr
+	close(qc.closed)
+	require.Equal(t, writeErr, qc.WriteMessage(websocket.BinaryMessage, append([]byte(protocol.AgreementVoteTag), 1)))
+	require.Empty(t, qc.outgoing[quicStreamVotes])
+}
+
+func TestQuicStreamClassOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, quicStreamVotes, quicStreamClassOf(protocol.AgreementVoteTag))
+	require.Equal(t, quicStreamProposals, quicStreamClassOf(protocol.ProposalPayloadTag))
+	require.Equal(t, quicStreamTransactions, quicStreamClassOf(protocol.TxnTag))
+	require.Equal(t, quicStreamCatchup, quicStreamClassOf(protocol.UniEnsBlockReqTag))
+	require.Equal(t, quicStreamCatchup, quicStreamClassOf(protocol.TopicMsgRespTag))
+	require.Equal(t, quicStreamControl, quicStreamClassOf(protocol.MsgOfInterestTag))
+	require.Equal(t, quicStreamControl, quicStreamClassOf(protocol.NetPrioResponseTag))
+}
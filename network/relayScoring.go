@@ -0,0 +1,395 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// relayScoreMessageWindow is the duration for which we wait for a message to arrive from all the scored relays.
+	// relays that didn't deliver the message within that window are charged with relayScoreUndeliveredPenalty.
+	relayScoreMessageWindow = 5 * time.Second
+	// relayScoreUndeliveredPenalty is the delay sample recorded for a message that was never delivered by a relay.
+	relayScoreUndeliveredPenalty = 5 * time.Second
+	// relayScoreMaxPendingMessages caps the number of messages tracked concurrently.
+	relayScoreMaxPendingMessages = 2500
+	// relayScoreSampleRate is the inverse of the fraction of the monitored messages used to measure the delay. The
+	// sampled messages are picked by their digest, so that all the relays are measured on the same messages.
+	relayScoreSampleRate = 8
+	// relayScoreDelaySmoothing is the weight of a new sample in the exponential moving average of the relative delay.
+	relayScoreDelaySmoothing = 0.01
+	// relayScoreThroughputSmoothing is the weight of a new sample in the exponential moving average of the throughput.
+	relayScoreThroughputSmoothing = 0.3
+	// relayScoreThroughputWindow is the minimal duration over which the received bytes are accumulated before being
+	// converted into a throughput sample.
+	relayScoreThroughputWindow = 30 * time.Second
+	// relayScoreThroughputPenalty is the delay added to the score of a relay that has a negligible throughput compared
+	// to the best connected relay. Relays with intermediate throughput are charged proportionally.
+	relayScoreThroughputPenalty = 100 * time.Millisecond
+	// relayScoreMinSamples is the number of delay samples required before a relay score is considered meaningful.
+	relayScoreMinSamples = 200
+	// relayScoreRetention is the duration for which the score of a disconnected relay is retained.
+	relayScoreRetention = 24 * time.Hour
+	// relayRotationInterval is the minimal interval between two consecutive rotations of a scored connection.
+	relayRotationInterval = 10 * time.Minute
+)
+
+// relayScoreMonitoredTags are the broadcast message tags used to measure the relative delay of the relays.
+var relayScoreMonitoredTags = []Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.TxnTag}
+
+// relayScore is the measured performance of a single relay. The score survives disconnections, so that a well
+// performing relay would be preferred when the node reconnects.
+type relayScore struct {
+	// windowBytes and windowStart accumulate the bytes received since the last throughput sample. windowBytes is
+	// updated atomically, so it's kept first for 64 bit alignment.
+	windowBytes uint64
+	windowStart time.Time
+	// prefix is the network prefix of the relay's ip address, as seen on the last connection.
+	prefix string
+	// connected is true while we have an outgoing connection to the relay.
+	connected bool
+	// connectedAt is the time at which the current connection was established, in nanoseconds.
+	connectedAt int64
+	// delay is the moving average of the message arrival delay relative to the first relay that delivered it, in nanoseconds.
+	delay float64
+	// samples is the number of delay samples accumulated.
+	samples uint64
+	// throughput is the moving average of the received bytes per second.
+	throughput float64
+	// lastSeen is the last time the relay was connected.
+	lastSeen time.Time
+}
+
+// relayScoreMessage tracks the relays which delivered a single monitored message.
+type relayScoreMessage struct {
+	firstArrival int64
+	delivered    map[string]bool
+}
+
+// relayScoreboard continuously scores the relays we have outgoing connections to, by their relative message arrival
+// delay and their throughput. Unlike the connectionPerformanceMonitor, which runs a one-shot measurement over a fixed
+// set of connections, the scoreboard keeps updating as connections come and go, and is used to pick the outgoing
+// connections of non-relay nodes.
+type relayScoreboard struct {
+	// the read lock is enough to account for the received bytes, which happens for every message.
+	deadlock.RWMutex
+	monitoredTags map[Tag]bool
+	relays        map[string]*relayScore
+	messages      map[crypto.Digest]*relayScoreMessage
+	messageOrder  []crypto.Digest
+	lastRotation  time.Time
+	// rotatedOut is the address of the last relay dropped by a rotation.
+	rotatedOut string
+}
+
+func makeRelayScoreboard(messageTags []Tag) *relayScoreboard {
+	rs := &relayScoreboard{
+		monitoredTags: make(map[Tag]bool, len(messageTags)),
+		relays:        make(map[string]*relayScore),
+		messages:      make(map[crypto.Digest]*relayScoreMessage),
+	}
+	for _, tag := range messageTags {
+		rs.monitoredTags[tag] = true
+	}
+	return rs
+}
+
+// connected starts scoring the given relay address, which is reachable at the given remote address.
+func (rs *relayScoreboard) connected(address string, remoteAddr string, now time.Time) {
+	rs.Lock()
+	defer rs.Unlock()
+	relay := rs.relays[address]
+	if relay == nil {
+		relay = &relayScore{}
+		rs.relays[address] = relay
+	}
+	relay.prefix = addressPrefix(remoteAddr)
+	relay.connected = true
+	relay.connectedAt = now.UnixNano()
+	atomic.StoreUint64(&relay.windowBytes, 0)
+	relay.windowStart = now
+	relay.lastSeen = now
+}
+
+// disconnected stops scoring the given relay address, retaining its score.
+func (rs *relayScoreboard) disconnected(address string, now time.Time) {
+	rs.Lock()
+	defer rs.Unlock()
+	if relay := rs.relays[address]; relay != nil {
+		relay.connected = false
+		relay.lastSeen = now
+	}
+}
+
+// Notify updates the score of the relay from which the given message arrived. Only the sampled messages take the
+// write lock.
+func (rs *relayScoreboard) Notify(address string, msg *IncomingMessage) {
+	rs.RLock()
+	relay := rs.relays[address]
+	if relay == nil || !relay.connected {
+		rs.RUnlock()
+		return
+	}
+	atomic.AddUint64(&relay.windowBytes, uint64(len(msg.Data)+2))
+	rs.RUnlock()
+	if !rs.monitoredTags[msg.Tag] {
+		return
+	}
+	digest := msg.messageDigest()
+	if digest[0]%relayScoreSampleRate != 0 {
+		return
+	}
+
+	rs.Lock()
+	defer rs.Unlock()
+	// the relay might have been disconnected, and even reconnected, in the meantime.
+	if relay = rs.relays[address]; relay == nil || !relay.connected {
+		return
+	}
+	rs.pruneMessages(msg.Received)
+
+	pending := rs.messages[digest]
+	if pending == nil {
+		rs.messages[digest] = &relayScoreMessage{
+			firstArrival: msg.Received,
+			delivered:    map[string]bool{address: true},
+		}
+		rs.messageOrder = append(rs.messageOrder, digest)
+		relay.addDelaySample(0)
+		return
+	}
+	if pending.delivered[address] {
+		return
+	}
+	pending.delivered[address] = true
+	delay := msg.Received - pending.firstArrival
+	if delay < 0 {
+		// messages from different connections might be notified out of order.
+		delay = 0
+	}
+	relay.addDelaySample(delay)
+}
+
+// pruneMessages drops the messages whose arrival window has expired, charging the relays that didn't deliver them.
+// Should be called with the lock held.
+func (rs *relayScoreboard) pruneMessages(now int64) {
+	pruned := 0
+	for _, digest := range rs.messageOrder {
+		pending := rs.messages[digest]
+		if now-pending.firstArrival < int64(relayScoreMessageWindow) && len(rs.messageOrder)-pruned <= relayScoreMaxPendingMessages {
+			break
+		}
+		for address, relay := range rs.relays {
+			// only charge relays that were connected when the message first arrived.
+			if relay.connected && relay.connectedAt <= pending.firstArrival && !pending.delivered[address] {
+				relay.addDelaySample(int64(relayScoreUndeliveredPenalty))
+			}
+		}
+		delete(rs.messages, digest)
+		pruned++
+	}
+	if pruned > 0 {
+		rs.messageOrder = append(rs.messageOrder[:0], rs.messageOrder[pruned:]...)
+	}
+}
+
+// addDelaySample updates the moving average of the relative delay with the given sample.
+func (relay *relayScore) addDelaySample(delay int64) {
+	if relay.samples == 0 {
+		relay.delay = float64(delay)
+	} else {
+		relay.delay += relayScoreDelaySmoothing * (float64(delay) - relay.delay)
+	}
+	relay.samples++
+}
+
+// tick converts the bytes received by each of the connected relays into throughput samples, and forgets the relays
+// that haven't been connected for a long time. It's expected to be called periodically.
+func (rs *relayScoreboard) tick(now time.Time) {
+	rs.Lock()
+	defer rs.Unlock()
+	for address, relay := range rs.relays {
+		if !relay.connected {
+			if now.Sub(relay.lastSeen) > relayScoreRetention {
+				delete(rs.relays, address)
+			}
+			continue
+		}
+		relay.lastSeen = now
+		elapsed := now.Sub(relay.windowStart)
+		if elapsed < relayScoreThroughputWindow {
+			continue
+		}
+		sample := float64(atomic.SwapUint64(&relay.windowBytes, 0)) / elapsed.Seconds()
+		if relay.throughput == 0 {
+			relay.throughput = sample
+		} else {
+			relay.throughput += relayScoreThroughputSmoothing * (sample - relay.throughput)
+		}
+		relay.windowStart = now
+	}
+}
+
+// bestThroughput returns the highest throughput among the connected relays. Should be called with the lock held.
+func (rs *relayScoreboard) bestThroughput() (best float64) {
+	for _, relay := range rs.relays {
+		if relay.connected && relay.throughput > best {
+			best = relay.throughput
+		}
+	}
+	return
+}
+
+// score returns the effective delay of the relay, in nanoseconds; lower is better. The throughput is compared to the
+// best connected relay, so that a relay that delivers the messages quickly but misses much of the traffic would still
+// be ranked below a relay that delivers everything.
+func (relay *relayScore) score(bestThroughput float64) float64 {
+	score := relay.delay
+	if bestThroughput > 0 && relay.throughput < bestThroughput {
+		score += float64(relayScoreThroughputPenalty) * (1 - relay.throughput/bestThroughput)
+	}
+	return score
+}
+
+// ranked returns true if the relay has accumulated enough samples for its score to be meaningful.
+func (relay *relayScore) ranked() bool {
+	return relay.samples >= relayScoreMinSamples
+}
+
+// orderCandidates reorders the given candidate addresses for outgoing connections. Up to exploitSlots of the best
+// scored disconnected relays come first, followed by the rest of the candidates in their original order. A candidate
+// that shares a network prefix with a connected relay, or with a better candidate, is moved to the end of the list,
+// as is the relay that was most recently dropped by a rotation.
+func (rs *relayScoreboard) orderCandidates(candidates []string, exploitSlots int) []string {
+	rs.Lock()
+	defer rs.Unlock()
+	usedPrefixes := make(map[string]bool)
+	for _, relay := range rs.relays {
+		if relay.connected && relay.prefix != "" {
+			usedPrefixes[relay.prefix] = true
+		}
+	}
+
+	best := rs.bestThroughput()
+	known := make([]string, 0, len(candidates))
+	for _, address := range candidates {
+		if relay := rs.relays[address]; relay != nil && !relay.connected && relay.ranked() {
+			known = append(known, address)
+		}
+	}
+	sort.SliceStable(known, func(i, j int) bool {
+		return rs.relays[known[i]].score(best) < rs.relays[known[j]].score(best)
+	})
+
+	ordered := make([]string, 0, len(candidates))
+	picked := make(map[string]bool)
+	for _, address := range known {
+		if len(ordered) >= exploitSlots {
+			break
+		}
+		prefix := rs.relays[address].prefix
+		if prefix != "" && usedPrefixes[prefix] {
+			continue
+		}
+		usedPrefixes[prefix] = true
+		ordered = append(ordered, address)
+		picked[address] = true
+	}
+
+	var duplicates []string
+	for _, address := range candidates {
+		if picked[address] {
+			continue
+		}
+		if address == rs.rotatedOut {
+			// give other relays a chance before reconnecting to the one we've just dropped.
+			duplicates = append(duplicates, address)
+			continue
+		}
+		if relay := rs.relays[address]; relay != nil && relay.prefix != "" {
+			if usedPrefixes[relay.prefix] {
+				duplicates = append(duplicates, address)
+				continue
+			}
+			usedPrefixes[relay.prefix] = true
+		}
+		ordered = append(ordered, address)
+	}
+	return append(ordered, duplicates...)
+}
+
+// rotationCandidate returns the address of the connection that should be dropped in order to make room for exploring
+// another relay, or an empty string if no connection should be dropped at this time. Once all the given connections
+// are ranked, a connection that shares a network prefix with a better one is dropped first; otherwise, the worst
+// scored connection is dropped.
+func (rs *relayScoreboard) rotationCandidate(addresses []string, now time.Time) string {
+	rs.Lock()
+	defer rs.Unlock()
+	if len(addresses) == 0 || now.Sub(rs.lastRotation) < relayRotationInterval {
+		return ""
+	}
+	for _, address := range addresses {
+		if relay := rs.relays[address]; relay == nil || !relay.ranked() {
+			// keep measuring.
+			return ""
+		}
+	}
+
+	best := rs.bestThroughput()
+	ranked := append([]string{}, addresses...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return rs.relays[ranked[i]].score(best) < rs.relays[ranked[j]].score(best)
+	})
+	drop := ranked[len(ranked)-1]
+	usedPrefixes := make(map[string]bool)
+	for _, address := range ranked {
+		prefix := rs.relays[address].prefix
+		if prefix == "" {
+			continue
+		}
+		if usedPrefixes[prefix] {
+			drop = address
+			break
+		}
+		usedPrefixes[prefix] = true
+	}
+	rs.lastRotation = now
+	rs.rotatedOut = drop
+	return drop
+}
+
+// addressPrefix returns the network prefix of the given host:port remote address : a /16 for IPv4 addresses and
+// a /48 for IPv6 addresses. It returns an empty string if the address can't be parsed.
+func addressPrefix(remoteAddr string) string {
+	ip := net.ParseIP(justHost(remoteAddr))
+	if ip == nil {
+		return ""
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(16, 32)).String() + "/16"
+	}
+	return ip.Mask(net.CIDRMask(48, 128)).String() + "/48"
+}
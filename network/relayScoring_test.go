@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// feedRelayMessages notifies the scoreboard with count distinct vote messages, about one in relayScoreSampleRate of
+// which is sampled, delivered by each of the relays after the given delay. Relays with a negative delay don't deliver
+// the messages at all.
+func feedRelayMessages(rs *relayScoreboard, delays map[string]time.Duration, start time.Time, count int) time.Time {
+	// notify in the order of arrival.
+	addresses := make([]string, 0, len(delays))
+	for address := range delays {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return delays[addresses[i]] < delays[addresses[j]]
+	})
+	now := start
+	for i := 0; i < count; i++ {
+		now = start.Add(time.Duration(i) * 10 * time.Millisecond)
+		for _, address := range addresses {
+			delay := delays[address]
+			if delay < 0 {
+				continue
+			}
+			rs.Notify(address, &IncomingMessage{
+				Tag:      protocol.AgreementVoteTag,
+				Data:     []byte(fmt.Sprintf("vote-%d", i)),
+				Received: now.Add(delay).UnixNano(),
+			})
+		}
+	}
+	return now
+}
+
+func TestRelayScoreboardScoring(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := makeRelayScoreboard(relayScoreMonitoredTags)
+	start := time.Now()
+	for i, address := range []string{"fast", "slow", "silent"} {
+		rs.connected(address, fmt.Sprintf("10.%d.0.1:4160", i), start)
+	}
+	// messages from a relay we're not connected to are ignored.
+	end := feedRelayMessages(rs, map[string]time.Duration{"fast": 0, "slow": 50 * time.Millisecond, "silent": -1, "unknown": 0}, start, 2*relayScoreMinSamples*relayScoreSampleRate)
+	// expire all the pending messages.
+	rs.Lock()
+	rs.pruneMessages(end.Add(time.Minute).UnixNano())
+	rs.Unlock()
+
+	require.Nil(t, rs.relays["unknown"])
+	require.True(t, rs.relays["fast"].ranked())
+	require.True(t, rs.relays["slow"].ranked())
+	require.True(t, rs.relays["silent"].ranked())
+	require.InDelta(t, 0, rs.relays["fast"].delay, float64(time.Millisecond))
+	require.InDelta(t, float64(50*time.Millisecond), rs.relays["slow"].delay, float64(time.Millisecond))
+	require.InDelta(t, float64(relayScoreUndeliveredPenalty), rs.relays["silent"].delay, float64(100*time.Millisecond))
+
+	// the throughput is sampled once the window has elapsed; a relay with a fraction of the best throughput is
+	// charged accordingly.
+	rs.relays["fast"].windowBytes = 1000000
+	rs.relays["slow"].windowBytes = 500000
+	rs.tick(start.Add(relayScoreThroughputWindow))
+	best := rs.bestThroughput()
+	require.Equal(t, rs.relays["fast"].throughput, best)
+	require.Equal(t, rs.relays["fast"].delay, rs.relays["fast"].score(best))
+	require.InDelta(t, rs.relays["slow"].delay+float64(relayScoreThroughputPenalty)/2, rs.relays["slow"].score(best), 1)
+
+	// the score is retained after disconnecting, and forgotten once the retention period is over.
+	rs.disconnected("slow", start)
+	rs.tick(start.Add(relayScoreRetention / 2))
+	require.NotNil(t, rs.relays["slow"])
+	rs.tick(start.Add(2 * relayScoreRetention))
+	require.Nil(t, rs.relays["slow"])
+	require.NotNil(t, rs.relays["fast"])
+}
+
+func TestRelayScoreboardOrderCandidates(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := makeRelayScoreboard(relayScoreMonitoredTags)
+	start := time.Now()
+	rs.connected("a", "10.1.0.1:4160", start)
+	rs.connected("b", "10.1.0.2:4160", start)
+	rs.connected("c", "10.2.0.1:4160", start)
+	rs.connected("d", "10.3.0.1:4160", start)
+	feedRelayMessages(rs, map[string]time.Duration{"a": 0, "b": 10 * time.Millisecond, "c": 20 * time.Millisecond, "d": 30 * time.Millisecond}, start, 2*relayScoreMinSamples*relayScoreSampleRate)
+	for _, address := range []string{"a", "b", "c"} {
+		rs.disconnected(address, start)
+	}
+
+	// a and c are the best scored relays with distinct prefixes; b shares a prefix with a, and e is unknown.
+	// d is still connected, and is therefore not expected to be among the candidates.
+	require.Equal(t, []string{"a", "c", "e", "b"}, rs.orderCandidates([]string{"e", "b", "c", "a"}, 2))
+	// with a single exploitation slot, the rest of the candidates retain their order.
+	require.Equal(t, []string{"a", "e", "c", "b"}, rs.orderCandidates([]string{"e", "b", "c", "a"}, 1))
+	// without exploitation slots, only the prefix diversity is considered.
+	require.Equal(t, []string{"e", "b", "c", "a"}, rs.orderCandidates([]string{"e", "b", "c", "a"}, 0))
+	require.Equal(t, []string{"a", "c", "b"}, rs.orderCandidates([]string{"a", "b", "c"}, 0))
+}
+
+func TestRelayScoreboardRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := makeRelayScoreboard(relayScoreMonitoredTags)
+	start := time.Now()
+	rs.connected("a", "10.1.0.1:4160", start)
+	rs.connected("b", "10.2.0.1:4160", start)
+	rs.connected("c", "10.2.0.2:4160", start)
+	addresses := []string{"a", "b", "c"}
+
+	// no rotation until all the connections are ranked.
+	feedRelayMessages(rs, map[string]time.Duration{"a": 0, "b": 5 * time.Millisecond, "c": 10 * time.Millisecond}, start, relayScoreMinSamples/2*relayScoreSampleRate)
+	require.Equal(t, "", rs.rotationCandidate(addresses, start))
+
+	now := feedRelayMessages(rs, map[string]time.Duration{"a": 30 * time.Millisecond, "b": 5 * time.Millisecond, "c": 10 * time.Millisecond}, start.Add(time.Minute), 2*relayScoreMinSamples*relayScoreSampleRate)
+	// c shares a prefix with b, which is better, and therefore would be dropped before a.
+	require.Equal(t, "c", rs.rotationCandidate(addresses, now))
+	require.Equal(t, "", rs.rotationCandidate(addresses, now.Add(relayRotationInterval/2)))
+	// the rotated out relay would be the last candidate to reconnect.
+	rs.disconnected("c", now)
+	require.Equal(t, []string{"d", "c"}, rs.orderCandidates([]string{"c", "d"}, 0))
+
+	// with distinct prefixes, the worst scored connection is dropped.
+	require.Equal(t, "a", rs.rotationCandidate([]string{"a", "b"}, now.Add(relayRotationInterval)))
+}
+
+func TestAddressPrefix(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "192.168.0.0/16", addressPrefix("192.168.14.3:4160"))
+	require.Equal(t, "192.168.0.0/16", addressPrefix("192.168.200.1"))
+	require.Equal(t, "2001:db8:85a3::/48", addressPrefix("[2001:db8:85a3:8d3:1319:8a2e:370:7348]:4160"))
+	require.Equal(t, "", addressPrefix("relay.algorand.network:4160"))
+}
@@ -268,11 +268,16 @@ func (c *requestTrackedConnection) Close() error {
 
 // Accept waits for and returns the next connection to the listener.
 func (rt *RequestTracker) Accept() (conn net.Conn, err error) {
+	return rt.acceptFrom(rt.listener)
+}
+
+// acceptFrom waits for the next connection of the given listener which isn't rejected by the connection rate limiting.
+func (rt *RequestTracker) acceptFrom(listener net.Listener) (conn net.Conn, err error) {
 	// the following for loop is a bit tricky :
 	// in the normal use case, we accept the connection and exit right away.
 	// the only case where the for loop is being iterated is when we are rejecting a connection.
 	for {
-		conn, err = rt.listener.Accept()
+		conn, err = listener.Accept()
 		if err != nil || conn == nil {
 			return
 		}
@@ -413,6 +418,24 @@ func (rt *RequestTracker) Listener(listener net.Listener) net.Listener {
 	return rt
 }
 
+// requestTrackedListener applies the connection rate limiting of a RequestTracker to an additional listener,
+// so that the connections it accepts would be tracked along with the ones of the tracker's own listener.
+type requestTrackedListener struct {
+	net.Listener
+	tracker *RequestTracker
+}
+
+// Accept waits for and returns the next connection to the listener.
+func (l *requestTrackedListener) Accept() (net.Conn, error) {
+	return l.tracker.acceptFrom(l.Listener)
+}
+
+// TrackListener returns a listener wrapping the given one, whose connections are tracked and rate limited
+// together with the connections of the request tracker's own listener.
+func (rt *RequestTracker) TrackListener(listener net.Listener) net.Listener {
+	return &requestTrackedListener{Listener: listener, tracker: rt}
+}
+
 // GetTrackedRequest return the tracked request
 func (rt *RequestTracker) GetTrackedRequest(request *http.Request) (trackedRequest *TrackerRequest) {
 	rt.httpConnectionsMu.Lock()
@@ -233,6 +233,19 @@ type IncomingMessage struct {
 	// is used to ensure fairness across peers in terms of processing
 	// messages.
 	processing chan struct{}
+
+	// digest caches the digest of the message once computed by messageDigest.
+	digest    crypto.Digest
+	hasDigest bool
+}
+
+// messageDigest returns the digest of the message, computing it once for the monitors and filters that need it.
+func (msg *IncomingMessage) messageDigest() crypto.Digest {
+	if !msg.hasDigest {
+		msg.digest = generateMessageDigest(msg.Tag, msg.Data)
+		msg.hasDigest = true
+	}
+	return msg.digest
 }
 
 // Tag is a short string (2 bytes) marking a type of message
@@ -376,6 +389,19 @@ type WebsocketNetwork struct {
 	// connPerfMonitor is used on outgoing connections to measure their relative message timing
 	connPerfMonitor *connectionPerformanceMonitor
 
+	// relayScores continuously scores the relays we're connected to, and is used to select the outgoing connections.
+	// It is nil on relays, which keep using the connPerfMonitor for dropping their least performing connection.
+	relayScores *relayScoreboard
+
+	// quicListener and quicServer accept the gossip connections made over QUIC, when the QUIC transport is enabled.
+	quicListener *quicListener
+	quicServer   *http.Server
+
+	// quicFallbacks maps the relay addresses we've failed to connect to over QUIC to the time at which
+	// QUIC would be attempted again.
+	quicFallbacks  map[string]time.Time
+	quicFallbackMu deadlock.Mutex
+
 	// lastNetworkAdvanceMu syncronized the access to lastNetworkAdvance
 	lastNetworkAdvanceMu deadlock.Mutex
 
@@ -413,6 +439,14 @@ type WebsocketNetwork struct {
 	// is consumed by any of the messageHandlerThread(s). The ticker itself is created during
 	// Start(), and being shut down when Stop() is called.
 	peersConnectivityCheckTicker *time.Ticker
+
+	// pinnedPeers contains the relay addresses the node operator asked to always keep connected to.
+	// Locked by pinnedPeersMu.
+	pinnedPeers   map[string]bool
+	pinnedPeersMu deadlock.Mutex
+
+	// compressor applies the per-message compression policy for peers that have negotiated compression.
+	compressor msgCompressor
 }
 
 type broadcastRequest struct {
@@ -704,6 +738,8 @@ func (wn *WebsocketNetwork) setup() {
 	wn.meshUpdateRequests = make(chan meshRequest, 5)
 	wn.readyChan = make(chan struct{})
 	wn.tryConnectAddrs = make(map[string]int64)
+	wn.pinnedPeers = make(map[string]bool)
+	wn.compressor = makeMsgCompressor(wn.config, wn.log)
 	wn.eventualReadyDelay = time.Minute
 	wn.prioTracker = newPrioTracker(wn)
 	if wn.slowWritingPeerMonitorInterval == 0 {
@@ -727,6 +763,10 @@ func (wn *WebsocketNetwork) setup() {
 		wn.incomingMsgFilter = makeMessageFilter(wn.config.IncomingMessageFilterBucketCount, wn.config.IncomingMessageFilterBucketSize)
 	}
 	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag})
+	if wn.config.NetAddress == "" {
+		wn.relayScores = makeRelayScoreboard(relayScoreMonitoredTags)
+	}
+	wn.quicFallbacks = make(map[string]time.Time)
 	wn.lastNetworkAdvance = time.Now().UTC()
 	wn.handlers.log = wn.log
 
@@ -773,6 +813,9 @@ func (wn *WebsocketNetwork) Start() {
 		// wrap the limited connection listener with a requests tracker listener
 		wn.listener = wn.requestsTracker.Listener(listener)
 		wn.log.Debugf("listening on %s", wn.listener.Addr().String())
+		if wn.config.EnableQUICTransport {
+			wn.startQuicListener()
+		}
 		wn.throttledOutgoingConnections = int32(wn.config.GossipFanout / 2)
 	} else {
 		// on non-relay, all the outgoing connections are throttled.
@@ -864,6 +907,12 @@ func (wn *WebsocketNetwork) Stop() {
 	if err != nil {
 		wn.log.Warnf("problem shutting down %s: %v", listenAddr, err)
 	}
+	if wn.quicServer != nil {
+		err = wn.quicServer.Shutdown(ctx)
+		if err != nil {
+			wn.log.Warnf("problem shutting down quic listener %s: %v", listenAddr, err)
+		}
+	}
 	wn.wg.Wait()
 	if wn.listener != nil {
 		wn.log.Debugf("closed %s", listenAddr)
@@ -1094,6 +1143,10 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	wn.setHeaders(responseHeader)
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
+	compression := wn.compressor.negotiate(request.Header)
+	if compression {
+		wn.compressor.setHeader(responseHeader)
+	}
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1111,15 +1164,23 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		wn.requestsLogger.SetStatusCode(response, http.StatusSwitchingProtocols)
 	}
 
+	var peerConn wsPeerWebsocketConn = conn
+	if quicConn := quicPeerConnOf(request, conn); quicConn != nil {
+		// the handshake was carried out over the control stream of a QUIC connection.
+		peerConn = quicConn
+		networkQuicConnectionsTotal.Inc(nil)
+	}
+
 	peer := &wsPeer{
 		wsPeerCore:        makePeerCore(wn, trackedRequest.otherPublicAddr, wn.GetRoundTripper(), trackedRequest.remoteHost),
-		conn:              conn,
+		conn:              peerConn,
 		outgoing:          false,
 		InstanceName:      trackedRequest.otherInstanceName,
 		incomingMsgFilter: wn.incomingMsgFilter,
 		prioChallenge:     challenge,
 		createTime:        trackedRequest.created,
 		version:           matchingVersion,
+		compression:       compression,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1248,7 +1309,7 @@ func (wn *WebsocketNetwork) checkSlowWritingPeers() {
 }
 
 func (wn *WebsocketNetwork) sendFilterMessage(msg IncomingMessage) {
-	digest := generateMessageDigest(msg.Tag, msg.Data)
+	digest := msg.messageDigest()
 	//wn.log.Debugf("send filter %s(%d) %v", msg.Tag, len(msg.Data), digest)
 	err := wn.Broadcast(context.Background(), protocol.MsgDigestSkipTag, digest[:], false, msg.Sender)
 	if err != nil && err != errBcastQFull {
@@ -1423,6 +1484,21 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 		}
 	}
 
+	// compress the messages once for all the peers that have negotiated compression.
+	var compressed [][]byte
+	for i, tag := range request.tags {
+		if !wn.compressor.shouldCompress(tag, len(request.data[i])) {
+			continue
+		}
+		if compressed == nil {
+			if !anyPeerCompression(peers) {
+				break
+			}
+			compressed = make([][]byte, len(request.data), len(request.data))
+		}
+		compressed[i] = wn.compressor.frame(data[i])
+	}
+
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
 	for _, peer := range peers {
@@ -1432,7 +1508,7 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 		if peer == request.except {
 			continue
 		}
-		ok := peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime, nil)
+		ok := peer.writeNonBlockMsgs(request.ctx, data, compressed, prio, digests, request.enqueueTime, nil)
 		if ok {
 			sentMessageCount++
 			continue
@@ -1445,6 +1521,16 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 	networkBroadcastSendMicros.AddUint64(uint64(dt.Nanoseconds()/1000), nil)
 }
 
+// anyPeerCompression returns true if any of the given peers has negotiated compression.
+func anyPeerCompression(peers []*wsPeer) bool {
+	for _, peer := range peers {
+		if peer.compression {
+			return true
+		}
+	}
+	return false
+}
+
 // NumPeers returns number of peers we connect to (all peers incoming and outbound).
 func (wn *WebsocketNetwork) NumPeers() int {
 	wn.peersLock.RLock()
@@ -1465,6 +1551,16 @@ func (wn *WebsocketNetwork) outgoingPeers() (peers []Peer) {
 	return
 }
 
+// unpinnedOutgoingPeers returns the outgoing peers that were not pinned by the node operator.
+func (wn *WebsocketNetwork) unpinnedOutgoingPeers() (peers []Peer) {
+	for _, peer := range wn.outgoingPeers() {
+		if !wn.isPinned(peer.(*wsPeer).rootURL) {
+			peers = append(peers, peer)
+		}
+	}
+	return
+}
+
 func (wn *WebsocketNetwork) numOutgoingPeers() int {
 	wn.peersLock.RLock()
 	defer wn.peersLock.RUnlock()
@@ -1580,6 +1676,10 @@ func (wn *WebsocketNetwork) meshThread() {
 			close(request.done)
 		}
 
+		if wn.relayScores != nil {
+			wn.relayScores.tick(time.Now())
+		}
+
 		// send the currently connected peers information to the
 		// telemetry server; that would allow the telemetry server
 		// to construct a cross-node map of all the nodes interconnections.
@@ -1593,14 +1693,23 @@ func (wn *WebsocketNetwork) meshThread() {
 // note that the determination of needed connection could be inaccurate, and it might return false while
 // more connection should be created.
 func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
+	// pinned peers are connected to regardless of the GossipFanout target.
+	pinnedConnecting := wn.connectPinnedPeers()
 	desired := wn.config.GossipFanout
 	numOutgoingTotal := wn.numOutgoingPeers() + wn.numOutgoingPending()
 	need := desired - numOutgoingTotal
 	if need <= 0 {
-		return false
+		return pinnedConnecting
+	}
+	var newAddrs []string
+	if wn.relayScores != nil {
+		// consider all the relays, so that the best scored ones could be picked.
+		newAddrs = wn.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole)
+		newAddrs = wn.relayScores.orderCandidates(newAddrs, desired-wn.explorationSlots()-numOutgoingTotal)
+	} else {
+		// get more than we need so that we can ignore duplicates
+		newAddrs = wn.phonebook.GetAddresses(desired+numOutgoingTotal, PhoneBookEntryRelayRole)
 	}
-	// get more than we need so that we can ignore duplicates
-	newAddrs := wn.phonebook.GetAddresses(desired+numOutgoingTotal, PhoneBookEntryRelayRole)
 	for _, na := range newAddrs {
 		if na == wn.config.PublicAddress {
 			// filter out self-public address, so we won't try to connect to outselves.
@@ -1631,6 +1740,12 @@ func (wn *WebsocketNetwork) checkExistingConnectionsNeedDisconnecting() bool {
 		return wn.checkNetworkAdvanceDisconnect()
 	}
 
+	// when exploring, periodically free a slot for another relay; the performance monitor below keeps
+	// dropping the least performing peer either way.
+	if wn.relayScores != nil && wn.explorationSlots() > 0 && wn.checkRelayRotation() {
+		return true
+	}
+
 	if !wn.connPerfMonitor.ComparePeers(outgoingPeers) {
 		// different set of peers. restart monitoring.
 		wn.connPerfMonitor.Reset(outgoingPeers)
@@ -1662,6 +1777,42 @@ func (wn *WebsocketNetwork) checkExistingConnectionsNeedDisconnecting() bool {
 	return true
 }
 
+// explorationSlots returns the number of outgoing connection slots reserved for exploring unscored relays.
+func (wn *WebsocketNetwork) explorationSlots() int {
+	slots := wn.config.OutgoingRelayExplorationSlots
+	if slots < 0 {
+		return 0
+	}
+	if slots > wn.config.GossipFanout {
+		return wn.config.GossipFanout
+	}
+	return slots
+}
+
+// checkRelayRotation periodically drops the worst scored outgoing connection, freeing an exploration slot
+// for another relay. It returns true if a connection was dropped. It's only used when exploration slots are configured.
+func (wn *WebsocketNetwork) checkRelayRotation() bool {
+	if wn.numOutgoingPending() > 0 {
+		return false
+	}
+	outgoingPeers := wn.unpinnedOutgoingPeers()
+	addresses := make([]string, len(outgoingPeers))
+	for i, peer := range outgoingPeers {
+		addresses[i] = peer.(*wsPeer).rootURL
+	}
+	drop := wn.relayScores.rotationCandidate(addresses, time.Now())
+	if drop == "" {
+		return false
+	}
+	for _, peer := range outgoingPeers {
+		if peer.(*wsPeer).rootURL == drop {
+			wn.disconnect(peer, disconnectRelayRotation)
+			return true
+		}
+	}
+	return false
+}
+
 // checkNetworkAdvanceDisconnect is using the lastNetworkAdvance indicator to see if the network is currently "stuck".
 // if it's seems to be "stuck", a randomally picked peer would be disconnected.
 func (wn *WebsocketNetwork) checkNetworkAdvanceDisconnect() bool {
@@ -1669,7 +1820,7 @@ func (wn *WebsocketNetwork) checkNetworkAdvanceDisconnect() bool {
 	if time.Now().UTC().Sub(lastNetworkAdvance) < cliqueResolveInterval {
 		return false
 	}
-	outgoingPeers := wn.outgoingPeers()
+	outgoingPeers := wn.unpinnedOutgoingPeers()
 	if len(outgoingPeers) == 0 {
 		return false
 	}
@@ -1986,18 +2137,12 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	}
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, ProtocolVersion)
+	wn.compressor.setHeader(requestHeader)
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
-	var websocketDialer = websocket.Dialer{
-		Proxy:             http.ProxyFromEnvironment,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-		NetDialContext:    wn.dialer.DialContext,
-		NetDial:           wn.dialer.Dial,
-	}
 
-	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
+	conn, response, err := wn.dialGossip(addr, gossipAddr, requestHeader)
 	if err != nil {
 		if err == websocket.ErrBadHandshake {
 			// reading here from ioutil is safe only because it came from DialContext above, which alredy finsihed reading all the data from the network
@@ -2041,11 +2186,14 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		return
 	}
 
+	// pinned connections are maintained regardless of their performance.
 	throttledConnection := false
-	if atomic.AddInt32(&wn.throttledOutgoingConnections, int32(-1)) >= 0 {
-		throttledConnection = true
-	} else {
-		atomic.AddInt32(&wn.throttledOutgoingConnections, int32(1))
+	if !wn.isPinned(addr) {
+		if atomic.AddInt32(&wn.throttledOutgoingConnections, int32(-1)) >= 0 {
+			throttledConnection = true
+		} else {
+			atomic.AddInt32(&wn.throttledOutgoingConnections, int32(1))
+		}
 	}
 
 	peer := &wsPeer{
@@ -2055,10 +2203,15 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		incomingMsgFilter:           wn.incomingMsgFilter,
 		createTime:                  time.Now(),
 		connMonitor:                 wn.connPerfMonitor,
+		relayScores:                 wn.relayScores,
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
+		compression:                 wn.compressor.negotiate(response.Header),
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
+	if wn.relayScores != nil {
+		wn.relayScores.connected(addr, conn.RemoteAddr().String(), time.Now())
+	}
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
 	localAddr, _ := wn.Address()
@@ -2178,6 +2331,9 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	if peer.outgoing {
 		eventDetails.Endpoint = peer.GetAddress()
 		eventDetails.MessageDelay = peer.peerMessageDelay
+		if wn.relayScores != nil {
+			wn.relayScores.disconnected(peer.rootURL, time.Now())
+		}
 	}
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.DisconnectPeerEvent,
 		telemetryspec.DisconnectPeerEventDetails{
@@ -94,8 +94,17 @@ type wsPeerWebsocketConn interface {
 	SetPongHandler(h func(appData string) error)
 }
 
+// wsPeerAsyncWriter is implemented by the connections whose WriteMessage queues the message rather than writing
+// it, so that the slow writing peers could be detected for them too.
+type wsPeerAsyncWriter interface {
+	// oldestPendingWrite returns the UnixNano time at which the oldest message not yet written was passed to
+	// WriteMessage, or zero if there is no such message.
+	oldestPendingWrite() int64
+}
+
 type sendMessage struct {
 	data         []byte
+	compressed   []byte                               // when non-nil, the framed ( and possibly compressed ) representation of data, sent to peers that have negotiated compression
 	enqueued     time.Time                            // the time at which the message was first generated
 	peerEnqueued time.Time                            // the time at which the peer was attempting to enqueue the message
 	msgTags      map[protocol.Tag]bool                // when msgTags is specified ( i.e. non-nil ), the send goroutine is to replace the message tag filter with this one. No data would be accompanied to this message.
@@ -125,6 +134,8 @@ const disconnectCliqueResolve disconnectReason = "CliqueResolving"
 const disconnectRequestReceived disconnectReason = "DisconnectRequest"
 const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectClientCallback disconnectReason = "ClientCallback"
+const disconnectAdminRequest disconnectReason = "AdminRequest"
+const disconnectRelayRotation disconnectReason = "RelayRotation"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -149,6 +160,10 @@ type wsPeer struct {
 	// Nonce used to uniquely identify requests
 	requestNonce uint64
 
+	// messagesReceived and messagesSent count the messages exchanged with this peer.
+	messagesReceived uint64
+	messagesSent     uint64
+
 	wsPeerCore
 
 	// conn will be *websocket.Conn (except in testing)
@@ -208,6 +223,10 @@ type wsPeer struct {
 	// field set to nil.
 	connMonitor *connectionPerformanceMonitor
 
+	// relayScores is used on outgoing connections of non-relay nodes to continuously score the relay
+	// we're connected to. It is nil otherwise.
+	relayScores *relayScoreboard
+
 	// peerMessageDelay is calculated by the connection monitor; it's the relative avarage per-message delay.
 	peerMessageDelay int64
 
@@ -215,6 +234,10 @@ type wsPeer struct {
 	// performance or not. Throttled connections are more likely to be short-lived connections.
 	throttledOutgoingConnection bool
 
+	// compression is true if both sides have negotiated per-message compression during the handshake, in which case
+	// the messages with a compressible tag are framed with a compression format byte.
+	compression bool
+
 	// clientDataStore is a generic key/value store used to store client-side data entries associated with a particular peer.
 	// Locked by clientDataStoreMu.
 	clientDataStore map[string]interface{}
@@ -465,8 +488,22 @@ func (wp *wsPeer) readLoop() {
 		atomic.StoreInt64(&wp.lastPacketTime, msg.Received)
 		networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+2), nil)
 		networkMessageReceivedTotal.AddUint64(1, nil)
+		atomic.AddUint64(&wp.messagesReceived, 1)
 		networkReceivedBytesByTag.Add(string(tag[:]), uint64(len(msg.Data)+2))
 		networkMessageReceivedByTag.Add(string(tag[:]), 1)
+		if wp.compression && compressibleTags[msg.Tag] {
+			var decompressed bool
+			msg.Data, decompressed, err = unframe(msg.Data)
+			if err != nil {
+				wp.net.log.Warnf("wsPeer readLoop: unable to decode %s message from %s : %v", msg.Tag, wp.conn.RemoteAddr().String(), err)
+				networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "bad compressed message"})
+				cleanupCloseError = disconnectBadData
+				return
+			}
+			if decompressed {
+				networkDecompressedMessagesReceivedTotal.Inc(nil)
+			}
+		}
 		msg.Sender = wp
 		msg.Sequence = sequenceCounters[msg.Tag]
 		sequenceCounters[msg.Tag] = msg.Sequence + 1
@@ -476,6 +513,9 @@ func (wp *wsPeer) readLoop() {
 		if wp.connMonitor != nil {
 			wp.connMonitor.Notify(&msg)
 		}
+		if wp.relayScores != nil {
+			wp.relayScores.Notify(wp.rootURL, &msg)
+		}
 
 		switch msg.Tag {
 		case protocol.MsgOfInterestTag:
@@ -670,9 +710,17 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		wp.net.log.Infof("failed to send ping message to peer : %v", err)
 	}
 
+	data := msg.data
+	if wp.compression && compressibleTags[tag] {
+		data = msg.compressed
+		if data == nil {
+			data = wp.net.compressor.frame(msg.data)
+		}
+	}
+
 	atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, msg.enqueued.UnixNano())
 	defer atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, 0)
-	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
+	err := wp.conn.WriteMessage(websocket.BinaryMessage, data)
 	if err != nil {
 		if atomic.LoadInt32(&wp.didInnerClose) == 0 {
 			wp.net.log.Warn("peer write error ", err)
@@ -687,10 +735,18 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectWriteError
 	}
 	atomic.StoreInt64(&wp.lastPacketTime, time.Now().UnixNano())
-	networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+	networkSentBytesTotal.AddUint64(uint64(len(data)), nil)
+	networkSentBytesByTag.Add(string(tag), uint64(len(data)))
 	networkMessageSentTotal.AddUint64(1, nil)
+	atomic.AddUint64(&wp.messagesSent, 1)
 	networkMessageSentByTag.Add(string(tag), 1)
+	if len(data) < len(msg.data) {
+		// the framing adds a single byte; a shorter message was compressed.
+		saved := uint64(len(msg.data) - len(data))
+		networkCompressedMessagesSentTotal.Inc(nil)
+		networkCompressionSavedBytesTotal.AddUint64(saved, nil)
+		networkCompressionSavedBytesByTag.Add(string(tag), saved)
+	}
 	networkMessageQueueMicrosTotal.AddUint64(uint64(time.Now().Sub(msg.peerEnqueued).Nanoseconds()/1000), nil)
 
 	if msg.callback != nil {
@@ -749,11 +805,11 @@ func (wp *wsPeer) writeNonBlock(ctx context.Context, data []byte, highPrio bool,
 	digests := make([]crypto.Digest, 1, 1)
 	msgs[0] = data
 	digests[0] = digest
-	return wp.writeNonBlockMsgs(ctx, msgs, highPrio, digests, msgEnqueueTime, callback)
+	return wp.writeNonBlockMsgs(ctx, msgs, nil, highPrio, digests, msgEnqueueTime, callback)
 }
 
 // return true if enqueued/sent
-func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, highPrio bool, digest []crypto.Digest, msgEnqueueTime time.Time, callback UnicastWebsocketMessageStateCallback) bool {
+func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, compressed [][]byte, highPrio bool, digest []crypto.Digest, msgEnqueueTime time.Time, callback UnicastWebsocketMessageStateCallback) bool {
 	includeIndices := make([]int, 0, len(data))
 	for i := range data {
 		if wp.outgoingMsgFilter != nil && len(data[i]) > messageFilterSize && wp.outgoingMsgFilter.CheckDigest(digest[i], false, false) {
@@ -775,7 +831,11 @@ func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, highPrio
 	msgs := make([]sendMessage, 0, len(includeIndices))
 	enqueueTime := time.Now()
 	for _, index := range includeIndices {
-		msgs = append(msgs, sendMessage{data: data[index], enqueued: msgEnqueueTime, peerEnqueued: enqueueTime, ctx: ctx, callback: callback})
+		msg := sendMessage{data: data[index], enqueued: msgEnqueueTime, peerEnqueued: enqueueTime, ctx: ctx, callback: callback}
+		if compressed != nil {
+			msg.compressed = compressed[index]
+		}
+		msgs = append(msgs, msg)
 	}
 
 	if highPrio {
@@ -827,6 +887,12 @@ func (wp *wsPeer) GetLastPacketTime() int64 {
 
 func (wp *wsPeer) CheckSlowWritingPeer(now time.Time) bool {
 	ongoingMessageTime := atomic.LoadInt64(&wp.intermittentOutgoingMessageEnqueueTime)
+	if ongoingMessageTime == 0 {
+		// a connection writing asynchronously might still be writing messages it has already accepted.
+		if asyncConn, ok := wp.conn.(wsPeerAsyncWriter); ok {
+			ongoingMessageTime = asyncConn.oldestPendingWrite()
+		}
+	}
 	if ongoingMessageTime == 0 {
 		return false
 	}
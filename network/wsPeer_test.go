@@ -96,4 +96,6 @@ func TestAtomicVariablesAligment(t *testing.T) {
 	require.True(t, (unsafe.Offsetof(p.requestNonce)%8) == 0)
 	require.True(t, (unsafe.Offsetof(p.lastPacketTime)%8) == 0)
 	require.True(t, (unsafe.Offsetof(p.intermittentOutgoingMessageEnqueueTime)%8) == 0)
+	require.True(t, (unsafe.Offsetof(p.messagesReceived)%8) == 0)
+	require.True(t, (unsafe.Offsetof(p.messagesSent)%8) == 0)
 }
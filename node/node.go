@@ -51,10 +51,12 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/txnsync"
+	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/execpool"
 	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-algorand/util/timers"
+	"github.com/algorand/go-algorand/util/tokens"
 	"github.com/algorand/go-deadlock"
 )
 
@@ -103,7 +105,9 @@ type AlgorandFullNode struct {
 	accountManager  *data.AccountManager
 
 	agreementService         *agreement.Service
+	evidence                 *agreement.EvidenceStore
 	catchupService           *catchup.Service
+	catchupPeerStats         *catchup.PeerStatsStore
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
 	ledgerService            *rpcs.LedgerService
@@ -117,6 +121,9 @@ type AlgorandFullNode struct {
 	genesisHash crypto.Digest
 	devMode     bool // is this node operates in a developer mode ? ( benign agreement, broadcasting transaction generates a new block )
 
+	// phonebookAddresses are the static relay addresses the node was started with.
+	phonebookAddresses []string
+
 	log logging.Logger
 
 	// syncStatusMu used for locking lastRoundTimestamp and hasSyncedSinceStartup
@@ -162,9 +169,35 @@ type TxnWithStatus struct {
 // MakeFull sets up an Algorand full node
 // (i.e., it returns a node that participates in consensus)
 func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+	if genesis.DevMode {
+		cfg.DisableNetworking = true
+	}
+
+	p2pNode, err := network.NewWebsocketNetwork(log.With("name", cfg.NetAddress), cfg, phonebookAddresses, genesis.ID(), genesis.Network)
+	if err != nil {
+		log.Errorf("could not create websocket node: %v", err)
+		return nil, err
+	}
+
+	node, err := makeFull(log, rootDir, cfg, phonebookAddresses, genesis, p2pNode, timers.MakeMonotonicClock(time.Now()), timers.MakeMonotonicClock(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	p2pNode.SetPrioScheme(node)
+	return node, nil
+}
+
+// MakeEmulatedFull sets up an Algorand full node over an emulated network. The agreement and transaction
+// sync timeouts are driven by the given virtual time, which would typically be the one driving the network.
+func MakeEmulatedFull(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, net *network.EmulatedNode, vt *timers.VirtualTime) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, nil, genesis, net, vt.Clock(), vt.Clock())
+}
 
+// makeFull sets up an Algorand full node on top of the given gossip network.
+func makeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis, net network.GossipNode, agreementClock timers.Clock, txnSyncClock timers.WallClock) (*AlgorandFullNode, error) {
 	node := new(AlgorandFullNode)
 	node.rootDir = rootDir
+	node.phonebookAddresses = phonebookAddresses
 	node.log = log.With("name", cfg.NetAddress)
 	node.genesisID = genesis.ID()
 	node.genesisHash = crypto.HashObj(genesis)
@@ -176,13 +209,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network)
-	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
-		return nil, err
-	}
-	p2pNode.SetPrioScheme(node)
-	node.net = p2pNode
+	node.net = net
 	node.accountManager = data.MakeAccountManager(log)
 
 	accountListener := makeTopAccountListener(log)
@@ -192,7 +219,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
 
 	// create initial ledger, if it doesn't exist
-	err = os.Mkdir(genesisDir, 0700)
+	err := os.Mkdir(genesisDir, 0700)
 	if err != nil && !os.IsExist(err) {
 		log.Errorf("Unable to create genesis directory: %v", err)
 		return nil, err
@@ -226,9 +253,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		}
 	}
 
-	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
-	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
-	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
+	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, node.net, node.genesisID)
+	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, node.net, node.genesisID)
+	rpcs.RegisterTxService(node.transactionPool, node.net, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
 	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
@@ -237,13 +264,23 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	evidencePathname := filepath.Join(genesisDir, config.EvidenceFilename)
+	evidenceAccess, err := db.MakeAccessor(evidencePathname, false, false)
+	if err != nil {
+		log.Errorf("Cannot load equivocation evidence data: %v", err)
+		return nil, err
+	}
+	node.evidence, err = agreement.MakeEvidenceStore(evidenceAccess)
+	if err != nil {
+		log.Errorf("Cannot initialize equivocation evidence data: %v", err)
+		evidenceAccess.Close()
+		return nil, err
+	}
+
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
-	var agreementClock timers.Clock
 	if node.devMode {
 		agreementClock = timers.MakeFrozenClock()
-	} else {
-		agreementClock = timers.MakeMonotonicClock(time.Now())
 	}
 	agreementParameters := agreement.Parameters{
 		Logger:         log,
@@ -257,13 +294,27 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		KeyManager:     node,
 		RandomSource:   node,
 		BacklogPool:    node.highPriorityCryptoVerificationPool,
+
+		EvidenceRecorder: node.evidence,
 	}
 	node.agreementService = agreement.MakeService(agreementParameters)
 
-	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeBatchingAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool, cfg.VoteVerificationBatchWindow)}
+	node.catchupService = catchup.MakeService(node.log, node.config, node.net, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	// the peer statistics only guide the peer selection; catchup works without them, so failing to load them isn't fatal.
+	catchupPeerStatsPathname := filepath.Join(genesisDir, config.CatchupPeerStatsFilename)
+	catchupPeerStatsAccess, err := db.MakeAccessor(catchupPeerStatsPathname, false, false)
+	if err != nil {
+		log.Warnf("Cannot load catchup peer statistics: %v", err)
+	} else if catchupPeerStats, err := catchup.MakePeerStatsStore(catchupPeerStatsAccess); err != nil {
+		log.Warnf("Cannot initialize catchup peer statistics: %v", err)
+		catchupPeerStatsAccess.Close()
+	} else {
+		node.catchupPeerStats = catchupPeerStats
+		node.catchupService.SetPeerStatsStore(catchupPeerStats)
+	}
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
-	node.txnSyncConnector = makeTransactionSyncNodeConnector(node)
+	node.txnSyncConnector = makeTransactionSyncNodeConnector(node, txnSyncClock)
 	node.txnSyncService = txnsync.MakeTransactionSyncService(node.log, node.txnSyncConnector, cfg.NetAddress != "", node.genesisID, node.genesisHash, node.config, node.lowPriorityCryptoVerificationPool)
 
 	err = node.loadParticipationKeys()
@@ -459,6 +510,16 @@ func (node *AlgorandFullNode) Stop() {
 		node.blockService.Stop()
 		node.ledgerService.Stop()
 	}
+	// the catchup service isn't running at this point, whether it was just stopped or the node is in catchpoint
+	// catchup mode, so nothing records into the peer statistics anymore.
+	if node.catchupPeerStats != nil {
+		if err := node.catchupPeerStats.Close(); err != nil {
+			node.log.Warnf("Cannot store catchup peer statistics: %v", err)
+		}
+		node.catchupPeerStats = nil
+	}
+	// likewise, the agreement service isn't running, so its evidence writer has exited.
+	node.evidence.Close()
 	node.catchupBlockAuth.Quit()
 	node.highPriorityCryptoVerificationPool.Shutdown()
 	node.lowPriorityCryptoVerificationPool.Shutdown()
@@ -752,6 +813,22 @@ func (node *AlgorandFullNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: node.transactionPool.FeePerByte()}
 }
 
+// FeeMarket returns a summary of the fees offered by the transactions pending in the transaction pool.
+func (node *AlgorandFullNode) FeeMarket() pools.FeeMarket {
+	return node.transactionPool.FeeMarket()
+}
+
+// AgreementState returns a snapshot of the current state of the agreement service.
+func (node *AlgorandFullNode) AgreementState(ctx context.Context) (agreement.State, error) {
+	return node.agreementService.Inspect(ctx)
+}
+
+// Equivocations returns up to max of the equivocations observed by the agreement service, for rounds starting at
+// minRound. A max of zero returns all of them.
+func (node *AlgorandFullNode) Equivocations(minRound basics.Round, max uint64) ([]agreement.EquivocationEvidence, error) {
+	return node.evidence.Equivocations(minRound, max)
+}
+
 // GetPendingTxnsFromPool returns a snapshot of every pending transactions from the node's transaction pool in a slice.
 // Transactions are sorted in decreasing order. If no transactions, returns an empty slice.
 func (node *AlgorandFullNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
@@ -837,9 +914,32 @@ func (node *AlgorandFullNode) loadParticipationKeys() error {
 		}
 	}
 
+	node.loadRemoteParticipationKeys()
 	return nil
 }
 
+// loadRemoteParticipationKeys retrieves the participation keys held by the configured remote participation signer.
+// The remote signer being unreachable isn't fatal; the previously retrieved keys remain in use until the next refresh.
+func (node *AlgorandFullNode) loadRemoteParticipationKeys() {
+	if node.config.ParticipationSignerEndpoint == "" {
+		return
+	}
+	token, err := util.GetFirstLineFromFile(filepath.Join(node.rootDir, tokens.ParticipationSignerTokenFilename))
+	if err != nil && !os.IsNotExist(err) {
+		node.log.Warnf("loadRemoteParticipationKeys: unable to read the participation signer token: %v", err)
+		return
+	}
+	parts, err := account.FetchRemoteParticipations(node.config.ParticipationSignerEndpoint, token)
+	if err != nil {
+		node.log.Warnf("loadRemoteParticipationKeys: unable to retrieve the remote participation keys: %v", err)
+		return
+	}
+	added := node.accountManager.SetRemoteParticipations(parts)
+	if added > 0 {
+		node.log.Infof("Loaded %d participation keys from the remote participation signer at %s", added, node.config.ParticipationSignerEndpoint)
+	}
+}
+
 var txPoolGuage = metrics.MakeGauge(metrics.MetricName{Name: "algod_tx_pool_count", Description: "current number of available transactions in pool"})
 
 func (node *AlgorandFullNode) txPoolGaugeThread() {
@@ -1139,7 +1239,7 @@ func (node *AlgorandFullNode) AssembleBlock(round basics.Round, deadline time.Ti
 // VotingKeys implements the key manager's VotingKeys method, and provides additional validation with the ledger.
 // that allows us to load multiple overlapping keys for the same account, and filter these per-round basis.
 func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []account.Participation {
-	keys := node.accountManager.Keys(votingRound)
+	keys := append(node.accountManager.Keys(votingRound), node.accountManager.RemoteKeys(votingRound)...)
 
 	participations := make([]account.Participation, 0, len(keys))
 	accountsData := make(map[basics.Address]basics.OnlineAccountData, len(keys))
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"os"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/network"
+)
+
+// ErrPeerManagementUnsupported is returned when the node's network implementation doesn't support peer management.
+var ErrPeerManagementUnsupported = errors.New("peer management is not supported by the node network")
+
+// peerManager returns the websocket network the node is using, if any.
+func (node *AlgorandFullNode) peerManager() (*network.WebsocketNetwork, error) {
+	wn, ok := node.net.(*network.WebsocketNetwork)
+	if !ok {
+		return nil, ErrPeerManagementUnsupported
+	}
+	return wn, nil
+}
+
+// PeersInfo returns the list of the currently connected peers.
+func (node *AlgorandFullNode) PeersInfo() ([]network.PeerInfo, error) {
+	wn, err := node.peerManager()
+	if err != nil {
+		return nil, err
+	}
+	return wn.PeersInfo(), nil
+}
+
+// DisconnectPeer disconnects the peer with the given address.
+func (node *AlgorandFullNode) DisconnectPeer(address string) error {
+	wn, err := node.peerManager()
+	if err != nil {
+		return err
+	}
+	node.log.Infof("disconnecting peer %s per admin request", address)
+	return wn.DisconnectPeer(address)
+}
+
+// PinPeer asks the network to maintain an outgoing connection to the given relay address.
+func (node *AlgorandFullNode) PinPeer(address string) error {
+	wn, err := node.peerManager()
+	if err != nil {
+		return err
+	}
+	node.log.Infof("pinning peer %s per admin request", address)
+	return wn.PinPeer(address)
+}
+
+// UnpinPeer removes the given relay address from the set of pinned peers.
+func (node *AlgorandFullNode) UnpinPeer(address string) error {
+	wn, err := node.peerManager()
+	if err != nil {
+		return err
+	}
+	node.log.Infof("unpinning peer %s per admin request", address)
+	return wn.UnpinPeer(address)
+}
+
+// ReloadPhonebook reloads the static relay addresses from the phonebook file in the data directory.
+// If there is no such file, the addresses the node was started with are used. It returns the number
+// of loaded addresses.
+func (node *AlgorandFullNode) ReloadPhonebook() (int, error) {
+	wn, err := node.peerManager()
+	if err != nil {
+		return 0, err
+	}
+	addresses, err := config.LoadPhonebook(node.rootDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return 0, err
+		}
+		addresses = node.phonebookAddresses
+	}
+	node.log.Infof("reloading phonebook with %d addresses per admin request", len(addresses))
+	wn.ReloadPhonebook(addresses)
+	return len(addresses), nil
+}
@@ -46,11 +46,11 @@ type transactionSyncNodeConnector struct {
 	openStateCh    chan struct{}
 }
 
-func makeTransactionSyncNodeConnector(node *AlgorandFullNode) *transactionSyncNodeConnector {
+func makeTransactionSyncNodeConnector(node *AlgorandFullNode, clock timers.WallClock) *transactionSyncNodeConnector {
 	return &transactionSyncNodeConnector{
 		node:        node,
 		eventsCh:    make(chan txnsync.Event, 1),
-		clock:       timers.MakeMonotonicClock(time.Now()),
+		clock:       clock,
 		txHandler:   node.txHandler.SolicitedAsyncTxHandler(),
 		openStateCh: make(chan struct{}),
 	}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// BlockBundleFileExtension is the extension of the block bundle files.
+const BlockBundleFileExtension = ".blockbundle"
+
+// BlockBundleFileName returns the conventional name of the block bundle file holding the given range of rounds.
+func BlockBundleFileName(genesisID string, first, last basics.Round) string {
+	return fmt.Sprintf("%s-%d-%d%s", genesisID, first, last, BlockBundleFileExtension)
+}
+
+// BlockBundleHeader describes the content of a block bundle.
+type BlockBundleHeader struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	GenesisID   string        `codec:"gen"`
+	GenesisHash crypto.Digest `codec:"gh"`
+
+	// FirstRound and LastRound are the rounds of the first and last blocks in the bundle, inclusive.
+	FirstRound basics.Round `codec:"first"`
+	LastRound  basics.Round `codec:"last"`
+}
+
+// A block bundle is a gzip compressed stream, holding the msgpack encoded BlockBundleHeader followed by
+// the msgpack encoded EncodedBlockCert of each of the rounds, in order. Its content is the same as the
+// one served by the block service, so bundles could be written out of the raw blocks returned by any node.
+
+// BlockBundleWriter writes a block bundle.
+type BlockBundleWriter struct {
+	gz     *gzip.Writer
+	header BlockBundleHeader
+	next   basics.Round
+}
+
+// MakeBlockBundleWriter starts writing a block bundle for the given header into w.
+func MakeBlockBundleWriter(w io.Writer, header BlockBundleHeader) (*BlockBundleWriter, error) {
+	if header.LastRound < header.FirstRound {
+		return nil, fmt.Errorf("MakeBlockBundleWriter: last round %d is before first round %d", header.LastRound, header.FirstRound)
+	}
+	gz := gzip.NewWriter(w)
+	_, err := gz.Write(protocol.Encode(&header))
+	if err != nil {
+		return nil, err
+	}
+	return &BlockBundleWriter{gz: gz, header: header, next: header.FirstRound}, nil
+}
+
+// Add appends the given encoded block and certificate, formatted as an EncodedBlockCert, to the bundle.
+// The blocks need to be added in order.
+func (bw *BlockBundleWriter) Add(encodedBlockCert []byte) error {
+	if bw.next > bw.header.LastRound {
+		return fmt.Errorf("BlockBundleWriter.Add: the bundle is already complete up to round %d", bw.header.LastRound)
+	}
+	var entry EncodedBlockCert
+	err := protocol.Decode(encodedBlockCert, &entry)
+	if err != nil {
+		return fmt.Errorf("BlockBundleWriter.Add: unable to decode block %d: %w", bw.next, err)
+	}
+	if entry.Block.Round() != bw.next || entry.Certificate.Round != bw.next {
+		return fmt.Errorf("BlockBundleWriter.Add: expected block %d, got block %d with certificate %d", bw.next, entry.Block.Round(), entry.Certificate.Round)
+	}
+	if entry.Block.GenesisHash() != bw.header.GenesisHash {
+		return fmt.Errorf("BlockBundleWriter.Add: block %d has genesis hash %v rather than %v", bw.next, entry.Block.GenesisHash(), bw.header.GenesisHash)
+	}
+	_, err = bw.gz.Write(encodedBlockCert)
+	if err != nil {
+		return err
+	}
+	bw.next++
+	return nil
+}
+
+// Close completes the bundle. It doesn't close the underlying writer.
+func (bw *BlockBundleWriter) Close() error {
+	if bw.next <= bw.header.LastRound {
+		bw.gz.Close()
+		return fmt.Errorf("BlockBundleWriter.Close: the bundle is missing rounds %d to %d", bw.next, bw.header.LastRound)
+	}
+	return bw.gz.Close()
+}
+
+// BlockBundleReader reads the blocks of a block bundle.
+type BlockBundleReader struct {
+	gz      *gzip.Reader
+	decoder protocol.Decoder
+	next    basics.Round
+
+	// Header describes the content of the bundle.
+	Header BlockBundleHeader
+}
+
+// OpenBlockBundle starts reading the block bundle from r.
+func OpenBlockBundle(r io.Reader) (*BlockBundleReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("OpenBlockBundle: not a block bundle: %w", err)
+	}
+	br := &BlockBundleReader{gz: gz, decoder: protocol.NewDecoder(gz)}
+	err = br.decoder.Decode(&br.Header)
+	if err != nil {
+		gz.Close()
+		return nil, fmt.Errorf("OpenBlockBundle: unable to decode the bundle header: %w", err)
+	}
+	if br.Header.LastRound < br.Header.FirstRound {
+		gz.Close()
+		return nil, fmt.Errorf("OpenBlockBundle: last round %d is before first round %d", br.Header.LastRound, br.Header.FirstRound)
+	}
+	br.next = br.Header.FirstRound
+	return br, nil
+}
+
+// Next returns the next block of the bundle along with its certificate, or io.EOF once all of them were read.
+func (br *BlockBundleReader) Next() (*bookkeeping.Block, *agreement.Certificate, error) {
+	if br.next > br.Header.LastRound {
+		return nil, nil, io.EOF
+	}
+	var entry EncodedBlockCert
+	err := br.decoder.Decode(&entry)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, nil, fmt.Errorf("BlockBundleReader.Next: unable to decode block %d: %w", br.next, err)
+	}
+	if entry.Block.Round() != br.next || entry.Certificate.Round != br.next {
+		return nil, nil, fmt.Errorf("BlockBundleReader.Next: expected block %d, got block %d with certificate %d", br.next, entry.Block.Round(), entry.Certificate.Round)
+	}
+	br.next++
+	return &entry.Block, &entry.Certificate, nil
+}
+
+// Close releases the resources of the reader. It doesn't close the underlying reader.
+func (br *BlockBundleReader) Close() error {
+	return br.gz.Close()
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package rpcs
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func encodedTestBlockCert(genesisHash crypto.Digest, round basics.Round) []byte {
+	var entry EncodedBlockCert
+	entry.Block.BlockHeader.Round = round
+	entry.Block.BlockHeader.GenesisHash = genesisHash
+	entry.Certificate.Round = round
+	return protocol.Encode(&entry)
+}
+
+func TestBlockBundleRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	header := BlockBundleHeader{
+		GenesisID:   "test-v1",
+		GenesisHash: crypto.Hash([]byte("test-v1")),
+		FirstRound:  5,
+		LastRound:   9,
+	}
+	var buf bytes.Buffer
+	bw, err := MakeBlockBundleWriter(&buf, header)
+	require.NoError(t, err)
+	for r := header.FirstRound; r <= header.LastRound; r++ {
+		require.NoError(t, bw.Add(encodedTestBlockCert(header.GenesisHash, r)))
+	}
+	require.Error(t, bw.Add(encodedTestBlockCert(header.GenesisHash, header.LastRound+1)))
+	require.NoError(t, bw.Close())
+	require.Equal(t, "test-v1-5-9.blockbundle", BlockBundleFileName(header.GenesisID, header.FirstRound, header.LastRound))
+
+	br, err := OpenBlockBundle(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, header, br.Header)
+	for r := header.FirstRound; r <= header.LastRound; r++ {
+		var block *bookkeeping.Block
+		var cert *agreement.Certificate
+		block, cert, err = br.Next()
+		require.NoError(t, err)
+		require.Equal(t, r, block.Round())
+		require.Equal(t, r, cert.Round)
+		require.Equal(t, header.GenesisHash, block.GenesisHash())
+	}
+	_, _, err = br.Next()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, br.Close())
+}
+
+func TestBlockBundleWriterRejectsUnexpectedBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	header := BlockBundleHeader{GenesisID: "test-v1", GenesisHash: crypto.Hash([]byte("test-v1")), FirstRound: 1, LastRound: 3}
+	var buf bytes.Buffer
+	bw, err := MakeBlockBundleWriter(&buf, header)
+	require.NoError(t, err)
+
+	require.Error(t, bw.Add(encodedTestBlockCert(header.GenesisHash, 2)))
+	require.Error(t, bw.Add(encodedTestBlockCert(crypto.Hash([]byte("other-v1")), 1)))
+	require.Error(t, bw.Add([]byte{0xff}))
+	require.NoError(t, bw.Add(encodedTestBlockCert(header.GenesisHash, 1)))
+	require.Error(t, bw.Close())
+
+	_, err = MakeBlockBundleWriter(&buf, BlockBundleHeader{FirstRound: 3, LastRound: 2})
+	require.Error(t, err)
+}
+
+func TestBlockBundleReaderTruncated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	header := BlockBundleHeader{GenesisID: "test-v1", GenesisHash: crypto.Hash([]byte("test-v1")), FirstRound: 1, LastRound: 3}
+	var buf bytes.Buffer
+	bw, err := MakeBlockBundleWriter(&buf, header)
+	require.NoError(t, err)
+	require.NoError(t, bw.Add(encodedTestBlockCert(header.GenesisHash, 1)))
+	require.NoError(t, bw.Add(encodedTestBlockCert(header.GenesisHash, 2)))
+	// close the gzip stream without the last block, as an interrupted export would.
+	require.NoError(t, bw.gz.Close())
+
+	br, err := OpenBlockBundle(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	_, _, err = br.Next()
+	require.NoError(t, err)
+	_, _, err = br.Next()
+	require.NoError(t, err)
+	_, _, err = br.Next()
+	require.Error(t, err)
+	require.NotEqual(t, io.EOF, err)
+
+	_, err = OpenBlockBundle(bytes.NewReader([]byte("not a bundle")))
+	require.Error(t, err)
+}
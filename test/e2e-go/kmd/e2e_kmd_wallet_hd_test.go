@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeHDWalletAndHandleToken(f *fixtures.KMDFixture, a *require.Assertions, mdk crypto.MasterDerivationKey) string {
+	req0 := kmdapi.APIV1POSTWalletRequest{
+		WalletName:          "hd",
+		WalletPassword:      f.WalletPassword,
+		WalletDriverName:    "sqlite",
+		MasterDerivationKey: mdk,
+		DerivationScheme:    wallet.DerivationBIP32Ed25519,
+	}
+	resp0 := kmdapi.APIV1POSTWalletResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+	a.Equal(wallet.DerivationBIP32Ed25519, resp0.Wallet.DerivationScheme)
+
+	req1 := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       resp0.Wallet.ID,
+		WalletPassword: f.WalletPassword,
+	}
+	resp1 := kmdapi.APIV1POSTWalletInitResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	return resp1.WalletHandleToken
+}
+
+func TestHDWalletGenerateDeriveAndExport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	var mdk crypto.MasterDerivationKey
+	crypto.RandBytes(mdk[:])
+	walletHandleToken := makeHDWalletAndHandleToken(&f, a, mdk)
+
+	// The wallet keys are the ones of the BIP-39 mnemonic of the mdk
+	mnemonic, err := passphrase.KeyToBIP39Mnemonic(mdk[:])
+	a.NoError(err)
+	master := crypto.HDMasterKey(passphrase.BIP39Seed(mnemonic, ""))
+
+	// Generated keys follow m/44'/283'/0'/0/i
+	for i := uint32(0); i < 2; i++ {
+		resp := kmdapi.APIV1POSTKeyResponse{}
+		err = f.Client.DoV1Request(kmdapi.APIV1POSTKeyRequest{WalletHandleToken: walletHandleToken}, &resp)
+		a.NoError(err)
+
+		expected, err := master.Derive(crypto.AlgorandHDPath(0, i))
+		a.NoError(err)
+		a.Equal(basics.Address(expected.PublicKey()).String(), resp.Address)
+	}
+
+	// Keys can be derived at any path, but only once
+	req0 := kmdapi.APIV1POSTKeyDeriveRequest{
+		WalletHandleToken: walletHandleToken,
+		Path:              "m/44'/283'/1'/0/0",
+	}
+	resp0 := kmdapi.APIV1POSTKeyDeriveResponse{}
+	err = f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+	expected, err := master.Derive(crypto.AlgorandHDPath(1, 0))
+	a.NoError(err)
+	a.Equal(basics.Address(expected.PublicKey()).String(), resp0.Address)
+	err = f.Client.DoV1Request(req0, &resp0)
+	a.Error(err)
+
+	// The extended public key derives the generated addresses
+	req1 := kmdapi.APIV1POSTExtendedPublicKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		Path:              "m/44'/283'/0'/0",
+	}
+	resp1 := kmdapi.APIV1POSTExtendedPublicKeyExportResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	xpub, err := crypto.HDPublicKeyFromBytes(resp1.ExtendedPublicKey)
+	a.NoError(err)
+	child, err := xpub.Child(1)
+	a.NoError(err)
+	generated, err := master.Derive(crypto.AlgorandHDPath(0, 1))
+	a.NoError(err)
+	a.Equal(generated.PublicKey(), child.PublicKey)
+
+	// Derived keys can't be exported
+	req2 := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           resp0.Address,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp2 := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+}
+
+func TestHDWalletSignTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	var mdk crypto.MasterDerivationKey
+	crypto.RandBytes(mdk[:])
+	walletHandleToken := makeHDWalletAndHandleToken(&f, a, mdk)
+
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(kmdapi.APIV1POSTKeyRequest{WalletHandleToken: walletHandleToken}, &resp0)
+	a.NoError(err)
+	addr, err := basics.UnmarshalChecksumAddress(resp0.Address)
+	a.NoError(err)
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     addr,
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+
+	req1 := kmdapi.APIV1POSTTransactionSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Transaction:       protocol.Encode(&tx),
+		WalletPassword:    f.WalletPassword,
+	}
+	resp1 := kmdapi.APIV1POSTTransactionSignResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	// The signature is a standard ed25519 signature by the sender
+	var stx transactions.SignedTxn
+	err = protocol.Decode(resp1.SignedTransaction, &stx)
+	a.NoError(err)
+	a.True(stx.AuthAddr.IsZero())
+	a.True(crypto.SignatureVerifier(addr).Verify(tx, stx.Sig))
+}
+
+func TestSequentialWalletRejectsDerivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	req0 := kmdapi.APIV1POSTKeyDeriveRequest{
+		WalletHandleToken: walletHandleToken,
+		Path:              "m/44'/283'/0'/0/0",
+	}
+	resp0 := kmdapi.APIV1POSTKeyDeriveResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.Error(err)
+
+	req1 := kmdapi.APIV1POSTExtendedPublicKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		Path:              "m/44'/283'/0'/0",
+	}
+	resp1 := kmdapi.APIV1POSTExtendedPublicKeyExportResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.Error(err)
+}
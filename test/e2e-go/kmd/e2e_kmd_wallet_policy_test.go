@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testPolicyPassword = "policy"
+
+func generateKey(f *fixtures.KMDFixture, a *require.Assertions, walletHandleToken string) basics.Address {
+	resp := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(kmdapi.APIV1POSTKeyRequest{WalletHandleToken: walletHandleToken}, &resp)
+	a.NoError(err)
+	addr, err := basics.UnmarshalChecksumAddress(resp.Address)
+	a.NoError(err)
+	return addr
+}
+
+func testPayment(sender, receiver basics.Address, amount uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     sender,
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func signTransaction(f *fixtures.KMDFixture, walletHandleToken string, tx transactions.Transaction) error {
+	req := kmdapi.APIV1POSTTransactionSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Transaction:       protocol.Encode(&tx),
+		WalletPassword:    f.WalletPassword,
+	}
+	resp := kmdapi.APIV1POSTTransactionSignResponse{}
+	return f.Client.DoV1Request(req, &resp)
+}
+
+func TestPolicyRestrictsSigning(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	addr := generateKey(&f, a, walletHandleToken)
+	var friend, stranger basics.Address
+	crypto.RandBytes(friend[:])
+	crypto.RandBytes(stranger[:])
+
+	// Set the policy
+	p := kmdapi.APIV1Policy{
+		AllowedReceivers: []string{friend.String()},
+		MaxAmount:        1000000,
+		ForbidRekey:      true,
+	}
+	req0 := kmdapi.APIV1POSTPolicyRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		PolicyPassword:    testPolicyPassword,
+		Policy:            p,
+	}
+	resp0 := kmdapi.APIV1POSTPolicyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	req1 := kmdapi.APIV1POSTPolicyInfoRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp1 := kmdapi.APIV1POSTPolicyInfoResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	a.True(resp1.Enforced)
+	a.Equal(p, resp1.Policy)
+
+	// Only what the policy allows gets signed
+	a.NoError(signTransaction(&f, walletHandleToken, testPayment(addr, friend, 1000)))
+	a.Error(signTransaction(&f, walletHandleToken, testPayment(addr, stranger, 1000)))
+	a.Error(signTransaction(&f, walletHandleToken, testPayment(addr, friend, 2000000)))
+	rekey := testPayment(addr, friend, 1000)
+	rekey.RekeyTo = stranger
+	a.Error(signTransaction(&f, walletHandleToken, rekey))
+
+	// Keys can't be taken out to sign elsewhere
+	req2 := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           addr.String(),
+		WalletPassword:    f.WalletPassword,
+	}
+	resp2 := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+
+	// The wallet password alone can't lift the policy
+	req3 := kmdapi.APIV1DELETEPolicyRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		PolicyPassword:    f.WalletPassword,
+	}
+	resp3 := kmdapi.APIV1DELETEPolicyResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.Error(err)
+
+	// Every decision is in the audit log
+	req4 := kmdapi.APIV1POSTAuditLogRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp4 := kmdapi.APIV1POSTAuditLogResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+	a.True(resp4.Intact)
+	a.Len(resp4.Entries, 7)
+	var ops []string
+	var allowed []bool
+	for _, entry := range resp4.Entries {
+		ops = append(ops, entry.Operation)
+		allowed = append(allowed, entry.Allowed)
+	}
+	a.Equal([]string{policy.OpSetPolicy, policy.OpSignTransaction, policy.OpSignTransaction, policy.OpSignTransaction, policy.OpSignTransaction, policy.OpExportKey, policy.OpDeletePolicy}, ops)
+	a.Equal([]bool{true, true, false, false, false, false, false}, allowed)
+	a.Equal(addr.String(), resp4.Entries[1].Sender)
+	last := resp4.Entries[len(resp4.Entries)-1]
+	a.Equal(last.Seq, resp4.HeadSeq)
+	a.Equal(last.Hash, resp4.HeadHash)
+
+	// The head kept from the last request vouches for the log up to it
+	req4.TrustedHeadSeq = resp4.HeadSeq
+	req4.TrustedHeadHash = resp4.HeadHash
+	resp4 = kmdapi.APIV1POSTAuditLogResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+	a.True(resp4.Intact)
+
+	// The policy password lifts it
+	req3.PolicyPassword = testPolicyPassword
+	resp5 := kmdapi.APIV1DELETEPolicyResponse{}
+	err = f.Client.DoV1Request(req3, &resp5)
+	a.NoError(err)
+	a.NoError(signTransaction(&f, walletHandleToken, testPayment(addr, stranger, 2000000)))
+	resp6 := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req2, &resp6)
+	a.NoError(err)
+}
+
+func TestPolicyRequiresCosigners(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	addr := generateKey(&f, a, walletHandleToken)
+	var guard basics.Address
+	crypto.RandBytes(guard[:])
+
+	req0 := kmdapi.APIV1POSTPolicyRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		PolicyPassword:    testPolicyPassword,
+		Policy:            kmdapi.APIV1Policy{RequiredCosigners: []string{guard.String()}},
+	}
+	resp0 := kmdapi.APIV1POSTPolicyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Single signatures leave the guard out
+	a.Error(signTransaction(&f, walletHandleToken, testPayment(addr, guard, 1000)))
+
+	// So do multisig accounts that reach their threshold without it
+	msigSign := func(threshold uint8) error {
+		req1 := kmdapi.APIV1POSTMultisigImportRequest{
+			WalletHandleToken: walletHandleToken,
+			Version:           1,
+			Threshold:         threshold,
+			PKs:               []crypto.PublicKey{crypto.PublicKey(addr), crypto.PublicKey(guard)},
+		}
+		resp1 := kmdapi.APIV1POSTMultisigImportResponse{}
+		err := f.Client.DoV1Request(req1, &resp1)
+		a.NoError(err)
+		msigAddr, err := basics.UnmarshalChecksumAddress(resp1.Address)
+		a.NoError(err)
+
+		tx := testPayment(msigAddr, guard, 1000)
+		req2 := kmdapi.APIV1POSTMultisigTransactionSignRequest{
+			WalletHandleToken: walletHandleToken,
+			Transaction:       protocol.Encode(&tx),
+			PublicKey:         crypto.PublicKey(addr),
+			WalletPassword:    f.WalletPassword,
+		}
+		resp2 := kmdapi.APIV1POSTMultisigTransactionSignResponse{}
+		return f.Client.DoV1Request(req2, &resp2)
+	}
+	a.NoError(msigSign(2))
+	a.Error(msigSign(1))
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package emulation hosts a set of complete Algorand nodes in a single process, connected over an emulated
+// network. The network and the agreement timeouts are driven by a shared virtual time, allowing tests to
+// script partitions, link degradation and node restarts, and to reproduce these without spinning up a
+// cluster.
+package emulation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+const (
+	defaultStep        = 10 * time.Millisecond
+	defaultMaxTickWait = 100 * time.Millisecond
+	defaultKeyLifetime = 10000
+)
+
+// NodeConfig describes a single emulated node.
+type NodeConfig struct {
+	// Name identifies the node in the emulated network.
+	Name string
+	// Relay nodes relay the gossiped messages, and serve blocks to the other nodes.
+	Relay bool
+	// Stake is the amount of microalgos held by the node's participating account. Nodes without stake don't
+	// participate in the agreement.
+	Stake uint64
+}
+
+// Config describes the emulated nodes, and the way these are connected.
+type Config struct {
+	Nodes []NodeConfig
+	// Consensus is the consensus version of the genesis; the current version is used if left empty.
+	Consensus protocol.ConsensusVersion
+	// Link is the default profile of all the links in the emulated network.
+	Link network.LinkProfile
+	// Seed is the seed of the network's random source.
+	Seed int64
+	// Step is the virtual time advanced on every tick of Run.
+	Step time.Duration
+	// MaxTickWait bounds the real time a tick waits for the nodes to handle the messages delivered during it.
+	MaxTickWait time.Duration
+	// Local, if set, may adjust the local configuration of every node before it is created.
+	Local func(name string, cfg *config.Local)
+}
+
+// Emulation is a set of full nodes connected over an emulated network.
+type Emulation struct {
+	t        testing.TB
+	cfg      Config
+	log      logging.Logger
+	time     *timers.VirtualTime
+	net      *network.EmulatedNetwork
+	genesis  bookkeeping.Genesis
+	names    []string
+	rootDirs map[string]string
+	configs  map[string]config.Local
+	nodes    map[string]*node.AlgorandFullNode
+}
+
+// MakeEmulation creates the genesis, the participation keys and the data directories of the configured
+// nodes, and sets up the nodes over a new emulated network. Non-relay nodes connect to all the relays; if
+// there are no relays, all the nodes connect to each other.
+func MakeEmulation(t testing.TB, cfg Config) *Emulation {
+	if cfg.Consensus == "" {
+		cfg.Consensus = protocol.ConsensusCurrentVersion
+	}
+	if cfg.Step == 0 {
+		cfg.Step = defaultStep
+	}
+	if cfg.MaxTickWait == 0 {
+		cfg.MaxTickWait = defaultMaxTickWait
+	}
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Warn)
+	vt := timers.MakeVirtualTime()
+	e := &Emulation{
+		t:        t,
+		cfg:      cfg,
+		log:      log,
+		time:     vt,
+		net:      network.MakeEmulatedNetwork(log, vt, cfg.Seed),
+		rootDirs: make(map[string]string),
+		configs:  make(map[string]config.Local),
+		nodes:    make(map[string]*node.AlgorandFullNode),
+	}
+	e.net.SetDefaultLink(cfg.Link)
+	e.genesis = bookkeeping.Genesis{
+		SchemaID:    "emulation",
+		Proto:       cfg.Consensus,
+		Network:     "emulated",
+		FeeSink:     testSinkAddr.String(),
+		RewardsPool: testPoolAddr.String(),
+	}
+	proto := config.Consensus[cfg.Consensus]
+	e.genesis.Allocation = append(e.genesis.Allocation,
+		bookkeeping.GenesisAllocation{
+			Address: testPoolAddr.String(),
+			Comment: "RewardsPool",
+			State:   basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: proto.MinBalance}},
+		},
+		bookkeeping.GenesisAllocation{
+			Address: testSinkAddr.String(),
+			Comment: "FeeSink",
+			State:   basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: proto.MinBalance}},
+		})
+
+	tempDir, err := ioutil.TempDir("", "emulation")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(tempDir)
+	})
+	for _, nc := range cfg.Nodes {
+		rootDir := filepath.Join(tempDir, nc.Name)
+		genesisDir := filepath.Join(rootDir, e.genesis.ID())
+		require.NoError(t, os.MkdirAll(genesisDir, 0700))
+		e.names = append(e.names, nc.Name)
+		e.rootDirs[nc.Name] = rootDir
+
+		local := config.GetDefaultLocal()
+		if nc.Relay {
+			local.NetAddress = nc.Name + ":4160"
+			local.Archival = true
+		}
+		if cfg.Local != nil {
+			cfg.Local(nc.Name, &local)
+		}
+		e.configs[nc.Name] = local
+
+		if nc.Stake > 0 {
+			e.genesis.Allocation = append(e.genesis.Allocation, makeParticipant(t, genesisDir, nc, proto.DefaultKeyDilution))
+		}
+	}
+
+	for _, name := range e.names {
+		_, err := e.net.AddNode(name, e.configs[name], e.genesis.ID())
+		require.NoError(t, err)
+		e.nodes[name] = e.makeNode(name)
+	}
+	e.connect()
+	return e
+}
+
+// makeParticipant creates the root and participation keys of the given node, and returns its genesis allocation.
+func makeParticipant(t testing.TB, genesisDir string, nc NodeConfig, keyDilution uint64) bookkeeping.GenesisAllocation {
+	rootAccess, err := db.MakeAccessor(filepath.Join(genesisDir, config.RootKeyFilename(nc.Name)), false, false)
+	require.NoError(t, err)
+	defer rootAccess.Close()
+	root, err := account.GenerateRoot(rootAccess)
+	require.NoError(t, err)
+
+	partAccess, err := db.MakeAccessor(filepath.Join(genesisDir, config.PartKeyFilename(nc.Name, 0, defaultKeyLifetime)), false, false)
+	require.NoError(t, err)
+	defer partAccess.Close()
+	part, err := account.FillDBWithParticipationKeys(partAccess, root.Address(), 0, defaultKeyLifetime, keyDilution)
+	require.NoError(t, err)
+	defer part.Close()
+
+	return bookkeeping.GenesisAllocation{
+		Address: root.Address().String(),
+		Comment: nc.Name,
+		State: basics.AccountData{
+			Status:          basics.Online,
+			MicroAlgos:      basics.MicroAlgos{Raw: nc.Stake},
+			VoteID:          part.VotingSecrets().OneTimeSignatureVerifier,
+			SelectionID:     part.VRFSecrets().PK,
+			VoteFirstValid:  part.FirstValid,
+			VoteLastValid:   part.LastValid,
+			VoteKeyDilution: part.KeyDilution,
+		},
+	}
+}
+
+func (e *Emulation) makeNode(name string) *node.AlgorandFullNode {
+	n, err := node.MakeEmulatedFull(e.log.With("node", name), e.rootDirs[name], e.configs[name], e.genesis, e.net.Node(name), e.time)
+	require.NoError(e.t, err)
+	return n
+}
+
+// connect establishes the outgoing connections of all the nodes.
+func (e *Emulation) connect() {
+	var relays []string
+	for _, nc := range e.cfg.Nodes {
+		if nc.Relay {
+			relays = append(relays, nc.Name)
+		}
+	}
+	for i, nc := range e.cfg.Nodes {
+		targets := relays
+		if len(relays) == 0 {
+			targets = e.names[i+1:]
+		} else if nc.Relay {
+			targets = nil
+			for _, relay := range relays {
+				if relay > nc.Name {
+					targets = append(targets, relay)
+				}
+			}
+		}
+		for _, target := range targets {
+			if target != nc.Name {
+				e.net.Connect(nc.Name, target)
+			}
+		}
+	}
+}
+
+// Network returns the emulated network, allowing the test to adjust the profiles of individual links.
+func (e *Emulation) Network() *network.EmulatedNetwork {
+	return e.net
+}
+
+// Time returns the virtual time driving the emulation.
+func (e *Emulation) Time() *timers.VirtualTime {
+	return e.time
+}
+
+// Genesis returns the genesis shared by all the nodes.
+func (e *Emulation) Genesis() bookkeeping.Genesis {
+	return e.genesis
+}
+
+// Names returns the names of all the nodes, in their configured order.
+func (e *Emulation) Names() []string {
+	return append([]string(nil), e.names...)
+}
+
+// Node returns the full node with the given name, or nil if it is stopped.
+func (e *Emulation) Node(name string) *node.AlgorandFullNode {
+	return e.nodes[name]
+}
+
+// Start starts all the nodes.
+func (e *Emulation) Start() {
+	for _, name := range e.names {
+		e.nodes[name].Start()
+	}
+	e.reconnect()
+}
+
+// Stop stops all the running nodes.
+func (e *Emulation) Stop() {
+	for _, name := range e.names {
+		if n := e.nodes[name]; n != nil {
+			n.Stop()
+		}
+	}
+}
+
+// StopNode stops the given node. Its data directory is kept, so that it could be restarted by StartNode.
+func (e *Emulation) StopNode(name string) {
+	if n := e.nodes[name]; n != nil {
+		n.Stop()
+		e.nodes[name] = nil
+	}
+}
+
+// StartNode restarts a node that was stopped by StopNode, recovering its state from its data directory.
+func (e *Emulation) StartNode(name string) {
+	if e.nodes[name] != nil {
+		return
+	}
+	e.nodes[name] = e.makeNode(name)
+	e.nodes[name].Start()
+	e.reconnect()
+}
+
+// reconnect asks all the running nodes to re-establish their outgoing connections.
+func (e *Emulation) reconnect() {
+	for _, name := range e.names {
+		if e.nodes[name] != nil {
+			e.net.Node(name).RequestConnectOutgoing(false, nil)
+		}
+	}
+}
+
+// Partition splits the network into the given groups of nodes.
+func (e *Emulation) Partition(groups ...[]string) {
+	e.net.Partition(groups...)
+}
+
+// Heal removes all the partitions, and re-establishes the connections that were dropped meanwhile.
+func (e *Emulation) Heal() {
+	e.net.Heal()
+	e.reconnect()
+}
+
+// Run advances the virtual time by d. The time advances in steps; after each step, the real time is given
+// to the nodes to handle the messages delivered during that step.
+func (e *Emulation) Run(d time.Duration) {
+	e.RunUntil(func() bool { return false }, d)
+}
+
+// RunUntil advances the virtual time until cond is satisfied, or until limit has passed. It returns true
+// if cond was satisfied.
+func (e *Emulation) RunUntil(cond func() bool, limit time.Duration) bool {
+	for elapsed := time.Duration(0); elapsed < limit; elapsed += e.cfg.Step {
+		if cond() {
+			return true
+		}
+		e.time.Advance(e.cfg.Step)
+		e.settle()
+	}
+	return cond()
+}
+
+// settle waits, in real time, for the nodes to handle the delivered messages.
+func (e *Emulation) settle() {
+	deadline := time.Now().Add(e.cfg.MaxTickWait)
+	time.Sleep(time.Millisecond)
+	for !e.net.Idle() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// WaitForRound advances the virtual time until all the given nodes have reached the given round, or until
+// limit has passed. If no nodes are given, all the running nodes are considered.
+func (e *Emulation) WaitForRound(round basics.Round, limit time.Duration, names ...string) bool {
+	if len(names) == 0 {
+		names = e.names
+	}
+	return e.RunUntil(func() bool {
+		for _, name := range names {
+			n := e.nodes[name]
+			if n != nil && n.Ledger().Latest() < round {
+				return false
+			}
+		}
+		return true
+	}, limit)
+}
+
+// Round returns the latest round of the given node.
+func (e *Emulation) Round(name string) basics.Round {
+	if n := e.nodes[name]; n != nil {
+		return n.Ledger().Latest()
+	}
+	return 0
+}
+
+var testSinkAddr = basics.Address(crypto.Hash([]byte("emulation fee sink")))
+var testPoolAddr = basics.Address(crypto.Hash([]byte("emulation rewards pool")))
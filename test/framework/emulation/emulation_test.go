@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package emulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestEmulation(t *testing.T, n3Stake uint64) *Emulation {
+	return MakeEmulation(t, Config{
+		Nodes: []NodeConfig{
+			{Name: "relay", Relay: true},
+			{Name: "n1", Stake: 1000000000000},
+			{Name: "n2", Stake: 1000000000000},
+			{Name: "n3", Stake: n3Stake},
+		},
+		Link: network.LinkProfile{Latency: 20 * time.Millisecond, Jitter: 10 * time.Millisecond},
+		Seed: 1,
+	})
+}
+
+func TestEmulationProgress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// n3 holds a small stake, so that the others could make progress while it is down.
+	e := makeTestEmulation(t, 100000000000)
+	e.Start()
+	defer e.Stop()
+
+	require.True(t, e.WaitForRound(3, time.Minute))
+
+	// a restarted node recovers its state, and catches up with the rest of the network.
+	e.StopNode("n3")
+	require.True(t, e.WaitForRound(e.Round("n1")+2, time.Minute))
+	e.StartNode("n3")
+	require.True(t, e.WaitForRound(e.Round("n1")+1, time.Minute))
+}
+
+func TestEmulationPartitionRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := makeTestEmulation(t, 1000000000000)
+	e.Start()
+	defer e.Stop()
+
+	require.True(t, e.WaitForRound(2, time.Minute))
+
+	// none of the sides holds enough stake to make progress on its own.
+	e.Partition([]string{"relay", "n1", "n2"}, []string{"n3"})
+	e.Run(10 * time.Second)
+	stalled := e.Round("n1")
+	e.Run(30 * time.Second)
+	for _, name := range e.Names() {
+		require.LessOrEqual(t, uint64(e.Round(name)), uint64(stalled+1), name)
+	}
+
+	e.Heal()
+	require.True(t, e.WaitForRound(stalled+3, 5*time.Minute))
+}
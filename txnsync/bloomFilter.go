@@ -41,6 +41,8 @@ const (
 	multiHashBloomFilter
 	xorBloomFilter32
 	xorBloomFilter8
+	// ibltFilter is a set reconciliation filter, which is only sent to peers that advertised support for it.
+	ibltFilter
 )
 
 // transactionsRange helps us to identify a subset of the transaction pool pending transaction groups.
@@ -69,6 +71,18 @@ type testableBloomFilter struct {
 	filter bloom.GenericFilter
 
 	clearPrevious bool
+
+	// reconciled is set for set reconciliation filters once these were reconciled against the local pending transaction groups.
+	reconciled *reconciledFilter
+}
+
+// reconciledFilter is the outcome of reconciling a set reconciliation filter against the local pending transaction groups.
+type reconciledFilter struct {
+	// missing contains the (short) ids of the local transaction groups that the peer doesn't have.
+	missing map[uint64]struct{}
+	// groupCounterLimit is the group counter following the last local transaction group that took part in the reconciliation.
+	// the peer might not have any of the transaction groups from this group counter on.
+	groupCounterLimit uint64
 }
 
 func decodeBloomFilter(enc encodedBloomFilter) (outFilter *testableBloomFilter, err error) {
@@ -85,6 +99,9 @@ func decodeBloomFilter(enc encodedBloomFilter) (outFilter *testableBloomFilter,
 	case xorBloomFilter8:
 		outFilter.filter = new(bloom.XorFilter8)
 		err = outFilter.filter.UnmarshalBinary(enc.BloomFilter)
+	case ibltFilter:
+		outFilter.filter = new(txidIBLT)
+		err = outFilter.filter.UnmarshalBinary(enc.BloomFilter)
 	default:
 		return nil, errInvalidBloomFilterEncoding
 	}
@@ -112,15 +129,58 @@ func (bf *bloomFilter) sameParams(other bloomFilter) bool {
 		(bf.containedTxnsRange == other.containedTxnsRange)
 }
 
-func (bf *testableBloomFilter) test(txID transactions.Txid) bool {
+func (bf *testableBloomFilter) test(txID transactions.Txid, groupCounter uint64) bool {
 	if bf.encodingParams.Modulator > 1 {
 		if txidToUint64(txID)%uint64(bf.encodingParams.Modulator) != uint64(bf.encodingParams.Offset) {
 			return false
 		}
 	}
+	if bf.reconciled != nil {
+		if groupCounter >= bf.reconciled.groupCounterLimit {
+			return false
+		}
+		_, missing := bf.reconciled.missing[txidToUint64(txID)]
+		return !missing
+	}
 	return bf.filter.Test(txID[:])
 }
 
+// needsReconciliation returns true for set reconciliation filters, which cannot be tested before being reconciled.
+func (bf *testableBloomFilter) needsReconciliation() bool {
+	_, isIBLT := bf.filter.(*txidIBLT)
+	return isIBLT && bf.reconciled == nil
+}
+
+// reconcile finds which of the given pending transaction groups are missing on the peer that sent the set reconciliation filter.
+// It returns the size of the difference between the two sets, or false if the difference was too large to be decoded.
+func (bf *testableBloomFilter) reconcile(pendingTransactions []pooldata.SignedTxGroup) (difference int, ok bool) {
+	remote := bf.filter.(*txidIBLT)
+	local := makeTxidIBLT(len(remote.cells), remote.seed)
+	for _, group := range pendingTransactions {
+		if bf.encodingParams.Modulator > 1 && txidToUint64(group.GroupTransactionID)%uint64(bf.encodingParams.Modulator) != uint64(bf.encodingParams.Offset) {
+			continue
+		}
+		local.Set(group.GroupTransactionID[:])
+	}
+	if remote.subtract(local) != nil {
+		return 0, false
+	}
+	added, removed, ok := remote.peel()
+	if !ok {
+		return 0, false
+	}
+	bf.reconciled = &reconciledFilter{missing: make(map[uint64]struct{}, len(removed))}
+	for _, key := range removed {
+		bf.reconciled.missing[key] = struct{}{}
+	}
+	if len(pendingTransactions) > 0 {
+		bf.reconciled.groupCounterLimit = pendingTransactions[len(pendingTransactions)-1].GroupCounter + 1
+	}
+	// the table isn't needed anymore; release it.
+	bf.filter = nil
+	return len(added) + len(removed), true
+}
+
 func filterFactoryBloom(numEntries int, s *syncState) (filter bloom.GenericFilter, filterType bloomFilterType) {
 	shuffler := uint32(s.node.Random(math.MaxUint64))
 	sizeBits, numHashes := bloom.Optimal(numEntries, bloomFilterFalsePositiveRate)
@@ -234,6 +294,42 @@ func (s *syncState) makeBloomFilter(encodingParams requestParams, txnGroups []po
 	return result
 }
 
+// makeReconciliationFilter creates a set reconciliation filter of the transaction groups that matches the request params, sized for
+// the expected difference from the peer's pending transaction groups. An empty filter is returned when a bloom filter is
+// expected to be smaller.
+func (s *syncState) makeReconciliationFilter(encodingParams requestParams, txnGroups []pooldata.SignedTxGroup, expectedDifference int) (result bloomFilter) {
+	result.encoded.EncodingParams = encodingParams
+	if encodingParams.Modulator == 0 {
+		return
+	}
+	result.containedTxnsRange.firstCounter = math.MaxUint64
+	for _, group := range txnGroups {
+		if encodingParams.Modulator > 1 && txidToUint64(group.GroupTransactionID)%uint64(encodingParams.Modulator) != uint64(encodingParams.Offset) {
+			continue
+		}
+		if result.containedTxnsRange.firstCounter == math.MaxUint64 {
+			result.containedTxnsRange.firstCounter = group.GroupCounter
+		}
+		result.containedTxnsRange.lastCounter = group.GroupCounter
+		result.containedTxnsRange.transactionsCount++
+	}
+	if !reconciliationFilterCheaper(int(result.containedTxnsRange.transactionsCount), expectedDifference) {
+		return bloomFilter{}
+	}
+
+	filter := makeTxidIBLT(ibltCellsCount(expectedDifference), s.node.Random(math.MaxUint64))
+	for _, group := range txnGroups {
+		if encodingParams.Modulator > 1 && txidToUint64(group.GroupTransactionID)%uint64(encodingParams.Modulator) != uint64(encodingParams.Offset) {
+			continue
+		}
+		filter.Set(group.GroupTransactionID[:])
+	}
+	if result.encode(filter, ibltFilter) != nil {
+		return bloomFilter{}
+	}
+	return result
+}
+
 func txidToUint64(txID transactions.Txid) uint64 {
 	return binary.LittleEndian.Uint64(txID[:8])
 }
@@ -319,7 +319,7 @@ func TestBloomFilterTest(t *testing.T) {
 		for testableBf.encodingParams.Modulator = 0; testableBf.encodingParams.Modulator < 7; testableBf.encodingParams.Modulator++ {
 			for testableBf.encodingParams.Offset = 0; testableBf.encodingParams.Offset < testableBf.encodingParams.Modulator; testableBf.encodingParams.Offset++ {
 				for _, tx := range txnGroups {
-					ans := testableBf.test(tx.GroupTransactionID)
+					ans := testableBf.test(tx.GroupTransactionID, tx.GroupCounter)
 					expected := true
 					if testableBf.encodingParams.Modulator > 1 {
 						if txidToUint64(tx.GroupTransactionID)%uint64(testableBf.encodingParams.Modulator) != uint64(testableBf.encodingParams.Offset) {
@@ -370,3 +370,56 @@ func (fn *justRandomFakeNode) IncomingTransactionGroups(peer *Peer, messageSeq u
 	return 0
 }
 func (fn *justRandomFakeNode) NotifyMonitor() chan struct{} { return nil }
+
+func makeReconciliationTestGroups(count int, firstCounter uint64) []pooldata.SignedTxGroup {
+	groups := make([]pooldata.SignedTxGroup, count)
+	for i := range groups {
+		groups[i].GroupCounter = firstCounter + uint64(i)
+		crypto.RandBytes(groups[i].GroupTransactionID[:])
+	}
+	return groups
+}
+
+func TestReconciliationFilter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var s syncState
+	s.node = &justRandomFakeNode{}
+
+	shared := makeReconciliationTestGroups(10000, 0)
+	onlySender := makeReconciliationTestGroups(30, 10000)
+	onlyReceiver := makeReconciliationTestGroups(20, 10000)
+	senderGroups := append(append([]pooldata.SignedTxGroup{}, shared...), onlySender...)
+	receiverGroups := append(append([]pooldata.SignedTxGroup{}, shared...), onlyReceiver...)
+
+	for _, encodingParams := range []requestParams{{Modulator: 1}, {Modulator: 2, Offset: 1}} {
+		bf := s.makeReconciliationFilter(encodingParams, senderGroups, 200)
+		require.Equal(t, byte(ibltFilter), bf.encoded.BloomFilterType)
+		require.Less(t, bf.encodedLength, len(senderGroups)*xorBloomFilterBytesPerEntry)
+
+		testableBf, err := decodeBloomFilter(bf.encoded)
+		require.NoError(t, err)
+		require.True(t, testableBf.needsReconciliation())
+		_, ok := testableBf.reconcile(receiverGroups)
+		require.True(t, ok)
+		require.False(t, testableBf.needsReconciliation())
+
+		matching := func(group pooldata.SignedTxGroup) bool {
+			return encodingParams.Modulator == 1 || txidToUint64(group.GroupTransactionID)%uint64(encodingParams.Modulator) == uint64(encodingParams.Offset)
+		}
+		for _, group := range shared {
+			require.Equal(t, matching(group), testableBf.test(group.GroupTransactionID, group.GroupCounter))
+		}
+		// the receiver needs to send the transaction groups that the sender doesn't have.
+		for _, group := range onlyReceiver {
+			require.False(t, testableBf.test(group.GroupTransactionID, group.GroupCounter))
+		}
+		// transaction groups that arrived after the reconciliation are unknown to the sender.
+		later := makeReconciliationTestGroups(1, 10020)[0]
+		require.False(t, testableBf.test(later.GroupTransactionID, later.GroupCounter))
+	}
+
+	// when the difference is expected to be large, a bloom filter is preferred.
+	bf := s.makeReconciliationFilter(requestParams{Modulator: 1}, senderGroups, len(senderGroups))
+	require.Zero(t, bf.encodedLength)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/binary"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -50,6 +51,16 @@ type emulator struct {
 	totalDuplicateTransactionSize uint64
 	lastRandom                    uint64
 	totalInitialTransactions      uint64
+	totalSentBytes                uint64
+	totalSentMessages             uint64
+	lastTransactionReceived       int64 // the clock time at which the last transaction group was added to any of the nodes.
+}
+
+// emulatorStats are the bandwidth and latency measurements of an emulated scenario.
+type emulatorStats struct {
+	sentBytes               uint64
+	sentMessages            uint64
+	lastTransactionReceived time.Duration
 }
 
 type nodeTransaction struct {
@@ -75,7 +86,7 @@ func (a nodeTransactions) Less(i, j int) bool {
 	return a[i].transactionSize < a[j].transactionSize
 }
 
-func emulateScenario(t *testing.T, scenario scenario) {
+func emulateScenario(t *testing.T, scenario scenario) (stats emulatorStats) {
 	e := &emulator{
 		scenario:  scenario,
 		nodeCount: len(scenario.netConfig.nodes),
@@ -96,6 +107,13 @@ func emulateScenario(t *testing.T, scenario scenario) {
 	t.Logf("Emulation Statistics:")
 	t.Logf("Total duplicate transaction count: %d", e.totalDuplicateTransactions)
 	t.Logf("Total duplicate transactions size: %d", e.totalDuplicateTransactionSize)
+	stats = emulatorStats{
+		sentBytes:               atomic.LoadUint64(&e.totalSentBytes),
+		sentMessages:            atomic.LoadUint64(&e.totalSentMessages),
+		lastTransactionReceived: time.Duration(atomic.LoadInt64(&e.lastTransactionReceived)),
+	}
+	t.Logf("Total sent bytes: %d in %d messages", stats.sentBytes, stats.sentMessages)
+	t.Logf("Last transaction group received after: %v", stats.lastTransactionReceived)
 	for n := 0; n < e.nodeCount; n++ {
 		t.Logf("%s transaction groups count : %d", e.nodes[n].name, len(results.nodes[n]))
 	}
@@ -112,6 +130,7 @@ func emulateScenario(t *testing.T, scenario scenario) {
 
 	require.Equal(t, scenario.expectedResults, results)
 	require.Equal(t, 1, 1)
+	return
 }
 
 func (e *emulator) run() {
@@ -162,6 +181,8 @@ func (e *emulator) stop() {
 
 func (e *emulator) initNodes() {
 	e.nodes = make([]*emulatedNode, e.nodeCount, e.nodeCount)
+	cfg := config.GetDefaultLocal()
+	cfg.TransactionSyncSetReconciliation = e.scenario.setReconciliation
 	for i := 0; i < e.nodeCount; i++ {
 		e.nodes[i] = makeEmulatedNode(e, i)
 		syncer := MakeTransactionSyncService(
@@ -170,7 +191,7 @@ func (e *emulator) initNodes() {
 			e.scenario.netConfig.nodes[i].isRelay,
 			"",
 			crypto.Digest{},
-			config.GetDefaultLocal(),
+			cfg,
 			e,
 		)
 		e.syncers = append(e.syncers, syncer)
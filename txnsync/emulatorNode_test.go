@@ -273,6 +273,8 @@ func (n *emulatedNode) SendPeerMessage(netPeer interface{}, msg []byte, callback
 	otherNode := n.emulator.nodes[peer.target]
 	sendTime := time.Duration(len(msg)) * time.Second / time.Duration(peer.uploadSpeed)
 	otherNode.enqueueMessage(n.nodeIndex, queuedMessage{bytes: msg, readyAt: sendTime})
+	atomic.AddUint64(&n.emulator.totalSentBytes, uint64(len(msg)))
+	atomic.AddUint64(&n.emulator.totalSentMessages, 1)
 
 	peer.deferredSentMessages = append(peer.deferredSentMessages, queuedSentMessageCallback{callback: callback, seq: peer.outSeq})
 	peer.outSeq++
@@ -311,6 +313,7 @@ func (n *emulatedNode) IncomingTransactionGroups(peer *Peer, messageSeq uint64,
 			group.EncodedLength += len(txn.MarshalMsg(encodingBuf))
 		}
 		n.txpoolEntries = append(n.txpoolEntries, group)
+		atomic.StoreInt64(&n.emulator.lastTransactionReceived, int64(n.emulator.clock.Since()))
 	}
 	protocol.PutEncodingBuf(encodingBuf)
 	if duplicateMessage > 0 && testing.Verbose() {
@@ -59,6 +59,8 @@ type scenario struct {
 	step            time.Duration
 	initialAlloc    []initialTransactionsAllocation
 	expectedResults emulatorResult
+	// setReconciliation enables set reconciliation filters on all the nodes.
+	setReconciliation bool
 }
 
 func TestEmulatedTrivialTransactionsExchange(t *testing.T) {
@@ -491,7 +493,7 @@ func TestEmulatedNonRelayToMultipleRelays(t *testing.T) {
 			},
 		},
 		step:         1 * time.Millisecond / 10,
-		testDuration: 2000 * time.Millisecond,
+		testDuration: 6000 * time.Millisecond,
 	}
 	// update the expected results to have the correct number of entries.
 	for j := range testScenario.initialAlloc {
@@ -727,7 +729,7 @@ func TestEmulatedTwentyNodesFourRelays(t *testing.T) {
 			},
 		},
 		step:         1 * time.Millisecond / 10,
-		testDuration: 2000 * time.Millisecond,
+		testDuration: 6000 * time.Millisecond,
 	}
 
 	// add nodes.
@@ -779,3 +781,72 @@ func TestEmulatedTwentyNodesFourRelays(t *testing.T) {
 
 	emulateScenario(t, testScenario)
 }
+
+func TestEmulatedSetReconciliation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testScenario := scenario{
+		netConfig: networkConfiguration{
+			nodes: []nodeConfiguration{
+				{
+					name:    "incoming-relay",
+					isRelay: true,
+				},
+				{
+					name:    "outgoing-relay",
+					isRelay: true,
+					outgoingConnections: []connectionSettings{
+						{
+							uploadSpeed:   10000000,
+							downloadSpeed: 10000000,
+							target:        0,
+						},
+					},
+				},
+			},
+		},
+		initialAlloc: []initialTransactionsAllocation{
+			{
+				node:              0,
+				transactionsCount: 2000,
+				transactionSize:   250,
+				expirationRound:   basics.Round(5),
+			},
+			{
+				node:              1,
+				transactionsCount: 2000,
+				transactionSize:   270,
+				expirationRound:   basics.Round(5),
+			},
+		},
+		expectedResults: emulatorResult{
+			nodes: []nodeTransactions{
+				{},
+				{},
+			},
+		},
+		step:         1 * time.Millisecond / 10,
+		testDuration: 6000 * time.Millisecond,
+	}
+	// update the expected results to have the correct number of entries.
+	for j := range testScenario.initialAlloc {
+		for i := 0; i < testScenario.initialAlloc[j].transactionsCount; i++ {
+			for n := range testScenario.expectedResults.nodes {
+				testScenario.expectedResults.nodes[n] = append(testScenario.expectedResults.nodes[n], nodeTransaction{expirationRound: testScenario.initialAlloc[j].expirationRound, transactionSize: testScenario.initialAlloc[j].transactionSize})
+			}
+		}
+	}
+
+	// run the same scenario with and without set reconciliation, so that their bandwidth and latency could be compared.
+	var bloomStats, reconciliationStats emulatorStats
+	t.Run("BloomFilters", func(t *testing.T) {
+		testScenario.setReconciliation = false
+		bloomStats = emulateScenario(t, testScenario)
+	})
+	t.Run("SetReconciliation", func(t *testing.T) {
+		testScenario.setReconciliation = true
+		reconciliationStats = emulateScenario(t, testScenario)
+	})
+	t.Logf("bloom filters : %d bytes sent, last transaction group received after %v", bloomStats.sentBytes, bloomStats.lastTransactionReceived)
+	t.Logf("set reconciliation : %d bytes sent, last transaction group received after %v", reconciliationStats.sentBytes, reconciliationStats.lastTransactionReceived)
+}
@@ -40,6 +40,7 @@ type transactionBlockMessage struct {
 	TransactionGroups    packedTransactionGroups `codec:"g"`
 	MsgSync              timingParams            `codec:"t"`
 	RelayedProposal      relayedProposal         `codec:"rp"`
+	Reconciliation       reconciliationParams    `codec:"rc"`
 }
 
 type encodedBloomFilter struct {
@@ -58,6 +59,20 @@ type requestParams struct {
 	Modulator byte `codec:"m"`
 }
 
+// reconciliationParams is used by peers that support set reconciliation filters to advertise it, and to report back how well the
+// set reconciliation filters they received were decoded. It's omitted by peers that don't have set reconciliation enabled, so that
+// their messages remain readable by peers that don't support it.
+type reconciliationParams struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"` //nolint:structcheck,unused
+
+	// SupportedFilters is a bitmask of the set reconciliation filter types the sender is able to decode, by their bloomFilterType.
+	SupportedFilters byte `codec:"s"`
+	// Failures is the number of set reconciliation filters that the sender couldn't decode since its previous message.
+	Failures uint32 `codec:"f"`
+	// Difference is the largest difference the sender has decoded from a set reconciliation filter since its previous message.
+	Difference uint32 `codec:"d"`
+}
+
 const (
 	compressionFormatNone byte = iota
 	compressionFormatDeflate
@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package txnsync
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ibltHashCount is the number of cells each key is stored at. The table is split into ibltHashCount equally
+// sized sub-tables, so that each key is stored at a single cell of every sub-table.
+const ibltHashCount = 3
+
+// ibltSeedSize is the size of the encoded seed, which precedes the encoded cells.
+const ibltSeedSize = 8
+
+// ibltCellMaxEncodedSize is the size of an encoded cell, when the counter is using the longest varint encoding.
+const ibltCellMaxEncodedSize = binary.MaxVarintLen32 + 8 + 4
+
+// minReconciliationDifference is the smallest difference we would size a set reconciliation filter for. Tables that
+// are smaller than that have a high chance of failing to decode even when the difference fits.
+const minReconciliationDifference = 16
+
+// xorBloomFilterBytesPerEntry is the approximated encoded size of each entry in a 32 bit xor filter, which is
+// used to decide whether a set reconciliation filter would be smaller than a bloom filter.
+const xorBloomFilterBytesPerEntry = 5
+
+var errInvalidIBLTEncoding = errors.New("invalid iblt encoding")
+
+// ibltCell is a single cell of an invertible bloom lookup table.
+type ibltCell struct {
+	count   int32
+	keySum  uint64
+	hashSum uint32
+}
+
+// txidIBLT is an invertible bloom lookup table of transaction group ids, which allows two peers to find the difference
+// between their sets of pending transaction groups using a table whose size is proportional to the difference
+// rather than to the size of the sets. Each transaction group id is represented by its first 8 bytes, which are
+// uniformly distributed.
+//
+// The table implements bloom.GenericFilter, so that it can be sent using the existing bloom filter encoding. Testing
+// a table directly has a high false positive rate once the table holds more keys than cells, which is why incoming
+// tables are reconciled against the local pending transaction groups before being tested.
+type txidIBLT struct {
+	seed  uint64
+	cells []ibltCell
+}
+
+// makeTxidIBLT creates an empty table, with at least the given number of cells.
+func makeTxidIBLT(cellsCount int, seed uint64) *txidIBLT {
+	if cellsCount < ibltHashCount {
+		cellsCount = ibltHashCount
+	}
+	cellsCount = (cellsCount + ibltHashCount - 1) / ibltHashCount * ibltHashCount
+	return &txidIBLT{
+		seed:  seed,
+		cells: make([]ibltCell, cellsCount),
+	}
+}
+
+// ibltCellsCount returns the number of cells needed for decoding a difference of the given size with a high probability.
+func ibltCellsCount(expectedDifference int) int {
+	if expectedDifference < minReconciliationDifference {
+		expectedDifference = minReconciliationDifference
+	}
+	return expectedDifference*2 + ibltHashCount*8
+}
+
+// reconciliationFilterCheaper returns true if a set reconciliation filter sized for the expected difference is expected to be smaller
+// than a bloom filter holding all the entries of the set.
+func reconciliationFilterCheaper(setSize, expectedDifference int) bool {
+	return ibltSeedSize+ibltCellsCount(expectedDifference)*ibltCellMaxEncodedSize < setSize*xorBloomFilterBytesPerEntry
+}
+
+// ibltMix is the splitmix64 finalizer, used for deriving the cells indices and checksum of a key.
+func ibltMix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func (t *txidIBLT) cellIndex(key uint64, hashIdx int) int {
+	subTableSize := uint64(len(t.cells) / ibltHashCount)
+	return hashIdx*int(subTableSize) + int(ibltMix((key^t.seed)+uint64(hashIdx+1)*0x9e3779b97f4a7c15)%subTableSize)
+}
+
+func (t *txidIBLT) checksum(key uint64) uint32 {
+	return uint32(ibltMix(key ^ ^t.seed))
+}
+
+// update adds (or removes, for a negative delta) the given key to the table.
+func (t *txidIBLT) update(key uint64, delta int32) {
+	checksum := t.checksum(key)
+	for i := 0; i < ibltHashCount; i++ {
+		cell := &t.cells[t.cellIndex(key, i)]
+		cell.count += delta
+		cell.keySum ^= key
+		cell.hashSum ^= checksum
+	}
+}
+
+// Set adds the given transaction group id to the table.
+func (t *txidIBLT) Set(x []byte) {
+	t.update(binary.LittleEndian.Uint64(x[:8]), 1)
+}
+
+// Test returns true if all the cells of the given transaction group id are non empty.
+func (t *txidIBLT) Test(x []byte) bool {
+	key := binary.LittleEndian.Uint64(x[:8])
+	for i := 0; i < ibltHashCount; i++ {
+		if t.cells[t.cellIndex(key, i)].count == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// subtract removes the keys of the other table from this one, leaving only the symmetric difference between the two.
+// both tables need to have the same size and seed.
+func (t *txidIBLT) subtract(other *txidIBLT) error {
+	if t.seed != other.seed || len(t.cells) != len(other.cells) {
+		return errInvalidIBLTEncoding
+	}
+	for i := range t.cells {
+		t.cells[i].count -= other.cells[i].count
+		t.cells[i].keySum ^= other.cells[i].keySum
+		t.cells[i].hashSum ^= other.cells[i].hashSum
+	}
+	return nil
+}
+
+func (t *txidIBLT) pure(cell ibltCell) bool {
+	return (cell.count == 1 || cell.count == -1) && cell.hashSum == t.checksum(cell.keySum)
+}
+
+// peel decodes the keys of a table which resulted from subtracting two tables. The added keys are the ones that
+// were only found on the first table, and the removed keys are the ones that were only found on the second table.
+// ok is false if the difference was too large to be decoded with the table size. The table is emptied in the process.
+func (t *txidIBLT) peel() (added, removed []uint64, ok bool) {
+	queue := make([]int, 0, len(t.cells))
+	for i, cell := range t.cells {
+		if t.pure(cell) {
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		cell := t.cells[queue[len(queue)-1]]
+		queue = queue[:len(queue)-1]
+		if !t.pure(cell) {
+			// the cell was already peeled through one of its other keys.
+			continue
+		}
+		if cell.count == 1 {
+			added = append(added, cell.keySum)
+		} else {
+			removed = append(removed, cell.keySum)
+		}
+		if len(added)+len(removed) > len(t.cells) {
+			// a valid table can't hold more keys than cells; this table was crafted.
+			return nil, nil, false
+		}
+		t.update(cell.keySum, -cell.count)
+		for i := 0; i < ibltHashCount; i++ {
+			idx := t.cellIndex(cell.keySum, i)
+			if t.pure(t.cells[idx]) {
+				queue = append(queue, idx)
+			}
+		}
+	}
+	for _, cell := range t.cells {
+		if cell != (ibltCell{}) {
+			return nil, nil, false
+		}
+	}
+	return added, removed, true
+}
+
+// MarshalBinary encodes the table as its seed followed by its cells.
+func (t *txidIBLT) MarshalBinary() ([]byte, error) {
+	out := make([]byte, ibltSeedSize, ibltSeedSize+len(t.cells)*ibltCellMaxEncodedSize)
+	binary.LittleEndian.PutUint64(out, t.seed)
+	var cellBytes [ibltCellMaxEncodedSize]byte
+	for _, cell := range t.cells {
+		n := binary.PutVarint(cellBytes[:], int64(cell.count))
+		binary.LittleEndian.PutUint64(cellBytes[n:], cell.keySum)
+		binary.LittleEndian.PutUint32(cellBytes[n+8:], cell.hashSum)
+		out = append(out, cellBytes[:n+12]...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary decodes a table which was encoded using MarshalBinary.
+func (t *txidIBLT) UnmarshalBinary(data []byte) error {
+	if len(data) < ibltSeedSize {
+		return errInvalidIBLTEncoding
+	}
+	t.seed = binary.LittleEndian.Uint64(data)
+	data = data[ibltSeedSize:]
+	t.cells = make([]ibltCell, 0, len(data)/(ibltCellMaxEncodedSize-binary.MaxVarintLen32+1))
+	for len(data) > 0 {
+		count, n := binary.Varint(data)
+		if n <= 0 || int64(int32(count)) != count || len(data) < n+12 {
+			return errInvalidIBLTEncoding
+		}
+		t.cells = append(t.cells, ibltCell{
+			count:   int32(count),
+			keySum:  binary.LittleEndian.Uint64(data[n:]),
+			hashSum: binary.LittleEndian.Uint32(data[n+8:]),
+		})
+		data = data[n+12:]
+	}
+	if len(t.cells) == 0 || len(t.cells)%ibltHashCount != 0 {
+		return errInvalidIBLTEncoding
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package txnsync
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestIBLTKeys(rnd *rand.Rand, count int) [][]byte {
+	keys := make([][]byte, count)
+	for i := range keys {
+		var key [crypto.DigestSize]byte
+		binary.LittleEndian.PutUint64(key[:], rnd.Uint64())
+		keys[i] = key[:]
+	}
+	return keys
+}
+
+func TestIBLTPeel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// decoding a small difference fails with a low probability ( which is recovered by retrying with a larger table ),
+	// so we use a fixed source of randomness to keep the test deterministic.
+	rnd := rand.New(rand.NewSource(1))
+	shared := makeTestIBLTKeys(rnd, 5000)
+	onlyFirst := makeTestIBLTKeys(rnd, 30)
+	onlySecond := makeTestIBLTKeys(rnd, 20)
+
+	cells := ibltCellsCount(len(onlyFirst) + len(onlySecond))
+	seed := rnd.Uint64()
+	first := makeTxidIBLT(cells, seed)
+	second := makeTxidIBLT(cells, seed)
+	for _, key := range shared {
+		first.Set(key)
+		second.Set(key)
+	}
+	for _, key := range onlyFirst {
+		first.Set(key)
+	}
+	for _, key := range onlySecond {
+		second.Set(key)
+	}
+	for _, key := range append(shared, onlyFirst...) {
+		require.True(t, first.Test(key))
+	}
+
+	require.NoError(t, first.subtract(second))
+	added, removed, ok := first.peel()
+	require.True(t, ok)
+	require.Len(t, added, len(onlyFirst))
+	require.Len(t, removed, len(onlySecond))
+	addedSet := make(map[uint64]bool)
+	for _, key := range added {
+		addedSet[key] = true
+	}
+	for _, key := range onlyFirst {
+		require.True(t, addedSet[binary.LittleEndian.Uint64(key)])
+	}
+	removedSet := make(map[uint64]bool)
+	for _, key := range removed {
+		removedSet[key] = true
+	}
+	for _, key := range onlySecond {
+		require.True(t, removedSet[binary.LittleEndian.Uint64(key)])
+	}
+}
+
+func TestIBLTPeelFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rnd := rand.New(rand.NewSource(1))
+	// a difference which is much larger than the table can't be decoded.
+	first := makeTxidIBLT(ibltCellsCount(10), 1)
+	second := makeTxidIBLT(ibltCellsCount(10), 1)
+	for _, key := range makeTestIBLTKeys(rnd, 500) {
+		first.Set(key)
+	}
+	require.NoError(t, first.subtract(second))
+	_, _, ok := first.peel()
+	require.False(t, ok)
+
+	// tables of different sizes or seeds can't be subtracted.
+	require.Error(t, makeTxidIBLT(30, 1).subtract(makeTxidIBLT(60, 1)))
+	require.Error(t, makeTxidIBLT(30, 1).subtract(makeTxidIBLT(30, 2)))
+}
+
+func TestIBLTEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rnd := rand.New(rand.NewSource(1))
+	table := makeTxidIBLT(100, rnd.Uint64())
+	require.Zero(t, len(table.cells)%ibltHashCount)
+	for _, key := range makeTestIBLTKeys(rnd, 1000) {
+		table.Set(key)
+	}
+	encoded, err := table.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded txidIBLT
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, *table, decoded)
+
+	require.Error(t, decoded.UnmarshalBinary(encoded[:ibltSeedSize-1]))
+	require.Error(t, decoded.UnmarshalBinary(encoded[:ibltSeedSize]))
+	require.Error(t, decoded.UnmarshalBinary(encoded[:len(encoded)-1]))
+
+	// the filter can be decoded from an incoming message.
+	filter, err := decodeBloomFilter(encodedBloomFilter{BloomFilterType: byte(ibltFilter), BloomFilter: encoded})
+	require.NoError(t, err)
+	require.True(t, filter.needsReconciliation())
+}
+
+func TestReconciliationFilterCheaper(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.True(t, reconciliationFilterCheaper(15000, 100))
+	require.False(t, reconciliationFilterCheaper(15000, 15000))
+	require.False(t, reconciliationFilterCheaper(10, minReconciliationDifference))
+}
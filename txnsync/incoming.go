@@ -173,6 +173,8 @@ func (s *syncState) evaluateIncomingMessage(message incomingMessage) {
 	transactionPoolSize := 0
 	totalAccumulatedTransactionsCount := 0 // the number of transactions that were added during the execution of this method
 	transactionHandlerBacklogFull := false
+	// pendingTransactions is loaded on demand, when we need to reconcile an incoming set reconciliation filter.
+	var pendingTransactions []pooldata.SignedTxGroup
 incomingMessageLoop:
 	for {
 		incomingMsg, seq, err := peer.incomingMessages.popSequence(peer.nextReceivedMessageSeq)
@@ -202,12 +204,24 @@ incomingMessageLoop:
 			continue
 		}
 
-		// if the peer sent us a bloom filter, store this.
+		// if the peer sent us a bloom filter, store this. set reconciliation filters need to be reconciled against our
+		// pending transaction groups first, and are dropped if these differ too much.
 		if incomingMsg.bloomFilter != nil {
-			peer.addIncomingBloomFilter(incomingMsg.message.Round, incomingMsg.bloomFilter, s.round)
+			if incomingMsg.bloomFilter.needsReconciliation() {
+				if pendingTransactions == nil {
+					pendingTransactions, _ = s.node.GetPendingTransactionGroups()
+				}
+				if !peer.reconcileIncomingFilter(incomingMsg.bloomFilter, pendingTransactions) {
+					incomingMsg.bloomFilter = nil
+				}
+			}
+			if incomingMsg.bloomFilter != nil {
+				peer.addIncomingBloomFilter(incomingMsg.message.Round, incomingMsg.bloomFilter, s.round)
+			}
 		}
 
 		peer.updateRequestParams(incomingMsg.message.UpdatedRequestParams.Modulator, incomingMsg.message.UpdatedRequestParams.Offset)
+		peer.updateReconciliationParams(incomingMsg.message.Reconciliation)
 		timeInQueue := time.Duration(0)
 		if incomingMsg.timeReceived > 0 {
 			timeInQueue = time.Since(time.Unix(0, incomingMsg.timeReceived))
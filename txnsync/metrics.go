@@ -26,3 +26,5 @@ var txsyncDecodedBloomFiltersTotal = metrics.MakeCounter(metrics.MetricName{Name
 var txsyncCreatedPeersTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_txsync_created_peers_total", Description: "total number of created peers"})
 var txsyncOutgoingMessagesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_txsync_outgoing_messages_total", Description: "total number of outgoing transaction sync messages"})
 var txsyncEncodedBloomFiltersTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_txsync_encoded_bloom_filters_total", Description: "total number of bloom filters encoded"})
+var txsyncReconciledFiltersTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_txsync_reconciled_filters_total", Description: "total number of set reconciliation filters reconciled"})
+var txsyncFailedReconciliationsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_txsync_failed_reconciliations_total", Description: "total number of set reconciliation filters that could not be reconciled"})
@@ -200,6 +200,14 @@ import (
 //    |-----> Msgsize
 //    |-----> MsgIsZero
 //
+// reconciliationParams
+//           |-----> (*) MarshalMsg
+//           |-----> (*) CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> (*) Msgsize
+//           |-----> (*) MsgIsZero
+//
 // relayedProposal
 //        |-----> (*) MarshalMsg
 //        |-----> (*) CanMarshalMsg
@@ -26345,6 +26353,158 @@ func (z program) MsgIsZero() bool {
 	return len(z) == 0
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *reconciliationParams) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 4 bits */
+	if (*z).Difference == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).Failures == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).SupportedFilters == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "d"
+			o = append(o, 0xa1, 0x64)
+			o = msgp.AppendUint32(o, (*z).Difference)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "f"
+			o = append(o, 0xa1, 0x66)
+			o = msgp.AppendUint32(o, (*z).Failures)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "s"
+			o = append(o, 0xa1, 0x73)
+			o = msgp.AppendByte(o, (*z).SupportedFilters)
+		}
+	}
+	return
+}
+
+func (_ *reconciliationParams) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*reconciliationParams)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *reconciliationParams) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).SupportedFilters, bts, err = msgp.ReadByteBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "SupportedFilters")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Failures, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Failures")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Difference, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Difference")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = reconciliationParams{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "s":
+				(*z).SupportedFilters, bts, err = msgp.ReadByteBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SupportedFilters")
+					return
+				}
+			case "f":
+				(*z).Failures, bts, err = msgp.ReadUint32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Failures")
+					return
+				}
+			case "d":
+				(*z).Difference, bts, err = msgp.ReadUint32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Difference")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *reconciliationParams) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*reconciliationParams)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *reconciliationParams) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ByteSize + 2 + msgp.Uint32Size + 2 + msgp.Uint32Size
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *reconciliationParams) MsgIsZero() bool {
+	return ((*z).SupportedFilters == 0) && ((*z).Failures == 0) && ((*z).Difference == 0)
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *relayedProposal) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -26966,8 +27126,8 @@ func (z *timingParams) MsgIsZero() bool {
 func (z *transactionBlockMessage) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(7)
-	var zb0001Mask uint8 /* 8 bits */
+	zb0001Len := uint32(8)
+	var zb0001Mask uint16 /* 9 bits */
 	if (*z).TxnBloomFilter.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -26984,18 +27144,22 @@ func (z *transactionBlockMessage) MarshalMsg(b []byte) (o []byte) {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
-	if (*z).RelayedProposal.MsgIsZero() {
+	if (*z).Reconciliation.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if (*z).MsgSync.MsgIsZero() {
+	if (*z).RelayedProposal.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if (*z).Version == 0 {
+	if (*z).MsgSync.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
+	if (*z).Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 	if zb0001Len != 0 {
@@ -27042,16 +27206,21 @@ func (z *transactionBlockMessage) MarshalMsg(b []byte) (o []byte) {
 			o = (*z).Round.MarshalMsg(o)
 		}
 		if (zb0001Mask & 0x20) == 0 { // if not empty
+			// string "rc"
+			o = append(o, 0xa2, 0x72, 0x63)
+			o = (*z).Reconciliation.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not empty
 			// string "rp"
 			o = append(o, 0xa2, 0x72, 0x70)
 			o = (*z).RelayedProposal.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x40) == 0 { // if not empty
+		if (zb0001Mask & 0x80) == 0 { // if not empty
 			// string "t"
 			o = append(o, 0xa1, 0x74)
 			o = (*z).MsgSync.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x80) == 0 { // if not empty
+		if (zb0001Mask & 0x100) == 0 { // if not empty
 			// string "v"
 			o = append(o, 0xa1, 0x76)
 			o = msgp.AppendInt32(o, (*z).Version)
@@ -27198,6 +27367,14 @@ func (z *transactionBlockMessage) UnmarshalMsg(bts []byte) (o []byte, err error)
 				return
 			}
 		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).Reconciliation.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Reconciliation")
+				return
+			}
+		}
 		if zb0001 > 0 {
 			err = msgp.ErrTooManyArrayFields(zb0001)
 			if err != nil {
@@ -27327,6 +27504,12 @@ func (z *transactionBlockMessage) UnmarshalMsg(bts []byte) (o []byte, err error)
 					err = msgp.WrapError(err, "RelayedProposal")
 					return
 				}
+			case "rc":
+				bts, err = (*z).Reconciliation.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Reconciliation")
+					return
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -27347,13 +27530,13 @@ func (_ *transactionBlockMessage) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *transactionBlockMessage) Msgsize() (s int) {
-	s = 1 + 2 + msgp.Int32Size + 2 + (*z).Round.Msgsize() + 2 + (*z).TxnBloomFilter.Msgsize() + 2 + 1 + 2 + msgp.ByteSize + 2 + msgp.ByteSize + 2 + (*z).TransactionGroups.Msgsize() + 2 + (*z).MsgSync.Msgsize() + 3 + (*z).RelayedProposal.Msgsize()
+	s = 1 + 2 + msgp.Int32Size + 2 + (*z).Round.Msgsize() + 2 + (*z).TxnBloomFilter.Msgsize() + 2 + 1 + 2 + msgp.ByteSize + 2 + msgp.ByteSize + 2 + (*z).TransactionGroups.Msgsize() + 2 + (*z).MsgSync.Msgsize() + 3 + (*z).RelayedProposal.Msgsize() + 3 + (*z).Reconciliation.Msgsize()
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *transactionBlockMessage) MsgIsZero() bool {
-	return ((*z).Version == 0) && ((*z).Round.MsgIsZero()) && ((*z).TxnBloomFilter.MsgIsZero()) && (((*z).UpdatedRequestParams.Offset == 0) && ((*z).UpdatedRequestParams.Modulator == 0)) && ((*z).TransactionGroups.MsgIsZero()) && ((*z).MsgSync.MsgIsZero()) && ((*z).RelayedProposal.MsgIsZero())
+	return ((*z).Version == 0) && ((*z).Round.MsgIsZero()) && ((*z).TxnBloomFilter.MsgIsZero()) && (((*z).UpdatedRequestParams.Offset == 0) && ((*z).UpdatedRequestParams.Modulator == 0)) && ((*z).TransactionGroups.MsgIsZero()) && ((*z).MsgSync.MsgIsZero()) && ((*z).RelayedProposal.MsgIsZero()) && ((*z).Reconciliation.MsgIsZero())
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -1,3 +1,4 @@
+//go:build !skip_msgp_testing
 // +build !skip_msgp_testing
 
 package txnsync
@@ -1272,6 +1273,66 @@ func BenchmarkUnmarshalpackedTransactionGroups(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalreconciliationParams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := reconciliationParams{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingreconciliationParams(t *testing.T) {
+	protocol.RunEncodingTest(t, &reconciliationParams{})
+}
+
+func BenchmarkMarshalMsgreconciliationParams(b *testing.B) {
+	v := reconciliationParams{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgreconciliationParams(b *testing.B) {
+	v := reconciliationParams{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalreconciliationParams(b *testing.B) {
+	v := reconciliationParams{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalrelayedProposal(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := relayedProposal{}
@@ -205,6 +205,10 @@ func (s *syncState) assemblePeerMessage(peer *Peer, pendingTransactions *pending
 
 	msgOps := peer.getMessageConstructionOps(s.isRelay, s.fetchTransactions)
 
+	if s.config.TransactionSyncSetReconciliation {
+		metaMessage.message.Reconciliation = peer.getLocalReconciliationParams()
+	}
+
 	if msgOps&messageConstUpdateRequestParams == messageConstUpdateRequestParams {
 		// update the UpdatedRequestParams
 		offset, modulator := peer.getLocalRequestParams()
@@ -250,8 +254,21 @@ func (s *syncState) assemblePeerMessage(peer *Peer, pendingTransactions *pending
 		}
 		profMakeBloomFilter := s.profiler.getElement(profElementMakeBloomFilter)
 		profMakeBloomFilter.start()
-		// generate a bloom filter that matches the requests params.
-		assembledBloomFilter = s.makeBloomFilter(metaMessage.message.UpdatedRequestParams, filterTxns, excludeTransactions, lastBloomFilter)
+		if s.config.TransactionSyncSetReconciliation && peer.remoteSupportsSetReconciliation {
+			// set reconciliation filters are sized by the expected difference rather than by the number of transaction groups,
+			// so these always cover all the pending transaction groups, and supersede any previously sent filter.
+			allTxns := pendingTransactions.pendingTransactionsGroups
+			assembledBloomFilter = s.makeReconciliationFilter(metaMessage.message.UpdatedRequestParams, allTxns, peer.expectedReconciliationDifference(len(allTxns)))
+			if assembledBloomFilter.encodedLength > 0 {
+				assembledBloomFilter.encoded.ClearPrevious = 1
+			} else {
+				peer.reconciliationFilterSkipped()
+			}
+		}
+		if assembledBloomFilter.encodedLength == 0 {
+			// generate a bloom filter that matches the requests params.
+			assembledBloomFilter = s.makeBloomFilter(metaMessage.message.UpdatedRequestParams, filterTxns, excludeTransactions, lastBloomFilter)
+		}
 		// we check here to see if the bloom filter we need happen to be the same as the one that was previously sent to the peer.
 		// ( note that we check here againt the peer, whereas the hint to makeBloomFilter could be the cached one for the relay )
 		if !assembledBloomFilter.sameParams(peer.lastSentBloomFilter) && assembledBloomFilter.encodedLength > 0 {
@@ -262,7 +279,7 @@ func (s *syncState) assemblePeerMessage(peer *Peer, pendingTransactions *pending
 			bloomFilterSize = assembledBloomFilter.encodedLength
 		}
 		profMakeBloomFilter.end()
-		if s.isRelay {
+		if s.isRelay && assembledBloomFilter.encoded.BloomFilterType != byte(ibltFilter) {
 			s.lastBloomFilter = assembledBloomFilter
 		}
 	}
@@ -148,6 +148,15 @@ type Peer struct {
 	// From this an efficient next filter can be calculated for just the new txns, or a full filter after a Round turnover.
 	sentFilterParams sentFilters
 
+	// remoteSupportsSetReconciliation is set once the peer advertised that it is able to decode set reconciliation filters.
+	remoteSupportsSetReconciliation bool
+	// reconciliationDifference is the expected size of the difference between the local pending transaction groups and the peer's,
+	// which is used for sizing the set reconciliation filters sent to the peer. Zero means that there is no estimate yet.
+	reconciliationDifference int
+	// pendingReconciliationFeedback is reported back to the peer on the next message, letting it know how well the set reconciliation
+	// filters it sent were decoded.
+	pendingReconciliationFeedback reconciliationParams
+
 	// lastConfirmedMessageSeqReceived is the last message sequence number that was confirmed by the peer to have been accepted.
 	lastConfirmedMessageSeqReceived    uint64
 	lastReceivedMessageLocalRound      basics.Round
@@ -408,7 +417,7 @@ scanLoop:
 
 		// check if the peer already received these messages from a different source other than us.
 		for _, filterIdx := range effectiveBloomFilters {
-			if p.recentIncomingBloomFilters[filterIdx].filter.test(txID) {
+			if p.recentIncomingBloomFilters[filterIdx].filter.test(txID, pendingTransactions[grpIdx].GroupCounter) {
 				// removedTxn++
 				continue scanLoop
 			}
@@ -511,6 +520,65 @@ func incomingPeersOnly(peers []*Peer) (incomingPeers []*Peer) {
 
 // incoming related functions
 
+// updateReconciliationParams updates the peer's set reconciliation support, and adjusts the expected difference used for sizing
+// the next set reconciliation filter according to the peer's feedback.
+func (p *Peer) updateReconciliationParams(params reconciliationParams) {
+	p.remoteSupportsSetReconciliation = params.SupportedFilters&(1<<ibltFilter) != 0
+	if params.Failures > 0 {
+		// the difference was larger than the filter could hold; once the doubled filter grows larger than
+		// a bloom filter, we would fall back to sending bloom filters.
+		if p.reconciliationDifference < minReconciliationDifference {
+			p.reconciliationDifference = minReconciliationDifference
+		}
+		p.reconciliationDifference *= 2
+	} else if params.Difference > 0 {
+		// leave room for the transactions that would arrive before the next filter is sent.
+		p.reconciliationDifference = int(params.Difference) * 2
+	}
+}
+
+// expectedReconciliationDifference returns the difference that a set reconciliation filter of a set of the given size should be sized for.
+func (p *Peer) expectedReconciliationDifference(setSize int) int {
+	if p.reconciliationDifference > 0 {
+		return p.reconciliationDifference
+	}
+	if setSize/8 > minReconciliationDifference {
+		return setSize / 8
+	}
+	return minReconciliationDifference
+}
+
+// reconciliationFilterSkipped is called when a bloom filter was sent instead of a set reconciliation filter since the expected
+// difference was too large. Decreasing the expected difference ensures that set reconciliation would be attempted again later on.
+func (p *Peer) reconciliationFilterSkipped() {
+	p.reconciliationDifference /= 2
+}
+
+// reconcileIncomingFilter reconciles a set reconciliation filter received from the peer against the local pending transaction groups,
+// and records the outcome so that it would be reported back to the peer. A filter that failed to reconcile cannot be used.
+func (p *Peer) reconcileIncomingFilter(filter *testableBloomFilter, pendingTransactions []pooldata.SignedTxGroup) bool {
+	difference, ok := filter.reconcile(pendingTransactions)
+	if !ok {
+		p.pendingReconciliationFeedback.Failures++
+		txsyncFailedReconciliationsTotal.Inc(nil)
+		return false
+	}
+	if uint32(difference) > p.pendingReconciliationFeedback.Difference {
+		p.pendingReconciliationFeedback.Difference = uint32(difference)
+	}
+	txsyncReconciledFiltersTotal.Inc(nil)
+	return true
+}
+
+// getLocalReconciliationParams returns the set reconciliation params to be sent on the next message to the peer, and clears the
+// pending feedback.
+func (p *Peer) getLocalReconciliationParams() (params reconciliationParams) {
+	params = p.pendingReconciliationFeedback
+	params.SupportedFilters = 1 << ibltFilter
+	p.pendingReconciliationFeedback = reconciliationParams{}
+	return
+}
+
 // addIncomingBloomFilter keeps the most recent {maxIncomingBloomFilterHistory} filters
 func (p *Peer) addIncomingBloomFilter(round basics.Round, incomingFilter *testableBloomFilter, currentRound basics.Round) {
 	minRound := currentRound.SubSaturate(2)
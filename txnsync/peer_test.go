@@ -1009,3 +1009,47 @@ func TestMakePeer(t *testing.T) {
 	a.Equal(p2.dataExchangeRate, uint64(defaultDataExchangeRate))
 
 }
+
+// TestReconciliationNegotiation tests the set reconciliation advertisement and feedback
+func TestReconciliationNegotiation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+	config := config.GetDefaultLocal()
+	tlog := logging.TestingLog(t)
+	log := wrapLogger(tlog, &config)
+	p := makePeer(nil, true, true, &config, log, 0)
+
+	a.False(p.remoteSupportsSetReconciliation)
+	a.Equal(1000, p.expectedReconciliationDifference(8000))
+	a.Equal(minReconciliationDifference, p.expectedReconciliationDifference(10))
+
+	// peers that don't advertise it don't get set reconciliation filters.
+	p.updateReconciliationParams(reconciliationParams{})
+	a.False(p.remoteSupportsSetReconciliation)
+	p.updateReconciliationParams(reconciliationParams{SupportedFilters: 1 << ibltFilter, Difference: 50})
+	a.True(p.remoteSupportsSetReconciliation)
+	a.Equal(100, p.expectedReconciliationDifference(8000))
+
+	// failures double the expected difference, and skipping set reconciliation halves it.
+	p.updateReconciliationParams(reconciliationParams{SupportedFilters: 1 << ibltFilter, Failures: 1})
+	a.Equal(200, p.expectedReconciliationDifference(8000))
+	p.reconciliationFilterSkipped()
+	a.Equal(100, p.expectedReconciliationDifference(8000))
+
+	// the outcome of reconciling incoming filters is reported back on the next message.
+	// a table holding many more transaction groups than cells can't be reconciled against an empty set.
+	table := makeTxidIBLT(ibltCellsCount(minReconciliationDifference), 1)
+	for i := 0; i < 1000; i++ {
+		digest := crypto.Hash([]byte{byte(i), byte(i >> 8)})
+		table.Set(digest[:])
+	}
+	encoded, err := table.MarshalBinary()
+	a.NoError(err)
+	bf, err := decodeBloomFilter(encodedBloomFilter{BloomFilterType: byte(ibltFilter), BloomFilter: encoded})
+	a.NoError(err)
+	a.False(p.reconcileIncomingFilter(bf, nil))
+	params := p.getLocalReconciliationParams()
+	a.Equal(reconciliationParams{SupportedFilters: 1 << ibltFilter, Failures: 1}, params)
+	a.Equal(reconciliationParams{SupportedFilters: 1 << ibltFilter}, p.getLocalReconciliationParams())
+}
@@ -82,6 +82,15 @@ var (
 	AgreementMessagesHandled = MetricName{Name: "algod_agreement_handled", Description: "Number of agreement messages handled"}
 	// AgreementMessagesDropped "Number of agreement messages dropped"
 	AgreementMessagesDropped = MetricName{Name: "algod_agreement_dropped", Description: "Number of agreement messages dropped"}
+	// AgreementVoteBatchesVerified "Number of vote batches verified"
+	AgreementVoteBatchesVerified = MetricName{Name: "algod_agreement_vote_batches_verified", Description: "Number of vote batches verified"}
+	// AgreementVoteBatchFallbacks "Number of vote batches that failed batch verification and were verified vote by vote"
+	AgreementVoteBatchFallbacks = MetricName{Name: "algod_agreement_vote_batch_fallbacks", Description: "Number of vote batches that failed batch verification and were verified vote by vote"}
+
+	// AlgodAPITokenRequests "Number of REST API requests accepted, by token"
+	AlgodAPITokenRequests = MetricName{Name: "algod_api_token_requests", Description: "Number of REST API requests accepted, by token"}
+	// AlgodAPITokenRejected "Number of REST API requests rejected, by token and reason"
+	AlgodAPITokenRejected = MetricName{Name: "algod_api_token_rejected", Description: "Number of REST API requests rejected, by token and reason"}
 
 	// TransactionMessagesHandled "Number of transaction messages handled"
 	TransactionMessagesHandled = MetricName{Name: "algod_transaction_messages_handled", Description: "Number of transaction messages handled"}
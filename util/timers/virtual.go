@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// virtualEpoch is the wall clock time reported by the timeout channels of a VirtualTime at its zero point.
+var virtualEpoch = time.Unix(0, 0).UTC()
+
+// VirtualTime is a time source which advances only when explicitly asked to, allowing multiple
+// clocks and scheduled events to share a single, deterministic, notion of time.
+type VirtualTime struct {
+	mu     deadlock.Mutex
+	now    time.Duration
+	seq    uint64
+	events virtualEventHeap
+}
+
+// virtualEvent is a callback scheduled to be invoked once the virtual time reaches its deadline.
+type virtualEvent struct {
+	deadline time.Duration
+	// seq breaks ties between events having the same deadline, so that these are invoked in the order they were scheduled.
+	seq uint64
+	fn  func()
+}
+
+type virtualEventHeap []virtualEvent
+
+func (h virtualEventHeap) Len() int { return len(h) }
+func (h virtualEventHeap) Less(i, j int) bool {
+	if h[i].deadline != h[j].deadline {
+		return h[i].deadline < h[j].deadline
+	}
+	return h[i].seq < h[j].seq
+}
+func (h virtualEventHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *virtualEventHeap) Push(x interface{}) { *h = append(*h, x.(virtualEvent)) }
+func (h *virtualEventHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	*h = old[:n-1]
+	return e
+}
+
+// MakeVirtualTime creates a new VirtualTime, starting at zero.
+func MakeVirtualTime() *VirtualTime {
+	return &VirtualTime{}
+}
+
+// Now returns the amount of virtual time that has elapsed since the VirtualTime was created.
+func (vt *VirtualTime) Now() time.Duration {
+	vt.mu.Lock()
+	defer vt.mu.Unlock()
+	return vt.now
+}
+
+// AfterFunc schedules fn to be called once the virtual time has advanced by d. The callback is invoked
+// synchronously by Advance, and therefore must not block, nor advance the time by itself.
+func (vt *VirtualTime) AfterFunc(d time.Duration, fn func()) {
+	if d < 0 {
+		d = 0
+	}
+	vt.mu.Lock()
+	defer vt.mu.Unlock()
+	vt.seq++
+	heap.Push(&vt.events, virtualEvent{deadline: vt.now + d, seq: vt.seq, fn: fn})
+}
+
+// After returns a channel that receives the virtual time once it has advanced by d.
+func (vt *VirtualTime) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	vt.AfterFunc(d, func() {
+		ch <- virtualEpoch.Add(vt.Now())
+	})
+	return ch
+}
+
+// Advance moves the virtual time forward by d, invoking all the callbacks whose deadlines were reached
+// by their deadline order. Callbacks scheduled by other callbacks are invoked as well, if they fall within
+// the advanced interval.
+func (vt *VirtualTime) Advance(d time.Duration) {
+	vt.mu.Lock()
+	target := vt.now + d
+	for len(vt.events) > 0 && vt.events[0].deadline <= target {
+		e := heap.Pop(&vt.events).(virtualEvent)
+		vt.now = e.deadline
+		vt.mu.Unlock()
+		e.fn()
+		vt.mu.Lock()
+	}
+	vt.now = target
+	vt.mu.Unlock()
+}
+
+// Pending returns the number of scheduled callbacks that were not invoked yet.
+func (vt *VirtualTime) Pending() int {
+	vt.mu.Lock()
+	defer vt.mu.Unlock()
+	return len(vt.events)
+}
+
+// Clock returns a WallClock which is driven by the virtual time, zeroed to the current virtual time.
+func (vt *VirtualTime) Clock() WallClock {
+	return &Virtual{time: vt, zero: vt.Now()}
+}
+
+// Virtual is a WallClock which emits timeouts according to a VirtualTime.
+type Virtual struct {
+	time     *VirtualTime
+	zero     time.Duration
+	timeouts map[time.Duration]<-chan time.Time
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (v *Virtual) Zero() Clock {
+	return v.time.Clock()
+}
+
+// TimeoutAt returns a channel that will signal when the virtual duration has elapsed.
+func (v *Virtual) TimeoutAt(delta time.Duration) <-chan time.Time {
+	if v.timeouts == nil {
+		v.timeouts = make(map[time.Duration]<-chan time.Time)
+	}
+	timeoutCh, ok := v.timeouts[delta]
+	if ok {
+		return timeoutCh
+	}
+
+	left := v.zero + delta - v.time.Now()
+	if left <= 0 {
+		timeout := make(chan time.Time)
+		close(timeout)
+		timeoutCh = timeout
+	} else {
+		timeoutCh = v.time.After(left)
+	}
+	v.timeouts[delta] = timeoutCh
+	return timeoutCh
+}
+
+// Encode implements Clock.Encode.
+func (v *Virtual) Encode() []byte {
+	return protocol.EncodeReflect(v.zero)
+}
+
+// Decode implements Clock.Decode.
+func (v *Virtual) Decode(data []byte) (Clock, error) {
+	var zero time.Duration
+	err := protocol.DecodeReflect(data, &zero)
+	return &Virtual{time: v.time, zero: zero}, err
+}
+
+func (v *Virtual) String() string {
+	return v.zero.String()
+}
+
+// Since returns the virtual time that has passed since the clock was last zeroed out.
+func (v *Virtual) Since() time.Duration {
+	return v.time.Now() - v.zero
+}
+
+// DeadlineMonitorAt returns a DeadlineMonitor that expires after the provided delta time from zero has passed.
+func (v *Virtual) DeadlineMonitorAt(at time.Duration) DeadlineMonitor {
+	return MakeMonotonicDeadlineMonitor(v, at)
+}
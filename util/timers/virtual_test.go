@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVirtualTimeOrdering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	vt := MakeVirtualTime()
+	var fired []int
+	vt.AfterFunc(30*time.Millisecond, func() { fired = append(fired, 3) })
+	vt.AfterFunc(10*time.Millisecond, func() {
+		fired = append(fired, 1)
+		// events scheduled by a callback are invoked within the same advance, if due.
+		vt.AfterFunc(5*time.Millisecond, func() { fired = append(fired, 2) })
+	})
+	vt.AfterFunc(30*time.Millisecond, func() { fired = append(fired, 4) })
+	vt.AfterFunc(50*time.Millisecond, func() { fired = append(fired, 5) })
+
+	vt.Advance(20 * time.Millisecond)
+	require.Equal(t, []int{1, 2}, fired)
+	require.Equal(t, 20*time.Millisecond, vt.Now())
+	require.Equal(t, 3, vt.Pending())
+
+	vt.Advance(20 * time.Millisecond)
+	require.Equal(t, []int{1, 2, 3, 4}, fired)
+	require.Equal(t, 1, vt.Pending())
+}
+
+func TestVirtualClock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	vt := MakeVirtualTime()
+	vt.Advance(time.Hour)
+	c := vt.Clock().Zero().(WallClock)
+
+	d := 100 * time.Millisecond
+	ch := c.TimeoutAt(d)
+	require.Equal(t, ch, c.TimeoutAt(d))
+	vt.Advance(d - 1)
+	require.False(t, polled(ch))
+	require.Equal(t, d-1, c.Since())
+
+	monitor := c.DeadlineMonitorAt(d)
+	require.False(t, monitor.Expired())
+	vt.Advance(1)
+	require.True(t, polled(ch))
+	require.True(t, monitor.Expired())
+
+	// past timeouts fire immediately.
+	require.True(t, polled(c.TimeoutAt(d/2)))
+
+	// a decoded clock produces the same timeouts as the original one.
+	decoded, err := c.Decode(c.Encode())
+	require.NoError(t, err)
+	require.True(t, polled(decoded.TimeoutAt(d)))
+	ch = decoded.TimeoutAt(2 * d)
+	require.False(t, polled(ch))
+	vt.Advance(d)
+	require.True(t, polled(ch))
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AlgodScopedTokensFilename is the file in the algod datadir holding the scoped API tokens
+const AlgodScopedTokensFilename = "algod.tokens.json"
+
+// RouteGroup names a set of algod REST routes that a scoped token may be allowed to access.
+type RouteGroup string
+
+// The route groups a scoped token can be granted.
+const (
+	// RouteGroupRead covers the read-only ledger and node status routes.
+	RouteGroupRead RouteGroup = "read"
+	// RouteGroupSubmit covers transaction submission.
+	RouteGroupSubmit RouteGroup = "submit"
+	// RouteGroupTeal covers TEAL compilation and dryrun.
+	RouteGroupTeal RouteGroup = "teal"
+	// RouteGroupAdmin covers the private routes and pprof. It implies all the other groups.
+	RouteGroupAdmin RouteGroup = "admin"
+)
+
+// AllRouteGroups lists every known route group.
+var AllRouteGroups = []RouteGroup{RouteGroupRead, RouteGroupSubmit, RouteGroupTeal, RouteGroupAdmin}
+
+// ParseRouteGroups converts a list of route group names into RouteGroups,
+// returning an error on any unknown name.
+func ParseRouteGroups(names []string) ([]RouteGroup, error) {
+	groups := make([]RouteGroup, 0, len(names))
+	for _, name := range names {
+		group := RouteGroup(strings.ToLower(strings.TrimSpace(name)))
+		known := false
+		for _, g := range AllRouteGroups {
+			if g == group {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown route group '%s'", name)
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("at least one route group is required")
+	}
+	return groups, nil
+}
+
+// ScopedToken is an API token restricted to some route groups. Only the hash
+// of the token is persisted; the token itself is shown once, when it is created.
+type ScopedToken struct {
+	Name      string       `json:"name"`
+	Hash      string       `json:"hash"`
+	Groups    []RouteGroup `json:"groups"`
+	Created   time.Time    `json:"created"`
+	Expires   *time.Time   `json:"expires,omitempty"`
+	RateLimit uint64       `json:"rateLimit,omitempty"` // requests per second, 0 for no limit
+}
+
+// Allows returns true if the token may access routes in the given group.
+func (st ScopedToken) Allows(group RouteGroup) bool {
+	for _, g := range st.Groups {
+		if g == group || g == RouteGroupAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired returns true if the token has an expiry time and it has passed.
+func (st ScopedToken) Expired(now time.Time) bool {
+	return st.Expires != nil && !now.Before(*st.Expires)
+}
+
+type scopedTokensFile struct {
+	Tokens []ScopedToken `json:"tokens"`
+}
+
+// HashAPIToken returns the hash under which a scoped token is stored.
+func HashAPIToken(apiToken string) string {
+	h := sha256.Sum256([]byte(apiToken))
+	return hex.EncodeToString(h[:])
+}
+
+// LoadScopedTokens reads the scoped tokens from the datadir. A missing
+// tokens file is not an error, and yields no tokens.
+func LoadScopedTokens(dataDir string) ([]ScopedToken, error) {
+	data, err := ioutil.ReadFile(tokenFilepath(dataDir, AlgodScopedTokensFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f scopedTokensFile
+	err = json.Unmarshal(data, &f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", AlgodScopedTokensFilename, err)
+	}
+	return f.Tokens, nil
+}
+
+// SaveScopedTokens replaces the scoped tokens in the datadir.
+func SaveScopedTokens(dataDir string, scoped []ScopedToken) error {
+	sort.Slice(scoped, func(i, j int) bool { return scoped[i].Name < scoped[j].Name })
+	data, err := json.MarshalIndent(scopedTokensFile{Tokens: scoped}, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file and rename it, so a running algod never reads a partial file
+	filename := tokenFilepath(dataDir, AlgodScopedTokensFilename)
+	tmpFilename := filename + ".tmp"
+	err = ioutil.WriteFile(tmpFilename, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+// CreateScopedToken generates a new scoped token, persists it to the datadir
+// and returns the token.
+func CreateScopedToken(dataDir, name string, groups []RouteGroup, expires *time.Time, rateLimit uint64) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("a token name is required")
+	}
+	if len(groups) == 0 {
+		return "", fmt.Errorf("at least one route group is required")
+	}
+
+	scoped, err := LoadScopedTokens(dataDir)
+	if err != nil {
+		return "", err
+	}
+	for _, st := range scoped {
+		if st.Name == name {
+			return "", fmt.Errorf("a token named '%s' already exists", name)
+		}
+	}
+
+	apiToken, err := generateAPIToken()
+	if err != nil {
+		return "", err
+	}
+
+	scoped = append(scoped, ScopedToken{
+		Name:      name,
+		Hash:      HashAPIToken(apiToken),
+		Groups:    groups,
+		Created:   time.Now().UTC(),
+		Expires:   expires,
+		RateLimit: rateLimit,
+	})
+	return apiToken, SaveScopedTokens(dataDir, scoped)
+}
+
+// RevokeScopedToken removes the named scoped token from the datadir.
+func RevokeScopedToken(dataDir, name string) error {
+	scoped, err := LoadScopedTokens(dataDir)
+	if err != nil {
+		return err
+	}
+	for i, st := range scoped {
+		if st.Name == name {
+			scoped = append(scoped[:i], scoped[i+1:]...)
+			return SaveScopedTokens(dataDir, scoped)
+		}
+	}
+	return fmt.Errorf("no token named '%s'", name)
+}
@@ -33,6 +33,9 @@ const (
 	AlgodTokenFilename      = "algod.token"
 	AlgodAdminTokenFilename = "algod.admin.token"
 	KmdTokenFilename        = "kmd.token"
+
+	// ParticipationSignerTokenFilename is the token authenticating the node against a remote participation signer
+	ParticipationSignerTokenFilename = "partsigner.token"
 )
 
 func tokenFilepath(dataDir, tokenFilename string) string {
@@ -64,6 +67,17 @@ func writeAPITokenToDisk(dataDir, tokenFilename, apiToken string) error {
 
 // GenerateAPIToken writes a cryptographically secure APIToken to disk
 func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
+	hexToken, err := generateAPIToken()
+	if err != nil {
+		return "", err
+	}
+
+	// Persist the token to disk
+	return hexToken, writeAPITokenToDisk(dataDir, tokenFilename, hexToken)
+}
+
+// generateAPIToken returns a cryptographically secure APIToken
+func generateAPIToken() (string, error) {
 	// Random bytes will be converted to hex to make token
 	var entropyLen = (minimumAPITokenLength + 1) / 2
 	tokenBytes := make([]byte, entropyLen)
@@ -82,9 +96,7 @@ func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("generated invalid token: %v", err)
 	}
-
-	// Persist the token to disk
-	return hexToken, writeAPITokenToDisk(dataDir, tokenFilename, hexToken)
+	return hexToken, nil
 }
 
 // ValidateAPIToken returns a non-nil error if the passed APIToken fails our